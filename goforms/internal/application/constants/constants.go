@@ -44,6 +44,7 @@ const (
 	PathForgotPassword = "/forgot-password"
 	PathResetPassword  = "/reset-password"
 	PathVerifyEmail    = "/verify-email"
+	PathOpenAPI        = "/openapi.json"
 
 	// Authenticated paths
 	PathDashboard = "/dashboard"
@@ -66,10 +67,19 @@ const (
 	PathAPIMetrics          = "/api/v1/metrics"
 	PathAPIForms            = "/api/v1/forms"
 	PathAPIFormsLaravel     = "/api/forms"
+	PathAPIWorkspaces       = "/api/workspaces"
+	PathAPIServerForms      = "/api/server/forms"
 	PathFormsPublic         = "/forms" // Public embed routes: /forms/:id/embed, schema, submit
 	PathAPIAdmin            = "/api/v1/admin"
 	PathAPIAdminUsers       = "/api/v1/admin/users"
 	PathAPIAdminForms       = "/api/v1/admin/forms"
+	PathAPIAdminConfig      = "/api/v1/admin/config"
+	// PathAPIAdminUserRole changes a user's role; the user's sessions end with the change
+	PathAPIAdminUserRole = "/api/v1/admin/users/:id/role"
+	// PathAPISessionsLogoutAll ends every session of the signed-in user
+	PathAPISessionsLogoutAll = "/api/v1/sessions/logout-all"
+	// PathAPISubmissionStream is the route of the live submission stream, a long-lived response
+	PathAPISubmissionStream = "/api/forms/:id/submissions/stream"
 
 	// Static asset paths
 	PathStatic    = "/static"
@@ -80,6 +90,8 @@ const (
 	PathFonts     = "/fonts"
 	PathFavicon   = "/favicon.ico"
 	PathRobotsTxt = "/robots.txt"
+	// PathEmbedAssets serves the versioned renderer bundle under the public /assets prefix
+	PathEmbedAssets = PathAssets + "/embed"
 
 	PathLoginPost  = "/login"
 	PathSignupPost = "/signup"
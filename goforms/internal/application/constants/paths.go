@@ -22,12 +22,15 @@ func NewPathManager() *PathManager {
 			PathSignup,
 			PathHealth,
 			PathMetrics,
+			PathOpenAPI,
 			PathForgotPassword,
 			PathResetPassword,
 			PathVerifyEmail,
 			PathAPIHealth,
 			PathAPIValidation,
 			PathAPIFormsLaravel, // Laravel assertion API: auth via X-User-Id/X-Signature on route group
+			PathAPIWorkspaces,   // Laravel assertion API for workspace management
+			PathAPIServerForms,  // Server-to-server API: auth via scoped API keys on route group
 		},
 		StaticPaths: []string{
 			PathStatic,
@@ -43,6 +46,8 @@ func NewPathManager() *PathManager {
 			PathAdmin,
 			PathAdminUsers,
 			PathAdminForms,
+			PathAPIAdminConfig,
+			PathAPIAdminUsers,
 		},
 		APIValidationPaths: []string{
 			PathAPIValidation,
@@ -0,0 +1,405 @@
+// Package formhtml renders stored Form.io schemas as plain, accessible HTML forms
+// for respondents who cannot run the JavaScript renderer, and decodes the
+// urlencoded submissions those forms post back into Form.io submission data.
+package formhtml
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/goformx/goforms/internal/application/validation"
+	"github.com/goformx/goforms/internal/domain/form/model"
+)
+
+// Node kinds in the rendered tree
+const (
+	KindField   = "field"
+	KindGroup   = "group"
+	KindColumns = "columns"
+)
+
+// Node is a renderable piece of the form: an input field, a group (panel or
+// fieldset) of child nodes, or a row of columns.
+type Node struct {
+	Kind     string
+	Field    *Field
+	Title    string
+	Children []Node
+	Columns  [][]Node
+}
+
+// Field is the view model of one input component
+type Field struct {
+	Type        string
+	Key         string
+	ID          string
+	Label       string
+	Description string
+	Placeholder string
+	Required    bool
+	Multiple    bool
+
+	// Value is the scalar value shown in text-like inputs
+	Value string
+	// Checked applies to single checkboxes
+	Checked bool
+	Options []Option
+
+	InputType string
+	MinLength int
+	MaxLength int
+	Min       string
+	Max       string
+
+	Errors []string
+}
+
+// Option is one choice of a select, radio or selectboxes component
+type Option struct {
+	Label    string
+	Value    string
+	Selected bool
+}
+
+// HasErrors reports whether the field failed validation
+func (f *Field) HasErrors() bool {
+	return len(f.Errors) > 0
+}
+
+// DescribedBy lists the element IDs describing the field, for aria-describedby
+func (f *Field) DescribedBy() string {
+	var ids []string
+
+	if f.Description != "" {
+		ids = append(ids, f.ID+"-description")
+	}
+
+	if f.HasErrors() {
+		ids = append(ids, f.ID+"-error")
+	}
+
+	return strings.Join(ids, " ")
+}
+
+// Build converts a form schema into renderable nodes. values holds what the
+// respondent posted, so a re-rendered form keeps their input exactly as typed;
+// it is nil on first render, when schema default values are used instead.
+// Errors are attached to the fields they belong to.
+func Build(schema model.JSON, values url.Values, errs []validation.Error) []Node {
+	components, _ := schema["components"].([]any)
+
+	errorsByField := make(map[string][]string)
+	for _, validationErr := range errs {
+		errorsByField[validationErr.Field] = append(errorsByField[validationErr.Field], validationErr.Message)
+	}
+
+	return buildNodes(components, values, errorsByField)
+}
+
+// SubmitLabel returns the label of the schema's submit button, if it has one
+func SubmitLabel(schema model.JSON) string {
+	components, _ := schema["components"].([]any)
+	for _, component := range components {
+		componentMap, ok := component.(map[string]any)
+		if !ok || stringProp(componentMap, "type") != "button" {
+			continue
+		}
+
+		if action := stringProp(componentMap, "action"); action == "" || action == "submit" {
+			return stringProp(componentMap, "label")
+		}
+	}
+
+	return ""
+}
+
+func buildNodes(components []any, values url.Values, errorsByField map[string][]string) []Node {
+	nodes := make([]Node, 0, len(components))
+
+	for _, component := range components {
+		componentMap, ok := component.(map[string]any)
+		if !ok || boolProp(componentMap, "hidden") {
+			continue
+		}
+
+		if node, built := buildNode(componentMap, values, errorsByField); built {
+			nodes = append(nodes, node)
+		}
+	}
+
+	return nodes
+}
+
+func buildNode(component map[string]any, values url.Values, errorsByField map[string][]string) (Node, bool) {
+	switch stringProp(component, "type") {
+	case "panel", "fieldset", "well":
+		children, _ := component["components"].([]any)
+		title := stringProp(component, "title")
+		if title == "" {
+			title = stringProp(component, "legend")
+		}
+
+		return Node{Kind: KindGroup, Title: title, Children: buildNodes(children, values, errorsByField)}, true
+	case "columns":
+		columns, _ := component["columns"].([]any)
+		node := Node{Kind: KindColumns}
+
+		for _, column := range columns {
+			columnMap, ok := column.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			children, _ := columnMap["components"].([]any)
+			node.Columns = append(node.Columns, buildNodes(children, values, errorsByField))
+		}
+
+		return node, true
+	case "button", "htmlelement", "content", "hidden":
+		// Our own submit button replaces the schema's; free-form HTML and hidden values are never rendered
+		return Node{}, false
+	}
+
+	// Only components that take input are rendered; anything unrecognised becomes a text input
+	key := stringProp(component, "key")
+	if key == "" || component["input"] == false {
+		return Node{}, false
+	}
+
+	field := buildField(component, key, values)
+	field.Errors = errorsByField[key]
+
+	return Node{Kind: KindField, Field: field}, true
+}
+
+func buildField(component map[string]any, key string, values url.Values) *Field {
+	validate, _ := component["validate"].(map[string]any)
+
+	field := &Field{
+		Type:        stringProp(component, "type"),
+		Key:         key,
+		ID:          "gfx-" + key,
+		Label:       stringProp(component, "label"),
+		Description: stringProp(component, "description"),
+		Placeholder: stringProp(component, "placeholder"),
+		Required:    boolProp(validate, "required"),
+		Multiple:    boolProp(component, "multiple"),
+		MinLength:   intProp(validate, "minLength"),
+		MaxLength:   intProp(validate, "maxLength"),
+		Min:         numberProp(validate, "min"),
+		Max:         numberProp(validate, "max"),
+	}
+
+	if field.Label == "" {
+		field.Label = key
+	}
+
+	posted, wasPosted := values[key]
+	if values == nil {
+		posted, wasPosted = defaultValues(component["defaultValue"])
+	}
+
+	switch field.Type {
+	case "textarea":
+	case "email":
+		field.InputType = "email"
+	case "number":
+		field.InputType = "number"
+	case "checkbox":
+		field.Checked = wasPosted && len(posted) > 0 && posted[0] == "true"
+	case "select":
+		field.Options = selectedOptions(componentOptions(component), posted)
+	case "radio":
+		field.Options = selectedOptions(componentOptions(component), posted)
+	case "selectboxes":
+		field.Multiple = true
+		field.Options = selectedOptions(componentOptions(component), posted)
+	default:
+		field.Type = "textfield"
+		field.InputType = "text"
+	}
+
+	if len(posted) > 0 {
+		field.Value = posted[0]
+	}
+
+	return field
+}
+
+// Decode converts posted urlencoded values into submission data typed the way the
+// Form.io renderer would submit them: numbers as float64, checkboxes as bool,
+// selectboxes as an object of option flags and multi-selects as lists. A number
+// that does not parse is kept as text so validation reports it.
+func Decode(schema model.JSON, values url.Values) model.JSON {
+	data := model.JSON{}
+
+	components, ok := validation.NewSchemaParser().ExtractInputComponents(schema)
+	if !ok {
+		return data
+	}
+
+	for _, component := range components {
+		key := stringProp(component, "key")
+		if key == "" || component["input"] == false {
+			continue
+		}
+
+		posted := values[key]
+
+		switch stringProp(component, "type") {
+		case "button", "htmlelement", "content", "hidden":
+			continue
+		case "checkbox":
+			data[key] = len(posted) > 0 && posted[0] == "true"
+		case "selectboxes":
+			flags := make(map[string]any)
+			for _, option := range componentOptions(component) {
+				flags[option.Value] = contains(posted, option.Value)
+			}
+
+			data[key] = flags
+		case "number":
+			if len(posted) == 0 || strings.TrimSpace(posted[0]) == "" {
+				continue
+			}
+
+			if number, err := strconv.ParseFloat(strings.TrimSpace(posted[0]), 64); err == nil {
+				data[key] = number
+			} else {
+				data[key] = posted[0]
+			}
+		case "select":
+			if boolProp(component, "multiple") {
+				list := make([]any, 0, len(posted))
+				for _, value := range posted {
+					list = append(list, value)
+				}
+
+				data[key] = list
+
+				continue
+			}
+
+			data[key] = first(posted)
+		default:
+			data[key] = first(posted)
+		}
+	}
+
+	return data
+}
+
+// componentOptions reads select options from data.values and radio/selectboxes options from values
+func componentOptions(component map[string]any) []Option {
+	values, _ := component["values"].([]any)
+	if data, ok := component["data"].(map[string]any); ok {
+		if dataValues, dataOk := data["values"].([]any); dataOk {
+			values = dataValues
+		}
+	}
+
+	options := make([]Option, 0, len(values))
+
+	for _, value := range values {
+		valueMap, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		option := Option{Label: stringProp(valueMap, "label"), Value: stringProp(valueMap, "value")}
+		if option.Label == "" {
+			option.Label = option.Value
+		}
+
+		options = append(options, option)
+	}
+
+	return options
+}
+
+// selectedOptions marks the options whose values were posted or defaulted
+func selectedOptions(options []Option, selected []string) []Option {
+	for i := range options {
+		options[i].Selected = contains(selected, options[i].Value)
+	}
+
+	return options
+}
+
+// defaultValues converts a schema defaultValue into the posted-value form Build works with
+func defaultValues(value any) ([]string, bool) {
+	switch val := value.(type) {
+	case string:
+		return []string{val}, val != ""
+	case bool:
+		return []string{strconv.FormatBool(val)}, val
+	case float64:
+		return []string{strconv.FormatFloat(val, 'f', -1, 64)}, true
+	case []any:
+		var list []string
+		for _, item := range val {
+			if s, ok := item.(string); ok {
+				list = append(list, s)
+			}
+		}
+
+		return list, len(list) > 0
+	case map[string]any:
+		var list []string
+		for option, ticked := range val {
+			if ticked == true {
+				list = append(list, option)
+			}
+		}
+
+		return list, len(list) > 0
+	}
+
+	return nil, false
+}
+
+func first(values []string) string {
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
+
+func stringProp(m map[string]any, key string) string {
+	s, _ := m[key].(string)
+
+	return s
+}
+
+func boolProp(m map[string]any, key string) bool {
+	b, _ := m[key].(bool)
+
+	return b
+}
+
+func intProp(m map[string]any, key string) int {
+	f, _ := m[key].(float64)
+
+	return int(f)
+}
+
+func numberProp(m map[string]any, key string) string {
+	f, ok := m[key].(float64)
+	if !ok {
+		return ""
+	}
+
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
@@ -0,0 +1,138 @@
+package formhtml_test
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goformx/goforms/internal/application/formhtml"
+	"github.com/goformx/goforms/internal/application/validation"
+	"github.com/goformx/goforms/internal/domain/form/model"
+)
+
+func option(value string) map[string]any {
+	return map[string]any{"label": strings.ToUpper(value), "value": value}
+}
+
+func testSchema() model.JSON {
+	return model.JSON{
+		"components": []any{
+			map[string]any{
+				"type": "panel", "key": "about", "title": "About you",
+				"components": []any{
+					map[string]any{"type": "textfield", "key": "name", "label": "Name", "input": true,
+						"validate": map[string]any{"required": true}},
+					map[string]any{"type": "number", "key": "age", "label": "Age", "input": true},
+				},
+			},
+			map[string]any{
+				"type": "columns", "key": "cols",
+				"columns": []any{
+					map[string]any{"components": []any{
+						map[string]any{"type": "select", "key": "color", "label": "Color", "input": true,
+							"data": map[string]any{"values": []any{option("red"), option("blue")}}},
+					}},
+					map[string]any{"components": []any{
+						map[string]any{"type": "radio", "key": "size", "label": "Size", "input": true,
+							"values": []any{option("s"), option("m")}},
+					}},
+				},
+			},
+			map[string]any{"type": "selectboxes", "key": "topics", "label": "Topics", "input": true,
+				"values": []any{option("news"), option("offers")}},
+			map[string]any{"type": "checkbox", "key": "agree", "label": "I agree", "input": true},
+			map[string]any{"type": "textarea", "key": "notes", "label": "Notes", "input": true},
+			map[string]any{"type": "hidden", "key": "secret", "input": true, "hidden": true},
+			map[string]any{"type": "button", "key": "submit", "label": "Go", "action": "submit"},
+		},
+	}
+}
+
+func TestDecode_TypesValuesLikeTheRenderer(t *testing.T) {
+	data := formhtml.Decode(testSchema(), url.Values{
+		"name":   {"Ada"},
+		"age":    {"36"},
+		"color":  {"blue"},
+		"size":   {"m"},
+		"topics": {"offers"},
+		"agree":  {"true"},
+	})
+
+	assert.Equal(t, "Ada", data["name"])
+	assert.InDelta(t, 36.0, data["age"], 0)
+	assert.Equal(t, "blue", data["color"])
+	assert.Equal(t, "m", data["size"])
+	assert.Equal(t, map[string]any{"news": false, "offers": true}, data["topics"])
+	assert.Equal(t, true, data["agree"])
+	assert.Equal(t, "", data["notes"])
+	assert.NotContains(t, data, "submit")
+}
+
+func TestDecode_KeepsUnparseableNumbersForValidation(t *testing.T) {
+	data := formhtml.Decode(testSchema(), url.Values{"age": {"old"}})
+	assert.Equal(t, "old", data["age"])
+	assert.Equal(t, false, data["agree"], "unticked checkboxes are false")
+
+	empty := formhtml.Decode(testSchema(), url.Values{"age": {""}})
+	assert.NotContains(t, empty, "age")
+}
+
+func TestBuild_NestsLayoutAndAttachesErrors(t *testing.T) {
+	nodes := formhtml.Build(testSchema(), url.Values{"color": {"red"}, "topics": {"news"}},
+		[]validation.Error{{Field: "name", Message: "Name is required"}})
+
+	require.Len(t, nodes, 5, "hidden and button components are not rendered")
+
+	panel := nodes[0]
+	assert.Equal(t, formhtml.KindGroup, panel.Kind)
+	assert.Equal(t, "About you", panel.Title)
+	require.Len(t, panel.Children, 2)
+	assert.Equal(t, []string{"Name is required"}, panel.Children[0].Field.Errors)
+	assert.Equal(t, "gfx-name-error", panel.Children[0].Field.DescribedBy())
+
+	columns := nodes[1]
+	assert.Equal(t, formhtml.KindColumns, columns.Kind)
+	require.Len(t, columns.Columns, 2)
+	color := columns.Columns[0][0].Field
+	assert.Equal(t, []formhtml.Option{{Label: "RED", Value: "red", Selected: true}, {Label: "BLUE", Value: "blue"}}, color.Options)
+
+	topics := nodes[2].Field
+	assert.True(t, topics.Options[0].Selected)
+	assert.False(t, topics.Options[1].Selected)
+
+	assert.Equal(t, "Go", formhtml.SubmitLabel(testSchema()))
+}
+
+func TestBuild_UsesDefaultValuesOnFirstRender(t *testing.T) {
+	schema := model.JSON{"components": []any{
+		map[string]any{"type": "textfield", "key": "city", "input": true, "defaultValue": "Paris"},
+		map[string]any{"type": "checkbox", "key": "subscribe", "input": true, "defaultValue": true},
+	}}
+
+	nodes := formhtml.Build(schema, nil, nil)
+	require.Len(t, nodes, 2)
+	assert.Equal(t, "Paris", nodes[0].Field.Value)
+	assert.Equal(t, "city", nodes[0].Field.Label, "the key labels unlabelled fields")
+	assert.True(t, nodes[1].Field.Checked)
+}
+
+func TestRender_AccessibleMarkup(t *testing.T) {
+	nodes := formhtml.Build(testSchema(), url.Values{"name": {`<b>"x"</b>`}},
+		[]validation.Error{{Field: "name", Message: "Name is required"}})
+
+	body, err := formhtml.Render(formhtml.NewPage("Survey", "/forms/f/submit", nodes))
+	require.NoError(t, err)
+
+	page := string(body)
+	assert.Contains(t, page, `<title>Error: Survey</title>`)
+	assert.Contains(t, page, `<a href="#gfx-name">Name: Name is required</a>`)
+	assert.Contains(t, page, `aria-describedby="gfx-name-error"`)
+	assert.Contains(t, page, `aria-required="true"`)
+	assert.Contains(t, page, `<legend>About you</legend>`)
+	assert.Contains(t, page, `<legend>Size</legend>`)
+	assert.Contains(t, page, `name="topics"`)
+	assert.NotContains(t, page, `<b>"x"</b>`, "posted values are escaped")
+}
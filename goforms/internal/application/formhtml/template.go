@@ -0,0 +1,195 @@
+package formhtml
+
+import (
+	"bytes"
+	"fmt"
+	"html/template"
+)
+
+// Page is the data rendered into the form page
+type Page struct {
+	Title string
+	// Action is the URL the form posts to
+	Action string
+	// StyleURL and StyleIntegrity reference the page stylesheet
+	StyleURL       string
+	StyleIntegrity string
+	SubmitLabel    string
+	Nodes          []Node
+	// Errors lists every field error for the summary at the top of the form
+	Errors []SummaryError
+	// Message is a form-level error not tied to a field
+	Message string
+	// Submitted shows the confirmation instead of the form
+	Submitted bool
+	// IdempotencyField and IdempotencyKey, when set, post a key with the form so a resubmitted
+	// post is answered with the first response instead of being stored twice
+	IdempotencyField string
+	IdempotencyKey   string
+}
+
+// SummaryError links an entry of the error summary to the invalid field
+type SummaryError struct {
+	FieldID string
+	Label   string
+	Message string
+}
+
+// NewPage assembles a page for the nodes, collecting their errors into the summary
+func NewPage(title, action string, nodes []Node) *Page {
+	page := &Page{Title: title, Action: action, Nodes: nodes, SubmitLabel: "Submit"}
+	collectErrors(nodes, &page.Errors)
+
+	return page
+}
+
+func collectErrors(nodes []Node, summary *[]SummaryError) {
+	for _, node := range nodes {
+		switch node.Kind {
+		case KindField:
+			for _, message := range node.Field.Errors {
+				*summary = append(*summary, SummaryError{FieldID: node.Field.ID, Label: node.Field.Label, Message: message})
+			}
+		case KindGroup:
+			collectErrors(node.Children, summary)
+		case KindColumns:
+			for _, column := range node.Columns {
+				collectErrors(column, summary)
+			}
+		}
+	}
+}
+
+// Render writes the page as a complete HTML document
+func Render(page *Page) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := pageTemplate.Execute(&buf, page); err != nil {
+		return nil, fmt.Errorf("render form page: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// pageTemplate renders plain HTML controls with native labels and fieldsets, so the form
+// works with assistive technology and without any script. Errors are announced through
+// the summary and tied to their inputs with aria-describedby.
+var pageTemplate = template.Must(template.New("page").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+  <title>{{if .Errors}}Error: {{end}}{{.Title}}</title>
+  {{- if .StyleURL}}
+  <link rel="stylesheet" href="{{.StyleURL}}"{{with .StyleIntegrity}} integrity="{{.}}"{{end}}>
+  {{- end}}
+</head>
+<body>
+<main class="goformx-form">
+  <h1>{{.Title}}</h1>
+  {{- if .Submitted}}
+  <div class="goformx-success" role="status">
+    <p>Thank you, your response has been submitted.</p>
+  </div>
+  {{- else}}
+  {{- if or .Errors .Message}}
+  <div class="goformx-summary" role="alert" aria-labelledby="gfx-summary-title" tabindex="-1">
+    <h2 id="gfx-summary-title">There is a problem</h2>
+    {{- with .Message}}
+    <p>{{.}}</p>
+    {{- end}}
+    {{- if .Errors}}
+    <ul>
+      {{- range .Errors}}
+      <li><a href="#{{.FieldID}}">{{.Label}}: {{.Message}}</a></li>
+      {{- end}}
+    </ul>
+    {{- end}}
+  </div>
+  {{- end}}
+  <form method="post" action="{{.Action}}" enctype="application/x-www-form-urlencoded" novalidate>
+    {{- if .IdempotencyKey}}
+    <input type="hidden" name="{{.IdempotencyField}}" value="{{.IdempotencyKey}}">
+    {{- end}}
+    {{- template "nodes" .Nodes}}
+    <button type="submit" class="goformx-submit">{{.SubmitLabel}}</button>
+  </form>
+  {{- end}}
+</main>
+</body>
+</html>
+{{define "nodes"}}{{range .}}{{template "node" .}}{{end}}{{end}}
+{{define "node"}}
+{{- if eq .Kind "group"}}
+<fieldset class="goformx-group">
+  {{- with .Title}}
+  <legend>{{.}}</legend>
+  {{- end}}
+  {{- template "nodes" .Children}}
+</fieldset>
+{{- else if eq .Kind "columns"}}
+<div class="goformx-columns">
+  {{- range .Columns}}
+  <div class="goformx-column">{{template "nodes" .}}</div>
+  {{- end}}
+</div>
+{{- else}}{{template "field" .Field}}{{end}}
+{{- end}}
+{{define "required"}}{{if .Required}} <span class="goformx-required" aria-hidden="true">*</span>{{end}}{{end}}
+{{define "aria"}}{{if .Required}} aria-required="true"{{end}}{{if .HasErrors}} aria-invalid="true"{{end}}
+{{- with .DescribedBy}} aria-describedby="{{.}}"{{end}}{{end}}
+{{define "help"}}
+{{- with .Description}}
+  <p class="goformx-description" id="{{$.ID}}-description">{{.}}</p>
+{{- end}}
+{{- if .HasErrors}}
+  <p class="goformx-error" id="{{.ID}}-error">{{range $i, $e := .Errors}}{{if $i}} {{end}}{{$e}}{{end}}</p>
+{{- end}}
+{{- end}}
+{{define "field"}}
+<div class="goformx-field">
+{{- if or (eq .Type "radio") (eq .Type "selectboxes")}}
+  <fieldset id="{{.ID}}"{{template "aria" .}}>
+    <legend>{{.Label}}{{template "required" .}}</legend>
+    {{- $field := .}}
+    {{- range $i, $option := .Options}}
+    <label class="goformx-option" for="{{$field.ID}}-{{$i}}">
+      <input type="{{if eq $field.Type "radio"}}radio{{else}}checkbox{{end}}" id="{{$field.ID}}-{{$i}}" name="{{$field.Key}}"
+        value="{{$option.Value}}"{{if $option.Selected}} checked{{end}}{{if and $field.Required (eq $field.Type "radio")}} required{{end}}>
+      {{$option.Label}}
+    </label>
+    {{- end}}
+    {{- template "help" .}}
+  </fieldset>
+{{- else if eq .Type "checkbox"}}
+  <label class="goformx-option" for="{{.ID}}">
+    <input type="checkbox" id="{{.ID}}" name="{{.Key}}" value="true"{{if .Checked}} checked{{end}}
+      {{- if .Required}} required{{end}}{{template "aria" .}}>
+    {{.Label}}{{template "required" .}}
+  </label>
+  {{- template "help" .}}
+{{- else}}
+  <label for="{{.ID}}">{{.Label}}{{template "required" .}}</label>
+  {{- if eq .Type "textarea"}}
+  <textarea id="{{.ID}}" name="{{.Key}}" rows="4"{{with .Placeholder}} placeholder="{{.}}"{{end}}
+    {{- if .MinLength}} minlength="{{.MinLength}}"{{end}}{{if .MaxLength}} maxlength="{{.MaxLength}}"{{end}}
+    {{- if .Required}} required{{end}}{{template "aria" .}}>{{.Value}}</textarea>
+  {{- else if eq .Type "select"}}
+  <select id="{{.ID}}" name="{{.Key}}"{{if .Multiple}} multiple{{end}}{{if .Required}} required{{end}}{{template "aria" .}}>
+    {{- if not .Multiple}}
+    <option value="">{{with .Placeholder}}{{.}}{{else}}Select an option{{end}}</option>
+    {{- end}}
+    {{- range .Options}}
+    <option value="{{.Value}}"{{if .Selected}} selected{{end}}>{{.Label}}</option>
+    {{- end}}
+  </select>
+  {{- else}}
+  <input type="{{.InputType}}" id="{{.ID}}" name="{{.Key}}" value="{{.Value}}"{{with .Placeholder}} placeholder="{{.}}"{{end}}
+    {{- if .MinLength}} minlength="{{.MinLength}}"{{end}}{{if .MaxLength}} maxlength="{{.MaxLength}}"{{end}}
+    {{- with .Min}} min="{{.}}"{{end}}{{with .Max}} max="{{.}}"{{end}}{{if eq .InputType "number"}} step="any"{{end}}
+    {{- if .Required}} required{{end}}{{template "aria" .}}>
+  {{- end}}
+  {{- template "help" .}}
+{{- end}}
+</div>
+{{- end}}
+`))
@@ -0,0 +1,50 @@
+package web
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/goformx/goforms/internal/application/constants"
+	ctxmw "github.com/goformx/goforms/internal/application/middleware/context"
+	"github.com/goformx/goforms/internal/application/response"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+)
+
+// AdminConfigHandler shows administrators the configuration the server is running with
+type AdminConfigHandler struct {
+	*BaseHandler
+}
+
+// NewAdminConfigHandler creates a new AdminConfigHandler
+func NewAdminConfigHandler(base *BaseHandler) *AdminConfigHandler {
+	return &AdminConfigHandler{BaseHandler: base}
+}
+
+// adminConfigResponse is the effective security configuration with its secrets redacted
+type adminConfigResponse struct {
+	Security   config.SecurityConfig `json:"security"`
+	LoadedAt   time.Time             `json:"loaded_at"`
+	Reloadable []string              `json:"reloadable"`
+}
+
+// RegisterRoutes registers the admin configuration routes
+func (h *AdminConfigHandler) RegisterRoutes(e *echo.Echo) {
+	e.GET(constants.PathAPIAdminConfig, h.handleGetConfig)
+}
+
+// GET /api/v1/admin/config - the security configuration in effect, including reloaded changes
+func (h *AdminConfigHandler) handleGetConfig(c echo.Context) error {
+	// The access rules already limit the path to admins; the check guards against the rules drifting
+	if !ctxmw.IsAdmin(c) {
+		return h.HandleForbidden(c, "Admin access required")
+	}
+
+	security, loadedAt := h.securityPolicy().Snapshot()
+
+	return response.Success(c, adminConfigResponse{
+		Security:   security.Redacted(),
+		LoadedAt:   loadedAt,
+		Reloadable: config.ReloadableSecuritySections,
+	})
+}
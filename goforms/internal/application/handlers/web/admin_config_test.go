@@ -0,0 +1,71 @@
+package web //nolint:testpackage // internal test for unexported handler methods
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	ctxmw "github.com/goformx/goforms/internal/application/middleware/context"
+	"github.com/goformx/goforms/internal/application/response"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	mocklogging "github.com/goformx/goforms/test/mocks/logging"
+	mocksanitization "github.com/goformx/goforms/test/mocks/sanitization"
+)
+
+// serveAdminConfig requests the admin configuration as a user with the given role
+func serveAdminConfig(t *testing.T, role string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+
+	cfg := &config.Config{Security: config.SecurityConfig{
+		CSRF:      config.CSRFConfig{Secret: "csrf-secret-that-is-at-least-32-characters"},
+		CORS:      config.CORSConfig{AllowedOrigins: []string{"https://app.example"}},
+		Assertion: config.AssertionConfig{Secret: "assertion-secret-that-is-at-least-32-chars"},
+		APIKey:    config.APIKeyConfig{Keys: []string{"configured-api-key"}},
+	}}
+
+	handler := NewAdminConfigHandler(&BaseHandler{
+		Logger:         logger,
+		Config:         cfg,
+		ErrorHandler:   response.NewErrorHandler(logger, mocksanitization.NewMockService(ctrl)),
+		SecurityPolicy: config.NewSecurityPolicy(cfg),
+	})
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/api/v1/admin/config", http.NoBody), rec)
+	ctxmw.SetUserID(c, "1")
+	ctxmw.SetRole(c, role)
+
+	require.NoError(t, handler.handleGetConfig(c))
+
+	return rec
+}
+
+func TestAdminConfig_RedactsSecrets(t *testing.T) {
+	rec := serveAdminConfig(t, "admin")
+
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	body := rec.Body.String()
+	assert.Contains(t, body, `"allowed_origins":["https://app.example"]`)
+	assert.Contains(t, body, `"reloadable":["cors"`)
+	assert.Contains(t, body, "[REDACTED]")
+	assert.NotContains(t, body, "csrf-secret-that-is-at-least-32-characters")
+	assert.NotContains(t, body, "assertion-secret-that-is-at-least-32-chars")
+	assert.NotContains(t, body, "configured-api-key")
+}
+
+func TestAdminConfig_RequiresAdmin(t *testing.T) {
+	rec := serveAdminConfig(t, "user")
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+	assert.NotContains(t, rec.Body.String(), "allowed_origins")
+}
@@ -26,6 +26,8 @@ type BaseHandler struct {
 	FormService    form.Service
 	SessionManager *session.Manager
 	ErrorHandler   response.ErrorHandlerInterface
+	// SecurityPolicy supplies the reloadable security settings; when nil they are fixed at startup
+	SecurityPolicy *config.SecurityPolicy
 }
 
 // NewBaseHandler creates a new base handler with common dependencies
@@ -36,6 +38,7 @@ func NewBaseHandler(
 	formService form.Service,
 	sessionManager *session.Manager,
 	errorHandler response.ErrorHandlerInterface,
+	securityPolicy *config.SecurityPolicy,
 ) *BaseHandler {
 	return &BaseHandler{
 		Logger:         logger,
@@ -44,9 +47,28 @@ func NewBaseHandler(
 		FormService:    formService,
 		SessionManager: sessionManager,
 		ErrorHandler:   errorHandler,
+		SecurityPolicy: securityPolicy,
 	}
 }
 
+// Security returns the security configuration in effect for the current request
+func (h *BaseHandler) Security() *config.SecurityConfig {
+	if h.SecurityPolicy == nil {
+		return &h.Config.Security
+	}
+
+	return h.SecurityPolicy.Current()
+}
+
+// securityPolicy returns the policy middleware read their security settings from
+func (h *BaseHandler) securityPolicy() *config.SecurityPolicy {
+	if h.SecurityPolicy == nil {
+		return config.NewSecurityPolicy(h.Config)
+	}
+
+	return h.SecurityPolicy
+}
+
 // RequireAuthenticatedUser ensures the user is authenticated and returns the user object
 func (h *BaseHandler) RequireAuthenticatedUser(c echo.Context) (*entities.User, error) {
 	userID, ok := mwcontext.GetUserID(c)
@@ -76,7 +98,9 @@ func (h *BaseHandler) HandleError(c echo.Context, err error, message string) err
 
 // HandleNotFound handles not found errors
 func (h *BaseHandler) HandleNotFound(c echo.Context, message string) error {
-	if notFoundErr := h.ErrorHandler.HandleNotFoundError(message, c); notFoundErr != nil {
+	if notFoundErr := h.ErrorHandler.HandleDomainError(
+		domainerrors.New(domainerrors.ErrCodeNotFound, message, nil), c,
+	); notFoundErr != nil {
 		return fmt.Errorf("handle not found error: %w", notFoundErr)
 	}
 
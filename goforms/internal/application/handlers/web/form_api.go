@@ -5,7 +5,6 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
-	"regexp"
 	"strconv"
 	"strings"
 	"time"
@@ -14,15 +13,24 @@ import (
 
 	"github.com/goformx/goforms/internal/application/constants"
 	"github.com/goformx/goforms/internal/application/middleware/access"
+	apikeymw "github.com/goformx/goforms/internal/application/middleware/apikey"
 	"github.com/goformx/goforms/internal/application/middleware/assertion"
 	ctxmw "github.com/goformx/goforms/internal/application/middleware/context"
+	"github.com/goformx/goforms/internal/application/middleware/idempotency"
 	"github.com/goformx/goforms/internal/application/middleware/security"
 	"github.com/goformx/goforms/internal/application/response"
+	"github.com/goformx/goforms/internal/application/stream"
 	"github.com/goformx/goforms/internal/application/validation"
-	domainerrors "github.com/goformx/goforms/internal/domain/common/errors"
+	"github.com/goformx/goforms/internal/domain/apikey"
+	"github.com/goformx/goforms/internal/domain/audit"
+	"github.com/goformx/goforms/internal/domain/bulk"
 	formdomain "github.com/goformx/goforms/internal/domain/form"
 	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/domain/review"
 	"github.com/goformx/goforms/internal/domain/user"
+	"github.com/goformx/goforms/internal/domain/workspace"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/renderer"
 	"github.com/goformx/goforms/internal/infrastructure/sanitization"
 )
 
@@ -37,6 +45,14 @@ type FormAPIHandler struct {
 	FormServiceHandler     *FormService
 	AssertionMiddleware    *assertion.Middleware
 	UserEnsurer            user.UserEnsurer
+	AuditService           audit.Service
+	APIKeys                apikey.Service
+	APIKeyMiddleware       *apikeymw.Middleware
+	Renderer               *renderer.Bundle
+	BulkJobs               bulk.Service
+	Reviews                review.Service
+	Streams                *stream.Hub
+	Idempotency            *idempotency.Middleware
 }
 
 // NewFormAPIHandler creates a new FormAPIHandler.
@@ -47,6 +63,9 @@ func NewFormAPIHandler(
 	formValidator *validation.FormValidator,
 	sanitizer sanitization.ServiceInterface,
 	userEnsurer user.UserEnsurer,
+	auditService audit.Service,
+	workspaces workspace.Service,
+	apiKeys apikey.Service,
 ) *FormAPIHandler {
 	// Create dependencies
 	requestProcessor := NewFormRequestProcessor(sanitizer, formValidator, base.Logger)
@@ -54,10 +73,18 @@ func NewFormAPIHandler(
 	errorHandler := NewFormErrorHandler(responseBuilder)
 	comprehensiveValidator := validation.NewComprehensiveValidator()
 	formServiceHandler := NewFormService(formService, base.Logger)
-	assertionMiddleware := assertion.NewMiddleware(base.Config, base.Logger)
+	assertionMiddleware := assertion.NewMiddleware(base.Config, base.Logger).WithPolicy(base.securityPolicy())
+
+	formBase := NewFormBaseHandler(base, formService, formValidator)
+	formBase.Workspaces = workspaces
+
+	var apiKeyMiddleware *apikeymw.Middleware
+	if apiKeys != nil {
+		apiKeyMiddleware = apikeymw.NewMiddleware(base.Config, apiKeys, base.Logger).WithPolicy(base.securityPolicy())
+	}
 
 	return &FormAPIHandler{
-		FormBaseHandler:        NewFormBaseHandler(base, formService, formValidator),
+		FormBaseHandler:        formBase,
 		AccessManager:          accessManager,
 		RequestProcessor:       requestProcessor,
 		ResponseBuilder:        responseBuilder,
@@ -66,6 +93,9 @@ func NewFormAPIHandler(
 		FormServiceHandler:     formServiceHandler,
 		AssertionMiddleware:    assertionMiddleware,
 		UserEnsurer:            userEnsurer,
+		AuditService:           auditService,
+		APIKeys:                apiKeys,
+		APIKeyMiddleware:       apiKeyMiddleware,
 	}
 }
 
@@ -76,6 +106,9 @@ func (h *FormAPIHandler) RegisterRoutes(e *echo.Echo) {
 
 	// Public /forms routes for embed (schema, validation, submit, embed HTML)
 	h.RegisterPublicFormsRoutes(e)
+
+	// Server-to-server /api/server/forms routes authenticated by scoped API keys
+	h.RegisterServerRoutes(e)
 }
 
 // RegisterLaravelRoutes registers /api/forms routes with assertion middleware for Laravel proxy.
@@ -85,54 +118,67 @@ func (h *FormAPIHandler) RegisterLaravelRoutes(e *echo.Echo) {
 	formsLaravel.Use(h.ensureUserMiddleware())
 
 	formsLaravel.GET("", h.handleListForms)
-	formsLaravel.POST("", h.handleCreateForm)
+	formsLaravel.POST("", h.handleCreateForm, h.idempotent()...)
 
 	// Usage endpoints — registered before /:id to avoid parameter conflict
 	formsLaravel.GET("/usage/forms-count", h.handleFormsCount)
 	formsLaravel.GET("/usage/submissions-count", h.handleSubmissionsCount)
 
+	// Audit log endpoints — also registered before /:id
+	formsLaravel.GET("/audit", h.handleListAuditLog)
+	formsLaravel.GET("/audit/verify", h.handleVerifyAuditLog)
+
 	formsLaravel.GET("/:id", h.handleGetForm)
 	formsLaravel.PUT("/:id", h.handleUpdateForm)
 	formsLaravel.DELETE("/:id", h.handleDeleteForm)
 	formsLaravel.GET("/:id/submissions", h.handleListSubmissions)
+	h.registerBulkRoutes(formsLaravel)
+	h.registerReviewRoutes(formsLaravel)
+	h.registerStreamRoutes(formsLaravel)
 	formsLaravel.GET("/:id/submissions/:sid", h.handleGetSubmission)
+	formsLaravel.GET("/:id/audit", h.handleFormAuditLog)
+	formsLaravel.GET("/:id/api-keys", h.handleListFormAPIKeys)
+	formsLaravel.POST("/:id/api-keys", h.handleCreateFormAPIKey)
+	formsLaravel.DELETE("/:id/api-keys/:keyId", h.handleRevokeFormAPIKey)
 }
 
 // ensureUserMiddleware returns middleware that lazily syncs the Laravel user to a Go shadow row.
 // Runs after assertion verification so user_id is available in the context.
 func (h *FormAPIHandler) ensureUserMiddleware() echo.MiddlewareFunc {
-	return func(next echo.HandlerFunc) echo.HandlerFunc {
-		return func(c echo.Context) error {
-			userID, ok := c.Get("user_id").(string)
-			if !ok {
-				return next(c)
-			}
-			if err := h.UserEnsurer.EnsureUser(c.Request().Context(), userID); err != nil {
-				h.Logger.Error("failed to ensure Laravel user",
-					"user_id", h.Logger.SanitizeField("user_id", userID), "error", err)
-				return h.HandleError(c, err, "Failed to ensure user")
-			}
-			return next(c)
-		}
-	}
+	return newEnsureUserMiddleware(h.BaseHandler, h.UserEnsurer)
 }
 
 // RegisterPublicFormsRoutes registers public routes at /forms/:id/... for cleaner embed URLs.
 // These routes bypass the /api/v1 prefix and are intended for cross-origin embedding.
 func (h *FormAPIHandler) RegisterPublicFormsRoutes(e *echo.Echo) {
 	formsPublic := e.Group(constants.PathFormsPublic)
-	formsPublic.Use(NewFormCORSMiddleware(h.FormService, h.Config.Security.CORS))
+	formsPublic.Use(newFormCORSMiddleware(h.FormService, func() config.CORSConfig { return h.Security().CORS }))
 
-	// Apply API key middleware if enabled (same as /api/v1/forms)
-	if h.Config.Security.APIKey.Enabled {
-		apiKeyAuth := security.NewAPIKeyAuth(h.Logger, h.Config)
-		formsPublic.Use(apiKeyAuth.Setup())
+	// Apply API key middleware while enabled; form-scoped database keys are accepted alongside configured keys
+	apiKeyAuth := security.NewAPIKeyAuth(h.Logger, h.Config).WithPolicy(h.securityPolicy())
+	if h.APIKeyMiddleware != nil {
+		apiKeyAuth = apiKeyAuth.WithValidator(h)
 	}
 
+	formsPublic.Use(apiKeyAuth.Setup())
+
 	formsPublic.GET("/:id/schema", h.handleFormSchema)
 	formsPublic.GET("/:id/validation", h.handleFormValidationSchema)
-	formsPublic.POST("/:id/submit", h.handleFormSubmit)
+	formsPublic.POST("/:id/submit", h.handleFormSubmit, h.idempotent()...)
 	formsPublic.GET("/:id/embed", h.handleFormEmbed)
+	formsPublic.GET("/:id/html", h.handleFormHTML)
+
+	// Versioned renderer assets for the embed page; outside the group so API keys and form CORS do not apply
+	e.GET(constants.PathEmbedAssets+"/:version/*", h.handleEmbedAsset)
+}
+
+// idempotent returns the Idempotency-Key middleware for routes clients retry, when configured
+func (h *FormAPIHandler) idempotent() []echo.MiddlewareFunc {
+	if h.Idempotency == nil {
+		return nil
+	}
+
+	return []echo.MiddlewareFunc{h.Idempotency.Handle()}
 }
 
 // Register registers the FormAPIHandler with the Echo instance.
@@ -141,15 +187,32 @@ func (h *FormAPIHandler) Register(_ *echo.Echo) {
 	// This method is required to satisfy the Handler interface
 }
 
-// GET /api/v1/forms
+// GET /api/v1/forms - list forms, sorted, filtered and keyset-paginated
 func (h *FormAPIHandler) handleListForms(c echo.Context) error {
 	userID, ok := c.Get("user_id").(string)
 	if !ok {
 		return h.HandleForbidden(c, "User not authenticated")
 	}
 
-	// Get forms for the user
-	forms, err := h.FormService.ListForms(c.Request().Context(), userID)
+	filter, err := parseFormListFilter(c)
+	if err != nil {
+		return response.ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	workspaceID, err := h.activeWorkspace(c, userID, workspace.PermissionViewForm)
+	if err != nil {
+		return h.handleWorkspaceError(c, err)
+	}
+
+	// List the active workspace's forms, or the user's own forms in personal scope
+	filter.UserID = userID
+	filter.WorkspaceID = workspaceID
+
+	page, err := h.FormService.ListFormsPage(c.Request().Context(), filter)
+	if errors.Is(err, formdomain.ErrInvalidListFilter) {
+		return response.ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
 	if err != nil {
 		h.Logger.Error("failed to list forms", "error", err)
 
@@ -158,10 +221,9 @@ func (h *FormAPIHandler) handleListForms(c echo.Context) error {
 
 	h.Logger.Debug("forms listed successfully",
 		"user_id", h.Logger.SanitizeField("user_id", userID),
-		"form_count", len(forms))
+		"form_count", len(page.Forms))
 
-	// Build response with proper error checking
-	if respErr := h.ResponseBuilder.BuildFormListResponse(c, forms); respErr != nil {
+	if respErr := h.ResponseBuilder.BuildFormPageResponse(c, page); respErr != nil {
 		h.Logger.Error("failed to build form list response", "error", respErr)
 
 		return h.HandleError(c, respErr, "Failed to build response")
@@ -172,7 +234,7 @@ func (h *FormAPIHandler) handleListForms(c echo.Context) error {
 
 // GET /api/v1/forms/:id
 func (h *FormAPIHandler) handleGetForm(c echo.Context) error {
-	form, err := h.getFormWithOwnershipOrError(c)
+	form, err := h.getFormWithPermissionOrError(c, workspace.PermissionViewForm)
 	if err != nil {
 		return err
 	}
@@ -215,14 +277,18 @@ func (h *FormAPIHandler) handleFormValidationSchema(c echo.Context) error {
 		return validationErr
 	}
 
-	// Generate client-side validation rules from form schema
-	clientValidation, err := h.ComprehensiveValidator.GenerateClientValidation(form.Schema)
+	// Generate client-side validation rules from form schema, with messages in the respondent's language
+	acceptLanguage := c.Request().Header.Get("Accept-Language")
+
+	clientValidation, err := h.ComprehensiveValidator.GenerateClientValidationLocalized(form.Schema, acceptLanguage)
 	if err != nil {
 		h.Logger.Error("failed to generate client validation schema", "error", err, "form_id", form.ID)
 
 		return h.wrapError("handle schema error", h.ErrorHandler.HandleSchemaError(c, err))
 	}
 
+	c.Response().Header().Set("Content-Language", h.ComprehensiveValidator.Locale(form.Schema, acceptLanguage))
+
 	return response.Success(c, clientValidation)
 }
 
@@ -233,6 +299,11 @@ func (h *FormAPIHandler) handleCreateForm(c echo.Context) error {
 		return h.HandleForbidden(c, "User not authenticated")
 	}
 
+	member, err := h.activeWorkspaceMember(c, userID, workspace.PermissionCreateForm)
+	if err != nil {
+		return h.handleWorkspaceError(c, err)
+	}
+
 	req, err := h.RequestProcessor.ProcessCreateRequest(c)
 	if err != nil {
 		return h.wrapError("handle create error", h.ErrorHandler.HandleSchemaError(c, err))
@@ -244,36 +315,46 @@ func (h *FormAPIHandler) handleCreateForm(c echo.Context) error {
 		planTier = "free"
 	}
 
-	form, err := h.FormServiceHandler.CreateForm(c.Request().Context(), userID, req, planTier)
+	var workspaceID string
+	if member != nil {
+		// Workspace forms count against the plan of the workspace's owners, not the acting member's
+		workspaceID = member.WorkspaceID
+		if planTier, err = h.Workspaces.PlanTier(c.Request().Context(), member, planTier); err != nil {
+			return h.handleWorkspaceError(c, err)
+		}
+	}
+
+	form, err := h.FormServiceHandler.CreateForm(c.Request().Context(), userID, workspaceID, req, planTier)
 	if err != nil {
 		h.Logger.Error("failed to create form", "error", err)
 
-		var domainErr *domainerrors.DomainError
-		if errors.As(err, &domainErr) {
-			return c.JSON(domainErr.HTTPStatus(), response.APIResponse{
-				Success: false,
-				Message: domainErr.Message,
-				Data:    domainErr.Context,
-			})
-		}
-
 		return h.HandleError(c, err, "Failed to create form")
 	}
 
 	h.Logger.Debug("form created successfully", "form_id", form.ID, "user_id", h.Logger.SanitizeField("user_id", userID))
 
+	h.recordAudit(c, audit.Record{
+		OwnerID:      auditOwner(form),
+		Action:       audit.ActionFormCreated,
+		ResourceType: audit.ResourceForm,
+		ResourceID:   form.ID,
+		After:        formSnapshot(form),
+	})
+
 	return c.JSON(http.StatusCreated, response.APIResponse{
 		Success: true,
 		Message: "Form created successfully",
 		Data: map[string]any{
 			"form": map[string]any{
-				"id":          form.ID,
-				"title":       form.Title,
-				"description": form.Description,
-				"status":      form.Status,
-				"schema":      form.Schema,
-				"created_at":  form.CreatedAt.Format(time.RFC3339),
-				"updated_at":  form.UpdatedAt.Format(time.RFC3339),
+				"id":           form.ID,
+				"title":        form.Title,
+				"description":  form.Description,
+				"status":       form.Status,
+				"schema":       form.Schema,
+				"tags":         form.Tags,
+				"workspace_id": form.WorkspaceID,
+				"created_at":   form.CreatedAt.Format(time.RFC3339),
+				"updated_at":   form.UpdatedAt.Format(time.RFC3339),
 			},
 		},
 	})
@@ -281,7 +362,7 @@ func (h *FormAPIHandler) handleCreateForm(c echo.Context) error {
 
 // PUT /api/forms/:id - update form (assertion auth)
 func (h *FormAPIHandler) handleUpdateForm(c echo.Context) error {
-	form, err := h.getFormWithOwnershipOrError(c)
+	form, err := h.getFormWithPermissionOrError(c, workspace.PermissionEditForm)
 	if err != nil {
 		return err
 	}
@@ -291,23 +372,12 @@ func (h *FormAPIHandler) handleUpdateForm(c echo.Context) error {
 		return h.wrapError("handle update error", h.ErrorHandler.HandleSchemaError(c, err))
 	}
 
-	updatePlanTier, ok := ctxmw.GetPlanTier(c)
-	if !ok || updatePlanTier == "" {
-		h.Logger.Warn("plan tier missing from context, defaulting to free", "path", c.Path())
-		updatePlanTier = "free"
-	}
+	before := formSnapshot(form)
+
+	updatePlanTier := h.planTierFor(c, form)
 	if updateErr := h.FormServiceHandler.UpdateForm(c.Request().Context(), form, req, updatePlanTier); updateErr != nil {
 		h.Logger.Error("failed to update form", "error", updateErr, "form_id", form.ID)
 
-		var domainErr *domainerrors.DomainError
-		if errors.As(updateErr, &domainErr) {
-			return c.JSON(domainErr.HTTPStatus(), response.APIResponse{
-				Success: false,
-				Message: domainErr.Message,
-				Data:    domainErr.Context,
-			})
-		}
-
 		return h.HandleError(c, updateErr, "Failed to update form")
 	}
 
@@ -319,6 +389,16 @@ func (h *FormAPIHandler) handleUpdateForm(c echo.Context) error {
 		updatedForm = form
 	}
 
+	after := formSnapshot(updatedForm)
+	h.recordAudit(c, audit.Record{
+		OwnerID:      auditOwner(updatedForm),
+		Action:       formUpdateAction(before, after),
+		ResourceType: audit.ResourceForm,
+		ResourceID:   updatedForm.ID,
+		Before:       before,
+		After:        after,
+	})
+
 	if respErr := h.ResponseBuilder.BuildFormResponse(c, updatedForm); respErr != nil {
 		h.Logger.Error("failed to build form response", "error", respErr, "form_id", form.ID)
 
@@ -330,7 +410,7 @@ func (h *FormAPIHandler) handleUpdateForm(c echo.Context) error {
 
 // DELETE /api/forms/:id - delete form (assertion auth)
 func (h *FormAPIHandler) handleDeleteForm(c echo.Context) error {
-	form, err := h.getFormWithOwnershipOrError(c)
+	form, err := h.getFormWithPermissionOrError(c, workspace.PermissionDeleteForm)
 	if err != nil {
 		return err
 	}
@@ -341,24 +421,43 @@ func (h *FormAPIHandler) handleDeleteForm(c echo.Context) error {
 		return h.HandleError(c, deleteErr, "Failed to delete form")
 	}
 
+	h.recordAudit(c, audit.Record{
+		OwnerID:      auditOwner(form),
+		Action:       audit.ActionFormDeleted,
+		ResourceType: audit.ResourceForm,
+		ResourceID:   form.ID,
+		Before:       formSnapshot(form),
+	})
+
 	return c.JSON(http.StatusNoContent, nil)
 }
 
 // GET /api/forms/:id/submissions - list submissions (assertion auth)
 func (h *FormAPIHandler) handleListSubmissions(c echo.Context) error {
-	form, err := h.getFormWithOwnershipOrError(c)
+	form, err := h.getFormWithPermissionOrError(c, workspace.PermissionViewSubmissions)
 	if err != nil {
 		return err
 	}
 
-	submissions, err := h.FormServiceHandler.GetFormSubmissions(c.Request().Context(), form.ID)
+	filter, err := parseSubmissionListFilter(c)
+	if err != nil {
+		return response.ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	filter.FormID = form.ID
+
+	page, err := h.FormService.ListSubmissionsPage(c.Request().Context(), filter)
+	if errors.Is(err, formdomain.ErrInvalidListFilter) {
+		return response.ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
 	if err != nil {
 		h.Logger.Error("failed to list form submissions", "error", err, "form_id", form.ID)
 
 		return h.HandleError(c, err, "Failed to list submissions")
 	}
 
-	if respErr := h.ResponseBuilder.BuildSubmissionListResponse(c, submissions); respErr != nil {
+	if respErr := h.ResponseBuilder.BuildSubmissionPageResponse(c, page); respErr != nil {
 		h.Logger.Error("failed to build submission list response", "error", respErr, "form_id", form.ID)
 
 		return h.HandleError(c, respErr, "Failed to build response")
@@ -369,7 +468,7 @@ func (h *FormAPIHandler) handleListSubmissions(c echo.Context) error {
 
 // GET /api/forms/:id/submissions/:sid - get submission (assertion auth)
 func (h *FormAPIHandler) handleGetSubmission(c echo.Context) error {
-	form, err := h.getFormWithOwnershipOrError(c)
+	form, err := h.getFormWithPermissionOrError(c, workspace.PermissionViewSubmissions)
 	if err != nil {
 		return err
 	}
@@ -393,168 +492,142 @@ func (h *FormAPIHandler) handleGetSubmission(c echo.Context) error {
 	return c.JSON(http.StatusOK, response.APIResponse{
 		Success: true,
 		Data: map[string]any{
-			"id":           submission.ID,
-			"form_id":      submission.FormID,
-			"status":       submission.Status,
-			"submitted_at": submission.SubmittedAt.Format(time.RFC3339),
-			"data":         submission.Data,
+			"id":            submission.ID,
+			"form_id":       submission.FormID,
+			"status":        submission.Status,
+			"review_status": submission.ReviewStatus,
+			"assignee_id":   submission.AssigneeID,
+			"tags":          submission.Tags,
+			"submitted_at":  submission.SubmittedAt.Format(time.RFC3339),
+			"data":          submission.Data,
 		},
 	})
 }
 
-// GET /forms/:id/embed returns a minimal HTML page for embedding the form via iframe.
-// Loads Form.io from CDN and renders the form, posting to /forms/:id/submit.
-func (h *FormAPIHandler) handleFormEmbed(c echo.Context) error {
+// POST /forms/:id/submit
+func (h *FormAPIHandler) handleFormSubmit(c echo.Context) error {
+	formID := c.Param("id")
+	h.logFormSubmissionRequest(c, formID)
+
 	form, err := h.getFormOrError(c)
 	if err != nil {
 		return err
 	}
 
-	if form.Schema == nil {
-		h.Logger.Warn("form schema is nil for embed", "form_id", form.ID)
-
-		return h.wrapError("handle embed error",
-			h.ErrorHandler.HandleSchemaError(c, errors.New("form schema is required")))
+	if isURLEncodedSubmission(c) {
+		return h.submit(c, form, &htmlSubmission{h: h})
 	}
 
-	formID := form.ID
-	schemaURL := "/forms/" + formID + "/schema"
-	submitURL := "/forms/" + formID + "/submit"
+	return h.submitForm(c, form)
+}
 
-	// Build frame-ancestors and target origin from the form's CORS origins
-	corsOrigins, _, _ := form.GetCorsConfig()
-	targetOrigin := "'none'"
-	frameAncestors := "'none'"
-	if len(corsOrigins) > 0 {
-		targetOrigin = escapeHTML(corsOrigins[0])
+// submitForm validates and stores a JSON submission for the form, then writes the response
+func (h *FormAPIHandler) submitForm(c echo.Context, form *model.Form) error {
+	return h.submit(c, form, jsonSubmission{h: h})
+}
 
-		var safeOrigins []string
-		for _, o := range corsOrigins {
-			if sanitized := sanitizeCSPOrigin(o); sanitized != "" {
-				safeOrigins = append(safeOrigins, sanitized)
-			}
-		}
+// submissionFormat reads a submission in one encoding and writes the responses to it. Errors
+// returned by its methods have already been written to the response.
+type submissionFormat interface {
+	// read returns the submitted data
+	read(c echo.Context, form *model.Form) (model.JSON, error)
+	// invalid answers data that failed validation against the form schema
+	invalid(c echo.Context, form *model.Form, result validation.Result) error
+	// failed answers a submission the form service could not store
+	failed(c echo.Context, form *model.Form, err error) error
+	// submitted answers a stored submission
+	submitted(c echo.Context, form *model.Form, submission *model.FormSubmission) error
+}
 
-		if len(safeOrigins) > 0 {
-			frameAncestors = strings.Join(safeOrigins, " ")
-		}
+// submit is the submission pipeline every encoding goes through: the data is validated against
+// the form schema, stored and audited, while format reads it and writes the response
+func (h *FormAPIHandler) submit(c echo.Context, form *model.Form, format submissionFormat) error {
+	if validationErr := h.validateFormSchema(c, form); validationErr != nil {
+		return validationErr
 	}
 
-	csp := "default-src 'none'; " +
-		"script-src https://cdn.form.io 'unsafe-inline'; " +
-		"style-src https://cdn.form.io 'unsafe-inline'; " +
-		"connect-src 'self'; " +
-		"font-src https://cdn.form.io; " +
-		"img-src 'self' data:; " +
-		"frame-ancestors " + frameAncestors
-
-	html := `<!DOCTYPE html>
-<html data-cors-origin="` + targetOrigin + `">
-<head>
-  <meta charset="utf-8">
-  <meta name="viewport" content="width=device-width, initial-scale=1">
-  <title>` + escapeHTML(form.Title) + `</title>
-  <link rel="stylesheet" href="https://cdn.form.io/formiojs/formio.full.min.css">
-</head>
-<body>
-  <div id="formio"></div>
-  <script src="https://cdn.form.io/formiojs/formio.full.min.js"></script>
-  <script>
-    (function() {
-      var schemaUrl = '` + schemaURL + `';
-      var submitUrl = '` + submitURL + `';
-      var container = document.getElementById('formio');
-      var targetOrigin = document.documentElement.dataset.corsOrigin || '*';
-      Formio.createForm(container, schemaUrl, {
-        submit: submitUrl,
-        noSubmit: false
-      }).then(function(form) {
-        form.on('submit', function(submission) {
-          if (submission && submission.submission) {
-            window.parent.postMessage({ type: 'goformx:submitted', submission: submission.submission }, targetOrigin);
-          }
-        });
-      }).catch(function(err) {
-        container.innerHTML = '<p style="color: #dc2626;">Failed to load form. Please try again.</p>';
-        console.error('Form.io load error:', err);
-      });
-    })();
-  </script>
-</body>
-</html>`
-
-	// Strip X-Frame-Options to allow embedding; CSP frame-ancestors handles framing control
-	c.Response().Header().Del("X-Frame-Options")
-	c.Response().Header().Set("Content-Security-Policy", csp)
-	c.Response().Header().Set("Content-Type", "text/html; charset=utf-8")
-
-	return c.HTML(http.StatusOK, html)
-}
-
-// escapeHTML escapes HTML special characters for safe inclusion in attribute values.
-func escapeHTML(s string) string {
-	return strings.NewReplacer(
-		"&", "&amp;",
-		"<", "&lt;",
-		">", "&gt;",
-		`"`, "&quot;",
-		"'", "&#39;",
-	).Replace(s)
-}
-
-// validCSPOriginPattern matches safe scheme://host[:port] patterns for CSP directives.
-var validCSPOriginPattern = regexp.MustCompile(`^https?://[a-zA-Z0-9._:\-]+$`)
-
-// sanitizeCSPOrigin returns the origin if it matches a safe pattern, or empty string otherwise.
-// This prevents CSP header injection via malicious CORS origins stored in the database.
-func sanitizeCSPOrigin(origin string) string {
-	if validCSPOriginPattern.MatchString(origin) {
-		return origin
-	}
-
-	return ""
-}
-
-// POST /api/v1/forms/:id/submit
-func (h *FormAPIHandler) handleFormSubmit(c echo.Context) error {
-	formID := c.Param("id")
-	h.logFormSubmissionRequest(c, formID)
-
-	form, err := h.getFormOrError(c)
+	submissionData, err := format.read(c, form)
 	if err != nil {
 		return err
 	}
 
-	if validationErr := h.validateFormSchema(c, form); validationErr != nil {
-		return validationErr
+	result := h.ComprehensiveValidator.ValidateFormLocalized(form.Schema, submissionData, c.Request().Header.Get("Accept-Language"))
+	if !result.IsValid {
+		h.Logger.Warn("Form validation failed", "form_id", form.ID, "error_count", len(result.Errors))
+
+		return format.invalid(c, form, result)
 	}
 
-	submissionData, err := h.processSubmissionRequest(c, form.ID)
-	if err != nil {
-		return err
+	h.Logger.Debug("Form validation passed", "form_id", form.ID)
+
+	submission := &model.FormSubmission{
+		FormID:      form.ID,
+		Data:        submissionData,
+		SubmittedAt: time.Now(),
+		Status:      model.SubmissionStatusPending,
 	}
 
-	if validationDataErr := h.validateSubmissionData(c, form, submissionData); validationDataErr != nil {
-		return validationDataErr
+	if err = h.FormService.SubmitForm(c.Request().Context(), submission); err != nil {
+		h.Logger.Error("Failed to submit form", "form_id", form.ID, "error", err)
+
+		return format.failed(c, form, err)
 	}
 
-	submission, err := h.createAndSubmitForm(c, form, submissionData)
+	h.Logger.Info("Form submitted successfully", "form_id", form.ID, "submission_id", submission.ID)
+
+	h.recordAudit(c, audit.Record{
+		OwnerID:      auditOwner(form),
+		Action:       audit.ActionSubmissionCreated,
+		ResourceType: audit.ResourceSubmission,
+		ResourceID:   submission.ID,
+		After:        submissionSnapshot(submission),
+	})
+
+	return format.submitted(c, form, submission)
+}
+
+// jsonSubmission is a submission posted as JSON and answered with JSON
+type jsonSubmission struct {
+	h *FormAPIHandler
+}
+
+// read decodes the request body
+func (s jsonSubmission) read(c echo.Context, form *model.Form) (model.JSON, error) {
+	submissionData, err := s.h.RequestProcessor.ProcessSubmissionRequest(c)
 	if err != nil {
-		return err
+		s.h.Logger.Error("Failed to process submission request", "form_id", form.ID, "error", err)
+
+		return nil, s.h.wrapError("handle submission error", s.h.ErrorHandler.HandleSubmissionError(c, err))
 	}
 
-	h.Logger.Info("Form submitted successfully", "form_id", form.ID, "submission_id", submission.ID)
+	s.h.Logger.Debug("Submission data processed successfully", "form_id", form.ID, "data_keys", len(submissionData))
 
-	// Build response with proper error checking
-	if respErr := h.ResponseBuilder.BuildSubmissionResponse(c, submission); respErr != nil {
-		h.Logger.Error(
+	return submissionData, nil
+}
+
+// invalid lists the validation errors in the language they were written in
+func (s jsonSubmission) invalid(c echo.Context, _ *model.Form, result validation.Result) error {
+	c.Response().Header().Set("Content-Language", result.Locale)
+
+	return s.h.wrapError("build multiple error response", s.h.ResponseBuilder.BuildMultipleErrorResponse(c, result.Errors))
+}
+
+// failed writes the error response of the service error
+func (s jsonSubmission) failed(c echo.Context, _ *model.Form, err error) error {
+	return s.h.wrapError("handle submission error", s.h.ErrorHandler.HandleSubmissionError(c, err))
+}
+
+// submitted writes the stored submission
+func (s jsonSubmission) submitted(c echo.Context, form *model.Form, submission *model.FormSubmission) error {
+	if respErr := s.h.ResponseBuilder.BuildSubmissionResponse(c, submission); respErr != nil {
+		s.h.Logger.Error(
 			"failed to build submission response",
 			"error", respErr,
 			"form_id", form.ID,
 			"submission_id", submission.ID,
 		)
 
-		return h.HandleError(c, respErr, "Failed to build response")
+		return s.h.HandleError(c, respErr, "Failed to build response")
 	}
 
 	return nil
@@ -567,7 +640,18 @@ func (h *FormAPIHandler) handleFormsCount(c echo.Context) error {
 		return h.HandleForbidden(c, "User not authenticated")
 	}
 
-	count, err := h.FormService.CountFormsByUser(c.Request().Context(), userID)
+	workspaceID, err := h.activeWorkspace(c, userID, workspace.PermissionViewForm)
+	if err != nil {
+		return h.handleWorkspaceError(c, err)
+	}
+
+	var count int
+	if workspaceID != "" {
+		count, err = h.FormService.CountFormsByWorkspace(c.Request().Context(), workspaceID)
+	} else {
+		count, err = h.FormService.CountFormsByUser(c.Request().Context(), userID)
+	}
+
 	if err != nil {
 		return h.HandleError(c, err, "Failed to count forms")
 	}
@@ -589,15 +673,25 @@ func (h *FormAPIHandler) handleSubmissionsCount(c echo.Context) error {
 
 	year, month, err := parseYearMonth(monthStr)
 	if err != nil {
-		return c.JSON(http.StatusBadRequest, response.APIResponse{
-			Success: false,
-			Message: "Invalid month format. Use YYYY-MM.",
-		})
+		return response.ErrorResponse(c, http.StatusBadRequest, "Invalid month format. Use YYYY-MM.")
+	}
+
+	workspaceID, err := h.activeWorkspace(c, userID, workspace.PermissionViewSubmissions)
+	if err != nil {
+		return h.handleWorkspaceError(c, err)
+	}
+
+	var count int
+	if workspaceID != "" {
+		count, err = h.FormService.CountSubmissionsByWorkspaceMonth(
+			c.Request().Context(), workspaceID, year, month,
+		)
+	} else {
+		count, err = h.FormService.CountSubmissionsByUserMonth(
+			c.Request().Context(), userID, year, month,
+		)
 	}
 
-	count, err := h.FormService.CountSubmissionsByUserMonth(
-		c.Request().Context(), userID, year, month,
-	)
 	if err != nil {
 		return h.HandleError(c, err, "Failed to count submissions")
 	}
@@ -608,6 +702,34 @@ func (h *FormAPIHandler) handleSubmissionsCount(c echo.Context) error {
 	})
 }
 
+// planTierFor returns the plan tier a form is charged to: its workspace's, or else the asserted
+// tier, falling back to the tier recorded on the form for API-key requests, which carry no plan assertion.
+func (h *FormAPIHandler) planTierFor(c echo.Context, form *model.Form) string {
+	if form != nil && form.WorkspaceID != "" && h.Workspaces != nil {
+		ws, err := h.Workspaces.GetWorkspace(c.Request().Context(), form.WorkspaceID)
+		if err == nil {
+			return ws.PlanTier
+		}
+
+		h.Logger.Warn("failed to load form workspace, using the form's plan tier",
+			"form_id", form.ID, "workspace_id", form.WorkspaceID, "error", err)
+
+		return form.PlanTier
+	}
+
+	if planTier, ok := ctxmw.GetPlanTier(c); ok && planTier != "" {
+		return planTier
+	}
+
+	if form != nil && form.PlanTier != "" {
+		return form.PlanTier
+	}
+
+	h.Logger.Warn("plan tier missing from context, defaulting to free", "path", c.Path())
+
+	return "free"
+}
+
 // parseYearMonth parses a "YYYY-MM" string into year and month integers.
 func parseYearMonth(s string) (year, month int, err error) {
 	const expectedParts = 2
@@ -656,7 +778,7 @@ func (h *FormAPIHandler) Stop(_ context.Context) error {
 func (h *FormAPIHandler) getFormOrError(c echo.Context) (*model.Form, error) {
 	form, err := h.GetFormByID(c)
 	if err != nil {
-		return nil, h.HandleError(c, err, "Failed to get form")
+		return nil, h.formLookupError(c, err)
 	}
 
 	if form == nil {
@@ -668,15 +790,18 @@ func (h *FormAPIHandler) getFormOrError(c echo.Context) (*model.Form, error) {
 	return form, nil
 }
 
-// getFormWithOwnershipOrError retrieves a form with ownership verification
-func (h *FormAPIHandler) getFormWithOwnershipOrError(c echo.Context) (*model.Form, error) {
-	form, err := h.GetFormWithOwnership(c)
+// getFormWithPermissionOrError retrieves a form after verifying the user holds permission on it
+func (h *FormAPIHandler) getFormWithPermissionOrError(
+	c echo.Context,
+	permission workspace.Permission,
+) (*model.Form, error) {
+	form, err := h.GetFormWithPermission(c, permission)
 	if err != nil {
-		return nil, h.HandleError(c, err, "Failed to get form")
+		return nil, h.formLookupError(c, err)
 	}
 
 	if form == nil {
-		h.Logger.Error("form is nil after GetFormWithOwnership", "form_id", c.Param("id"))
+		h.Logger.Error("form is nil after GetFormWithPermission", "form_id", c.Param("id"))
 
 		return nil, h.wrapError("handle form not found", h.ErrorHandler.HandleFormNotFoundError(c, ""))
 	}
@@ -684,6 +809,18 @@ func (h *FormAPIHandler) getFormWithOwnershipOrError(c echo.Context) (*model.For
 	return form, nil
 }
 
+// formLookupError responds to a failed form lookup unless the lookup already responded, as
+// access checks do. It always returns an error so the caller stops before using the form.
+func (h *FormAPIHandler) formLookupError(c echo.Context, err error) error {
+	if !c.Response().Committed {
+		if handleErr := h.HandleError(c, err, "Failed to get form"); handleErr != nil {
+			return handleErr
+		}
+	}
+
+	return h.wrapError("get form", err)
+}
+
 // validateFormSchema validates that form schema exists
 func (h *FormAPIHandler) validateFormSchema(c echo.Context, form *model.Form) error {
 	if form.Schema == nil {
@@ -707,58 +844,6 @@ func (h *FormAPIHandler) logFormSubmissionRequest(c echo.Context, formID string)
 		"user_agent", c.Request().UserAgent())
 }
 
-// processSubmissionRequest processes and validates the submission request
-func (h *FormAPIHandler) processSubmissionRequest(c echo.Context, formID string) (model.JSON, error) {
-	submissionData, err := h.RequestProcessor.ProcessSubmissionRequest(c)
-	if err != nil {
-		h.Logger.Error("Failed to process submission request", "form_id", formID, "error", err)
-
-		return nil, h.wrapError("handle submission error", h.ErrorHandler.HandleSubmissionError(c, err))
-	}
-
-	h.Logger.Debug("Submission data processed successfully", "form_id", formID, "data_keys", len(submissionData))
-
-	return submissionData, nil
-}
-
-// validateSubmissionData validates submission data against form schema
-func (h *FormAPIHandler) validateSubmissionData(c echo.Context, form *model.Form, submissionData model.JSON) error {
-	validationResult := h.ComprehensiveValidator.ValidateForm(form.Schema, submissionData)
-	if !validationResult.IsValid {
-		h.Logger.Warn("Form validation failed", "form_id", form.ID, "error_count", len(validationResult.Errors))
-
-		return h.wrapError("build multiple error response",
-			h.ResponseBuilder.BuildMultipleErrorResponse(c, validationResult.Errors))
-	}
-
-	h.Logger.Debug("Form validation passed", "form_id", form.ID)
-
-	return nil
-}
-
-// createAndSubmitForm creates and submits the form
-func (h *FormAPIHandler) createAndSubmitForm(
-	c echo.Context,
-	form *model.Form,
-	submissionData model.JSON,
-) (*model.FormSubmission, error) {
-	submission := &model.FormSubmission{
-		FormID:      form.ID,
-		Data:        submissionData,
-		SubmittedAt: time.Now(),
-		Status:      model.SubmissionStatusPending,
-	}
-
-	err := h.FormService.SubmitForm(c.Request().Context(), submission)
-	if err != nil {
-		h.Logger.Error("Failed to submit form", "form_id", form.ID, "submission_id", submission.ID, "error", err)
-
-		return nil, h.wrapError("handle submission error", h.ErrorHandler.HandleSubmissionError(c, err))
-	}
-
-	return submission, nil
-}
-
 // wrapError provides consistent error wrapping
 func (h *FormAPIHandler) wrapError(ctx string, err error) error {
 	return fmt.Errorf("%s: %w", ctx, err)
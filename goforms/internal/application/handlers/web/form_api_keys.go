@@ -0,0 +1,242 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/goformx/goforms/internal/application/constants"
+	apikeymw "github.com/goformx/goforms/internal/application/middleware/apikey"
+	"github.com/goformx/goforms/internal/application/response"
+	"github.com/goformx/goforms/internal/domain/apikey"
+	"github.com/goformx/goforms/internal/domain/audit"
+	"github.com/goformx/goforms/internal/domain/workspace"
+)
+
+// APIKeyCreateRequest represents the data needed to create an API key
+type APIKeyCreateRequest struct {
+	Name        string     `json:"name"`
+	Permissions []string   `json:"permissions"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+}
+
+// RegisterServerRoutes registers /api/server/forms routes for backends authenticating with scoped API keys.
+// Handlers are shared with the assertion API; RequireFormPermission checks the key's scope and permissions.
+func (h *FormAPIHandler) RegisterServerRoutes(e *echo.Echo) {
+	if h.APIKeyMiddleware == nil {
+		return
+	}
+
+	server := e.Group(constants.PathAPIServerForms)
+	server.Use(h.APIKeyMiddleware.Verify())
+
+	server.GET("/:id", h.handleGetForm)
+	server.PUT("/:id", h.handleUpdateForm)
+	server.GET("/:id/submissions", h.handleListSubmissions)
+	server.POST("/:id/submissions", h.handleKeySubmit)
+	server.GET("/:id/submissions/:sid", h.handleGetSubmission)
+}
+
+// ValidateAPIKey accepts database-backed keys scoped to the form named in the route.
+// It lets form-scoped keys pass the configured API key check on the public /forms routes.
+func (h *FormAPIHandler) ValidateAPIKey(c echo.Context, _ string) bool {
+	key, ok := h.APIKeyMiddleware.Authenticate(c)
+	if !ok {
+		return false
+	}
+
+	form, err := h.FormService.GetForm(c.Request().Context(), c.Param("id"))
+	if err != nil || form == nil || !key.AppliesTo(form.ID, form.WorkspaceID) {
+		return false
+	}
+
+	return c.Request().Method != http.MethodPost || key.Can(apikey.PermissionSubmit)
+}
+
+// POST /api/server/forms/:id/submissions - create a submission (API key auth)
+func (h *FormAPIHandler) handleKeySubmit(c echo.Context) error {
+	h.logFormSubmissionRequest(c, c.Param("id"))
+
+	form, err := h.getFormOrError(c)
+	if err != nil {
+		return err
+	}
+
+	key, ok := apikeymw.GetKey(c)
+	if !ok {
+		return h.forbidFormAccess(c)
+	}
+
+	if permissionErr := h.RequireKeyPermission(c, form, key, apikey.PermissionSubmit); permissionErr != nil {
+		return permissionErr
+	}
+
+	return h.submitForm(c, form)
+}
+
+// GET /api/forms/:id/api-keys - list a form's API keys (assertion auth)
+func (h *FormAPIHandler) handleListFormAPIKeys(c echo.Context) error {
+	form, err := h.getFormWithPermissionOrError(c, workspace.PermissionManageAPIKeys)
+	if err != nil {
+		return err
+	}
+
+	keys, err := h.APIKeys.List(c.Request().Context(), apikey.Filter{FormID: form.ID})
+	if err != nil {
+		return h.handleAPIKeyError(c, err)
+	}
+
+	return response.Success(c, map[string]any{"api_keys": keys, "count": len(keys)})
+}
+
+// POST /api/forms/:id/api-keys - create a form-scoped API key (assertion auth)
+func (h *FormAPIHandler) handleCreateFormAPIKey(c echo.Context) error {
+	form, err := h.getFormWithPermissionOrError(c, workspace.PermissionManageAPIKeys)
+	if err != nil {
+		return err
+	}
+
+	key, token, err := h.createAPIKey(c, h.APIKeys, apikey.CreateParams{FormID: form.ID})
+	if err != nil {
+		return h.handleAPIKeyError(c, err)
+	}
+
+	h.recordAudit(c, audit.Record{
+		OwnerID:      auditOwner(form),
+		Action:       audit.ActionAPIKeyCreated,
+		ResourceType: audit.ResourceAPIKey,
+		ResourceID:   key.ID,
+		After:        apiKeySnapshot(key),
+	})
+
+	return apiKeyCreatedResponse(c, key, token)
+}
+
+// DELETE /api/forms/:id/api-keys/:keyId - revoke a form-scoped API key (assertion auth)
+func (h *FormAPIHandler) handleRevokeFormAPIKey(c echo.Context) error {
+	form, err := h.getFormWithPermissionOrError(c, workspace.PermissionManageAPIKeys)
+	if err != nil {
+		return err
+	}
+
+	key, err := h.revokeAPIKey(c, h.APIKeys, apikey.Filter{FormID: form.ID})
+	if err != nil {
+		return h.handleAPIKeyError(c, err)
+	}
+
+	h.recordAudit(c, audit.Record{
+		OwnerID:      auditOwner(form),
+		Action:       audit.ActionAPIKeyRevoked,
+		ResourceType: audit.ResourceAPIKey,
+		ResourceID:   key.ID,
+		After:        apiKeySnapshot(key),
+	})
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// createAPIKey binds an APIKeyCreateRequest and creates a key in the given scope
+func (h *BaseHandler) createAPIKey(
+	c echo.Context,
+	keys apikey.Service,
+	scope apikey.CreateParams,
+) (*apikey.Key, string, error) {
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return nil, "", workspace.ErrNotMember
+	}
+
+	var req APIKeyCreateRequest
+	if err := c.Bind(&req); err != nil {
+		return nil, "", errInvalidAPIKeyRequest
+	}
+
+	permissions := make([]apikey.Permission, len(req.Permissions))
+	for i, permission := range req.Permissions {
+		permissions[i] = apikey.Permission(permission)
+	}
+
+	scope.Name = req.Name
+	scope.Permissions = permissions
+	scope.ExpiresAt = req.ExpiresAt
+	scope.CreatedBy = userID
+
+	return keys.Create(c.Request().Context(), scope)
+}
+
+// revokeAPIKey revokes the key named by the :keyId parameter if it belongs to the scope
+func (h *BaseHandler) revokeAPIKey(c echo.Context, keys apikey.Service, scope apikey.Filter) (*apikey.Key, error) {
+	key, err := keys.Get(c.Request().Context(), c.Param("keyId"))
+	if err != nil {
+		return nil, err
+	}
+
+	// Keys outside the scope are reported as missing so their existence is not leaked
+	if key.FormID != scope.FormID || key.WorkspaceID != scope.WorkspaceID {
+		return nil, apikey.ErrKeyNotFound
+	}
+
+	return keys.Revoke(c.Request().Context(), key.ID)
+}
+
+// errInvalidAPIKeyRequest is returned when an API key request body cannot be bound
+var errInvalidAPIKeyRequest = errors.New("invalid request body")
+
+// handleAPIKeyError maps API key errors to HTTP responses
+func (h *BaseHandler) handleAPIKeyError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, apikey.ErrKeyNotFound):
+		return h.HandleNotFound(c, "API key not found")
+	case errors.Is(err, errInvalidAPIKeyRequest):
+		return response.ErrorResponse(c, http.StatusBadRequest, "Invalid request body")
+	case errors.Is(err, apikey.ErrNameRequired),
+		errors.Is(err, apikey.ErrInvalidScope),
+		errors.Is(err, apikey.ErrInvalidPermission),
+		errors.Is(err, apikey.ErrInvalidExpiry):
+		return response.ErrorResponse(c, http.StatusBadRequest, err.Error())
+	default:
+		return h.handleWorkspaceError(c, err)
+	}
+}
+
+// apiKeyCreatedResponse returns the new key's metadata together with its plaintext, shown only once
+func apiKeyCreatedResponse(c echo.Context, key *apikey.Key, token string) error {
+	return c.JSON(http.StatusCreated, response.APIResponse{
+		Success: true,
+		Message: "API key created. Store it now; it will not be shown again.",
+		Data: map[string]any{
+			"api_key": key,
+			"key":     token,
+		},
+	})
+}
+
+// apiKeySnapshot captures the auditable state of an API key; the hash is never recorded
+func apiKeySnapshot(key *apikey.Key) audit.Snapshot {
+	snapshot := audit.Snapshot{
+		"id":          key.ID,
+		"name":        key.Name,
+		"prefix":      key.Prefix,
+		"permissions": key.Permissions,
+	}
+
+	if key.FormID != "" {
+		snapshot["form_id"] = key.FormID
+	}
+
+	if key.WorkspaceID != "" {
+		snapshot["workspace_id"] = key.WorkspaceID
+	}
+
+	if key.ExpiresAt != nil {
+		snapshot["expires_at"] = key.ExpiresAt.UTC().Format(time.RFC3339)
+	}
+
+	if key.RevokedAt != nil {
+		snapshot["revoked_at"] = key.RevokedAt.UTC().Format(time.RFC3339)
+	}
+
+	return snapshot
+}
@@ -0,0 +1,151 @@
+package web //nolint:testpackage // internal test for unexported handler methods
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	apikeymw "github.com/goformx/goforms/internal/application/middleware/apikey"
+	"github.com/goformx/goforms/internal/application/response"
+	"github.com/goformx/goforms/internal/domain/apikey"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/domain/workspace"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+	mockform "github.com/goformx/goforms/test/mocks/form"
+	mocklogging "github.com/goformx/goforms/test/mocks/logging"
+)
+
+func TestRequireFormPermission_APIKey(t *testing.T) {
+	form := &model.Form{ID: "form-1", UserID: "owner", WorkspaceID: "ws-1"}
+
+	tests := []struct {
+		name       string
+		key        *apikey.Key
+		permission workspace.Permission
+		wantStatus int
+	}{
+		{
+			name:       "form-scoped key reads submissions",
+			key:        &apikey.Key{ID: "k1", FormID: "form-1", Permissions: apikey.Permissions{apikey.PermissionReadSubmissions}},
+			permission: workspace.PermissionViewSubmissions,
+		},
+		{
+			name:       "workspace-scoped key manages form",
+			key:        &apikey.Key{ID: "k2", WorkspaceID: "ws-1", Permissions: apikey.Permissions{apikey.PermissionManageForm}},
+			permission: workspace.PermissionEditForm,
+		},
+		{
+			name:       "key scoped to another form is not found",
+			key:        &apikey.Key{ID: "k3", FormID: "form-2", Permissions: apikey.Permissions{apikey.PermissionManageForm}},
+			permission: workspace.PermissionViewForm,
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "submit-only key cannot read submissions",
+			key:        &apikey.Key{ID: "k4", FormID: "form-1", Permissions: apikey.Permissions{apikey.PermissionSubmit}},
+			permission: workspace.PermissionViewSubmissions,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "keys never delete forms",
+			key:        &apikey.Key{ID: "k5", FormID: "form-1", Permissions: apikey.Permissions{apikey.PermissionManageForm}},
+			permission: workspace.PermissionDeleteForm,
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			logger := mocklogging.NewMockLogger(ctrl)
+			logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+			handler := buildUsageHandler(t, mockform.NewMockService(ctrl), logger)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodGet, "/api/server/forms/form-1", http.NoBody), rec)
+			apikeymw.SetKey(c, tt.key)
+
+			err := handler.RequireFormPermission(c, form, tt.permission)
+			if tt.wantStatus == 0 {
+				require.NoError(t, err)
+
+				return
+			}
+
+			var httpErr *echo.HTTPError
+			require.ErrorAs(t, err, &httpErr)
+			assert.Equal(t, tt.wantStatus, httpErr.Code)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+		})
+	}
+}
+
+func TestGetFormWithPermissionOrError_StopsAfterResponding(t *testing.T) {
+	form := &model.Form{ID: "form-1", UserID: "owner"}
+	submitOnly := &apikey.Key{ID: "k1", FormID: "form-1", Permissions: apikey.Permissions{apikey.PermissionSubmit}}
+
+	tests := []struct {
+		name       string
+		form       *model.Form
+		getErr     error
+		wantStatus int
+	}{
+		{
+			name:       "missing form is not found",
+			getErr:     common.NewNotFoundError("get", "form", "form-1"),
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "store failure is a server error",
+			getErr:     errors.New("connection reset"),
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name:       "denied permission is forbidden",
+			form:       form,
+			wantStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			logger := mocklogging.NewMockLogger(ctrl)
+			logger.EXPECT().WithComponent(gomock.Any()).Return(logger).AnyTimes()
+			logger.EXPECT().With(gomock.Any()).Return(logger).AnyTimes()
+			logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+			logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+			formService := mockform.NewMockService(ctrl)
+			formService.EXPECT().GetForm(gomock.Any(), "form-1").Return(tt.form, tt.getErr)
+
+			handler := buildUsageHandler(t, formService, logger)
+
+			e := echo.New()
+			rec := httptest.NewRecorder()
+			c := e.NewContext(httptest.NewRequest(http.MethodPut, "/api/server/forms/form-1", http.NoBody), rec)
+			c.SetParamNames("id")
+			c.SetParamValues("form-1")
+			apikeymw.SetKey(c, submitOnly)
+
+			got, err := handler.getFormWithPermissionOrError(c, workspace.PermissionEditForm)
+			require.Error(t, err, "callers must stop once a response was sent")
+			assert.Nil(t, got)
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			var problem response.Problem
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem), "exactly one response is written")
+			assert.Equal(t, tt.wantStatus, problem.Status)
+		})
+	}
+}
@@ -0,0 +1,336 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/goformx/goforms/internal/application/constants"
+	apikeymw "github.com/goformx/goforms/internal/application/middleware/apikey"
+	ctxmw "github.com/goformx/goforms/internal/application/middleware/context"
+	"github.com/goformx/goforms/internal/application/response"
+	"github.com/goformx/goforms/internal/domain/audit"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/domain/workspace"
+)
+
+// recordAudit appends a mutation to the audit log, filling in actor, IP and request ID from the request.
+// The mutation has already been committed, so a failure does not fail the request; it is logged as
+// auditNotRecorded with everything needed to find the mutation, for operators to alert on.
+func (h *FormAPIHandler) recordAudit(c echo.Context, record audit.Record) {
+	recordAuditEntry(h.BaseHandler, h.AuditService, c, record)
+}
+
+// auditNotRecorded is the log message of an audit entry that could not be written
+const auditNotRecorded = "audit entry not recorded"
+
+// recordAuditEntry appends a mutation to the audit log on behalf of any handler
+func recordAuditEntry(h *BaseHandler, auditService audit.Service, c echo.Context, record audit.Record) {
+	if auditService == nil {
+		return
+	}
+
+	if userID, ok := c.Get("user_id").(string); ok {
+		record.ActorID = userID
+	} else if key, keyOK := apikeymw.GetKey(c); keyOK {
+		record.ActorID = audit.APIKeyActorPrefix + key.ID
+	}
+
+	record.IPAddress = c.RealIP()
+	record.RequestID = requestIDFromContext(c)
+
+	if _, err := auditService.Record(c.Request().Context(), record); err != nil {
+		h.Logger.Error(auditNotRecorded,
+			"action", record.Action,
+			"resource_type", record.ResourceType,
+			"resource_id", record.ResourceID,
+			"owner_id", h.Logger.SanitizeField("owner_id", record.OwnerID),
+			"actor_id", h.Logger.SanitizeField("actor_id", record.ActorID),
+			"request_id", record.RequestID,
+			"error", err)
+	}
+}
+
+// isAdmin reports whether the current user is an administrator. Session routes carry the role in
+// the context; assertion routes only carry the user ID, so the role is looked up.
+func (h *FormAPIHandler) isAdmin(c echo.Context) bool {
+	if ctxmw.IsAdmin(c) {
+		return true
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok || h.UserService == nil {
+		return false
+	}
+
+	user, err := h.UserService.GetUserByID(c.Request().Context(), userID)
+	if err != nil || user == nil {
+		return false
+	}
+
+	return user.Role == constants.UserRoleAdmin
+}
+
+// requestIDFromContext returns the request ID assigned by the context middleware or supplied by the client
+func requestIDFromContext(c echo.Context) string {
+	if id := ctxmw.GetRequestID(c.Request().Context()); id != "" {
+		return id
+	}
+
+	if id := c.Request().Header.Get(ctxmw.RequestIDHeader); id != "" {
+		return id
+	}
+
+	return c.Response().Header().Get(echo.HeaderXRequestID)
+}
+
+// auditOwner returns the principal that owns a form's audit entries: its workspace, or its creator
+func auditOwner(form *model.Form) string {
+	if form.WorkspaceID != "" {
+		return form.WorkspaceID
+	}
+
+	return form.UserID
+}
+
+// formSnapshot captures the auditable state of a form
+func formSnapshot(form *model.Form) audit.Snapshot {
+	if form == nil {
+		return nil
+	}
+
+	snapshot := audit.Snapshot{
+		"id":          form.ID,
+		"user_id":     form.UserID,
+		"title":       form.Title,
+		"description": form.Description,
+		"status":      form.Status,
+		"active":      form.Active,
+		"plan_tier":   form.PlanTier,
+	}
+
+	if form.WorkspaceID != "" {
+		snapshot["workspace_id"] = form.WorkspaceID
+	}
+
+	if form.Schema != nil {
+		snapshot["schema"] = map[string]any(form.Schema)
+	}
+
+	if form.CorsOrigins != nil {
+		snapshot["cors_origins"] = map[string]any(form.CorsOrigins)
+	}
+
+	return snapshot
+}
+
+// formUpdateAction classifies a form update by the fields that changed.
+// Status-only changes are state changes and CORS-only changes are settings updates.
+func formUpdateAction(before, after audit.Snapshot) audit.Action {
+	changed := make(map[string]bool)
+
+	for key, value := range after {
+		if !reflect.DeepEqual(before[key], value) {
+			changed[key] = true
+		}
+	}
+
+	for key := range before {
+		if _, ok := after[key]; !ok {
+			changed[key] = true
+		}
+	}
+
+	switch {
+	case len(changed) == 1 && changed["status"]:
+		return audit.ActionFormStateChanged
+	case len(changed) == 1 && changed["cors_origins"]:
+		return audit.ActionFormSettingsUpdated
+	default:
+		return audit.ActionFormUpdated
+	}
+}
+
+// submissionSnapshot captures the auditable state of a submission.
+// Only field names are recorded so that submitted values never enter the immutable log.
+func submissionSnapshot(submission *model.FormSubmission) audit.Snapshot {
+	if submission == nil {
+		return nil
+	}
+
+	fields := make([]string, 0, len(submission.Data))
+	for key := range submission.Data {
+		fields = append(fields, key)
+	}
+
+	sort.Strings(fields)
+
+	return audit.Snapshot{
+		"id":      submission.ID,
+		"form_id": submission.FormID,
+		"status":  string(submission.Status),
+		"fields":  fields,
+	}
+}
+
+// GET /api/forms/audit - list audit entries for the active workspace, or the current user in personal scope
+func (h *FormAPIHandler) handleListAuditLog(c echo.Context) error {
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return h.HandleForbidden(c, "User not authenticated")
+	}
+
+	filter, err := parseAuditFilter(c)
+	if err != nil {
+		return response.ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	workspaceID, err := h.activeWorkspace(c, userID, workspace.PermissionViewAudit)
+	if err != nil {
+		return h.handleWorkspaceError(c, err)
+	}
+
+	filter.OwnerID = userID
+	if workspaceID != "" {
+		filter.OwnerID = workspaceID
+	}
+
+	return h.respondWithAuditEntries(c, filter)
+}
+
+// GET /api/forms/:id/audit - list audit entries for a single form
+func (h *FormAPIHandler) handleFormAuditLog(c echo.Context) error {
+	form, err := h.getFormWithPermissionOrError(c, workspace.PermissionViewAudit)
+	if err != nil {
+		return err
+	}
+
+	filter, err := parseAuditFilter(c)
+	if err != nil {
+		return response.ErrorResponse(c, http.StatusBadRequest, err.Error())
+	}
+
+	filter.OwnerID = auditOwner(form)
+	filter.ResourceType = audit.ResourceForm
+	filter.ResourceID = form.ID
+
+	return h.respondWithAuditEntries(c, filter)
+}
+
+// GET /api/forms/audit/verify - verify the integrity of the audit hash chain (admins only)
+func (h *FormAPIHandler) handleVerifyAuditLog(c echo.Context) error {
+	if h.AuditService == nil {
+		return h.HandleNotFound(c, "Audit log is not enabled")
+	}
+
+	// The chain spans every owner, so only administrators may verify it
+	if !h.isAdmin(c) {
+		return h.HandleForbidden(c, "Admin access required")
+	}
+
+	result, err := h.AuditService.Verify(c.Request().Context())
+	if err != nil {
+		h.Logger.Error("failed to verify audit log", "error", err)
+
+		return h.HandleError(c, err, "Failed to verify audit log")
+	}
+
+	status := http.StatusOK
+	if !result.Valid {
+		status = http.StatusConflict
+	}
+
+	return c.JSON(status, response.APIResponse{
+		Success: result.Valid,
+		Data:    result,
+	})
+}
+
+// respondWithAuditEntries queries the audit log and writes a paginated response
+func (h *FormAPIHandler) respondWithAuditEntries(c echo.Context, filter audit.Filter) error {
+	if h.AuditService == nil {
+		return h.HandleNotFound(c, "Audit log is not enabled")
+	}
+
+	entries, total, err := h.AuditService.List(c.Request().Context(), filter)
+	if err != nil {
+		h.Logger.Error("failed to list audit entries", "error", err)
+
+		return h.HandleError(c, err, "Failed to list audit log")
+	}
+
+	filter.Normalize()
+
+	return c.JSON(http.StatusOK, response.APIResponse{
+		Success: true,
+		Data: map[string]any{
+			"entries": entries,
+			"total":   total,
+			"limit":   filter.Limit,
+			"offset":  filter.Offset,
+		},
+	})
+}
+
+// parseAuditFilter builds an audit filter from query parameters
+func parseAuditFilter(c echo.Context) (audit.Filter, error) {
+	filter := audit.Filter{
+		ActorID:      c.QueryParam("actor"),
+		Action:       audit.Action(c.QueryParam("action")),
+		ResourceType: c.QueryParam("resource_type"),
+		ResourceID:   c.QueryParam("resource_id"),
+	}
+
+	var err error
+
+	if filter.From, err = parseAuditTime(c.QueryParam("from")); err != nil {
+		return filter, errInvalidAuditParam("from")
+	}
+
+	if filter.To, err = parseAuditTime(c.QueryParam("to")); err != nil {
+		return filter, errInvalidAuditParam("to")
+	}
+
+	if filter.Limit, err = parseAuditInt(c.QueryParam("limit")); err != nil {
+		return filter, errInvalidAuditParam("limit")
+	}
+
+	if filter.Offset, err = parseAuditInt(c.QueryParam("offset")); err != nil {
+		return filter, errInvalidAuditParam("offset")
+	}
+
+	return filter, nil
+}
+
+// errInvalidAuditParam describes an invalid audit query parameter
+func errInvalidAuditParam(name string) error {
+	return fmt.Errorf("invalid %s parameter", name)
+}
+
+// parseAuditTime parses an optional RFC 3339 timestamp
+func parseAuditTime(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+
+	return time.Parse(time.RFC3339, value)
+}
+
+// parseAuditInt parses an optional non-negative integer
+func parseAuditInt(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 0 {
+		return 0, fmt.Errorf("invalid integer: %q", value)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,95 @@
+package web //nolint:testpackage // internal test for unexported handler methods
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goformx/goforms/internal/domain/audit"
+	"github.com/goformx/goforms/internal/domain/entities"
+	mockaudit "github.com/goformx/goforms/test/mocks/audit"
+	mockform "github.com/goformx/goforms/test/mocks/form"
+	mocklogging "github.com/goformx/goforms/test/mocks/logging"
+	mockuser "github.com/goformx/goforms/test/mocks/user"
+)
+
+// buildAuditHandler constructs a FormAPIHandler whose users user123 (a member) and admin1 (an administrator) exist
+func buildAuditHandler(t *testing.T, auditService audit.Service) *FormAPIHandler {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	users := mockuser.NewMockService(ctrl)
+	users.EXPECT().GetUserByID(gomock.Any(), "user123").Return(&entities.User{ID: "user123", Role: "user"}, nil).AnyTimes()
+	users.EXPECT().GetUserByID(gomock.Any(), "admin1").Return(&entities.User{ID: "admin1", Role: "admin"}, nil).AnyTimes()
+
+	handler := buildUsageHandler(t, mockform.NewMockService(ctrl), logger)
+	handler.UserService = users
+	handler.AuditService = auditService
+
+	return handler
+}
+
+func serveVerifyAuditLog(t *testing.T, handler *FormAPIHandler, userID string) *httptest.ResponseRecorder {
+	t.Helper()
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/api/forms/audit/verify", http.NoBody), rec)
+	c.Set("user_id", userID)
+
+	require.NoError(t, handler.handleVerifyAuditLog(c))
+
+	return rec
+}
+
+func TestHandleVerifyAuditLog_RequiresAdmin(t *testing.T) {
+	auditService := mockaudit.NewMockService(gomock.NewController(t))
+	auditService.EXPECT().Verify(gomock.Any()).Return(&audit.VerificationResult{Valid: true, Checked: 3}, nil)
+
+	handler := buildAuditHandler(t, auditService)
+
+	assert.Equal(t, http.StatusForbidden, serveVerifyAuditLog(t, handler, "user123").Code)
+
+	rec := serveVerifyAuditLog(t, handler, "admin1")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"checked":3`)
+}
+
+func TestHandleVerifyAuditLog_AuditDisabled(t *testing.T) {
+	handler := buildAuditHandler(t, nil)
+
+	assert.Equal(t, http.StatusNotFound, serveVerifyAuditLog(t, handler, "admin1").Code)
+}
+
+func TestRecordAudit_LogsFailuresWithoutFailingTheRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	auditService := mockaudit.NewMockService(ctrl)
+	auditService.EXPECT().Record(gomock.Any(), gomock.Any()).Return(nil, errors.New("database is down"))
+
+	logger := mocklogging.NewMockLogger(ctrl)
+	logger.EXPECT().SanitizeField(gomock.Any(), gomock.Any()).Return("redacted").AnyTimes()
+	logger.EXPECT().Error(auditNotRecorded, gomock.Any()).Do(func(_ string, fields ...any) {
+		assert.Contains(t, fields, "form-1")
+		assert.Contains(t, fields, audit.ActionFormCreated)
+	})
+
+	handler := buildUsageHandler(t, mockform.NewMockService(ctrl), logger)
+	handler.AuditService = auditService
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodPost, "/api/forms", http.NoBody), rec)
+	c.Set("user_id", "user123")
+
+	handler.recordAudit(c, audit.Record{Action: audit.ActionFormCreated, ResourceType: audit.ResourceForm, ResourceID: "form-1"})
+	assert.False(t, c.Response().Committed, "the mutation's own response is still written")
+}
@@ -1,14 +1,20 @@
 package web
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/goformx/goforms/internal/application/constants"
+	apikeymw "github.com/goformx/goforms/internal/application/middleware/apikey"
 	"github.com/goformx/goforms/internal/application/validation"
+	"github.com/goformx/goforms/internal/domain/apikey"
+	"github.com/goformx/goforms/internal/domain/audit"
 	formdomain "github.com/goformx/goforms/internal/domain/form"
 	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/domain/workspace"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
 )
 
 // FormBaseHandler extends BaseHandler with form-specific functionality
@@ -16,6 +22,8 @@ type FormBaseHandler struct {
 	*BaseHandler
 	FormService   formdomain.Service
 	FormValidator *validation.FormValidator
+	// Workspaces authorizes access to workspace-owned forms; when nil only personal forms are accessible
+	Workspaces workspace.Service
 }
 
 // NewFormBaseHandler creates a new form base handler
@@ -39,16 +47,20 @@ func (h *FormBaseHandler) GetFormByID(c echo.Context) (*model.Form, error) {
 	logger.Debug("getting form by ID")
 
 	form, err := h.FormService.GetForm(c.Request().Context(), formID)
-	if err != nil {
-		logger.Debug("failed to get form by ID", "error", err)
+	if form == nil && (err == nil || errors.Is(err, common.ErrNotFound)) {
+		logger.Debug("form not found")
 
-		return nil, fmt.Errorf("get form by ID: %w", err)
+		if handleErr := h.HandleNotFound(c, "Form not found"); handleErr != nil {
+			return nil, fmt.Errorf("get form by ID: %w", handleErr)
+		}
+
+		return nil, echo.NewHTTPError(constants.StatusNotFound, "Form not found")
 	}
 
-	if form == nil {
-		logger.Debug("form not found")
+	if err != nil {
+		logger.Debug("failed to get form by ID", "error", err)
 
-		return nil, fmt.Errorf("get form by ID: %w", h.HandleNotFound(c, "Form not found"))
+		return nil, fmt.Errorf("get form by ID: %w", err)
 	}
 
 	logger.Debug("form retrieved successfully", "title", form.Title)
@@ -56,8 +68,31 @@ func (h *FormBaseHandler) GetFormByID(c echo.Context) (*model.Form, error) {
 	return form, nil
 }
 
-// RequireFormOwnership verifies the user owns the form
-func (h *FormBaseHandler) RequireFormOwnership(c echo.Context, form *model.Form) error {
+// apiKeyPermissions maps the permissions checked by handlers to the API key permission that grants them.
+// Permissions not listed here are never granted to API keys.
+var apiKeyPermissions = map[workspace.Permission]apikey.Permission{
+	workspace.PermissionViewForm:        apikey.PermissionManageForm,
+	workspace.PermissionEditForm:        apikey.PermissionManageForm,
+	workspace.PermissionViewSubmissions: apikey.PermissionReadSubmissions,
+}
+
+// RequireFormPermission verifies the user, or the API key on the request, holds permission on the form.
+// Personal forms grant every permission to their creator; workspace forms defer to the member's role.
+// Users without any access get a not-found response so form existence is not leaked.
+func (h *FormBaseHandler) RequireFormPermission(
+	c echo.Context,
+	form *model.Form,
+	permission workspace.Permission,
+) error {
+	if key, isKey := apikeymw.GetKey(c); isKey {
+		keyPermission, granted := apiKeyPermissions[permission]
+		if !granted {
+			return h.forbidFormAccess(c)
+		}
+
+		return h.RequireKeyPermission(c, form, key, keyPermission)
+	}
+
 	userID, ok := c.Get("user_id").(string)
 	if !ok {
 		if handleErr := h.HandleForbidden(c, "User not authenticated"); handleErr != nil {
@@ -67,30 +102,92 @@ func (h *FormBaseHandler) RequireFormOwnership(c echo.Context, form *model.Form)
 		return echo.NewHTTPError(constants.StatusUnauthorized, "User not authenticated")
 	}
 
-	if form.UserID != userID {
-		h.Logger.Warn("ownership verification failed",
-			"resource_user_id", form.UserID,
-			"request_user_id", userID)
-
-		if handleErr := h.HandleNotFound(c, "Form not found"); handleErr != nil {
-			h.Logger.Error("failed to handle not found", "error", handleErr)
+	if form.WorkspaceID == "" {
+		if form.UserID == userID {
+			return nil
 		}
 
-		return echo.NewHTTPError(constants.StatusNotFound, "Form not found")
+		return h.denyFormAccess(c, form, userID)
+	}
+
+	if h.Workspaces == nil {
+		return h.denyFormAccess(c, form, userID)
+	}
+
+	_, err := h.Workspaces.Authorize(c.Request().Context(), form.WorkspaceID, userID, permission)
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, workspace.ErrNotMember):
+		return h.denyFormAccess(c, form, userID)
+	case errors.Is(err, workspace.ErrPermissionDenied):
+		return h.forbidFormAccess(c)
+	default:
+		return fmt.Errorf("authorize form access: %w", err)
+	}
+}
+
+// RequireKeyPermission verifies an API key is scoped to the form and grants permission.
+// Keys scoped elsewhere get a not-found response, like users without access.
+func (h *FormBaseHandler) RequireKeyPermission(
+	c echo.Context,
+	form *model.Form,
+	key *apikey.Key,
+	permission apikey.Permission,
+) error {
+	if !key.AppliesTo(form.ID, form.WorkspaceID) {
+		return h.denyFormAccess(c, form, audit.APIKeyActorPrefix+key.ID)
+	}
+
+	if !key.Can(permission) {
+		h.Logger.Warn("api key permission denied",
+			"form_id", form.ID,
+			"key_id", key.ID,
+			"permission", permission)
+
+		return h.forbidFormAccess(c)
 	}
 
 	return nil
 }
 
-// GetFormWithOwnership gets a form and verifies ownership in one call
-func (h *FormBaseHandler) GetFormWithOwnership(c echo.Context) (*model.Form, error) {
+// forbidFormAccess responds with forbidden for principals that can see a form but lack a permission
+func (h *FormBaseHandler) forbidFormAccess(c echo.Context) error {
+	if handleErr := h.HandleForbidden(c, "You don't have permission to perform this action"); handleErr != nil {
+		h.Logger.Error("failed to handle forbidden", "error", handleErr)
+	}
+
+	return echo.NewHTTPError(constants.StatusForbidden, "Permission denied")
+}
+
+// denyFormAccess responds with not found for users who have no access to a form
+func (h *FormBaseHandler) denyFormAccess(c echo.Context, form *model.Form, userID string) error {
+	h.Logger.Warn("form access verification failed",
+		"form_id", form.ID,
+		"workspace_id", form.WorkspaceID,
+		"resource_user_id", form.UserID,
+		"request_user_id", userID)
+
+	if handleErr := h.HandleNotFound(c, "Form not found"); handleErr != nil {
+		h.Logger.Error("failed to handle not found", "error", handleErr)
+	}
+
+	return echo.NewHTTPError(constants.StatusNotFound, "Form not found")
+}
+
+// GetFormWithPermission gets a form and verifies the user holds permission on it in one call
+func (h *FormBaseHandler) GetFormWithPermission(
+	c echo.Context,
+	permission workspace.Permission,
+) (*model.Form, error) {
 	form, err := h.GetFormByID(c)
 	if err != nil {
 		return nil, err
 	}
 
-	if ownershipErr := h.RequireFormOwnership(c, form); ownershipErr != nil {
-		return nil, ownershipErr
+	if permissionErr := h.RequireFormPermission(c, form, permission); permissionErr != nil {
+		return nil, permissionErr
 	}
 
 	return form, nil
@@ -0,0 +1,347 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/goformx/goforms/internal/application/constants"
+	apikeymw "github.com/goformx/goforms/internal/application/middleware/apikey"
+	"github.com/goformx/goforms/internal/application/response"
+	"github.com/goformx/goforms/internal/application/validation"
+	"github.com/goformx/goforms/internal/domain/audit"
+	"github.com/goformx/goforms/internal/domain/bulk"
+	formdomain "github.com/goformx/goforms/internal/domain/form"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/domain/workspace"
+)
+
+// defaultBulkJobListLimit is the number of jobs listed when no limit is given
+const defaultBulkJobListLimit = 20
+
+// BulkSubmissionRequest selects submissions by ids or by filter and names the operation to apply
+type BulkSubmissionRequest struct {
+	Operation string                `json:"operation"`
+	IDs       []string              `json:"ids"`
+	Filter    *BulkSubmissionFilter `json:"filter"`
+	Status    string                `json:"status"`
+	Format    string                `json:"format"`
+}
+
+// BulkSubmissionFilter selects submissions by status and submission time; an empty filter selects all
+type BulkSubmissionFilter struct {
+	Status          string     `json:"status"`
+	SubmittedAfter  *time.Time `json:"submitted_after"`
+	SubmittedBefore *time.Time `json:"submitted_before"`
+}
+
+// errInvalidBulkSelection is returned when a bulk request gives both or neither of ids and filter
+var errInvalidBulkSelection = fmt.Errorf("%w: provide either ids or filter", bulk.ErrInvalidRequest)
+
+// registerBulkRoutes registers the bulk submission job routes on the assertion API group
+func (h *FormAPIHandler) registerBulkRoutes(group *echo.Group) {
+	if h.BulkJobs == nil {
+		return
+	}
+
+	group.GET("/:id/submissions/bulk", h.handleListBulkJobs)
+	group.POST("/:id/submissions/bulk", h.handleStartBulkJob)
+	group.GET("/:id/submissions/bulk/:jobId", h.handleGetBulkJob)
+	group.POST("/:id/submissions/bulk/:jobId/retry", h.handleRetryBulkJob)
+	group.GET("/:id/submissions/bulk/:jobId/export", h.handleDownloadBulkExport)
+}
+
+// POST /api/forms/:id/submissions/bulk - apply an operation to selected submissions (assertion auth).
+// Small selections complete before the response (200); larger ones return 202 with the job to poll.
+func (h *FormAPIHandler) handleStartBulkJob(c echo.Context) error {
+	var req BulkSubmissionRequest
+	if err := c.Bind(&req); err != nil {
+		return response.ErrorResponse(c, http.StatusBadRequest, "Invalid request body")
+	}
+
+	operation := bulk.Operation(req.Operation)
+
+	form, err := h.getFormWithPermissionOrError(c, bulkPermission(operation))
+	if err != nil {
+		return err
+	}
+
+	selection, err := bulkSelection(req)
+	if err != nil {
+		return h.handleBulkError(c, err)
+	}
+
+	job, err := h.BulkJobs.Start(c.Request().Context(), bulk.Request{
+		FormID:    form.ID,
+		CreatedBy: requestActor(c),
+		Operation: operation,
+		Selection: selection,
+		Status:    model.SubmissionStatus(req.Status),
+		Format:    req.Format,
+		Columns:   exportColumns(form.Schema),
+	})
+	if err != nil {
+		return h.handleBulkError(c, err)
+	}
+
+	action := audit.ActionSubmissionsBulkUpdated
+	if operation == bulk.OperationExport {
+		action = audit.ActionSubmissionsExported
+	}
+
+	h.recordAudit(c, audit.Record{
+		OwnerID:      auditOwner(form),
+		Action:       action,
+		ResourceType: audit.ResourceBulkJob,
+		ResourceID:   job.ID,
+		After:        bulkJobSnapshot(job),
+	})
+
+	return bulkJobResponse(c, job)
+}
+
+// GET /api/forms/:id/submissions/bulk - list a form's recent bulk jobs (assertion auth)
+func (h *FormAPIHandler) handleListBulkJobs(c echo.Context) error {
+	form, err := h.getFormWithPermissionOrError(c, workspace.PermissionViewSubmissions)
+	if err != nil {
+		return err
+	}
+
+	limit, err := parseListLimit(c.QueryParam("limit"))
+	if err != nil {
+		return response.ErrorResponse(c, http.StatusBadRequest, errInvalidListParam("limit").Error())
+	}
+
+	if limit == 0 {
+		limit = defaultBulkJobListLimit
+	}
+
+	jobs, err := h.BulkJobs.List(c.Request().Context(), form.ID, min(limit, formdomain.MaxPageSize))
+	if err != nil {
+		return h.handleBulkError(c, err)
+	}
+
+	data := make([]map[string]any, len(jobs))
+	for i, job := range jobs {
+		data[i] = bulkJobData(job)
+	}
+
+	return response.Success(c, map[string]any{"jobs": data, "count": len(data)})
+}
+
+// GET /api/forms/:id/submissions/bulk/:jobId - report a bulk job's progress (assertion auth)
+func (h *FormAPIHandler) handleGetBulkJob(c echo.Context) error {
+	form, err := h.getFormWithPermissionOrError(c, workspace.PermissionViewSubmissions)
+	if err != nil {
+		return err
+	}
+
+	job, err := h.BulkJobs.Get(c.Request().Context(), form.ID, c.Param("jobId"))
+	if err != nil {
+		return h.handleBulkError(c, err)
+	}
+
+	return response.Success(c, bulkJobData(job))
+}
+
+// POST /api/forms/:id/submissions/bulk/:jobId/retry - resume a failed bulk job (assertion auth)
+func (h *FormAPIHandler) handleRetryBulkJob(c echo.Context) error {
+	form, err := h.getFormWithPermissionOrError(c, workspace.PermissionViewSubmissions)
+	if err != nil {
+		return err
+	}
+
+	job, err := h.BulkJobs.Get(c.Request().Context(), form.ID, c.Param("jobId"))
+	if err != nil {
+		return h.handleBulkError(c, err)
+	}
+
+	if permissionErr := h.RequireFormPermission(c, form, bulkPermission(job.Operation)); permissionErr != nil {
+		return permissionErr
+	}
+
+	if job, err = h.BulkJobs.Retry(c.Request().Context(), form.ID, job.ID); err != nil {
+		return h.handleBulkError(c, err)
+	}
+
+	return bulkJobResponse(c, job)
+}
+
+// GET /api/forms/:id/submissions/bulk/:jobId/export - download a completed export (assertion auth)
+func (h *FormAPIHandler) handleDownloadBulkExport(c echo.Context) error {
+	form, err := h.getFormWithPermissionOrError(c, workspace.PermissionViewSubmissions)
+	if err != nil {
+		return err
+	}
+
+	job, err := h.BulkJobs.Get(c.Request().Context(), form.ID, c.Param("jobId"))
+	if err != nil {
+		return h.handleBulkError(c, err)
+	}
+
+	output, err := h.BulkJobs.Output(c.Request().Context(), form.ID, job.ID)
+	if err != nil {
+		return h.handleBulkError(c, err)
+	}
+
+	contentType := "text/csv; charset=utf-8"
+	if job.ExportFormat == bulk.FormatNDJSON {
+		contentType = "application/x-ndjson"
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition,
+		fmt.Sprintf(`attachment; filename="submissions-%s.%s"`, form.ID, job.ExportFormat))
+
+	return c.Blob(http.StatusOK, contentType, output)
+}
+
+// handleBulkError maps bulk job errors to HTTP responses
+func (h *FormAPIHandler) handleBulkError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, bulk.ErrJobNotFound):
+		return h.HandleNotFound(c, "Bulk job not found")
+	case errors.Is(err, bulk.ErrInvalidRequest):
+		return response.ErrorResponse(c, http.StatusBadRequest, err.Error())
+	case errors.Is(err, bulk.ErrNotRetryable), errors.Is(err, bulk.ErrNoOutput):
+		return response.ErrorResponse(c, http.StatusConflict, err.Error())
+	default:
+		h.Logger.Error("bulk operation failed", "form_id", c.Param("id"), "error", err)
+
+		return h.HandleError(c, err, "Bulk operation failed")
+	}
+}
+
+// bulkPermission returns the permission an operation requires: exports only read submissions
+func bulkPermission(operation bulk.Operation) workspace.Permission {
+	if operation == bulk.OperationExport {
+		return workspace.PermissionViewSubmissions
+	}
+
+	return workspace.PermissionManageSubmissions
+}
+
+// bulkSelection turns the ids or filter of a request into a job selection
+func bulkSelection(req BulkSubmissionRequest) (bulk.Selection, error) {
+	if (len(req.IDs) > 0) == (req.Filter != nil) {
+		return bulk.Selection{}, errInvalidBulkSelection
+	}
+
+	if req.Filter == nil {
+		return bulk.Selection{IDs: req.IDs}, nil
+	}
+
+	return bulk.Selection{
+		Status:          model.SubmissionStatus(req.Filter.Status),
+		SubmittedAfter:  req.Filter.SubmittedAfter,
+		SubmittedBefore: req.Filter.SubmittedBefore,
+	}, nil
+}
+
+// requestActor identifies who made the request: the user, or the API key used for it
+func requestActor(c echo.Context) string {
+	if userID, ok := c.Get("user_id").(string); ok {
+		return userID
+	}
+
+	if key, ok := apikeymw.GetKey(c); ok {
+		return audit.APIKeyActorPrefix + key.ID
+	}
+
+	return audit.AnonymousActor
+}
+
+// exportColumns lists the keys of a form's input components in schema order
+func exportColumns(schema model.JSON) []string {
+	components, ok := validation.NewSchemaParser().ExtractInputComponents(schema)
+	if !ok {
+		return nil
+	}
+
+	var columns []string
+
+	for _, component := range components {
+		key, _ := component["key"].(string)
+		if key == "" || component["input"] == false {
+			continue
+		}
+
+		if componentType, _ := component["type"].(string); componentType == "button" {
+			continue
+		}
+
+		columns = append(columns, key)
+	}
+
+	return columns
+}
+
+// bulkJobResponse returns a job with 200 when it has finished and 202 with its location while it runs
+func bulkJobResponse(c echo.Context, job *bulk.Job) error {
+	if job.Done() {
+		return response.Success(c, bulkJobData(job))
+	}
+
+	c.Response().Header().Set(echo.HeaderLocation, bulkJobPath(job))
+
+	return c.JSON(http.StatusAccepted, response.APIResponse{
+		Success: true,
+		Message: "Bulk job queued",
+		Data:    bulkJobData(job),
+	})
+}
+
+// bulkJobData renders a job with its progress and, for completed exports, the download link
+func bulkJobData(job *bulk.Job) map[string]any {
+	data := map[string]any{
+		"job":      job,
+		"progress": job.Progress(),
+		"url":      bulkJobPath(job),
+	}
+
+	if job.Operation == bulk.OperationExport && job.Status == bulk.StatusCompleted {
+		data["download_url"] = bulkJobPath(job) + "/export"
+	}
+
+	return data
+}
+
+// bulkJobPath returns the progress resource of a job
+func bulkJobPath(job *bulk.Job) string {
+	return constants.PathAPIFormsLaravel + "/" + job.FormID + "/submissions/bulk/" + job.ID
+}
+
+// bulkJobSnapshot captures the auditable state of a bulk job
+func bulkJobSnapshot(job *bulk.Job) audit.Snapshot {
+	snapshot := audit.Snapshot{
+		"id":        job.ID,
+		"form_id":   job.FormID,
+		"operation": job.Operation,
+		"status":    job.Status,
+		"total":     job.Total,
+	}
+
+	if len(job.Selection.IDs) > 0 {
+		snapshot["ids"] = []string(job.Selection.IDs)
+	}
+
+	if job.Selection.Status != "" {
+		snapshot["filter_status"] = job.Selection.Status
+	}
+
+	if job.Selection.SubmittedAfter != nil {
+		snapshot["submitted_after"] = job.Selection.SubmittedAfter.UTC().Format(time.RFC3339)
+	}
+
+	if job.Selection.SubmittedBefore != nil {
+		snapshot["submitted_before"] = job.Selection.SubmittedBefore.UTC().Format(time.RFC3339)
+	}
+
+	if job.TargetStatus != "" {
+		snapshot["target_status"] = job.TargetStatus
+	}
+
+	return snapshot
+}
@@ -0,0 +1,158 @@
+package web //nolint:testpackage // internal test for unexported handler methods
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goformx/goforms/internal/application/response"
+	"github.com/goformx/goforms/internal/domain/bulk"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	mockbulk "github.com/goformx/goforms/test/mocks/bulk"
+	mockform "github.com/goformx/goforms/test/mocks/form"
+	mocklogging "github.com/goformx/goforms/test/mocks/logging"
+)
+
+func buildBulkHandler(t *testing.T) (*FormAPIHandler, *mockbulk.MockService) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	formService := mockform.NewMockService(ctrl)
+	formService.EXPECT().GetForm(gomock.Any(), "form-1").Return(&model.Form{
+		ID:     "form-1",
+		UserID: "user123",
+		Schema: model.JSON{"components": []any{
+			map[string]any{"type": "textfield", "key": "name", "input": true},
+			map[string]any{"type": "panel", "key": "details", "components": []any{
+				map[string]any{"type": "email", "key": "email", "input": true},
+			}},
+			map[string]any{"type": "button", "key": "submit", "input": true},
+		}},
+	}, nil).AnyTimes()
+
+	logger := mocklogging.NewMockLogger(ctrl)
+	logger.EXPECT().WithComponent(gomock.Any()).Return(logger).AnyTimes()
+	logger.EXPECT().With(gomock.Any()).Return(logger).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	bulkJobs := mockbulk.NewMockService(ctrl)
+	handler := buildUsageHandler(t, formService, logger)
+	handler.BulkJobs = bulkJobs
+
+	return handler, bulkJobs
+}
+
+func bulkRequest(t *testing.T, method, body string, params ...string) (echo.Context, *httptest.ResponseRecorder) {
+	t.Helper()
+
+	req := httptest.NewRequest(method, "/api/forms/form-1/submissions/bulk", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.Set("user_id", "user123")
+	c.SetParamNames(append([]string{"id"}, params[:len(params)/2]...)...)
+	c.SetParamValues(append([]string{"form-1"}, params[len(params)/2:]...)...)
+
+	return c, rec
+}
+
+func TestHandleStartBulkJob_RequiresExactlyOneSelection(t *testing.T) {
+	handler, _ := buildBulkHandler(t)
+
+	for _, body := range []string{
+		`{"operation":"delete"}`,
+		`{"operation":"delete","ids":["a0c8e3a4-5c3e-4f0e-9e55-0b0b6f1f7a11"],"filter":{"status":"pending"}}`,
+	} {
+		c, rec := bulkRequest(t, http.MethodPost, body)
+		require.NoError(t, handler.handleStartBulkJob(c))
+		assert.Equal(t, http.StatusBadRequest, rec.Code, body)
+	}
+}
+
+func TestHandleStartBulkJob_InlineJobReturnsResult(t *testing.T) {
+	handler, bulkJobs := buildBulkHandler(t)
+
+	bulkJobs.EXPECT().Start(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, req bulk.Request) (*bulk.Job, error) {
+			assert.Equal(t, "form-1", req.FormID)
+			assert.Equal(t, "user123", req.CreatedBy)
+			assert.Equal(t, bulk.OperationSetStatus, req.Operation)
+			assert.Equal(t, model.SubmissionStatusCompleted, req.Status)
+			assert.Equal(t, model.SubmissionStatusPending, req.Selection.Status)
+			assert.Empty(t, req.Selection.IDs)
+			assert.Equal(t, []string{"name", "email"}, req.Columns)
+
+			return &bulk.Job{ID: "job-1", FormID: "form-1", Operation: req.Operation, Status: bulk.StatusCompleted, Total: 3, Processed: 3}, nil
+		})
+
+	c, rec := bulkRequest(t, http.MethodPost, `{"operation":"set_status","status":"completed","filter":{"status":"pending"}}`)
+	require.NoError(t, handler.handleStartBulkJob(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get(echo.HeaderLocation))
+
+	var resp response.APIResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+
+	data, ok := resp.Data.(map[string]any)
+	require.True(t, ok)
+	assert.InDelta(t, float64(100), data["progress"], 0)
+	assert.Equal(t, "/api/forms/form-1/submissions/bulk/job-1", data["url"])
+}
+
+func TestHandleStartBulkJob_BackgroundJobIsAccepted(t *testing.T) {
+	handler, bulkJobs := buildBulkHandler(t)
+
+	bulkJobs.EXPECT().Start(gomock.Any(), gomock.Any()).
+		Return(&bulk.Job{ID: "job-2", FormID: "form-1", Operation: bulk.OperationExport, Status: bulk.StatusQueued, Total: 5000}, nil)
+
+	c, rec := bulkRequest(t, http.MethodPost, `{"operation":"export","filter":{}}`)
+	require.NoError(t, handler.handleStartBulkJob(c))
+	assert.Equal(t, http.StatusAccepted, rec.Code)
+	assert.Equal(t, "/api/forms/form-1/submissions/bulk/job-2", rec.Header().Get(echo.HeaderLocation))
+}
+
+func TestHandleStartBulkJob_MapsInvalidRequest(t *testing.T) {
+	handler, bulkJobs := buildBulkHandler(t)
+
+	bulkJobs.EXPECT().Start(gomock.Any(), gomock.Any()).Return(nil, bulk.ErrInvalidRequest)
+
+	c, rec := bulkRequest(t, http.MethodPost, `{"operation":"archive","filter":{}}`)
+	require.NoError(t, handler.handleStartBulkJob(c))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestHandleDownloadBulkExport_ServesFile(t *testing.T) {
+	handler, bulkJobs := buildBulkHandler(t)
+
+	bulkJobs.EXPECT().Get(gomock.Any(), "form-1", "job-1").
+		Return(&bulk.Job{ID: "job-1", FormID: "form-1", Operation: bulk.OperationExport, ExportFormat: bulk.FormatCSV}, nil)
+	bulkJobs.EXPECT().Output(gomock.Any(), "form-1", "job-1").Return([]byte("id,submitted_at,status\n"), nil)
+
+	c, rec := bulkRequest(t, http.MethodGet, "", "jobId", "job-1")
+	require.NoError(t, handler.handleDownloadBulkExport(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "text/csv; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentDisposition), `filename="submissions-form-1.csv"`)
+	assert.Equal(t, "id,submitted_at,status\n", rec.Body.String())
+}
+
+func TestHandleRetryBulkJob_ConflictWhenNotFailed(t *testing.T) {
+	handler, bulkJobs := buildBulkHandler(t)
+
+	job := &bulk.Job{ID: "job-1", FormID: "form-1", Operation: bulk.OperationDelete, Status: bulk.StatusCompleted}
+	bulkJobs.EXPECT().Get(gomock.Any(), "form-1", "job-1").Return(job, nil)
+	bulkJobs.EXPECT().Retry(gomock.Any(), "form-1", "job-1").Return(nil, bulk.ErrNotRetryable)
+
+	c, rec := bulkRequest(t, http.MethodPost, "", "jobId", "job-1")
+	require.NoError(t, handler.handleRetryBulkJob(c))
+	assert.Equal(t, http.StatusConflict, rec.Code)
+}
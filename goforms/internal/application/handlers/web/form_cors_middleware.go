@@ -13,10 +13,16 @@ import (
 )
 
 var defaultFormCORSMethods = []string{http.MethodGet, http.MethodPost, http.MethodOptions}
-var defaultFormCORSHeaders = []string{"Content-Type", "Accept", "Origin"}
+var defaultFormCORSHeaders = []string{"Content-Type", "Accept", "Origin", "Idempotency-Key"}
 
 // NewFormCORSMiddleware enforces per-form CORS rules for public endpoints.
 func NewFormCORSMiddleware(formService formdomain.Service, corsConfig config.CORSConfig) echo.MiddlewareFunc {
+	return newFormCORSMiddleware(formService, func() config.CORSConfig { return corsConfig })
+}
+
+// newFormCORSMiddleware enforces per-form CORS rules, falling back to the site-wide CORS settings
+// current reads on each request
+func newFormCORSMiddleware(formService formdomain.Service, current func() config.CORSConfig) echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
 			if !isPublicFormCORSRequest(c.Request().Method, c.Request().URL.Path) {
@@ -24,7 +30,8 @@ func NewFormCORSMiddleware(formService formdomain.Service, corsConfig config.COR
 			}
 
 			origin := c.Request().Header.Get("Origin")
-			if origin == "" {
+			// Same-origin requests, such as posts from the HTML form page, are not cross-origin
+			if origin == "" || origin == c.Scheme()+"://"+c.Request().Host {
 				return next(c)
 			}
 
@@ -38,6 +45,7 @@ func NewFormCORSMiddleware(formService formdomain.Service, corsConfig config.COR
 				return next(c)
 			}
 
+			corsConfig := current()
 			allowedOrigins, allowedMethods, allowedHeaders := form.GetCorsConfig()
 			resolvedOrigins := resolveCORSList(allowedOrigins, corsConfig.AllowedOrigins, nil)
 			if !isOriginAllowed(origin, resolvedOrigins) {
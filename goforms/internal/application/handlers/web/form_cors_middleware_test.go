@@ -133,6 +133,29 @@ func TestFormCORSMiddleware_SkipsWhenOriginMissing(t *testing.T) {
 	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
 }
 
+func TestFormCORSMiddleware_SkipsSameOriginSubmit(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	formService := mockform.NewMockService(ctrl)
+
+	e := echo.New()
+	formsPublic := e.Group(constants.PathFormsPublic)
+	formsPublic.Use(web.NewFormCORSMiddleware(formService, config.CORSConfig{}))
+	formsPublic.POST("/:id/submit", func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})
+
+	// The HTML form page posts from the server's own origin
+	req := httptest.NewRequest(http.MethodPost, "/forms/form-123/submit", http.NoBody)
+	req.Host = "forms.example"
+	req.Header.Set("Origin", "http://forms.example")
+	rec := httptest.NewRecorder()
+
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+}
+
 func TestFormCORSMiddleware_AllowsPublicFormsPath(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	formService := mockform.NewMockService(ctrl)
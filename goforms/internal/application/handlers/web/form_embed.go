@@ -0,0 +1,326 @@
+package web
+
+import (
+	"bytes"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"html/template"
+	"net/http"
+	"regexp"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/goformx/goforms/internal/application/constants"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/renderer"
+)
+
+const (
+	// embedNonceBytes is the entropy of the per-response CSP nonce
+	embedNonceBytes = 16
+	// embedAssetCacheControl lets browsers and proxies keep versioned assets for a year
+	embedAssetCacheControl = "public, max-age=31536000, immutable"
+	// embedLoaderCacheControl keeps the stable loader URL fresh enough to roll out releases quickly
+	embedLoaderCacheControl = "public, max-age=300"
+)
+
+// embedPageTemplate renders the iframe page. It carries no inline script or style: the
+// bootstrap script reads its settings from data attributes and scripts run under the response nonce.
+var embedPageTemplate = template.Must(template.New("embed").Parse(`<!DOCTYPE html>
+<html data-cors-origin="{{.TargetOrigin}}">
+<head>
+  <meta charset="utf-8">
+  <meta name="viewport" content="width=device-width, initial-scale=1">
+  <title>{{.Title}}</title>
+  <link rel="stylesheet" href="{{.RendererStyle.URL}}"{{with .RendererStyle.Integrity}} integrity="{{.}}"{{end}}>
+  <link rel="stylesheet" href="{{.BootstrapStyle.URL}}" integrity="{{.BootstrapStyle.Integrity}}">
+</head>
+<body>
+  <div id="formio"></div>
+  <script src="{{.RendererScript.URL}}"{{with .RendererScript.Integrity}} integrity="{{.}}"{{end}} nonce="{{.Nonce}}"></script>
+  <script src="{{.BootstrapScript.URL}}" integrity="{{.BootstrapScript.Integrity}}" nonce="{{.Nonce}}"
+    data-form-id="{{.FormID}}" data-schema-url="{{.SchemaURL}}" data-submit-url="{{.SubmitURL}}"></script>
+</body>
+</html>
+`))
+
+// embedAssetRef is a script or stylesheet reference on the embed page
+type embedAssetRef struct {
+	URL       string
+	Integrity string
+}
+
+// embedPageData is the template data for the embed page
+type embedPageData struct {
+	FormID          string
+	Title           string
+	TargetOrigin    string
+	Nonce           string
+	SchemaURL       string
+	SubmitURL       string
+	RendererScript  embedAssetRef
+	RendererStyle   embedAssetRef
+	BootstrapScript embedAssetRef
+	BootstrapStyle  embedAssetRef
+}
+
+// GET /forms/:id/embed returns a minimal HTML page for embedding the form via iframe.
+// The Form.io renderer is served from the embedded asset bundle (or the CDN when it was not
+// vendored) and the form posts to /forms/:id/submit. The embed SDK passes the host page origin
+// as ?origin=; the page only exchanges messages with it when the form's CORS origins allow it.
+func (h *FormAPIHandler) handleFormEmbed(c echo.Context) error {
+	form, err := h.getFormOrError(c)
+	if err != nil {
+		return err
+	}
+
+	if form.Schema == nil {
+		h.Logger.Warn("form schema is nil for embed", "form_id", form.ID)
+
+		return h.wrapError("handle embed error",
+			h.ErrorHandler.HandleSchemaError(c, errors.New("form schema is required")))
+	}
+
+	nonce, err := newCSPNonce()
+	if err != nil {
+		h.Logger.Error("failed to generate embed nonce", "error", err, "form_id", form.ID)
+
+		return h.HandleError(c, err, "Failed to render form")
+	}
+
+	// Build frame-ancestors and target origin from the form's CORS origins
+	corsOrigins, _, _ := form.GetCorsConfig()
+	targetOrigin := embedTargetOrigin(c.QueryParam("origin"), corsOrigins)
+	frameAncestors := "'none'"
+	if len(corsOrigins) > 0 {
+		var safeOrigins []string
+		for _, o := range corsOrigins {
+			if sanitized := sanitizeCSPOrigin(o); sanitized != "" {
+				safeOrigins = append(safeOrigins, sanitized)
+			}
+		}
+
+		if len(safeOrigins) > 0 {
+			frameAncestors = strings.Join(safeOrigins, " ")
+		}
+	}
+
+	data := embedPageData{
+		FormID:          form.ID,
+		Title:           form.Title,
+		TargetOrigin:    targetOrigin,
+		Nonce:           nonce,
+		SchemaURL:       "/forms/" + form.ID + "/schema",
+		SubmitURL:       "/forms/" + form.ID + "/submit",
+		BootstrapScript: h.embedAsset(renderer.BootstrapScript),
+		BootstrapStyle:  h.embedAsset(renderer.BootstrapStyle),
+	}
+
+	rendererOrigin := ""
+	if h.Renderer.Vendored() {
+		data.RendererScript = h.embedAsset(renderer.FormioScript)
+		data.RendererStyle = h.embedAsset(renderer.FormioStyle)
+	} else {
+		rendererOrigin = renderer.CDNOrigin
+		data.RendererScript = embedAssetRef{URL: renderer.CDNOrigin + "/formiojs/formio.full.min.js"}
+		data.RendererStyle = embedAssetRef{URL: renderer.CDNOrigin + "/formiojs/formio.full.min.css"}
+	}
+
+	var page bytes.Buffer
+	if execErr := embedPageTemplate.Execute(&page, data); execErr != nil {
+		h.Logger.Error("failed to render embed page", "error", execErr, "form_id", form.ID)
+
+		return h.HandleError(c, execErr, "Failed to render form")
+	}
+
+	headers := c.Response().Header()
+	// Strip X-Frame-Options to allow embedding; CSP frame-ancestors handles framing control
+	headers.Del("X-Frame-Options")
+	// Replace the site-wide policy; report-only mode swaps the header rather than stacking both
+	headers.Del("Content-Security-Policy")
+	csp := h.Security().CSP
+	headers.Set(csp.GetCSPHeaderName(), buildEmbedCSP(csp, nonce, rendererOrigin, frameAncestors))
+	// The nonce is unique to this response, so it must never be served from a cache
+	headers.Set("Cache-Control", "no-store")
+	headers.Set("Content-Type", "text/html; charset=utf-8")
+
+	return c.HTMLBlob(http.StatusOK, page.Bytes())
+}
+
+// GET /assets/embed/:version/* serves the embedded renderer assets.
+// Only the current bundle version is served, so every URL maps to immutable content. The
+// loader SDK is also served on its stable protocol channel (/assets/embed/v1/embed.js)
+// for host pages, with a short cache.
+func (h *FormAPIHandler) handleEmbedAsset(c echo.Context) error {
+	name := c.Param("*")
+	version := c.Param("version")
+
+	asset, ok := h.Renderer.Get(name)
+	if !ok {
+		return echo.ErrNotFound
+	}
+
+	cacheControl := embedAssetCacheControl
+
+	switch {
+	case version == h.Renderer.Version():
+	case version == renderer.LoaderChannel && name == renderer.LoaderScript:
+		cacheControl = embedLoaderCacheControl
+	default:
+		return echo.ErrNotFound
+	}
+
+	headers := c.Response().Header()
+	headers.Set("Cache-Control", cacheControl)
+	headers.Set("ETag", asset.ETag)
+	headers.Set("X-Content-Type-Options", "nosniff")
+	// Host pages load the loader cross-origin, optionally with an integrity attribute
+	headers.Set("Access-Control-Allow-Origin", "*")
+	headers.Set("Cross-Origin-Resource-Policy", "cross-origin")
+
+	if c.Request().Header.Get("If-None-Match") == asset.ETag {
+		return c.NoContent(http.StatusNotModified)
+	}
+
+	return c.Blob(http.StatusOK, asset.ContentType, asset.Content)
+}
+
+// embedTargetOrigin picks the host origin the embed page may exchange messages with.
+// A requested origin must be listed in the form's CORS origins; without one, the first
+// listed origin is used as before the SDK existed. "'none'" disables messaging.
+func embedTargetOrigin(requested string, corsOrigins []string) string {
+	if requested != "" {
+		if sanitizeCSPOrigin(requested) != "" && isOriginAllowed(requested, corsOrigins) {
+			return requested
+		}
+
+		return "'none'"
+	}
+
+	if len(corsOrigins) > 0 && corsOrigins[0] != "*" {
+		return corsOrigins[0]
+	}
+
+	return "'none'"
+}
+
+// embedAsset returns the versioned URL and SRI hash of a bundled asset
+func (h *FormAPIHandler) embedAsset(name string) embedAssetRef {
+	asset, ok := h.Renderer.Get(name)
+	if !ok {
+		return embedAssetRef{}
+	}
+
+	return embedAssetRef{
+		URL:       embedAssetPath(h.Renderer.Version(), name),
+		Integrity: asset.Integrity,
+	}
+}
+
+// embedAssetPath is the public path of a bundled asset
+func embedAssetPath(version, name string) string {
+	return fmt.Sprintf("%s/%s/%s", constants.PathEmbedAssets, version, name)
+}
+
+// buildEmbedCSP derives the embed page policy from the configured CSP. Scripts must carry the
+// response nonce or come from this server (or the renderer CDN when it is not vendored);
+// 'unsafe-inline' is dropped from script-src because the page has no inline script. style-src
+// gets no nonce: a nonce would disable any configured 'unsafe-inline' for the style attributes
+// the renderer sets at runtime. Framing is limited to the form's allowed origins.
+func buildEmbedCSP(cfg config.CSPConfig, nonce, rendererOrigin, frameAncestors string) string {
+	orDefault := func(value, fallback string) string {
+		if strings.TrimSpace(value) == "" {
+			return fallback
+		}
+
+		return value
+	}
+
+	nonceSource := "'nonce-" + nonce + "'"
+	scriptSrc := joinSources("'self'", nonceSource, rendererOrigin, withoutSource(cfg.ScriptSrc, "'unsafe-inline'"))
+	styleSrc := joinSources("'self'", rendererOrigin, cfg.StyleSrc)
+	fontSrc := joinSources("'self'", rendererOrigin, cfg.FontSrc)
+
+	directives := []struct{ name, value string }{
+		{"default-src", orDefault(cfg.DefaultSrc, "'none'")},
+		{"script-src", scriptSrc},
+		{"style-src", styleSrc},
+		{"connect-src", orDefault(cfg.ConnectSrc, "'self'")},
+		{"font-src", fontSrc},
+		{"img-src", orDefault(cfg.ImgSrc, "'self' data:")},
+		{"object-src", orDefault(cfg.ObjectSrc, "'none'")},
+		{"base-uri", orDefault(cfg.BaseURI, "'self'")},
+		{"form-action", orDefault(cfg.FormAction, "'self'")},
+		{"worker-src", cfg.WorkerSrc},
+		{"frame-ancestors", frameAncestors},
+		{"report-uri", cfg.ReportURI},
+	}
+
+	policies := make([]string, 0, len(directives))
+	for _, d := range directives {
+		if d.value != "" {
+			policies = append(policies, d.name+" "+d.value)
+		}
+	}
+
+	return strings.Join(policies, "; ")
+}
+
+// joinSources merges CSP source lists, dropping empties and duplicates while keeping order
+func joinSources(lists ...string) string {
+	seen := make(map[string]bool)
+
+	var sources []string
+
+	for _, list := range lists {
+		for _, source := range strings.Fields(list) {
+			if !seen[source] {
+				seen[source] = true
+				sources = append(sources, source)
+			}
+		}
+	}
+
+	return strings.Join(sources, " ")
+}
+
+// withoutSource removes one source expression from a CSP source list
+func withoutSource(list, source string) string {
+	fields := strings.Fields(list)
+	kept := fields[:0]
+
+	for _, f := range fields {
+		if f != source {
+			kept = append(kept, f)
+		}
+	}
+
+	return strings.Join(kept, " ")
+}
+
+// newCSPNonce returns a random base64 value for a CSP nonce-source
+func newCSPNonce() (string, error) {
+	buf := make([]byte, embedNonceBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate nonce: %w", err)
+	}
+
+	// URL-safe alphabet keeps the value free of characters the HTML template would entity-encode
+	return base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// validCSPOriginPattern matches safe scheme://host[:port] patterns for CSP directives.
+var validCSPOriginPattern = regexp.MustCompile(`^https?://[a-zA-Z0-9._:\-]+$`)
+
+// sanitizeCSPOrigin returns the origin if it matches a safe pattern, or empty string otherwise.
+// This prevents CSP header injection via malicious CORS origins stored in the database.
+func sanitizeCSPOrigin(origin string) string {
+	if validCSPOriginPattern.MatchString(origin) {
+		return origin
+	}
+
+	return ""
+}
@@ -0,0 +1,179 @@
+package web //nolint:testpackage // internal test for unexported handler methods
+
+import (
+	"html"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/renderer"
+	mockform "github.com/goformx/goforms/test/mocks/form"
+	mocklogging "github.com/goformx/goforms/test/mocks/logging"
+)
+
+func buildEmbedHandler(t *testing.T, formService *mockform.MockService) *FormAPIHandler {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	logger.EXPECT().WithComponent(gomock.Any()).Return(logger).AnyTimes()
+	logger.EXPECT().With(gomock.Any()).Return(logger).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	bundle, err := renderer.Load()
+	require.NoError(t, err)
+
+	handler := buildUsageHandler(t, formService, logger)
+	handler.Config = &config.Config{
+		Security: config.SecurityConfig{
+			CSP: config.CSPConfig{
+				ScriptSrc: "'self' 'unsafe-inline' 'unsafe-eval'",
+				StyleSrc:  "'self' 'unsafe-inline'",
+			},
+		},
+	}
+	handler.Renderer = bundle
+
+	return handler
+}
+
+func TestHandleFormEmbed_UsesNonceAndConfiguredCSP(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	formService := mockform.NewMockService(ctrl)
+	formService.EXPECT().GetForm(gomock.Any(), "form-1").Return(&model.Form{
+		ID:          "form-1",
+		Title:       `<Contact "us">`,
+		Schema:      model.JSON{"display": "form"},
+		CorsOrigins: model.JSON{"origins": []any{"https://site.example", "javascript:alert(1)"}},
+	}, nil)
+
+	handler := buildEmbedHandler(t, formService)
+
+	e := echo.New()
+	rec := httptest.NewRecorder()
+	c := e.NewContext(httptest.NewRequest(http.MethodGet, "/forms/form-1/embed", http.NoBody), rec)
+	c.SetParamNames("id")
+	c.SetParamValues("form-1")
+
+	require.NoError(t, handler.handleFormEmbed(c))
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, "no-store", rec.Header().Get("Cache-Control"))
+
+	csp := rec.Header().Get("Content-Security-Policy")
+	scriptSrc := cspDirective(csp, "script-src")
+	assert.Contains(t, scriptSrc, "'nonce-")
+	assert.Contains(t, scriptSrc, "'unsafe-eval'", "configured sources are kept")
+	assert.NotContains(t, scriptSrc, "'unsafe-inline'")
+	assert.Equal(t, "https://site.example", cspDirective(csp, "frame-ancestors"))
+
+	body := html.UnescapeString(rec.Body.String())
+	assert.NotContains(t, rec.Body.String(), "<Contact", "title is escaped")
+	assert.NotContains(t, body, "<script>", "no inline script")
+
+	bootstrap, ok := handler.Renderer.Get(renderer.BootstrapScript)
+	require.True(t, ok)
+	assert.Contains(t, body, `integrity="`+bootstrap.Integrity+`"`)
+	assert.Contains(t, body, `data-form-id="form-1"`)
+
+	nonce := strings.TrimSuffix(strings.TrimPrefix(strings.Fields(scriptSrc)[1], "'nonce-"), "'")
+	assert.Contains(t, body, `nonce="`+nonce+`"`)
+}
+
+func TestHandleEmbedAsset_CachesVersionedAssets(t *testing.T) {
+	handler := buildEmbedHandler(t, mockform.NewMockService(gomock.NewController(t)))
+	version := handler.Renderer.Version()
+
+	e := echo.New()
+	e.GET("/assets/embed/:version/*", handler.handleEmbedAsset)
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/assets/embed/"+version+"/"+renderer.BootstrapScript, http.NoBody))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, embedAssetCacheControl, rec.Header().Get("Cache-Control"))
+	assert.Contains(t, rec.Header().Get("Content-Type"), "javascript")
+
+	etag := rec.Header().Get("ETag")
+	require.NotEmpty(t, etag)
+
+	req := httptest.NewRequest(http.MethodGet, "/assets/embed/"+version+"/"+renderer.BootstrapScript, http.NoBody)
+	req.Header.Set("If-None-Match", etag)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	assert.Equal(t, http.StatusNotModified, rec.Code)
+
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/assets/embed/stale/"+renderer.BootstrapScript, http.NoBody))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestHandleEmbedAsset_LoaderChannel(t *testing.T) {
+	handler := buildEmbedHandler(t, mockform.NewMockService(gomock.NewController(t)))
+
+	e := echo.New()
+	e.GET("/assets/embed/:version/*", handler.handleEmbedAsset)
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/assets/embed/v1/embed.js", http.NoBody))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, embedLoaderCacheControl, rec.Header().Get("Cache-Control"))
+	assert.Equal(t, "*", rec.Header().Get("Access-Control-Allow-Origin"))
+
+	// Only the loader is published on the stable channel
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/assets/embed/v1/"+renderer.BootstrapScript, http.NoBody))
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+}
+
+func TestEmbedTargetOrigin(t *testing.T) {
+	origins := []string{"https://a.example", "https://b.example"}
+
+	tests := []struct {
+		name      string
+		requested string
+		allowed   []string
+		want      string
+	}{
+		{name: "allowed host origin", requested: "https://b.example", allowed: origins, want: "https://b.example"},
+		{name: "unlisted host origin", requested: "https://evil.example", allowed: origins, want: "'none'"},
+		{name: "malformed host origin", requested: "https://a.example'; script-src *", allowed: []string{"*"}, want: "'none'"},
+		{name: "wildcard allows any well-formed host", requested: "https://c.example", allowed: []string{"*"}, want: "https://c.example"},
+		{name: "legacy embed uses first origin", allowed: origins, want: "https://a.example"},
+		{name: "legacy embed never broadcasts", allowed: []string{"*"}, want: "'none'"},
+		{name: "no origins", requested: "https://a.example", want: "'none'"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, embedTargetOrigin(tt.requested, tt.allowed))
+		})
+	}
+}
+
+func TestBuildEmbedCSP_DefaultsWhenUnconfigured(t *testing.T) {
+	csp := buildEmbedCSP(config.CSPConfig{}, "abc", renderer.CDNOrigin, "'none'")
+
+	assert.Equal(t, "'none'", cspDirective(csp, "default-src"))
+	assert.Equal(t, "'self' 'nonce-abc' "+renderer.CDNOrigin, cspDirective(csp, "script-src"))
+	assert.Equal(t, "'self' "+renderer.CDNOrigin, cspDirective(csp, "style-src"))
+	assert.Equal(t, "'none'", cspDirective(csp, "frame-ancestors"))
+}
+
+// cspDirective returns the source list of a directive in a policy
+func cspDirective(policy, name string) string {
+	for _, directive := range strings.Split(policy, ";") {
+		directive = strings.TrimSpace(directive)
+		if value, ok := strings.CutPrefix(directive, name+" "); ok {
+			return value
+		}
+	}
+
+	return ""
+}
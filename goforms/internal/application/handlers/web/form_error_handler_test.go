@@ -1,6 +1,7 @@
 package web_test
 
 import (
+	"encoding/json"
 	"net/http"
 	"net/http/httptest"
 	"testing"
@@ -10,6 +11,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/goformx/goforms/internal/application/handlers/web"
+	"github.com/goformx/goforms/internal/application/response"
 	domainerrors "github.com/goformx/goforms/internal/domain/common/errors"
 	"github.com/goformx/goforms/internal/domain/form/model"
 )
@@ -352,8 +354,14 @@ func TestFormErrorHandler_ErrorResponseFormat(t *testing.T) {
 
 	// Check response format
 	assert.Equal(t, http.StatusNotFound, rec.Code)
-	assert.Contains(t, rec.Body.String(), "Form not found")
-	assert.Contains(t, rec.Header().Get("Content-Type"), "application/json")
+	assert.Equal(t, response.ProblemContentType, rec.Header().Get("Content-Type"))
+
+	var problem response.Problem
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+	assert.Equal(t, "urn:goformx:problem:not-found", problem.Type)
+	assert.Equal(t, "Not found", problem.Title)
+	assert.Equal(t, http.StatusNotFound, problem.Status)
+	assert.Equal(t, "Form not found: test-form-123", problem.Detail)
 }
 
 func TestFormErrorHandler_ConsistentErrorHandling(t *testing.T) {
@@ -404,8 +412,13 @@ func TestFormErrorHandler_ConsistentErrorHandling(t *testing.T) {
 			require.NoError(t, err)
 
 			// Check consistent format
-			assert.Contains(t, rec.Header().Get("Content-Type"), "application/json")
-			assert.NotEmpty(t, rec.Body.String())
+			assert.Equal(t, response.ProblemContentType, rec.Header().Get("Content-Type"))
+
+			var problem response.Problem
+			require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+			assert.Equal(t, rec.Code, problem.Status)
+			assert.Equal(t, response.ProblemType(problem.Code), problem.Type)
+			assert.NotEmpty(t, problem.Detail)
 		})
 	}
 }
@@ -0,0 +1,172 @@
+package web
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v4"
+
+	"github.com/goformx/goforms/internal/application/constants"
+	"github.com/goformx/goforms/internal/application/formhtml"
+	"github.com/goformx/goforms/internal/application/middleware/idempotency"
+	"github.com/goformx/goforms/internal/application/validation"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/renderer"
+)
+
+// formHTMLSubmittedParam marks the redirect back to the page after a successful submission
+const formHTMLSubmittedParam = "submitted"
+
+// GET /forms/:id/html renders the form as plain HTML for respondents without JavaScript.
+// The page posts application/x-www-form-urlencoded data to /forms/:id/submit, which
+// re-renders it with inline errors when validation fails.
+func (h *FormAPIHandler) handleFormHTML(c echo.Context) error {
+	form, err := h.getFormOrError(c)
+	if err != nil {
+		return err
+	}
+
+	if validationErr := h.validateFormSchema(c, form); validationErr != nil {
+		return validationErr
+	}
+
+	page := h.newFormHTMLPage(form, formhtml.Build(form.Schema, nil, nil))
+	page.Submitted = c.QueryParam(formHTMLSubmittedParam) == "1"
+
+	return h.renderFormHTML(c, form, http.StatusOK, page)
+}
+
+// isURLEncodedSubmission reports whether the request was posted by the HTML form page
+func isURLEncodedSubmission(c echo.Context) bool {
+	return strings.HasPrefix(c.Request().Header.Get(echo.HeaderContentType), echo.MIMEApplicationForm)
+}
+
+// htmlSubmission is a urlencoded post from the HTML form page. Invalid input re-renders the
+// page with inline errors; a stored submission redirects back with a confirmation so a refresh
+// cannot post it twice.
+type htmlSubmission struct {
+	h *FormAPIHandler
+	// values are the posted fields, to fill the page in again
+	values url.Values
+}
+
+// read decodes the posted fields by the types of their components
+func (s *htmlSubmission) read(c echo.Context, form *model.Form) (model.JSON, error) {
+	req := c.Request()
+	req.Body = http.MaxBytesReader(c.Response(), req.Body, constants.MaxFormSchemaSize)
+
+	if err := req.ParseForm(); err != nil {
+		s.h.Logger.Warn("failed to parse urlencoded submission", "form_id", form.ID, "error", err)
+
+		page := s.h.newFormHTMLPage(form, formhtml.Build(form.Schema, nil, nil))
+		page.Message = "The submission could not be read. Please try again."
+
+		return nil, s.h.renderFormHTML(c, form, http.StatusBadRequest, page)
+	}
+
+	s.values = req.PostForm
+
+	return formhtml.Decode(form.Schema, s.values), nil
+}
+
+// invalid shows the errors next to their fields
+func (s *htmlSubmission) invalid(c echo.Context, form *model.Form, result validation.Result) error {
+	page := s.h.newFormHTMLPage(form, formhtml.Build(form.Schema, s.values, result.Errors))
+
+	return s.h.renderFormHTML(c, form, http.StatusUnprocessableEntity, page)
+}
+
+// failed shows the page again, filled in, with a message the respondent can act on
+func (s *htmlSubmission) failed(c echo.Context, form *model.Form, err error) error {
+	status, message := http.StatusInternalServerError, "Your response could not be saved. Please try again."
+	if errors.Is(err, model.ErrFormInvalid) {
+		status, message = http.StatusBadRequest, "Your response could not be accepted."
+	}
+
+	page := s.h.newFormHTMLPage(form, formhtml.Build(form.Schema, s.values, nil))
+	page.Message = message
+
+	return s.h.renderFormHTML(c, form, status, page)
+}
+
+// submitted redirects to the confirmation
+func (s *htmlSubmission) submitted(c echo.Context, form *model.Form, _ *model.FormSubmission) error {
+	return c.Redirect(http.StatusSeeOther, formHTMLPath(form.ID)+"?"+formHTMLSubmittedParam+"=1")
+}
+
+// newFormHTMLPage builds the page around rendered nodes
+func (h *FormAPIHandler) newFormHTMLPage(form *model.Form, nodes []formhtml.Node) *formhtml.Page {
+	page := formhtml.NewPage(form.Title, constants.PathFormsPublic+"/"+form.ID+"/submit", nodes)
+
+	if label := formhtml.SubmitLabel(form.Schema); label != "" {
+		page.SubmitLabel = label
+	}
+
+	if style := h.embedAsset(renderer.FormStyle); style.URL != "" {
+		page.StyleURL = style.URL
+		page.StyleIntegrity = style.Integrity
+	}
+
+	// A fresh key per rendered page: a corrected resubmission after errors is a new request
+	if h.Idempotency != nil {
+		page.IdempotencyField, page.IdempotencyKey = idempotency.FormField, uuid.NewString()
+	}
+
+	return page
+}
+
+// renderFormHTML writes the page under a policy that allows no script at all
+func (h *FormAPIHandler) renderFormHTML(c echo.Context, form *model.Form, status int, page *formhtml.Page) error {
+	body, err := formhtml.Render(page)
+	if err != nil {
+		h.Logger.Error("failed to render form page", "error", err, "form_id", form.ID)
+
+		return h.HandleError(c, err, "Failed to render form")
+	}
+
+	corsOrigins, _, _ := form.GetCorsConfig()
+
+	headers := c.Response().Header()
+	headers.Del("X-Frame-Options")
+	headers.Del("Content-Security-Policy")
+	csp := h.Security().CSP
+	headers.Set(csp.GetCSPHeaderName(), buildFormHTMLCSP(csp, corsOrigins))
+	headers.Set("Cache-Control", "no-store")
+
+	return c.HTMLBlob(status, body)
+}
+
+// buildFormHTMLCSP allows only same-origin styles and form posts; the page runs no script.
+// Like the embed page, it may be framed by the form's allowed origins.
+func buildFormHTMLCSP(cfg config.CSPConfig, corsOrigins []string) string {
+	frameAncestors := "'none'"
+
+	var safeOrigins []string
+
+	for _, origin := range corsOrigins {
+		if sanitized := sanitizeCSPOrigin(origin); sanitized != "" {
+			safeOrigins = append(safeOrigins, sanitized)
+		}
+	}
+
+	if len(safeOrigins) > 0 {
+		frameAncestors = strings.Join(safeOrigins, " ")
+	}
+
+	policy := "default-src 'none'; style-src 'self'; img-src 'self' data:; form-action 'self'; base-uri 'none'; frame-ancestors " +
+		frameAncestors
+	if cfg.ReportURI != "" {
+		policy += "; report-uri " + cfg.ReportURI
+	}
+
+	return policy
+}
+
+// formHTMLPath is the public path of a form's HTML page
+func formHTMLPath(formID string) string {
+	return constants.PathFormsPublic + "/" + formID + "/html"
+}
@@ -0,0 +1,153 @@
+package web //nolint:testpackage // internal test for unexported handler methods
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goformx/goforms/internal/application/middleware/idempotency"
+	"github.com/goformx/goforms/internal/application/validation"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	mockform "github.com/goformx/goforms/test/mocks/form"
+	mocklogging "github.com/goformx/goforms/test/mocks/logging"
+)
+
+func htmlTestForm() *model.Form {
+	return &model.Form{
+		ID:    "form-1",
+		Title: "Contact",
+		Schema: model.JSON{
+			"display": "form",
+			"components": []any{
+				map[string]any{
+					"type": "email", "key": "email", "label": "Email", "input": true,
+					"validate": map[string]any{"required": true},
+				},
+				map[string]any{"type": "number", "key": "age", "label": "Age", "input": true},
+				map[string]any{"type": "button", "key": "submit", "label": "Send", "action": "submit"},
+			},
+		},
+	}
+}
+
+// expectLogs allows the submission path's log calls on the handler's mock logger
+func expectLogs(handler *FormAPIHandler) {
+	logger, ok := handler.Logger.(*mocklogging.MockLogger)
+	if !ok {
+		return
+	}
+
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+}
+
+func serveFormHTML(t *testing.T, handler *FormAPIHandler, method, target string, form url.Values) *httptest.ResponseRecorder {
+	t.Helper()
+
+	req := httptest.NewRequest(method, target, http.NoBody)
+	if form != nil {
+		req = httptest.NewRequest(method, target, strings.NewReader(form.Encode()))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+	}
+
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.SetParamNames("id")
+	c.SetParamValues("form-1")
+
+	if method == http.MethodGet {
+		require.NoError(t, handler.handleFormHTML(c))
+	} else {
+		require.NoError(t, handler.handleFormSubmit(c))
+	}
+
+	return rec
+}
+
+func TestHandleFormHTML_RendersScriptFreePage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	formService := mockform.NewMockService(ctrl)
+	formService.EXPECT().GetForm(gomock.Any(), "form-1").Return(htmlTestForm(), nil)
+
+	handler := buildEmbedHandler(t, formService)
+
+	rec := serveFormHTML(t, handler, http.MethodGet, "/forms/form-1/html", nil)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get("Content-Security-Policy"), "default-src 'none'")
+	assert.NotContains(t, rec.Header().Get("Content-Security-Policy"), "script-src")
+
+	body := rec.Body.String()
+	assert.Contains(t, body, `action="/forms/form-1/submit"`)
+	assert.Contains(t, body, `<label for="gfx-email">Email`)
+	assert.Contains(t, body, `type="email"`)
+	assert.Contains(t, body, ">Send</button>")
+	assert.NotContains(t, body, "<script")
+}
+
+func TestHandleFormHTML_PostsIdempotencyKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	formService := mockform.NewMockService(ctrl)
+	formService.EXPECT().GetForm(gomock.Any(), "form-1").Return(htmlTestForm(), nil).Times(2)
+
+	handler := buildEmbedHandler(t, formService)
+	handler.Idempotency = idempotency.NewMiddleware(idempotency.NewMemoryStore(), 0, handler.Logger)
+
+	first := serveFormHTML(t, handler, http.MethodGet, "/forms/form-1/html", nil).Body.String()
+	second := serveFormHTML(t, handler, http.MethodGet, "/forms/form-1/html", nil).Body.String()
+
+	field := `<input type="hidden" name="` + idempotency.FormField + `" value="`
+	assert.Contains(t, first, field)
+	assert.NotEqual(t, first, second, "every rendered page posts its own key")
+}
+
+func TestHandleFormSubmit_URLEncodedInvalidRerendersWithErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	formService := mockform.NewMockService(ctrl)
+	formService.EXPECT().GetForm(gomock.Any(), "form-1").Return(htmlTestForm(), nil)
+
+	handler := buildEmbedHandler(t, formService)
+	handler.ComprehensiveValidator = validation.NewComprehensiveValidator()
+	expectLogs(handler)
+
+	rec := serveFormHTML(t, handler, http.MethodPost, "/forms/form-1/submit", url.Values{"age": {"forty"}})
+
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+
+	body := rec.Body.String()
+	assert.Contains(t, body, `role="alert"`)
+	assert.Contains(t, body, `id="gfx-email-error"`)
+	assert.Contains(t, body, `aria-invalid="true"`)
+	assert.Contains(t, body, `value="forty"`, "posted input is kept")
+}
+
+func TestHandleFormSubmit_URLEncodedValidRedirects(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	formService := mockform.NewMockService(ctrl)
+	formService.EXPECT().GetForm(gomock.Any(), "form-1").Return(htmlTestForm(), nil)
+	formService.EXPECT().SubmitForm(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, submission *model.FormSubmission) error {
+			assert.Equal(t, "a@example.com", submission.Data["email"])
+			assert.InDelta(t, 42.0, submission.Data["age"], 0)
+
+			return nil
+		})
+
+	handler := buildEmbedHandler(t, formService)
+	handler.ComprehensiveValidator = validation.NewComprehensiveValidator()
+	expectLogs(handler)
+
+	rec := serveFormHTML(t, handler, http.MethodPost, "/forms/form-1/submit",
+		url.Values{"email": {"a@example.com"}, "age": {"42"}})
+
+	assert.Equal(t, http.StatusSeeOther, rec.Code)
+	assert.Equal(t, "/forms/form-1/html?submitted=1", rec.Header().Get("Location"))
+}
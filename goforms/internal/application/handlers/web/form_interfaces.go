@@ -4,12 +4,15 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/goformx/goforms/internal/application/validation"
+	formdomain "github.com/goformx/goforms/internal/domain/form"
 	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/domain/workspace"
 )
 
 // FormCreateRequest represents the data needed to create a form
 type FormCreateRequest struct {
-	Title string `json:"title"`
+	Title string   `json:"title"`
+	Tags  []string `json:"tags"`
 }
 
 // FormUpdateRequest represents the data needed to update a form
@@ -19,17 +22,21 @@ type FormUpdateRequest struct {
 	Status      string     `json:"status"`
 	CorsOrigins string     `json:"cors_origins"`
 	Schema      model.JSON `json:"schema"`
+	// Tags replaces the form's tags; omitted tags are left unchanged
+	Tags []string `json:"tags"`
+	// ReviewWorkflow replaces the form's review workflow; omitted workflows are left unchanged
+	ReviewWorkflow *model.ReviewWorkflow `json:"review_workflow"`
 }
 
 // FormRetriever interface for retrieving forms
 type FormRetriever interface {
 	GetFormByID(c echo.Context) (*model.Form, error)
-	GetFormWithOwnership(c echo.Context) (*model.Form, error)
+	GetFormWithPermission(c echo.Context, permission workspace.Permission) (*model.Form, error)
 }
 
-// FormOwnershipValidator interface for validating form ownership
-type FormOwnershipValidator interface {
-	RequireFormOwnership(c echo.Context, form *model.Form) error
+// FormPermissionValidator interface for validating form permissions
+type FormPermissionValidator interface {
+	RequireFormPermission(c echo.Context, form *model.Form, permission workspace.Permission) error
 }
 
 // FormRequestProcessor interface for processing form requests
@@ -46,9 +53,9 @@ type FormResponseBuilder interface {
 	BuildErrorResponse(c echo.Context, statusCode int, message string) error
 	BuildSchemaResponse(c echo.Context, schema model.JSON) error
 	BuildSubmissionResponse(c echo.Context, submission *model.FormSubmission) error
-	BuildSubmissionListResponse(c echo.Context, submissions []*model.FormSubmission) error
+	BuildSubmissionPageResponse(c echo.Context, page *formdomain.SubmissionPage) error
 	BuildFormResponse(c echo.Context, form *model.Form) error
-	BuildFormListResponse(c echo.Context, forms []*model.Form) error
+	BuildFormPageResponse(c echo.Context, page *formdomain.FormPage) error
 	BuildNotFoundResponse(c echo.Context, resource string) error
 	BuildValidationErrorResponse(c echo.Context, field, message string) error
 	BuildMultipleErrorResponse(c echo.Context, errors []validation.Error) error
@@ -0,0 +1,97 @@
+package web
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	formdomain "github.com/goformx/goforms/internal/domain/form"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+// parseFormListFilter builds a form listing filter from the limit, cursor, sort, order,
+// status, tag and q query parameters. The form service applies defaults and validates it.
+func parseFormListFilter(c echo.Context) (formdomain.FormListFilter, error) {
+	filter := formdomain.FormListFilter{
+		Status: c.QueryParam("status"),
+		Tag:    c.QueryParam("tag"),
+		Search: c.QueryParam("q"),
+		Sort:   c.QueryParam("sort"),
+		Order:  c.QueryParam("order"),
+	}
+
+	var err error
+
+	if filter.Limit, err = parseListLimit(c.QueryParam("limit")); err != nil {
+		return filter, errInvalidListParam("limit")
+	}
+
+	if cursor := c.QueryParam("cursor"); cursor != "" {
+		if filter.Cursor, err = common.DecodeCursor(cursor); err != nil {
+			return filter, errInvalidListParam("cursor")
+		}
+	}
+
+	return filter, nil
+}
+
+// assigneeMe is the assignee query value that stands for the signed-in user
+const assigneeMe = "me"
+
+// parseSubmissionListFilter builds a submission listing filter from the limit, cursor,
+// sort, order, status, review_status, assignee and tag query parameters. The form service
+// applies defaults and validates it.
+func parseSubmissionListFilter(c echo.Context) (formdomain.SubmissionListFilter, error) {
+	filter := formdomain.SubmissionListFilter{
+		Status:       model.SubmissionStatus(c.QueryParam("status")),
+		ReviewStatus: c.QueryParam("review_status"),
+		AssigneeID:   c.QueryParam("assignee"),
+		Tag:          c.QueryParam("tag"),
+		Sort:         c.QueryParam("sort"),
+		Order:        c.QueryParam("order"),
+	}
+
+	var err error
+
+	if filter.Limit, err = parseListLimit(c.QueryParam("limit")); err != nil {
+		return filter, errInvalidListParam("limit")
+	}
+
+	if cursor := c.QueryParam("cursor"); cursor != "" {
+		if filter.Cursor, err = common.DecodeCursor(cursor); err != nil {
+			return filter, errInvalidListParam("cursor")
+		}
+	}
+
+	if filter.AssigneeID == assigneeMe {
+		userID, ok := c.Get("user_id").(string)
+		if !ok || userID == "" {
+			return filter, errInvalidListParam("assignee")
+		}
+
+		filter.AssigneeID = userID
+	}
+
+	return filter, nil
+}
+
+// errInvalidListParam describes an invalid listing query parameter
+func errInvalidListParam(name string) error {
+	return fmt.Errorf("invalid %s parameter", name)
+}
+
+// parseListLimit parses an optional positive page size
+func parseListLimit(value string) (int, error) {
+	if value == "" {
+		return 0, nil
+	}
+
+	n, err := strconv.Atoi(value)
+	if err != nil || n < 1 {
+		return 0, fmt.Errorf("invalid limit: %q", value)
+	}
+
+	return n, nil
+}
@@ -0,0 +1,170 @@
+package web //nolint:testpackage // internal test for unexported handler methods
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goformx/goforms/internal/application/response"
+	formdomain "github.com/goformx/goforms/internal/domain/form"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+	mockform "github.com/goformx/goforms/test/mocks/form"
+	mocklogging "github.com/goformx/goforms/test/mocks/logging"
+)
+
+func listFormsRequest(t *testing.T, handler *FormAPIHandler, query string) (*httptest.ResponseRecorder, response.APIResponse) {
+	t.Helper()
+
+	req := httptest.NewRequest(http.MethodGet, "/api/forms?"+query, http.NoBody)
+	rec := httptest.NewRecorder()
+	c := echo.New().NewContext(req, rec)
+	c.Set("user_id", "user123")
+
+	require.NoError(t, handler.handleListForms(c))
+
+	var resp response.APIResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+
+	return rec, resp
+}
+
+func TestHandleListForms_PassesFilterAndReturnsPagination(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	formService := mockform.NewMockService(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().SanitizeField(gomock.Any(), gomock.Any()).Return("user").AnyTimes()
+
+	handler := buildUsageHandler(t, formService, logger)
+	handler.ResponseBuilder = NewFormResponseBuilder()
+
+	cursor := common.Cursor{Sort: formdomain.SortSubmissions, Order: common.OrderDesc, Value: "12", ID: "form0"}
+
+	formService.EXPECT().ListFormsPage(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, filter formdomain.FormListFilter) (*formdomain.FormPage, error) {
+			assert.Equal(t, "user123", filter.UserID)
+			assert.Empty(t, filter.WorkspaceID)
+			assert.Equal(t, "published", filter.Status)
+			assert.Equal(t, "Survey", filter.Tag, "the service normalizes the filter")
+			assert.Equal(t, "contact", filter.Search)
+			assert.Equal(t, 10, filter.Limit)
+			assert.Equal(t, &cursor, filter.Cursor)
+
+			return &formdomain.FormPage{
+				Forms:      []*model.Form{{ID: "form1", Title: "Contact", Tags: []string{"survey"}, SubmissionCount: 7}},
+				Total:      42,
+				NextCursor: "next",
+				PrevCursor: "prev",
+				Sort:       formdomain.SortSubmissions,
+				Order:      common.OrderDesc,
+				Limit:      10,
+			}, nil
+		})
+
+	rec, resp := listFormsRequest(t, handler,
+		"sort=submissions&order=desc&status=published&tag=Survey&q=contact&limit=10&cursor="+cursor.Encode())
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	data, ok := resp.Data.(map[string]any)
+	require.True(t, ok)
+	assert.InDelta(t, float64(1), data["count"], 0)
+
+	forms, ok := data["forms"].([]any)
+	require.True(t, ok)
+	require.Len(t, forms, 1)
+	assert.InDelta(t, float64(7), forms[0].(map[string]any)["submission_count"], 0)
+	assert.Equal(t, []any{"survey"}, forms[0].(map[string]any)["tags"])
+
+	assert.Equal(t, map[string]any{
+		"total":       float64(42),
+		"limit":       float64(10),
+		"sort":        "submissions",
+		"order":       "desc",
+		"next_cursor": "next",
+		"prev_cursor": "prev",
+	}, data["pagination"])
+}
+
+func TestHandleListForms_InvalidParameters_ReturnBadRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	formService := mockform.NewMockService(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	handler := buildUsageHandler(t, formService, logger)
+
+	// The service rejects filters it cannot serve
+	formService.EXPECT().ListFormsPage(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, filter formdomain.FormListFilter) (*formdomain.FormPage, error) {
+			filter.Normalize()
+
+			return nil, filter.Validate()
+		}).AnyTimes()
+
+	titleCursor := common.Cursor{Sort: formdomain.SortTitle, Order: common.OrderAsc, Value: "a", ID: "form1"}.Encode()
+
+	for _, query := range []string{
+		"limit=0",
+		"limit=abc",
+		"cursor=garbage",
+		"sort=owner",
+		"order=up",
+		"status=deleted",
+		"sort=created&cursor=" + titleCursor,
+	} {
+		rec, _ := listFormsRequest(t, handler, query)
+		assert.Equal(t, http.StatusBadRequest, rec.Code, query)
+		assert.Equal(t, response.ProblemContentType, rec.Header().Get(echo.HeaderContentType), query)
+
+		var problem response.Problem
+		require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem), query)
+		assert.Equal(t, "BAD_REQUEST", string(problem.Code), query)
+		assert.NotEmpty(t, problem.Detail, query)
+	}
+}
+
+func TestParseSubmissionListFilter(t *testing.T) {
+	filter, err := parseSubmissionListFilter(echo.New().NewContext(
+		httptest.NewRequest(http.MethodGet, "/api/forms/f/submissions?status=completed&order=asc&limit=5", http.NoBody),
+		httptest.NewRecorder()))
+	require.NoError(t, err)
+	assert.Equal(t, model.SubmissionStatusCompleted, filter.Status)
+	assert.Empty(t, filter.Sort, "defaults are left to the service")
+	assert.Equal(t, common.OrderAsc, filter.Order)
+	assert.Equal(t, 5, filter.Limit)
+
+	_, err = parseSubmissionListFilter(echo.New().NewContext(
+		httptest.NewRequest(http.MethodGet, "/api/forms/f/submissions?limit=-1", http.NoBody), httptest.NewRecorder()))
+	require.Error(t, err)
+}
+
+func TestParseSubmissionListFilter_ReviewFilters(t *testing.T) {
+	c := echo.New().NewContext(httptest.NewRequest(http.MethodGet,
+		"/api/forms/f/submissions?review_status=in_review&assignee=me&tag=%20VIP%20", http.NoBody), httptest.NewRecorder())
+	c.Set("user_id", "user123")
+
+	filter, err := parseSubmissionListFilter(c)
+	require.NoError(t, err)
+	assert.Equal(t, "in_review", filter.ReviewStatus)
+	assert.Equal(t, "user123", filter.AssigneeID)
+	assert.Equal(t, " VIP ", filter.Tag)
+
+	filter, err = parseSubmissionListFilter(echo.New().NewContext(
+		httptest.NewRequest(http.MethodGet, "/api/forms/f/submissions?assignee=none", http.NoBody), httptest.NewRecorder()))
+	require.NoError(t, err)
+	assert.Equal(t, formdomain.AssigneeNone, filter.AssigneeID)
+
+	_, err = parseSubmissionListFilter(echo.New().NewContext(
+		httptest.NewRequest(http.MethodGet, "/api/forms/f/submissions?assignee=me", http.NoBody), httptest.NewRecorder()))
+	require.Error(t, err, "me needs a signed-in user")
+}
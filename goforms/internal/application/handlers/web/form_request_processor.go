@@ -53,6 +53,7 @@ func (p *FormRequestProcessorImpl) ProcessCreateRequest(c echo.Context) (*FormCr
 	} else {
 		// Sanitize bound values
 		req.Title = p.sanitizer.String(req.Title)
+		req.Tags = p.sanitizeTags(req.Tags)
 	}
 
 	if err := p.validateCreateRequest(req); err != nil {
@@ -79,6 +80,7 @@ func (p *FormRequestProcessorImpl) ProcessUpdateRequest(c echo.Context) (*FormUp
 		req.Description = p.sanitizer.String(req.Description)
 		req.Status = p.sanitizer.String(req.Status)
 		req.CorsOrigins = p.sanitizer.String(req.CorsOrigins)
+		req.Tags = p.sanitizeTags(req.Tags)
 	}
 
 	// Validate CORS origins when publishing
@@ -146,7 +148,7 @@ func (p *FormRequestProcessorImpl) validateCreateRequest(req *FormCreateRequest)
 		return errors.New("title too long")
 	}
 
-	return nil
+	return validateTags(req.Tags)
 }
 
 // validateUpdateRequest validates form update request
@@ -191,6 +193,41 @@ func (p *FormRequestProcessorImpl) validateUpdateRequest(req *FormUpdateRequest)
 		return err
 	}
 
+	if req.ReviewWorkflow != nil {
+		if err := req.ReviewWorkflow.Validate(); err != nil {
+			return fmt.Errorf("invalid review workflow: %w", err)
+		}
+	}
+
+	return validateTags(req.Tags)
+}
+
+// sanitizeTags sanitizes each tag, keeping nil so omitted tags stay distinguishable from cleared ones
+func (p *FormRequestProcessorImpl) sanitizeTags(tags []string) []string {
+	if tags == nil {
+		return nil
+	}
+
+	sanitized := make([]string, len(tags))
+	for i, tag := range tags {
+		sanitized[i] = p.sanitizer.String(tag)
+	}
+
+	return sanitized
+}
+
+// validateTags enforces the tag count and length limits
+func validateTags(tags []string) error {
+	if len(model.NormalizeTags(tags)) > model.MaxTags {
+		return fmt.Errorf("a form can have at most %d tags", model.MaxTags)
+	}
+
+	for _, tag := range tags {
+		if len(strings.TrimSpace(tag)) > model.MaxTagLength {
+			return fmt.Errorf("tags must not exceed %d characters", model.MaxTagLength)
+		}
+	}
+
 	return nil
 }
 
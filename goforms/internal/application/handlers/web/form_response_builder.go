@@ -8,6 +8,7 @@ import (
 
 	"github.com/goformx/goforms/internal/application/response"
 	"github.com/goformx/goforms/internal/application/validation"
+	formdomain "github.com/goformx/goforms/internal/domain/form"
 	"github.com/goformx/goforms/internal/domain/form/model"
 )
 
@@ -28,12 +29,9 @@ func (b *FormResponseBuilderImpl) BuildSuccessResponse(c echo.Context, message s
 	})
 }
 
-// BuildErrorResponse builds a standardized error response
+// BuildErrorResponse builds a problem response with the generic error code of the status code
 func (b *FormResponseBuilderImpl) BuildErrorResponse(c echo.Context, statusCode int, message string) error {
-	return c.JSON(statusCode, response.APIResponse{
-		Success: false,
-		Message: message,
-	})
+	return response.ErrorResponse(c, statusCode, message)
 }
 
 // BuildSchemaResponse builds a schema response
@@ -67,55 +65,66 @@ func (b *FormResponseBuilderImpl) BuildFormResponse(c echo.Context, form *model.
 		Success: true,
 		Data: map[string]any{
 			"form": map[string]any{
-				"id":           form.ID,
-				"title":        form.Title,
-				"description":  form.Description,
-				"status":       form.Status,
-				"schema":       form.Schema,
-				"cors_origins": form.CorsOrigins,
-				"created_at":   form.CreatedAt.Format(time.RFC3339),
-				"updated_at":   form.UpdatedAt.Format(time.RFC3339),
+				"id":              form.ID,
+				"title":           form.Title,
+				"description":     form.Description,
+				"status":          form.Status,
+				"schema":          form.Schema,
+				"cors_origins":    form.CorsOrigins,
+				"tags":            form.Tags,
+				"review_workflow": form.Workflow(),
+				"created_at":      form.CreatedAt.Format(time.RFC3339),
+				"updated_at":      form.UpdatedAt.Format(time.RFC3339),
 			},
 		},
 	})
 }
 
-// BuildFormListResponse builds a form list response
-func (b *FormResponseBuilderImpl) BuildFormListResponse(c echo.Context, forms []*model.Form) error {
-	formData := make([]map[string]any, len(forms))
-	for i, form := range forms {
+// BuildFormPageResponse builds the response for one page of a form listing
+func (b *FormResponseBuilderImpl) BuildFormPageResponse(
+	c echo.Context,
+	page *formdomain.FormPage,
+) error {
+	formData := make([]map[string]any, len(page.Forms))
+	for i, form := range page.Forms {
 		formData[i] = map[string]any{
-			"id":          form.ID,
-			"title":       form.Title,
-			"description": form.Description,
-			"status":      form.Status,
-			"created_at":  form.CreatedAt.Format(time.RFC3339),
-			"updated_at":  form.UpdatedAt.Format(time.RFC3339),
+			"id":               form.ID,
+			"title":            form.Title,
+			"description":      form.Description,
+			"status":           form.Status,
+			"tags":             form.Tags,
+			"submission_count": form.SubmissionCount,
+			"created_at":       form.CreatedAt.Format(time.RFC3339),
+			"updated_at":       form.UpdatedAt.Format(time.RFC3339),
 		}
 	}
 
 	return c.JSON(http.StatusOK, response.APIResponse{
 		Success: true,
 		Data: map[string]any{
-			"forms": formData,
-			"count": len(forms),
+			"forms":      formData,
+			"count":      len(page.Forms),
+			"pagination": paginationData(page.Total, page.Limit, page.Sort, page.Order, page.NextCursor, page.PrevCursor),
 		},
 	})
 }
 
-// BuildSubmissionListResponse builds a response for form submission lists
-func (b *FormResponseBuilderImpl) BuildSubmissionListResponse(
+// BuildSubmissionPageResponse builds the response for one page of a submission listing
+func (b *FormResponseBuilderImpl) BuildSubmissionPageResponse(
 	c echo.Context,
-	submissions []*model.FormSubmission,
+	page *formdomain.SubmissionPage,
 ) error {
-	submissionData := make([]map[string]any, len(submissions))
-	for i, submission := range submissions {
+	submissionData := make([]map[string]any, len(page.Submissions))
+	for i, submission := range page.Submissions {
 		submissionData[i] = map[string]any{
-			"id":           submission.ID,
-			"form_id":      submission.FormID,
-			"status":       submission.Status,
-			"submitted_at": submission.SubmittedAt.Format(time.RFC3339),
-			"data":         submission.Data,
+			"id":            submission.ID,
+			"form_id":       submission.FormID,
+			"status":        submission.Status,
+			"review_status": submission.ReviewStatus,
+			"assignee_id":   submission.AssigneeID,
+			"tags":          submission.Tags,
+			"submitted_at":  submission.SubmittedAt.Format(time.RFC3339),
+			"data":          submission.Data,
 		}
 	}
 
@@ -123,58 +132,55 @@ func (b *FormResponseBuilderImpl) BuildSubmissionListResponse(
 		Success: true,
 		Data: map[string]any{
 			"submissions": submissionData,
-			"count":       len(submissions),
+			"count":       len(page.Submissions),
+			"pagination":  paginationData(page.Total, page.Limit, page.Sort, page.Order, page.NextCursor, page.PrevCursor),
 		},
 	})
 }
 
-// BuildValidationErrorResponse builds a validation error response
+// paginationData describes where a page sits in a keyset-paginated listing.
+// Empty cursors mean there is no page in that direction.
+func paginationData(total int64, limit int, sort, order, next, prev string) map[string]any {
+	return map[string]any{
+		"total":       total,
+		"limit":       limit,
+		"sort":        sort,
+		"order":       order,
+		"next_cursor": next,
+		"prev_cursor": prev,
+	}
+}
+
+// BuildValidationErrorResponse builds a validation problem for one invalid field
 func (b *FormResponseBuilderImpl) BuildValidationErrorResponse(c echo.Context, field, message string) error {
-	return c.JSON(http.StatusBadRequest, response.APIResponse{
-		Success: false,
-		Message: "Validation failed",
-		Data: map[string]any{
-			"field":   field,
-			"message": message,
-		},
+	return response.ValidationErrorResponse(c, "Validation failed", []response.FieldError{
+		{Field: field, Message: message},
 	})
 }
 
-// BuildMultipleErrorResponse builds a response for multiple validation errors
+// BuildMultipleErrorResponse builds a validation problem listing every invalid field
 func (b *FormResponseBuilderImpl) BuildMultipleErrorResponse(
 	c echo.Context,
 	errors []validation.Error,
 ) error {
-	errorData := make([]map[string]any, len(errors))
+	fieldErrors := make([]response.FieldError, len(errors))
 	for i, err := range errors {
-		errorData[i] = map[string]any{
-			"field":   err.Field,
-			"message": err.Message,
-			"rule":    err.Rule,
+		fieldErrors[i] = response.FieldError{
+			Field:   err.Field,
+			Message: err.Message,
+			Rule:    err.Rule,
 		}
 	}
 
-	return c.JSON(http.StatusBadRequest, response.APIResponse{
-		Success: false,
-		Message: "Validation failed",
-		Data: map[string]any{
-			"errors": errorData,
-		},
-	})
+	return response.ValidationErrorResponse(c, "Validation failed", fieldErrors)
 }
 
-// BuildNotFoundResponse builds a not found response
+// BuildNotFoundResponse builds a not found problem
 func (b *FormResponseBuilderImpl) BuildNotFoundResponse(c echo.Context, resource string) error {
-	return c.JSON(http.StatusNotFound, response.APIResponse{
-		Success: false,
-		Message: resource + " not found",
-	})
+	return response.ErrorResponse(c, http.StatusNotFound, resource+" not found")
 }
 
-// BuildForbiddenResponse builds a forbidden response
+// BuildForbiddenResponse builds a forbidden problem
 func (b *FormResponseBuilderImpl) BuildForbiddenResponse(c echo.Context, message string) error {
-	return c.JSON(http.StatusForbidden, response.APIResponse{
-		Success: false,
-		Message: message,
-	})
+	return response.ErrorResponse(c, http.StatusForbidden, message)
 }
@@ -0,0 +1,239 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/goformx/goforms/internal/application/response"
+	"github.com/goformx/goforms/internal/domain/audit"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/domain/review"
+	"github.com/goformx/goforms/internal/domain/workspace"
+)
+
+// errInvalidAssignee is returned when a submission is assigned to someone who cannot review it
+var errInvalidAssignee = errors.New("assignee cannot review this form's submissions")
+
+// SubmissionReviewRequest updates a submission's review state; omitted fields are left unchanged.
+// An empty assignee_id unassigns the submission and an empty tags list clears its tags.
+type SubmissionReviewRequest struct {
+	Status     *string  `json:"status"`
+	AssigneeID *string  `json:"assignee_id"`
+	Tags       []string `json:"tags"`
+}
+
+// SubmissionNoteRequest adds an internal note to a submission
+type SubmissionNoteRequest struct {
+	Body string `json:"body"`
+}
+
+// registerReviewRoutes registers the submission review routes on the assertion API group
+func (h *FormAPIHandler) registerReviewRoutes(group *echo.Group) {
+	if h.Reviews == nil {
+		return
+	}
+
+	group.PATCH("/:id/submissions/:sid/review", h.handleUpdateSubmissionReview)
+	group.GET("/:id/submissions/:sid/notes", h.handleListSubmissionNotes)
+	group.POST("/:id/submissions/:sid/notes", h.handleAddSubmissionNote)
+	group.DELETE("/:id/submissions/:sid/notes/:noteId", h.handleDeleteSubmissionNote)
+}
+
+// PATCH /api/forms/:id/submissions/:sid/review - change review status, assignee and tags (assertion auth)
+func (h *FormAPIHandler) handleUpdateSubmissionReview(c echo.Context) error {
+	form, err := h.getFormWithPermissionOrError(c, workspace.PermissionReviewSubmissions)
+	if err != nil {
+		return err
+	}
+
+	var req SubmissionReviewRequest
+	if bindErr := c.Bind(&req); bindErr != nil {
+		return response.ErrorResponse(c, http.StatusBadRequest, "Invalid request body")
+	}
+
+	if req.AssigneeID != nil {
+		if assigneeErr := h.validateAssignee(c, form, *req.AssigneeID); assigneeErr != nil {
+			return h.handleReviewError(c, assigneeErr)
+		}
+	}
+
+	before, err := h.Reviews.Get(c.Request().Context(), form.ID, c.Param("sid"))
+	if err != nil {
+		return h.handleReviewError(c, err)
+	}
+
+	beforeSnapshot := reviewSnapshot(before)
+
+	submission, err := h.Reviews.Update(c.Request().Context(), form, before.ID, requestActor(c), review.Update{
+		Status:     req.Status,
+		AssigneeID: req.AssigneeID,
+		Tags:       req.Tags,
+	})
+	if err != nil {
+		return h.handleReviewError(c, err)
+	}
+
+	h.recordAudit(c, audit.Record{
+		OwnerID:      auditOwner(form),
+		Action:       audit.ActionSubmissionUpdated,
+		ResourceType: audit.ResourceSubmission,
+		ResourceID:   submission.ID,
+		Before:       beforeSnapshot,
+		After:        reviewSnapshot(submission),
+	})
+
+	return response.Success(c, reviewData(submission))
+}
+
+// GET /api/forms/:id/submissions/:sid/notes - list a submission's internal notes (assertion auth)
+func (h *FormAPIHandler) handleListSubmissionNotes(c echo.Context) error {
+	form, err := h.getFormWithPermissionOrError(c, workspace.PermissionReviewSubmissions)
+	if err != nil {
+		return err
+	}
+
+	notes, err := h.Reviews.ListNotes(c.Request().Context(), form.ID, c.Param("sid"))
+	if err != nil {
+		return h.handleReviewError(c, err)
+	}
+
+	data := make([]map[string]any, len(notes))
+	for i, note := range notes {
+		data[i] = noteData(note)
+	}
+
+	return response.Success(c, map[string]any{"notes": data, "count": len(data)})
+}
+
+// POST /api/forms/:id/submissions/:sid/notes - add an internal note (assertion auth)
+func (h *FormAPIHandler) handleAddSubmissionNote(c echo.Context) error {
+	form, err := h.getFormWithPermissionOrError(c, workspace.PermissionReviewSubmissions)
+	if err != nil {
+		return err
+	}
+
+	var req SubmissionNoteRequest
+	if bindErr := c.Bind(&req); bindErr != nil {
+		return response.ErrorResponse(c, http.StatusBadRequest, "Invalid request body")
+	}
+
+	note, err := h.Reviews.AddNote(c.Request().Context(), form.ID, c.Param("sid"), requestActor(c), req.Body)
+	if err != nil {
+		return h.handleReviewError(c, err)
+	}
+
+	return c.JSON(http.StatusCreated, response.APIResponse{
+		Success: true,
+		Data:    noteData(note),
+	})
+}
+
+// DELETE /api/forms/:id/submissions/:sid/notes/:noteId - delete one of the caller's notes (assertion auth)
+func (h *FormAPIHandler) handleDeleteSubmissionNote(c echo.Context) error {
+	form, err := h.getFormWithPermissionOrError(c, workspace.PermissionReviewSubmissions)
+	if err != nil {
+		return err
+	}
+
+	if err = h.Reviews.DeleteNote(
+		c.Request().Context(), form.ID, c.Param("sid"), c.Param("noteId"), requestActor(c),
+	); err != nil {
+		return h.handleReviewError(c, err)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// validateAssignee checks that a submission can be assigned to assigneeID: the creator of a
+// personal form, or a workspace member whose role may review submissions. Empty unassigns.
+func (h *FormAPIHandler) validateAssignee(c echo.Context, form *model.Form, assigneeID string) error {
+	if assigneeID == "" {
+		return nil
+	}
+
+	if form.WorkspaceID == "" {
+		if assigneeID != form.UserID {
+			return errInvalidAssignee
+		}
+
+		return nil
+	}
+
+	if h.Workspaces == nil {
+		return errInvalidAssignee
+	}
+
+	_, err := h.Workspaces.Authorize(c.Request().Context(), form.WorkspaceID, assigneeID, workspace.PermissionReviewSubmissions)
+
+	switch {
+	case err == nil:
+		return nil
+	case errors.Is(err, workspace.ErrNotMember), errors.Is(err, workspace.ErrPermissionDenied):
+		return errInvalidAssignee
+	default:
+		return fmt.Errorf("authorize assignee: %w", err)
+	}
+}
+
+// handleReviewError maps review errors to HTTP responses
+func (h *FormAPIHandler) handleReviewError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, review.ErrSubmissionNotFound):
+		return h.HandleNotFound(c, "Submission not found")
+	case errors.Is(err, review.ErrNoteNotFound):
+		return h.HandleNotFound(c, "Note not found")
+	case errors.Is(err, review.ErrUnknownStatus), errors.Is(err, review.ErrInvalidTags),
+		errors.Is(err, review.ErrInvalidNote), errors.Is(err, errInvalidAssignee):
+		return response.ErrorResponse(c, http.StatusBadRequest, err.Error())
+	case errors.Is(err, review.ErrInvalidTransition), errors.Is(err, review.ErrReviewConflict):
+		return response.ErrorResponse(c, http.StatusConflict, err.Error())
+	case errors.Is(err, review.ErrNotNoteAuthor):
+		return response.ErrorResponse(c, http.StatusForbidden, err.Error())
+	default:
+		h.Logger.Error("submission review failed", "form_id", c.Param("id"), "submission_id", c.Param("sid"), "error", err)
+
+		return h.HandleError(c, err, "Submission review failed")
+	}
+}
+
+// reviewData renders a submission's review state
+func reviewData(submission *model.FormSubmission) map[string]any {
+	tags := submission.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+
+	return map[string]any{
+		"id":            submission.ID,
+		"form_id":       submission.FormID,
+		"review_status": submission.ReviewStatus,
+		"assignee_id":   submission.AssigneeID,
+		"tags":          tags,
+	}
+}
+
+// noteData renders an internal note
+func noteData(note *review.Note) map[string]any {
+	return map[string]any{
+		"id":            note.ID,
+		"submission_id": note.SubmissionID,
+		"author_id":     note.AuthorID,
+		"body":          note.Body,
+		"created_at":    note.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+// reviewSnapshot captures the review state of a submission for the audit log
+func reviewSnapshot(submission *model.FormSubmission) audit.Snapshot {
+	return audit.Snapshot{
+		"id":            submission.ID,
+		"form_id":       submission.FormID,
+		"review_status": submission.ReviewStatus,
+		"assignee_id":   submission.AssigneeID,
+		"tags":          append([]string{}, submission.Tags...),
+	}
+}
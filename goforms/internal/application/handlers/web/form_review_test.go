@@ -0,0 +1,125 @@
+package web //nolint:testpackage // internal test for unexported handler methods
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goformx/goforms/internal/application/response"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/domain/review"
+	mockform "github.com/goformx/goforms/test/mocks/form"
+	mocklogging "github.com/goformx/goforms/test/mocks/logging"
+	mockreview "github.com/goformx/goforms/test/mocks/review"
+)
+
+func buildReviewHandler(t *testing.T) (*FormAPIHandler, *mockreview.MockService) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	formService := mockform.NewMockService(ctrl)
+	formService.EXPECT().GetForm(gomock.Any(), "form-1").Return(&model.Form{ID: "form-1", UserID: "user123"}, nil).AnyTimes()
+
+	logger := mocklogging.NewMockLogger(ctrl)
+	logger.EXPECT().WithComponent(gomock.Any()).Return(logger).AnyTimes()
+	logger.EXPECT().With(gomock.Any()).Return(logger).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+
+	reviews := mockreview.NewMockService(ctrl)
+	handler := buildUsageHandler(t, formService, logger)
+	handler.Reviews = reviews
+
+	return handler, reviews
+}
+
+func TestHandleUpdateSubmissionReview_AppliesUpdate(t *testing.T) {
+	handler, reviews := buildReviewHandler(t)
+
+	reviews.EXPECT().Get(gomock.Any(), "form-1", "sub-1").
+		Return(&model.FormSubmission{ID: "sub-1", FormID: "form-1", ReviewStatus: model.ReviewStatusNew}, nil)
+	reviews.EXPECT().Update(gomock.Any(), gomock.Any(), "sub-1", "user123", gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ *model.Form, _, _ string, update review.Update) (*model.FormSubmission, error) {
+			require.NotNil(t, update.Status)
+			assert.Equal(t, model.ReviewStatusApproved, *update.Status)
+			require.NotNil(t, update.AssigneeID)
+			assert.Equal(t, "user123", *update.AssigneeID)
+			assert.Equal(t, []string{"vip"}, update.Tags)
+
+			return &model.FormSubmission{
+				ID: "sub-1", FormID: "form-1", ReviewStatus: *update.Status, AssigneeID: *update.AssigneeID, Tags: update.Tags,
+			}, nil
+		})
+
+	c, rec := bulkRequest(t, http.MethodPatch, `{"status":"approved","assignee_id":"user123","tags":["vip"]}`, "sid", "sub-1")
+	require.NoError(t, handler.handleUpdateSubmissionReview(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	var resp response.APIResponse
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &resp))
+
+	data, ok := resp.Data.(map[string]any)
+	require.True(t, ok)
+	assert.Equal(t, "approved", data["review_status"])
+	assert.Equal(t, "user123", data["assignee_id"])
+	assert.Equal(t, []any{"vip"}, data["tags"])
+}
+
+func TestHandleUpdateSubmissionReview_RejectsAssigneeOutsidePersonalForm(t *testing.T) {
+	handler, _ := buildReviewHandler(t)
+
+	c, rec := bulkRequest(t, http.MethodPatch, `{"assignee_id":"someone-else"}`, "sid", "sub-1")
+	require.NoError(t, handler.handleUpdateSubmissionReview(c))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
+
+func TestHandleUpdateSubmissionReview_MapsWorkflowErrors(t *testing.T) {
+	tests := []struct {
+		err  error
+		want int
+	}{
+		{review.ErrInvalidTransition, http.StatusConflict},
+		{review.ErrReviewConflict, http.StatusConflict},
+		{review.ErrUnknownStatus, http.StatusBadRequest},
+		{review.ErrInvalidTags, http.StatusBadRequest},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.err.Error(), func(t *testing.T) {
+			handler, reviews := buildReviewHandler(t)
+
+			reviews.EXPECT().Get(gomock.Any(), "form-1", "sub-1").Return(&model.FormSubmission{ID: "sub-1", FormID: "form-1"}, nil)
+			reviews.EXPECT().Update(gomock.Any(), gomock.Any(), "sub-1", "user123", gomock.Any()).Return(nil, tt.err)
+
+			c, rec := bulkRequest(t, http.MethodPatch, `{"status":"approved"}`, "sid", "sub-1")
+			require.NoError(t, handler.handleUpdateSubmissionReview(c))
+			assert.Equal(t, tt.want, rec.Code)
+		})
+	}
+}
+
+func TestHandleAddSubmissionNote_CreatesNote(t *testing.T) {
+	handler, reviews := buildReviewHandler(t)
+
+	reviews.EXPECT().AddNote(gomock.Any(), "form-1", "sub-1", "user123", "Called back").
+		Return(&review.Note{ID: "note-1", SubmissionID: "sub-1", AuthorID: "user123", Body: "Called back"}, nil)
+
+	c, rec := bulkRequest(t, http.MethodPost, `{"body":"Called back"}`, "sid", "sub-1")
+	require.NoError(t, handler.handleAddSubmissionNote(c))
+	assert.Equal(t, http.StatusCreated, rec.Code)
+	assert.Contains(t, rec.Body.String(), `"author_id":"user123"`)
+}
+
+func TestHandleDeleteSubmissionNote_OnlyAuthor(t *testing.T) {
+	handler, reviews := buildReviewHandler(t)
+
+	reviews.EXPECT().DeleteNote(gomock.Any(), "form-1", "sub-1", "note-1", "user123").Return(review.ErrNotNoteAuthor)
+
+	c, rec := bulkRequest(t, http.MethodDelete, "", "sid", "noteId", "sub-1", "note-1")
+	require.NoError(t, handler.handleDeleteSubmissionNote(c))
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+}
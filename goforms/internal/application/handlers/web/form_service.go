@@ -30,6 +30,7 @@ func NewFormService(formService formdomain.Service, logger logging.Logger) *Form
 func (s *FormService) CreateForm(
 	ctx context.Context,
 	userID string,
+	workspaceID string,
 	req *FormCreateRequest,
 	planTier string,
 ) (*model.Form, error) {
@@ -46,6 +47,8 @@ func (s *FormService) CreateForm(
 	}
 
 	form := model.NewForm(userID, req.Title, "", schema)
+	form.WorkspaceID = workspaceID
+	form.Tags = req.Tags
 
 	if err := s.formService.CreateForm(ctx, form, planTier); err != nil {
 		return nil, fmt.Errorf("create form: %w", err)
@@ -73,6 +76,14 @@ func (s *FormService) UpdateForm(
 		form.Schema = req.Schema
 	}
 
+	if req.Tags != nil {
+		form.Tags = req.Tags
+	}
+
+	if req.ReviewWorkflow != nil {
+		form.ReviewWorkflow = req.ReviewWorkflow
+	}
+
 	if err := s.formService.UpdateForm(ctx, form, planTier); err != nil {
 		return fmt.Errorf("update form: %w", err)
 	}
@@ -0,0 +1,166 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/goformx/goforms/internal/application/response"
+	"github.com/goformx/goforms/internal/application/stream"
+	"github.com/goformx/goforms/internal/domain/workspace"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+// streamRetry is the reconnection delay suggested to stream clients
+const streamRetry = 3 * time.Second
+
+// registerStreamRoutes registers the live submission stream on the assertion API group
+func (h *FormAPIHandler) registerStreamRoutes(group *echo.Group) {
+	if h.Streams == nil {
+		return
+	}
+
+	group.GET("/:id/submissions/stream", h.handleSubmissionStream)
+}
+
+// GET /api/forms/:id/submissions/stream - new submissions, review changes and analytics ticks as
+// server-sent events (assertion auth). Clients resume with Last-Event-ID. Access is checked again
+// at every heartbeat, and the stream ends once the user may no longer view the submissions.
+func (h *FormAPIHandler) handleSubmissionStream(c echo.Context) error {
+	form, err := h.getFormWithPermissionOrError(c, workspace.PermissionViewSubmissions)
+	if err != nil {
+		return err
+	}
+
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return h.HandleForbidden(c, "User not authenticated")
+	}
+
+	sub, err := h.Streams.Subscribe(form.ID, userID, c.Request().Header.Get("Last-Event-ID"))
+	switch {
+	case errors.Is(err, stream.ErrTooManyStreams):
+		return response.ErrorResponse(c, http.StatusTooManyRequests, "Too many open submission streams")
+	case err != nil:
+		return response.ErrorResponse(c, http.StatusServiceUnavailable, "Submission streams are unavailable")
+	}
+	defer sub.Close()
+
+	res := c.Response()
+	res.Header().Set(echo.HeaderContentType, "text/event-stream")
+	res.Header().Set(echo.HeaderCacheControl, "no-cache")
+	res.Header().Set("X-Accel-Buffering", "no")
+	res.WriteHeader(http.StatusOK)
+
+	// The server write timeout covers whole responses; a stream instead gets it per event, so
+	// clients that stop reading are still disconnected.
+	controller := http.NewResponseController(res)
+	write := func(f func(w io.Writer) error) error {
+		_ = controller.SetWriteDeadline(time.Now().Add(h.Config.App.WriteTimeout))
+		if writeErr := f(res); writeErr != nil {
+			return writeErr
+		}
+
+		res.Flush()
+
+		return nil
+	}
+
+	if err = write(func(w io.Writer) error {
+		if _, retryErr := fmt.Fprintf(w, "retry: %d\n\n", streamRetry.Milliseconds()); retryErr != nil {
+			return retryErr
+		}
+
+		for _, msg := range sub.Backlog {
+			if msgErr := writeStreamMessage(w, msg); msgErr != nil {
+				return msgErr
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil //nolint:nilerr // the client is gone and the response already started
+	}
+
+	heartbeat := time.NewTicker(h.Streams.Heartbeat())
+	defer heartbeat.Stop()
+
+	for {
+		select {
+		case <-c.Request().Context().Done():
+			return nil
+		case msg, open := <-sub.Messages():
+			if !open {
+				return nil
+			}
+
+			err = write(func(w io.Writer) error { return writeStreamMessage(w, msg) })
+		case <-heartbeat.C:
+			if h.streamAccessRevoked(c.Request().Context(), form.ID, userID) {
+				return nil
+			}
+
+			err = write(func(w io.Writer) error {
+				_, heartbeatErr := io.WriteString(w, ": heartbeat\n\n")
+
+				return heartbeatErr
+			})
+		}
+
+		if err != nil {
+			return nil //nolint:nilerr // the client is gone and the response already started
+		}
+	}
+}
+
+// streamAccessRevoked reports whether userID lost the permission to view the submissions of the
+// form since its stream opened: the form is gone, was moved, or the user left its workspace or
+// lost the role. Lookups that fail otherwise keep the stream open until the next heartbeat.
+func (h *FormAPIHandler) streamAccessRevoked(ctx context.Context, formID, userID string) bool {
+	form, err := h.FormService.GetForm(ctx, formID)
+	if form == nil && (err == nil || errors.Is(err, common.ErrNotFound)) {
+		return true
+	}
+
+	if err != nil {
+		h.Logger.Warn("failed to recheck submission stream access", "form_id", formID, "error", err)
+
+		return false
+	}
+
+	if form.WorkspaceID == "" {
+		return form.UserID != userID
+	}
+
+	if h.Workspaces == nil {
+		return true
+	}
+
+	_, err = h.Workspaces.Authorize(ctx, form.WorkspaceID, userID, workspace.PermissionViewSubmissions)
+
+	switch {
+	case err == nil:
+		return false
+	case errors.Is(err, workspace.ErrNotMember), errors.Is(err, workspace.ErrPermissionDenied):
+		return true
+	default:
+		h.Logger.Warn("failed to recheck submission stream access", "form_id", formID, "error", err)
+
+		return false
+	}
+}
+
+// writeStreamMessage writes msg as a server-sent event. Its data is compact JSON, a single line.
+func writeStreamMessage(w io.Writer, msg stream.Message) error {
+	_, err := fmt.Fprintf(w, "id: %s\nevent: %s\ndata: %s\n\n", msg.ID, msg.Event, msg.Data)
+	if err != nil {
+		return fmt.Errorf("write stream event: %w", err)
+	}
+
+	return nil
+}
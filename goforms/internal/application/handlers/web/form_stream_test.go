@@ -0,0 +1,243 @@
+package web //nolint:testpackage // internal test for unexported handler methods
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goformx/goforms/internal/application/stream"
+	"github.com/goformx/goforms/internal/domain/common/events"
+	formevents "github.com/goformx/goforms/internal/domain/form/events"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/domain/workspace"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/event"
+	mockform "github.com/goformx/goforms/test/mocks/form"
+	mocklogging "github.com/goformx/goforms/test/mocks/logging"
+	mockworkspace "github.com/goformx/goforms/test/mocks/workspace"
+)
+
+// startStreamServer serves the submission stream of form-1 to user123, fed by an in-process bus
+func startStreamServer(t *testing.T, cfg config.StreamConfig) (*httptest.Server, events.EventBus) {
+	t.Helper()
+
+	formService := mockform.NewMockService(gomock.NewController(t))
+	formService.EXPECT().GetForm(gomock.Any(), "form-1").Return(&model.Form{ID: "form-1", UserID: "user123"}, nil).AnyTimes()
+
+	return serveStream(t, cfg, formService, nil)
+}
+
+// serveStream serves the submission streams of formService's forms to user123, fed by an in-process bus
+func serveStream(
+	t *testing.T,
+	cfg config.StreamConfig,
+	formService *mockform.MockService,
+	workspaces workspace.Service,
+) (*httptest.Server, events.EventBus) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+
+	logger := mocklogging.NewMockLogger(ctrl)
+	logger.EXPECT().WithComponent(gomock.Any()).Return(logger).AnyTimes()
+	logger.EXPECT().With(gomock.Any()).Return(logger).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	bus := event.NewMemoryEventBus(logger)
+	hub := stream.NewHub(bus, cfg, logger)
+	require.NoError(t, hub.Start(context.Background()))
+
+	handler := buildUsageHandler(t, formService, logger)
+	handler.Config = &config.Config{App: config.AppConfig{WriteTimeout: 5 * time.Second}}
+	handler.Streams = hub
+	handler.Workspaces = workspaces
+
+	e := echo.New()
+	e.GET("/api/forms/:id/submissions/stream", handler.handleSubmissionStream, func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set("user_id", "user123")
+
+			return next(c)
+		}
+	})
+
+	server := httptest.NewServer(e)
+	t.Cleanup(func() {
+		hub.Close()
+		server.Close()
+		require.NoError(t, bus.Stop(context.Background()))
+	})
+
+	return server, bus
+}
+
+func openStream(t *testing.T, server *httptest.Server, lastEventID string) *http.Response {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/api/forms/form-1/submissions/stream", http.NoBody)
+	require.NoError(t, err)
+
+	if lastEventID != "" {
+		req.Header.Set("Last-Event-ID", lastEventID)
+	}
+
+	resp, err := server.Client().Do(req)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = resp.Body.Close() })
+
+	return resp
+}
+
+// nextEvent reads the fields of the next event, skipping comments
+func nextEvent(t *testing.T, lines *bufio.Scanner) map[string]string {
+	t.Helper()
+
+	fields := map[string]string{}
+
+	for lines.Scan() {
+		line := lines.Text()
+		if line == "" {
+			if len(fields) > 0 {
+				return fields
+			}
+
+			continue
+		}
+
+		if strings.HasPrefix(line, ":") {
+			continue
+		}
+
+		name, value, _ := strings.Cut(line, ": ")
+		fields[name] = value
+	}
+
+	require.FailNow(t, "stream ended", "error: %v", lines.Err())
+
+	return nil
+}
+
+func TestHandleSubmissionStream_StreamsAndResumes(t *testing.T) {
+	server, bus := startStreamServer(t, config.StreamConfig{})
+
+	resp := openStream(t, server, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	assert.Equal(t, "text/event-stream", resp.Header.Get(echo.HeaderContentType))
+	assert.Equal(t, "no-cache", resp.Header.Get(echo.HeaderCacheControl))
+
+	lines := bufio.NewScanner(resp.Body)
+	assert.Equal(t, map[string]string{"retry": "3000"}, nextEvent(t, lines))
+
+	ready := nextEvent(t, lines)
+	assert.Equal(t, stream.EventReady, ready["event"])
+
+	for _, id := range []string{"s1", "s2"} {
+		require.NoError(t, bus.Publish(context.Background(), formevents.NewFormSubmittedEvent(&model.FormSubmission{
+			ID: id, FormID: "form-1", Status: model.SubmissionStatusPending, SubmittedAt: time.Now(),
+		})))
+	}
+
+	first := nextEvent(t, lines)
+	assert.Equal(t, stream.EventSubmission, first["event"])
+	assert.Contains(t, first["data"], `"id":"s1"`)
+	assert.Equal(t, stream.EventSubmission, nextEvent(t, lines)["event"])
+
+	resumed := bufio.NewScanner(openStream(t, server, first["id"]).Body)
+	nextEvent(t, resumed)
+
+	missed := nextEvent(t, resumed)
+	assert.Equal(t, stream.EventSubmission, missed["event"])
+	assert.Contains(t, missed["data"], `"id":"s2"`)
+}
+
+func TestHandleSubmissionStream_SendsHeartbeats(t *testing.T) {
+	server, _ := startStreamServer(t, config.StreamConfig{Heartbeat: 10 * time.Millisecond})
+
+	lines := bufio.NewScanner(openStream(t, server, "").Body)
+
+	for lines.Scan() {
+		if lines.Text() == ": heartbeat" {
+			return
+		}
+	}
+
+	require.Fail(t, "stream ended without a heartbeat")
+}
+
+func TestHandleSubmissionStream_EndsWhenAccessIsRevoked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	formService := mockform.NewMockService(ctrl)
+	formService.EXPECT().GetForm(gomock.Any(), "form-1").
+		Return(&model.Form{ID: "form-1", UserID: "owner", WorkspaceID: "ws-1"}, nil).AnyTimes()
+
+	var removed atomic.Bool
+
+	workspaces := mockworkspace.NewMockService(ctrl)
+	workspaces.EXPECT().Authorize(gomock.Any(), "ws-1", "user123", workspace.PermissionViewSubmissions).
+		DoAndReturn(func(context.Context, string, string, workspace.Permission) (*workspace.Member, error) {
+			if removed.Load() {
+				return nil, workspace.ErrNotMember
+			}
+
+			return &workspace.Member{WorkspaceID: "ws-1", UserID: "user123", Role: workspace.RoleSubmissionsOnly}, nil
+		}).AnyTimes()
+
+	server, _ := serveStream(t, config.StreamConfig{Heartbeat: 10 * time.Millisecond}, formService, workspaces)
+
+	resp := openStream(t, server, "")
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	lines := bufio.NewScanner(resp.Body)
+	nextEvent(t, lines)
+	assert.Equal(t, stream.EventReady, nextEvent(t, lines)["event"])
+
+	// Removing the member from the workspace ends the stream at the next heartbeat
+	removed.Store(true)
+
+	done := make(chan struct{})
+
+	go func() {
+		defer close(done)
+
+		_, _ = io.Copy(io.Discard, resp.Body)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(2 * time.Second):
+		require.Fail(t, "stream outlived the membership")
+	}
+}
+
+func TestHandleSubmissionStream_LimitsStreamsPerUser(t *testing.T) {
+	server, _ := startStreamServer(t, config.StreamConfig{MaxConnectionsPerUser: 1})
+
+	first := openStream(t, server, "")
+	require.Equal(t, http.StatusOK, first.StatusCode)
+
+	assert.Equal(t, http.StatusTooManyRequests, openStream(t, server, "").StatusCode)
+
+	// Disconnecting frees the slot
+	require.NoError(t, first.Body.Close())
+	require.Eventually(t, func() bool {
+		resp := openStream(t, server, "")
+		defer resp.Body.Close()
+
+		return resp.StatusCode == http.StatusOK
+	}, 2*time.Second, 10*time.Millisecond)
+}
@@ -2,7 +2,9 @@ package web
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"strings"
 
 	"go.uber.org/fx"
@@ -10,17 +12,35 @@ import (
 	"github.com/labstack/echo/v4"
 
 	"github.com/goformx/goforms/internal/application/middleware/access"
+	"github.com/goformx/goforms/internal/application/middleware/assertion"
+	"github.com/goformx/goforms/internal/application/middleware/idempotency"
+	"github.com/goformx/goforms/internal/application/openapi"
+	"github.com/goformx/goforms/internal/application/stream"
 	"github.com/goformx/goforms/internal/application/validation"
+	"github.com/goformx/goforms/internal/domain/apikey"
+	"github.com/goformx/goforms/internal/domain/audit"
+	"github.com/goformx/goforms/internal/domain/bulk"
 	"github.com/goformx/goforms/internal/domain/form"
+	"github.com/goformx/goforms/internal/domain/review"
 	"github.com/goformx/goforms/internal/domain/user"
+	"github.com/goformx/goforms/internal/domain/workspace"
+	appconfig "github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/database"
+	"github.com/goformx/goforms/internal/infrastructure/event"
 	"github.com/goformx/goforms/internal/infrastructure/logging"
+	"github.com/goformx/goforms/internal/infrastructure/renderer"
 	"github.com/goformx/goforms/internal/infrastructure/sanitization"
+	"github.com/goformx/goforms/internal/infrastructure/server"
 )
 
 // Module provides web handler dependencies
 var Module = fx.Module("web-handlers",
 	// Core dependencies
 	fx.Provide(NewBaseHandler),
+	fx.Provide(NewAssertionMiddleware),
+	fx.Provide(NewIdempotencyMiddleware),
+	fx.Provide(NewStreamHub),
+	fx.Provide(renderer.Load),
 
 	// Handler providers
 	fx.Provide(
@@ -33,8 +53,75 @@ var Module = fx.Module("web-handlers",
 				formValidator *validation.FormValidator,
 				sanitizer sanitization.ServiceInterface,
 				userEnsurer user.UserEnsurer,
+				auditService audit.Service,
+				workspaces workspace.Service,
+				apiKeys apikey.Service,
+				bulkJobs bulk.Service,
+				reviews review.Service,
+				assertionMiddleware *assertion.Middleware,
+				rendererBundle *renderer.Bundle,
+				idempotencyMiddleware *idempotency.Middleware,
+				streams *stream.Hub,
 			) (Handler, error) {
-				return NewFormAPIHandler(base, formService, accessManager, formValidator, sanitizer, userEnsurer), nil
+				handler := NewFormAPIHandler(
+					base, formService, accessManager, formValidator, sanitizer, userEnsurer, auditService, workspaces,
+					apiKeys,
+				)
+				handler.AssertionMiddleware = assertionMiddleware
+				handler.Renderer = rendererBundle
+				handler.BulkJobs = bulkJobs
+				handler.Reviews = reviews
+				handler.Streams = streams
+				handler.Idempotency = idempotencyMiddleware
+
+				if !rendererBundle.Vendored() {
+					if !base.Config.App.IsDevelopment() {
+						return nil, errors.New("form renderer is not vendored: run task renderer:vendor before building")
+					}
+
+					base.Logger.Error("form renderer not vendored, embeds load Form.io from the CDN without integrity checks",
+						"cdn", renderer.CDNOrigin)
+				}
+
+				return handler, nil
+			},
+			fx.ResultTags(`group:"handlers"`),
+		),
+		// Workspace API handler - assertion auth
+		fx.Annotate(
+			func(
+				base *BaseHandler,
+				workspaces workspace.Service,
+				userEnsurer user.UserEnsurer,
+				apiKeys apikey.Service,
+				auditService audit.Service,
+				assertionMiddleware *assertion.Middleware,
+			) (Handler, error) {
+				handler := NewWorkspaceAPIHandler(base, workspaces, userEnsurer, apiKeys, auditService)
+				handler.AssertionMiddleware = assertionMiddleware
+
+				return handler, nil
+			},
+			fx.ResultTags(`group:"handlers"`),
+		),
+		// Admin configuration handler - session auth, admins only
+		fx.Annotate(
+			func(base *BaseHandler) (Handler, error) {
+				return NewAdminConfigHandler(base), nil
+			},
+			fx.ResultTags(`group:"handlers"`),
+		),
+		// Session handler - login is public, the other routes use session auth
+		fx.Annotate(
+			func(base *BaseHandler) (Handler, error) {
+				return NewSessionHandler(base), nil
+			},
+			fx.ResultTags(`group:"handlers"`),
+		),
+		// OpenAPI document handler - public
+		fx.Annotate(
+			func(base *BaseHandler, doc *openapi.Document) (Handler, error) {
+				return NewOpenAPIHandler(base, doc)
 			},
 			fx.ResultTags(`group:"handlers"`),
 		),
@@ -72,6 +159,69 @@ var Module = fx.Module("web-handlers",
 	)),
 )
 
+// NewAssertionMiddleware creates the assertion middleware shared by all assertion-protected handlers,
+// so a nonce claimed on one route group cannot be replayed against another.
+func NewAssertionMiddleware(
+	lc fx.Lifecycle,
+	config *appconfig.Config,
+	policy *appconfig.SecurityPolicy,
+	logger logging.Logger,
+) (*assertion.Middleware, error) {
+	store, err := assertion.NewNonceStore(config.Security.Assertion.Nonce)
+	if err != nil {
+		return nil, fmt.Errorf("create assertion nonce store: %w", err)
+	}
+
+	if closer, ok := store.(io.Closer); ok {
+		lc.Append(fx.Hook{
+			OnStop: func(_ context.Context) error {
+				return closer.Close()
+			},
+		})
+	}
+
+	return assertion.NewMiddlewareWithNonceStore(config, logger, store).WithPolicy(policy), nil
+}
+
+// NewIdempotencyMiddleware creates the Idempotency-Key middleware backed by the configured store
+func NewIdempotencyMiddleware(
+	config *appconfig.Config,
+	db database.DB,
+	logger logging.Logger,
+) (*idempotency.Middleware, error) {
+	store, err := idempotency.NewStore(config.App.Idempotency, db, logger)
+	if err != nil {
+		return nil, fmt.Errorf("create idempotency store: %w", err)
+	}
+
+	return idempotency.NewMiddleware(store, config.App.Idempotency.TTL, logger), nil
+}
+
+// NewStreamHub creates the hub of live submission streams, fed by the broadcast bus so each
+// replica sees the events of the streams it serves. Open streams would hold up server shutdown,
+// so they are ended when it begins.
+func NewStreamHub(
+	lc fx.Lifecycle,
+	config *appconfig.Config,
+	bus event.BroadcastBus,
+	srv *server.Server,
+	logger logging.Logger,
+) *stream.Hub {
+	hub := stream.NewHub(bus, config.App.Stream, logger)
+	srv.OnShutdown(hub.Close)
+
+	lc.Append(fx.Hook{
+		OnStart: hub.Start,
+		OnStop: func(_ context.Context) error {
+			hub.Close()
+
+			return nil
+		},
+	})
+
+	return hub
+}
+
 // RouteRegistrar handles route registration for all handlers
 type RouteRegistrar struct {
 	handlers      []Handler
@@ -107,6 +257,14 @@ func (rr *RouteRegistrar) registerHandlerRoutes(e *echo.Echo, handler Handler) {
 	switch h := handler.(type) {
 	case *FormAPIHandler:
 		rr.registerFormAPIRoutes(e, h)
+	case *WorkspaceAPIHandler:
+		h.RegisterRoutes(e)
+	case *AdminConfigHandler:
+		h.RegisterRoutes(e)
+	case *SessionHandler:
+		h.RegisterRoutes(e)
+	case *OpenAPIHandler:
+		h.RegisterRoutes(e)
 	default:
 		// Unknown handler type - skip
 		_ = h
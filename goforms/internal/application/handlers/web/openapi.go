@@ -0,0 +1,39 @@
+package web
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/goformx/goforms/internal/application/constants"
+	"github.com/goformx/goforms/internal/application/openapi"
+)
+
+// OpenAPIHandler serves the OpenAPI document describing the API
+type OpenAPIHandler struct {
+	*BaseHandler
+	document []byte
+}
+
+// NewOpenAPIHandler creates a new OpenAPIHandler. The document is marshaled once, since it
+// does not change while the server runs.
+func NewOpenAPIHandler(base *BaseHandler, doc *openapi.Document) (*OpenAPIHandler, error) {
+	document, err := json.Marshal(doc)
+	if err != nil {
+		return nil, fmt.Errorf("marshal OpenAPI document: %w", err)
+	}
+
+	return &OpenAPIHandler{BaseHandler: base, document: document}, nil
+}
+
+// RegisterRoutes registers the OpenAPI document route
+func (h *OpenAPIHandler) RegisterRoutes(e *echo.Echo) {
+	e.GET(constants.PathOpenAPI, h.handleDocument)
+}
+
+// GET /openapi.json - the OpenAPI 3.1 document
+func (h *OpenAPIHandler) handleDocument(c echo.Context) error {
+	return c.JSONBlob(http.StatusOK, h.document)
+}
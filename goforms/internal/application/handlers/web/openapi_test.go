@@ -0,0 +1,200 @@
+package web //nolint:testpackage // internal test for unexported handler methods
+
+import (
+	"encoding/json"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goformx/goforms/internal/application/middleware/apikey"
+	"github.com/goformx/goforms/internal/application/middleware/assertion"
+	"github.com/goformx/goforms/internal/application/openapi"
+	"github.com/goformx/goforms/internal/application/stream"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+	mockapikey "github.com/goformx/goforms/test/mocks/apikey"
+	mockbulk "github.com/goformx/goforms/test/mocks/bulk"
+	mockform "github.com/goformx/goforms/test/mocks/form"
+	mocklogging "github.com/goformx/goforms/test/mocks/logging"
+	mockreview "github.com/goformx/goforms/test/mocks/review"
+)
+
+// allHandlers builds every handler the way the server does with all optional features enabled
+func allHandlers(t *testing.T, doc *openapi.Document) ([]Handler, logging.Logger) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	logger.EXPECT().WithComponent(gomock.Any()).Return(logger).AnyTimes()
+	logger.EXPECT().With(gomock.Any()).Return(logger).AnyTimes()
+	logger.EXPECT().Debug(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	cfg := &config.Config{}
+	apiKeys := mockapikey.NewMockService(ctrl)
+
+	formHandler := buildUsageHandler(t, mockform.NewMockService(ctrl), logger)
+	formHandler.Config = cfg
+	formHandler.AssertionMiddleware = assertion.NewMiddleware(cfg, logger)
+	formHandler.APIKeyMiddleware = apikey.NewMiddleware(cfg, apiKeys, logger)
+	formHandler.BulkJobs = mockbulk.NewMockService(ctrl)
+	formHandler.Reviews = mockreview.NewMockService(ctrl)
+	formHandler.Streams = stream.NewHub(nil, config.StreamConfig{}, logger)
+
+	workspaceHandler := NewWorkspaceAPIHandler(formHandler.BaseHandler, nil, nil, apiKeys, nil)
+	workspaceHandler.AssertionMiddleware = formHandler.AssertionMiddleware
+
+	openAPIHandler, err := NewOpenAPIHandler(formHandler.BaseHandler, doc)
+	require.NoError(t, err)
+
+	return []Handler{
+		formHandler, workspaceHandler, NewAdminConfigHandler(formHandler.BaseHandler), NewSessionHandler(formHandler.BaseHandler),
+		openAPIHandler,
+	}, logger
+}
+
+// registerAllRoutes registers every handler's routes through RegisterHandlers, as the server does
+func registerAllRoutes(t *testing.T, doc *openapi.Document) *echo.Echo {
+	t.Helper()
+
+	handlers, logger := allHandlers(t, doc)
+
+	e := echo.New()
+	RegisterHandlers(e, handlers, nil, logger)
+
+	return e
+}
+
+// routeRegisteringTypes lists the types of this package with a RegisterRoutes method
+func routeRegisteringTypes(t *testing.T) []string {
+	t.Helper()
+
+	files, err := filepath.Glob("*.go")
+	require.NoError(t, err)
+
+	var types []string
+
+	for _, file := range files {
+		if strings.HasSuffix(file, "_test.go") {
+			continue
+		}
+
+		parsed, parseErr := parser.ParseFile(token.NewFileSet(), file, nil, parser.SkipObjectResolution)
+		require.NoError(t, parseErr)
+
+		for _, decl := range parsed.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || fn.Name.Name != "RegisterRoutes" {
+				continue
+			}
+
+			receiver := fn.Recv.List[0].Type
+			if star, isPointer := receiver.(*ast.StarExpr); isPointer {
+				receiver = star.X
+			}
+
+			if ident, isIdent := receiver.(*ast.Ident); isIdent {
+				types = append(types, ident.Name)
+			}
+		}
+	}
+
+	return types
+}
+
+func TestOpenAPIDocument_ChecksEveryHandler(t *testing.T) {
+	handlers, logger := allHandlers(t, openapi.NewDocument("test"))
+
+	checked := make([]string, 0, len(handlers))
+
+	for _, handler := range handlers {
+		name := reflect.TypeOf(handler).Elem().Name()
+		checked = append(checked, name)
+
+		// A handler the registrar does not know would have its routes silently skipped
+		e := echo.New()
+		RegisterHandlers(e, []Handler{handler}, nil, logger)
+		assert.NotEmpty(t, e.Routes(), "%s registers no routes through RegisterHandlers", name)
+	}
+
+	types := routeRegisteringTypes(t)
+	require.NotEmpty(t, types)
+
+	for _, name := range types {
+		assert.Contains(t, checked, name, "handler %s is missing from allHandlers, so its routes are not checked", name)
+	}
+}
+
+func TestOpenAPIDocument_CoversEveryRoute(t *testing.T) {
+	doc := openapi.NewDocument("test")
+	e := registerAllRoutes(t, doc)
+
+	registered := map[string]bool{}
+
+	for _, route := range e.Routes() {
+		if route.Method == echo.RouteNotFound {
+			continue
+		}
+
+		key := route.Method + " " + openapi.PathFromRoute(route.Path)
+		registered[key] = true
+
+		_, ok := doc.Lookup(route.Method, route.Path)
+		assert.True(t, ok, "route %s is not in the OpenAPI document", key)
+	}
+
+	require.NotEmpty(t, registered)
+
+	for path, item := range doc.Paths {
+		// The health check is served by the server package, not a handler
+		if path == "/health" {
+			continue
+		}
+
+		for method := range *item {
+			key := strings.ToUpper(method) + " " + path
+			assert.True(t, registered[key], "documented operation %s has no route", key)
+		}
+	}
+}
+
+func TestOpenAPIHandler_ServesDocument(t *testing.T) {
+	doc := openapi.NewDocument("1.2.3")
+	handler, err := NewOpenAPIHandler(&BaseHandler{}, doc)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodGet, "/openapi.json", http.NoBody)
+	rec := httptest.NewRecorder()
+
+	require.NoError(t, handler.handleDocument(echo.New().NewContext(req, rec)))
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentType), echo.MIMEApplicationJSON)
+
+	var served struct {
+		OpenAPI string `json:"openapi"`
+		Info    struct {
+			Version string `json:"version"`
+		} `json:"info"`
+		Components struct {
+			SecuritySchemes map[string]any `json:"securitySchemes"`
+		} `json:"components"`
+	}
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &served))
+
+	assert.Equal(t, openapi.Version, served.OpenAPI)
+	assert.Equal(t, "1.2.3", served.Info.Version)
+	assert.Contains(t, served.Components.SecuritySchemes, openapi.SchemeAssertion)
+	assert.Contains(t, served.Components.SecuritySchemes, openapi.SchemeAPIKey)
+}
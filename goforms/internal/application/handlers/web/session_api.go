@@ -0,0 +1,183 @@
+package web
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/goformx/goforms/internal/application/constants"
+	ctxmw "github.com/goformx/goforms/internal/application/middleware/context"
+	"github.com/goformx/goforms/internal/application/response"
+	domainerrors "github.com/goformx/goforms/internal/domain/common/errors"
+	"github.com/goformx/goforms/internal/domain/entities"
+	"github.com/goformx/goforms/internal/domain/user"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+// userRoles are the roles an administrator can give a user
+var userRoles = []string{constants.UserRoleUser, constants.UserRoleModerator, constants.UserRoleAdmin}
+
+// SessionHandler signs users in and out of dashboard sessions and changes their roles. A session
+// carries the role it was started with, so its ID is rotated on login and sessions end when the
+// role changes.
+type SessionHandler struct {
+	*BaseHandler
+}
+
+// NewSessionHandler creates a new SessionHandler
+func NewSessionHandler(base *BaseHandler) *SessionHandler {
+	return &SessionHandler{BaseHandler: base}
+}
+
+// loginRequest is the body of POST /login
+type loginRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+}
+
+// roleRequest is the body of PUT /api/v1/admin/users/:id/role
+type roleRequest struct {
+	Role string `json:"role"`
+}
+
+// sessionUser is a user as the session routes return it
+type sessionUser struct {
+	ID    string `json:"id"`
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+func newSessionUser(u *entities.User) sessionUser {
+	return sessionUser{ID: u.ID, Email: u.Email, Role: u.Role}
+}
+
+// RegisterRoutes registers the session routes
+func (h *SessionHandler) RegisterRoutes(e *echo.Echo) {
+	e.POST(constants.PathLoginPost, h.handleLogin)
+	e.POST(constants.PathLogout, h.handleLogout)
+	e.POST(constants.PathAPISessionsLogoutAll, h.handleLogoutAll)
+	e.PUT(constants.PathAPIAdminUserRole, h.handleUpdateUserRole)
+}
+
+// POST /login - start a session. The session the request arrived with, if any, is removed, so an
+// ID planted in the browser before login never becomes authenticated.
+func (h *SessionHandler) handleLogin(c echo.Context) error {
+	var req loginRequest
+	if err := c.Bind(&req); err != nil || req.Email == "" || req.Password == "" {
+		return response.ErrorResponse(c, http.StatusBadRequest, "Email and password are required")
+	}
+
+	login, err := h.UserService.Login(c.Request().Context(), &user.Login{Email: req.Email, Password: req.Password})
+	if err != nil {
+		if errors.Is(err, user.ErrInvalidCredentials) {
+			return response.ErrorResponse(c, http.StatusUnauthorized, "Invalid email or password")
+		}
+
+		return h.HandleError(c, err, "Failed to log in")
+	}
+
+	if !login.User.Active {
+		return response.ErrorResponse(c, http.StatusUnauthorized, "Invalid email or password")
+	}
+
+	if err = h.startSession(c, login.User); err != nil {
+		return h.HandleError(c, err, "Failed to start session")
+	}
+
+	return response.Success(c, map[string]any{"user": newSessionUser(login.User)})
+}
+
+// POST /logout - end the current session
+func (h *SessionHandler) handleLogout(c echo.Context) error {
+	if cookie, err := c.Cookie(h.SessionManager.GetCookieName()); err == nil {
+		h.SessionManager.DeleteSession(c.Request().Context(), cookie.Value)
+	}
+
+	h.SessionManager.ClearSessionCookie(c)
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// POST /api/v1/sessions/logout-all - end every session of the signed-in user, on every device
+func (h *SessionHandler) handleLogoutAll(c echo.Context) error {
+	userID, ok := ctxmw.GetUserID(c)
+	if !ok {
+		return response.ErrorResponse(c, http.StatusUnauthorized, "Authentication required")
+	}
+
+	removed, err := h.SessionManager.DeleteUserSessions(c.Request().Context(), userID)
+	if err != nil {
+		return h.HandleError(c, err, "Failed to end sessions")
+	}
+
+	h.SessionManager.ClearSessionCookie(c)
+
+	return response.Success(c, map[string]any{"sessions_ended": removed})
+}
+
+// PUT /api/v1/admin/users/:id/role - change a user's role. Every session of the user ends, so none
+// keeps the old role; an administrator changing their own role continues in a rotated session.
+func (h *SessionHandler) handleUpdateUserRole(c echo.Context) error {
+	// The access rules already limit the path to admins; the check guards against the rules drifting
+	if !ctxmw.IsAdmin(c) {
+		return h.HandleForbidden(c, "Admin access required")
+	}
+
+	var req roleRequest
+	if err := c.Bind(&req); err != nil || !slices.Contains(userRoles, req.Role) {
+		return response.ErrorResponse(c, http.StatusBadRequest, "Role must be one of user, moderator or admin")
+	}
+
+	ctx := c.Request().Context()
+
+	target, err := h.UserService.GetUserByID(ctx, c.Param("id"))
+	if err != nil && !errors.Is(err, common.ErrNotFound) && !domainerrors.IsNotFound(err) {
+		return h.HandleError(c, err, "Failed to get user")
+	}
+
+	if err != nil || target == nil {
+		return h.HandleNotFound(c, "User not found")
+	}
+
+	target.Role = req.Role
+	if err = h.UserService.UpdateUser(ctx, target); err != nil {
+		return h.HandleError(c, err, "Failed to update user")
+	}
+
+	// Ended even when the role is unchanged, so retrying after a failure here ends them
+	removed, err := h.SessionManager.DeleteUserSessions(ctx, target.ID)
+	if err != nil {
+		return h.HandleError(c, err, "Failed to end the user's sessions")
+	}
+
+	h.Logger.Info("user role changed", "user_id", h.Logger.SanitizeField("user_id", target.ID),
+		"role", target.Role, "sessions_ended", removed)
+
+	if actorID, ok := ctxmw.GetUserID(c); ok && actorID == target.ID {
+		if err = h.startSession(c, target); err != nil {
+			return h.HandleError(c, err, "Failed to start session")
+		}
+	}
+
+	return response.Success(c, map[string]any{"user": newSessionUser(target), "sessions_ended": removed})
+}
+
+// startSession rotates the request's session to a new one for u and sets its cookie
+func (h *SessionHandler) startSession(c echo.Context, u *entities.User) error {
+	var previous string
+	if cookie, err := c.Cookie(h.SessionManager.GetCookieName()); err == nil {
+		previous = cookie.Value
+	}
+
+	sessionID, err := h.SessionManager.RotateSession(c.Request().Context(), previous, u.ID, u.Email, u.Role)
+	if err != nil {
+		return fmt.Errorf("rotate session: %w", err)
+	}
+
+	h.SessionManager.SetSessionCookie(c, sessionID)
+
+	return nil
+}
@@ -0,0 +1,171 @@
+package web //nolint:testpackage // internal test for unexported handler methods
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goformx/goforms/internal/application/middleware/session"
+	"github.com/goformx/goforms/internal/application/response"
+	"github.com/goformx/goforms/internal/domain/entities"
+	"github.com/goformx/goforms/internal/domain/user"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	mocklogging "github.com/goformx/goforms/test/mocks/logging"
+	mocksanitization "github.com/goformx/goforms/test/mocks/sanitization"
+	mockuser "github.com/goformx/goforms/test/mocks/user"
+)
+
+func buildSessionHandler(t *testing.T) (*SessionHandler, *mockuser.MockService, *session.Manager) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().SanitizeField(gomock.Any(), gomock.Any()).Return("sanitized").AnyTimes()
+
+	users := mockuser.NewMockService(ctrl)
+	manager := session.NewManager(logger, &session.Config{
+		SessionConfig: &config.SessionConfig{MaxAge: time.Hour, CookieName: "session"},
+	}, session.NewMemoryStorage(), nil)
+
+	base := &BaseHandler{
+		Logger:         logger,
+		UserService:    users,
+		SessionManager: manager,
+		ErrorHandler:   response.NewErrorHandler(logger, mocksanitization.NewMockService(ctrl)),
+	}
+
+	return NewSessionHandler(base), users, manager
+}
+
+func sessionRequest(t *testing.T, method, body, sessionID string) (echo.Context, *httptest.ResponseRecorder) {
+	t.Helper()
+
+	req := httptest.NewRequest(method, "/", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	if sessionID != "" {
+		req.AddCookie(&http.Cookie{Name: "session", Value: sessionID})
+	}
+
+	rec := httptest.NewRecorder()
+
+	return echo.New().NewContext(req, rec), rec
+}
+
+func sessionCookie(t *testing.T, rec *httptest.ResponseRecorder) string {
+	t.Helper()
+
+	for _, cookie := range rec.Result().Cookies() {
+		if cookie.Name == "session" {
+			return cookie.Value
+		}
+	}
+
+	t.Fatal("no session cookie set")
+
+	return ""
+}
+
+func TestHandleLogin_RotatesThePlantedSession(t *testing.T) {
+	handler, users, manager := buildSessionHandler(t)
+	ctx := t.Context()
+
+	planted, err := manager.CreateSession(ctx, "", "", "")
+	require.NoError(t, err)
+
+	users.EXPECT().Login(gomock.Any(), &user.Login{Email: "ada@example.com", Password: "secret"}).
+		Return(&user.LoginResponse{User: &entities.User{ID: "user-1", Email: "ada@example.com", Role: "user", Active: true}}, nil)
+
+	c, rec := sessionRequest(t, http.MethodPost, `{"email":"ada@example.com","password":"secret"}`, planted)
+	require.NoError(t, handler.handleLogin(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	issued := sessionCookie(t, rec)
+	assert.NotEqual(t, planted, issued)
+
+	_, err = manager.GetSession(ctx, planted)
+	require.ErrorIs(t, err, session.ErrSessionNotFound)
+
+	got, err := manager.GetSession(ctx, issued)
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", got.UserID)
+}
+
+func TestHandleLogin_RejectsInvalidCredentials(t *testing.T) {
+	handler, users, _ := buildSessionHandler(t)
+
+	users.EXPECT().Login(gomock.Any(), gomock.Any()).Return(nil, user.ErrInvalidCredentials)
+
+	c, rec := sessionRequest(t, http.MethodPost, `{"email":"ada@example.com","password":"wrong"}`, "")
+	require.NoError(t, handler.handleLogin(c))
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+func TestHandleLogoutAll_EndsEverySessionOfTheUser(t *testing.T) {
+	handler, _, manager := buildSessionHandler(t)
+	ctx := t.Context()
+
+	current, err := manager.CreateSession(ctx, "user-1", "ada@example.com", "user")
+	require.NoError(t, err)
+	other, err := manager.CreateSession(ctx, "user-1", "ada@example.com", "user")
+	require.NoError(t, err)
+
+	c, rec := sessionRequest(t, http.MethodPost, "", current)
+	c.Set("user_id", "user-1")
+	require.NoError(t, handler.handleLogoutAll(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+	assert.JSONEq(t, `{"success":true,"data":{"sessions_ended":2}}`, rec.Body.String())
+
+	for _, id := range []string{current, other} {
+		_, err = manager.GetSession(ctx, id)
+		require.ErrorIs(t, err, session.ErrSessionNotFound)
+	}
+}
+
+func TestHandleUpdateUserRole_EndsTheTargetsSessions(t *testing.T) {
+	handler, users, manager := buildSessionHandler(t)
+	ctx := t.Context()
+
+	targetSession, err := manager.CreateSession(ctx, "user-2", "bob@example.com", "admin")
+	require.NoError(t, err)
+
+	users.EXPECT().GetUserByID(gomock.Any(), "user-2").
+		Return(&entities.User{ID: "user-2", Email: "bob@example.com", Role: "admin"}, nil)
+	users.EXPECT().UpdateUser(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, u *entities.User) error {
+		assert.Equal(t, "user", u.Role)
+		return nil
+	})
+
+	c, rec := sessionRequest(t, http.MethodPut, `{"role":"user"}`, "admin-session")
+	c.Set("user_id", "user-1")
+	c.Set("role", "admin")
+	c.SetParamNames("id")
+	c.SetParamValues("user-2")
+	require.NoError(t, handler.handleUpdateUserRole(c))
+	require.Equal(t, http.StatusOK, rec.Code)
+
+	_, err = manager.GetSession(ctx, targetSession)
+	require.ErrorIs(t, err, session.ErrSessionNotFound, "the demoted user's admin session must end")
+	assert.Empty(t, rec.Result().Cookies(), "the acting admin keeps their own session")
+}
+
+func TestHandleUpdateUserRole_RejectsUnknownRoles(t *testing.T) {
+	handler, _, _ := buildSessionHandler(t)
+
+	c, rec := sessionRequest(t, http.MethodPut, `{"role":"owner"}`, "")
+	c.Set("role", "admin")
+	c.SetParamNames("id")
+	c.SetParamValues("user-2")
+	require.NoError(t, handler.handleUpdateUserRole(c))
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+}
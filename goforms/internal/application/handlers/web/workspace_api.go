@@ -0,0 +1,370 @@
+package web
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/goformx/goforms/internal/application/constants"
+	"github.com/goformx/goforms/internal/application/middleware/assertion"
+	ctxmw "github.com/goformx/goforms/internal/application/middleware/context"
+	"github.com/goformx/goforms/internal/application/response"
+	"github.com/goformx/goforms/internal/domain/apikey"
+	"github.com/goformx/goforms/internal/domain/audit"
+	"github.com/goformx/goforms/internal/domain/user"
+	"github.com/goformx/goforms/internal/domain/workspace"
+)
+
+// WorkspaceCreateRequest represents the data needed to create a workspace
+type WorkspaceCreateRequest struct {
+	Name string `json:"name"`
+}
+
+// WorkspaceMemberRequest represents the data needed to add or update a member
+type WorkspaceMemberRequest struct {
+	Role string `json:"role"`
+}
+
+// WorkspaceAPIHandler handles workspace and membership management
+type WorkspaceAPIHandler struct {
+	*BaseHandler
+	Workspaces          workspace.Service
+	AssertionMiddleware *assertion.Middleware
+	UserEnsurer         user.UserEnsurer
+	APIKeys             apikey.Service
+	AuditService        audit.Service
+}
+
+// NewWorkspaceAPIHandler creates a new WorkspaceAPIHandler.
+func NewWorkspaceAPIHandler(
+	base *BaseHandler,
+	workspaces workspace.Service,
+	userEnsurer user.UserEnsurer,
+	apiKeys apikey.Service,
+	auditService audit.Service,
+) *WorkspaceAPIHandler {
+	return &WorkspaceAPIHandler{
+		BaseHandler:         base,
+		Workspaces:          workspaces,
+		AssertionMiddleware: assertion.NewMiddleware(base.Config, base.Logger).WithPolicy(base.securityPolicy()),
+		UserEnsurer:         userEnsurer,
+		APIKeys:             apiKeys,
+		AuditService:        auditService,
+	}
+}
+
+// RegisterRoutes registers /api/workspaces routes with assertion middleware.
+func (h *WorkspaceAPIHandler) RegisterRoutes(e *echo.Echo) {
+	workspaces := e.Group(constants.PathAPIWorkspaces)
+	workspaces.Use(h.AssertionMiddleware.Verify())
+	workspaces.Use(newEnsureUserMiddleware(h.BaseHandler, h.UserEnsurer))
+
+	workspaces.GET("", h.handleListWorkspaces)
+	workspaces.POST("", h.handleCreateWorkspace)
+	workspaces.GET("/:id", h.handleGetWorkspace)
+	workspaces.GET("/:id/members", h.handleListMembers)
+	workspaces.PUT("/:id/members/:userId", h.handleSetMemberRole)
+	workspaces.DELETE("/:id/members/:userId", h.handleRemoveMember)
+	workspaces.GET("/:id/api-keys", h.handleListAPIKeys)
+	workspaces.POST("/:id/api-keys", h.handleCreateAPIKey)
+	workspaces.DELETE("/:id/api-keys/:keyId", h.handleRevokeAPIKey)
+}
+
+// Register registers the WorkspaceAPIHandler with the Echo instance.
+func (h *WorkspaceAPIHandler) Register(_ *echo.Echo) {
+	// Routes are registered by RegisterHandlers function
+}
+
+// Start initializes the workspace API handler.
+func (h *WorkspaceAPIHandler) Start(_ context.Context) error {
+	return nil // No initialization needed
+}
+
+// Stop cleans up any resources used by the workspace API handler.
+func (h *WorkspaceAPIHandler) Stop(_ context.Context) error {
+	return nil // No cleanup needed
+}
+
+// GET /api/workspaces - list workspaces the user belongs to
+func (h *WorkspaceAPIHandler) handleListWorkspaces(c echo.Context) error {
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return h.HandleForbidden(c, "User not authenticated")
+	}
+
+	workspaces, err := h.Workspaces.ListWorkspaces(c.Request().Context(), userID)
+	if err != nil {
+		h.Logger.Error("failed to list workspaces", "error", err)
+
+		return h.HandleError(c, err, "Failed to list workspaces")
+	}
+
+	return response.Success(c, map[string]any{
+		"workspaces": workspaces,
+		"count":      len(workspaces),
+	})
+}
+
+// POST /api/workspaces - create a workspace owned by the user
+func (h *WorkspaceAPIHandler) handleCreateWorkspace(c echo.Context) error {
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return h.HandleForbidden(c, "User not authenticated")
+	}
+
+	var req WorkspaceCreateRequest
+	if err := c.Bind(&req); err != nil {
+		return response.ErrorResponse(c, http.StatusBadRequest, "Invalid request body")
+	}
+
+	// The creator owns the workspace, so its usage is charged to their plan
+	planTier, _ := ctxmw.GetPlanTier(c)
+
+	ws, err := h.Workspaces.CreateWorkspace(c.Request().Context(), userID, req.Name, planTier)
+	if err != nil {
+		if errors.Is(err, workspace.ErrNameRequired) {
+			return response.ErrorResponse(c, http.StatusBadRequest, "Workspace name is required")
+		}
+
+		h.Logger.Error("failed to create workspace", "error", err)
+
+		return h.HandleError(c, err, "Failed to create workspace")
+	}
+
+	return c.JSON(http.StatusCreated, response.APIResponse{
+		Success: true,
+		Message: "Workspace created successfully",
+		Data:    map[string]any{"workspace": ws},
+	})
+}
+
+// GET /api/workspaces/:id - get a workspace the user belongs to
+func (h *WorkspaceAPIHandler) handleGetWorkspace(c echo.Context) error {
+	member, err := h.authorize(c, workspace.PermissionViewWorkspace)
+	if err != nil {
+		return h.handleWorkspaceError(c, err)
+	}
+
+	ws, err := h.Workspaces.GetWorkspace(c.Request().Context(), member.WorkspaceID)
+	if err != nil {
+		return h.handleWorkspaceError(c, err)
+	}
+
+	return response.Success(c, map[string]any{
+		"workspace": ws,
+		"role":      member.Role,
+	})
+}
+
+// GET /api/workspaces/:id/members - list workspace members
+func (h *WorkspaceAPIHandler) handleListMembers(c echo.Context) error {
+	member, err := h.authorize(c, workspace.PermissionViewWorkspace)
+	if err != nil {
+		return h.handleWorkspaceError(c, err)
+	}
+
+	members, err := h.Workspaces.ListMembers(c.Request().Context(), member.WorkspaceID)
+	if err != nil {
+		return h.handleWorkspaceError(c, err)
+	}
+
+	return response.Success(c, map[string]any{"members": members})
+}
+
+// PUT /api/workspaces/:id/members/:userId - add a member or change their role
+func (h *WorkspaceAPIHandler) handleSetMemberRole(c echo.Context) error {
+	actor, err := h.authorize(c, workspace.PermissionManageMembers)
+	if err != nil {
+		return h.handleWorkspaceError(c, err)
+	}
+
+	var req WorkspaceMemberRequest
+	if bindErr := c.Bind(&req); bindErr != nil {
+		return response.ErrorResponse(c, http.StatusBadRequest, "Invalid request body")
+	}
+
+	member, err := h.Workspaces.SetMemberRole(
+		c.Request().Context(), actor.WorkspaceID, c.Param("userId"), workspace.Role(req.Role),
+	)
+	if err != nil {
+		return h.handleWorkspaceError(c, err)
+	}
+
+	return response.Success(c, map[string]any{"member": member})
+}
+
+// DELETE /api/workspaces/:id/members/:userId - remove a member; members may always remove themselves
+func (h *WorkspaceAPIHandler) handleRemoveMember(c echo.Context) error {
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return h.HandleForbidden(c, "User not authenticated")
+	}
+
+	permission := workspace.PermissionManageMembers
+	if c.Param("userId") == userID {
+		permission = workspace.PermissionViewWorkspace
+	}
+
+	actor, err := h.authorize(c, permission)
+	if err != nil {
+		return h.handleWorkspaceError(c, err)
+	}
+
+	if removeErr := h.Workspaces.RemoveMember(
+		c.Request().Context(), actor.WorkspaceID, c.Param("userId"),
+	); removeErr != nil {
+		return h.handleWorkspaceError(c, removeErr)
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GET /api/workspaces/:id/api-keys - list workspace-scoped API keys
+func (h *WorkspaceAPIHandler) handleListAPIKeys(c echo.Context) error {
+	member, err := h.authorize(c, workspace.PermissionManageAPIKeys)
+	if err != nil {
+		return h.handleWorkspaceError(c, err)
+	}
+
+	keys, err := h.APIKeys.List(c.Request().Context(), apikey.Filter{WorkspaceID: member.WorkspaceID})
+	if err != nil {
+		return h.handleAPIKeyError(c, err)
+	}
+
+	return response.Success(c, map[string]any{"api_keys": keys, "count": len(keys)})
+}
+
+// POST /api/workspaces/:id/api-keys - create a key covering every form in the workspace
+func (h *WorkspaceAPIHandler) handleCreateAPIKey(c echo.Context) error {
+	member, err := h.authorize(c, workspace.PermissionManageAPIKeys)
+	if err != nil {
+		return h.handleWorkspaceError(c, err)
+	}
+
+	key, token, err := h.createAPIKey(c, h.APIKeys, apikey.CreateParams{WorkspaceID: member.WorkspaceID})
+	if err != nil {
+		return h.handleAPIKeyError(c, err)
+	}
+
+	recordAuditEntry(h.BaseHandler, h.AuditService, c, audit.Record{
+		OwnerID:      member.WorkspaceID,
+		Action:       audit.ActionAPIKeyCreated,
+		ResourceType: audit.ResourceAPIKey,
+		ResourceID:   key.ID,
+		After:        apiKeySnapshot(key),
+	})
+
+	return apiKeyCreatedResponse(c, key, token)
+}
+
+// DELETE /api/workspaces/:id/api-keys/:keyId - revoke a workspace-scoped API key
+func (h *WorkspaceAPIHandler) handleRevokeAPIKey(c echo.Context) error {
+	member, err := h.authorize(c, workspace.PermissionManageAPIKeys)
+	if err != nil {
+		return h.handleWorkspaceError(c, err)
+	}
+
+	key, err := h.revokeAPIKey(c, h.APIKeys, apikey.Filter{WorkspaceID: member.WorkspaceID})
+	if err != nil {
+		return h.handleAPIKeyError(c, err)
+	}
+
+	recordAuditEntry(h.BaseHandler, h.AuditService, c, audit.Record{
+		OwnerID:      member.WorkspaceID,
+		Action:       audit.ActionAPIKeyRevoked,
+		ResourceType: audit.ResourceAPIKey,
+		ResourceID:   key.ID,
+		After:        apiKeySnapshot(key),
+	})
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+// authorize checks the current user holds permission in the workspace named by the :id parameter
+func (h *WorkspaceAPIHandler) authorize(c echo.Context, permission workspace.Permission) (*workspace.Member, error) {
+	userID, ok := c.Get("user_id").(string)
+	if !ok {
+		return nil, workspace.ErrNotMember
+	}
+
+	return h.Workspaces.Authorize(c.Request().Context(), c.Param("id"), userID, permission)
+}
+
+// handleWorkspaceError maps workspace domain errors to HTTP responses.
+// Non-members get a not-found response so workspace existence is not leaked.
+func (h *BaseHandler) handleWorkspaceError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, workspace.ErrNotMember), errors.Is(err, workspace.ErrWorkspaceNotFound):
+		return h.HandleNotFound(c, "Workspace not found")
+	case errors.Is(err, workspace.ErrPermissionDenied):
+		return h.HandleForbidden(c, "You don't have permission to perform this action")
+	case errors.Is(err, workspace.ErrInvalidRole):
+		return response.ErrorResponse(c, http.StatusBadRequest, "Invalid workspace role")
+	case errors.Is(err, workspace.ErrLastOwner):
+		return response.ErrorResponse(c, http.StatusConflict, "Workspace must keep at least one owner")
+	default:
+		h.Logger.Error("workspace operation failed", "error", err)
+
+		return h.HandleError(c, err, "Workspace operation failed")
+	}
+}
+
+// activeWorkspace returns the workspace selected by the assertion headers after checking the user
+// holds permission in it. An empty ID means the request is scoped to the user's personal forms.
+func (h *FormAPIHandler) activeWorkspace(
+	c echo.Context,
+	userID string,
+	permission workspace.Permission,
+) (string, error) {
+	member, err := h.activeWorkspaceMember(c, userID, permission)
+	if err != nil || member == nil {
+		return "", err
+	}
+
+	return member.WorkspaceID, nil
+}
+
+// activeWorkspaceMember is activeWorkspace returning the user's membership, or nil in personal scope
+func (h *FormAPIHandler) activeWorkspaceMember(
+	c echo.Context,
+	userID string,
+	permission workspace.Permission,
+) (*workspace.Member, error) {
+	workspaceID, ok := ctxmw.GetWorkspaceID(c)
+	if !ok {
+		return nil, nil //nolint:nilnil // personal scope has no membership
+	}
+
+	if h.Workspaces == nil {
+		return nil, workspace.ErrNotMember
+	}
+
+	member, err := h.Workspaces.Authorize(c.Request().Context(), workspaceID, userID, permission)
+	if err != nil {
+		return nil, fmt.Errorf("authorize workspace member: %w", err)
+	}
+
+	return member, nil
+}
+
+// newEnsureUserMiddleware returns middleware that lazily syncs the Laravel user to a Go shadow row.
+// Runs after assertion verification so user_id is available in the context.
+func newEnsureUserMiddleware(h *BaseHandler, ensurer user.UserEnsurer) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			userID, ok := c.Get("user_id").(string)
+			if !ok || ensurer == nil {
+				return next(c)
+			}
+			if err := ensurer.EnsureUser(c.Request().Context(), userID); err != nil {
+				h.Logger.Error("failed to ensure Laravel user",
+					"user_id", h.Logger.SanitizeField("user_id", userID), "error", err)
+				return h.HandleError(c, err, "Failed to ensure user")
+			}
+			return next(c)
+		}
+	}
+}
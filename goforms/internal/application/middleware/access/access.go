@@ -48,6 +48,7 @@ func DefaultConfig() *Config {
 			constants.PathForgotPassword,
 			constants.PathResetPassword,
 			constants.PathVerifyEmail,
+			constants.PathOpenAPI,
 			constants.PathAssets,
 			constants.PathFonts,
 			constants.PathCSS,
@@ -57,6 +58,8 @@ func DefaultConfig() *Config {
 			constants.PathStatic,
 			constants.PathImages,
 			constants.PathAPIFormsLaravel, // Laravel assertion API: auth via X-User-Id/X-Signature on route group
+			constants.PathAPIWorkspaces,   // Laravel assertion API for workspace management
+			constants.PathAPIServerForms,  // Server-to-server API: auth via scoped API keys on route group
 		},
 		AdminPaths: []string{
 			constants.PathAdmin,
@@ -186,6 +189,7 @@ func DefaultRules() []Rule {
 		{Path: constants.PathVerifyEmail, AccessLevel: Public, Methods: []string{}},
 		{Path: constants.PathHealth, AccessLevel: Public, Methods: []string{}},
 		{Path: constants.PathMetrics, AccessLevel: Public, Methods: []string{}},
+		{Path: constants.PathOpenAPI, AccessLevel: Public, Methods: []string{}},
 
 		// Static asset paths
 		{Path: constants.PathAssets, AccessLevel: Public, Methods: []string{}},
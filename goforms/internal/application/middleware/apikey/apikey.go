@@ -0,0 +1,157 @@
+// Package apikey provides middleware that authenticates server-to-server requests
+// with database-backed API keys.
+package apikey
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/goformx/goforms/internal/application/middleware/context"
+	"github.com/goformx/goforms/internal/application/response"
+	apikeydomain "github.com/goformx/goforms/internal/domain/apikey"
+	appconfig "github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+)
+
+const (
+	// defaultHeaderName is used when the API key config does not name a header
+	defaultHeaderName = "X-API-Key"
+	// bearerPrefix is accepted on the Authorization header as an alternative to the key header
+	bearerPrefix = "Bearer "
+
+	// keyContextKey is the Echo context key holding the authenticated *apikey.Key
+	keyContextKey = "api_key"
+
+	// FailureReasonContextKey is the Echo context key set when key authentication fails (value: reason string).
+	FailureReasonContextKey = "api_key_failure_reason"
+)
+
+// Middleware authenticates requests carrying a database-backed API key.
+type Middleware struct {
+	keys   apikeydomain.Service
+	policy *appconfig.SecurityPolicy
+	logger logging.Logger
+}
+
+// NewMiddleware creates a new API key middleware.
+// The key is read from the configured API key header, or from an Authorization bearer token.
+func NewMiddleware(config *appconfig.Config, keys apikeydomain.Service, logger logging.Logger) *Middleware {
+	if config == nil {
+		config = &appconfig.Config{}
+	}
+
+	return &Middleware{keys: keys, policy: appconfig.NewSecurityPolicy(config), logger: logger}
+}
+
+// WithPolicy reads the API key header name from a reloadable security policy instead of the startup configuration
+func (m *Middleware) WithPolicy(policy *appconfig.SecurityPolicy) *Middleware {
+	m.policy = policy
+
+	return m
+}
+
+// Verify returns an Echo middleware that rejects requests without a valid, unexpired, unrevoked key.
+// Scope and permission checks are left to handlers, which know the target resource.
+func (m *Middleware) Verify() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			token := m.extract(c.Request().Header)
+			if token == "" {
+				m.logFailure(c, "missing_key")
+
+				return response.ErrorResponse(c, http.StatusUnauthorized, "Authentication required")
+			}
+
+			key, err := m.keys.Authenticate(c.Request().Context(), token)
+			if err != nil {
+				m.logFailure(c, failureReason(err))
+
+				return response.ErrorResponse(c, http.StatusUnauthorized, "Authentication required")
+			}
+
+			SetKey(c, key)
+
+			return next(c)
+		}
+	}
+}
+
+// Authenticate resolves the key on the request without rejecting it, for routes where a key is optional.
+// On success the key is stored in the context.
+func (m *Middleware) Authenticate(c echo.Context) (*apikeydomain.Key, bool) {
+	token := m.extract(c.Request().Header)
+	if token == "" {
+		return nil, false
+	}
+
+	key, err := m.keys.Authenticate(c.Request().Context(), token)
+	if err != nil {
+		m.logFailure(c, failureReason(err))
+
+		return nil, false
+	}
+
+	SetKey(c, key)
+
+	return key, true
+}
+
+// extract reads the key from the configured header or an Authorization bearer token
+func (m *Middleware) extract(headers http.Header) string {
+	headerName := m.policy.Current().APIKey.HeaderName
+	if headerName == "" {
+		headerName = defaultHeaderName
+	}
+
+	if token := strings.TrimSpace(headers.Get(headerName)); token != "" {
+		return token
+	}
+
+	if auth := headers.Get("Authorization"); strings.HasPrefix(auth, bearerPrefix) {
+		return strings.TrimSpace(strings.TrimPrefix(auth, bearerPrefix))
+	}
+
+	return ""
+}
+
+func (m *Middleware) logFailure(c echo.Context, reason string) {
+	c.Set(FailureReasonContextKey, reason)
+
+	logger := m.logger
+	if logger == nil {
+		logger = context.GetLogger(c.Request().Context())
+	}
+
+	if logger != nil {
+		logger.Warn("api key authentication failed", "reason", reason, "path", c.Path(), "ip", c.RealIP())
+	}
+}
+
+// failureReason maps authentication errors to the reason recorded in the context
+func failureReason(err error) string {
+	switch {
+	case errors.Is(err, apikeydomain.ErrInvalidKey):
+		return "invalid_key"
+	case errors.Is(err, apikeydomain.ErrKeyRevoked):
+		return "revoked_key"
+	case errors.Is(err, apikeydomain.ErrKeyExpired):
+		return "expired_key"
+	default:
+		return "lookup_error"
+	}
+}
+
+// GetKey returns the authenticated API key from the context
+func GetKey(c echo.Context) (*apikeydomain.Key, bool) {
+	key, ok := c.Get(keyContextKey).(*apikeydomain.Key)
+
+	return key, ok && key != nil
+}
+
+// SetKey stores the authenticated API key in the context
+func SetKey(c echo.Context, key *apikeydomain.Key) {
+	c.Set(keyContextKey, key)
+}
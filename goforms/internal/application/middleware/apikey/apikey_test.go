@@ -0,0 +1,102 @@
+package apikey_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goformx/goforms/internal/application/middleware/apikey"
+	apikeydomain "github.com/goformx/goforms/internal/domain/apikey"
+	appconfig "github.com/goformx/goforms/internal/infrastructure/config"
+	mockapikey "github.com/goformx/goforms/test/mocks/apikey"
+	mocklogging "github.com/goformx/goforms/test/mocks/logging"
+)
+
+func newServer(t *testing.T, keys *mockapikey.MockService, capture **apikeydomain.Key) *echo.Echo {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	mw := apikey.NewMiddleware(&appconfig.Config{}, keys, logger)
+	e := echo.New()
+	e.Use(mw.Verify())
+	e.GET("/test", func(c echo.Context) error {
+		*capture, _ = apikey.GetKey(c)
+
+		return c.String(http.StatusOK, "ok")
+	})
+
+	return e
+}
+
+func TestVerify_ValidKeyHeader_SetsKeyInContext(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	keys := mockapikey.NewMockService(ctrl)
+	key := &apikeydomain.Key{ID: "k1", FormID: "form-1"}
+	keys.EXPECT().Authenticate(gomock.Any(), "gfx_secret").Return(key, nil)
+
+	var captured *apikeydomain.Key
+	e := newServer(t, keys, &captured)
+
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.Header.Set("X-API-Key", "gfx_secret")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, key, captured)
+}
+
+func TestVerify_BearerToken_Accepted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	keys := mockapikey.NewMockService(ctrl)
+	keys.EXPECT().Authenticate(gomock.Any(), "gfx_secret").Return(&apikeydomain.Key{ID: "k1"}, nil)
+
+	var captured *apikeydomain.Key
+	e := newServer(t, keys, &captured)
+
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.Header.Set("Authorization", "Bearer gfx_secret")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.NotNil(t, captured)
+}
+
+func TestVerify_MissingKey_Returns401(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	keys := mockapikey.NewMockService(ctrl)
+
+	var captured *apikeydomain.Key
+	e := newServer(t, keys, &captured)
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/test", http.NoBody))
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Nil(t, captured)
+}
+
+func TestVerify_RevokedKey_Returns401(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	keys := mockapikey.NewMockService(ctrl)
+	keys.EXPECT().Authenticate(gomock.Any(), "gfx_revoked").Return(nil, apikeydomain.ErrKeyRevoked)
+
+	var captured *apikeydomain.Key
+	e := newServer(t, keys, &captured)
+
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.Header.Set("X-API-Key", "gfx_revoked")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+	assert.Nil(t, captured)
+}
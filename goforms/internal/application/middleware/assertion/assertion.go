@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/goformx/goforms/internal/application/middleware/context"
+	"github.com/goformx/goforms/internal/application/response"
 	"github.com/goformx/goforms/internal/domain/common/plans"
 	appconfig "github.com/goformx/goforms/internal/infrastructure/config"
 	"github.com/goformx/goforms/internal/infrastructure/logging"
@@ -22,6 +23,15 @@ const (
 	headerTimestamp = "X-Timestamp"
 	headerSignature = "X-Signature"
 	headerPlanTier  = "X-Plan-Tier"
+	// headerWorkspaceID carries the active workspace; when present it is appended to the signed payload
+	headerWorkspaceID = "X-Workspace-Id"
+	// headerKeyID selects one of the configured signing keys; without it the legacy secret is used
+	headerKeyID = "X-Key-Id"
+	// headerNonce carries a single-use value that is signed and rejected if seen again within the replay window
+	headerNonce = "X-Nonce"
+
+	minNonceLength = 16
+	maxNonceLength = 128
 
 	defaultPlanTier = "free"
 
@@ -32,96 +42,222 @@ const (
 
 // Middleware verifies Laravel signed assertion headers and sets user_id in Echo context.
 type Middleware struct {
-	config *appconfig.Config
+	policy *appconfig.SecurityPolicy
 	logger logging.Logger
+	nonces NonceStore
 }
 
-// NewMiddleware creates a new assertion verification middleware.
+// NewMiddleware creates a new assertion verification middleware with a process-local nonce store.
 // logger may be nil; if set, it is used to log assertion failures so the 401 reason is always visible.
 func NewMiddleware(config *appconfig.Config, logger logging.Logger) *Middleware {
-	return &Middleware{config: config, logger: logger}
+	return NewMiddlewareWithNonceStore(config, logger, NewMemoryNonceStore())
+}
+
+// NewMiddlewareWithNonceStore creates an assertion verification middleware that records nonces in store.
+// Middlewares protecting the same routes must share a store for replay protection to hold.
+func NewMiddlewareWithNonceStore(config *appconfig.Config, logger logging.Logger, store NonceStore) *Middleware {
+	return &Middleware{policy: appconfig.NewSecurityPolicy(config), logger: logger, nonces: store}
+}
+
+// WithPolicy reads the signing secrets from a reloadable security policy instead of the startup
+// configuration, so rotated secrets apply from the next request
+func (m *Middleware) WithPolicy(policy *appconfig.SecurityPolicy) *Middleware {
+	m.policy = policy
+
+	return m
 }
 
 // Verify returns an Echo middleware that verifies X-User-Id, X-Timestamp, X-Signature headers.
 func (m *Middleware) Verify() echo.MiddlewareFunc {
-	cfg := m.config.Security.Assertion
-
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			userID, planTier, failReason := verifyAssertionHeaders(
+			cfg := m.policy.Current().Assertion
+
+			identity, failReason := verifyAssertionHeaders(
 				c.Request().Header, cfg, c.Request().Method, c.Request().URL.Path)
 			if failReason != "" {
 				m.logFailure(c, failReason)
 
-				return c.JSON(http.StatusUnauthorized, map[string]string{"error": "unauthorized"})
+				return response.ErrorResponse(c, http.StatusUnauthorized, "Authentication required")
+			}
+
+			if identity.nonce != "" {
+				if reason := m.claimNonce(c, identity.nonce, cfg); reason != "" {
+					m.logFailure(c, reason)
+
+					return response.ErrorResponse(c, http.StatusUnauthorized, "Authentication required")
+				}
 			}
 
 			if strings.TrimSpace(c.Request().Header.Get(headerPlanTier)) == "" && m.logger != nil {
 				m.logger.Warn("X-Plan-Tier header missing, defaulting to free",
-					"user_id", userID, "path", c.Path())
+					"user_id", identity.userID, "path", c.Path())
 			}
 
-			context.SetUserID(c, userID)
-			context.SetPlanTier(c, planTier)
+			context.SetUserID(c, identity.userID)
+			context.SetPlanTier(c, identity.planTier)
+
+			if identity.workspaceID != "" {
+				context.SetWorkspaceID(c, identity.workspaceID)
+			}
 
 			return next(c)
 		}
 	}
 }
 
-// verifyAssertionHeaders checks headers and config; returns (userID, planTier, "") on success
-// or ("", "", reason) on failure.
+// assertedIdentity is the verified identity carried by the assertion headers.
+type assertedIdentity struct {
+	userID      string
+	planTier    string
+	workspaceID string
+	nonce       string
+}
+
+// claimNonce records a verified nonce; a nonce is remembered for the whole window in which its timestamp
+// would be accepted, so a replay is caught until the timestamp check rejects it on its own.
+// The store failing closes the request rather than silently dropping replay protection.
+func (m *Middleware) claimNonce(c echo.Context, nonce string, cfg appconfig.AssertionConfig) string {
+	ttl := 2 * time.Duration(cfg.TimestampSkewSeconds) * time.Second
+
+	claimed, err := m.nonces.Claim(c.Request().Context(), nonce, ttl)
+	if err != nil {
+		if m.logger != nil {
+			m.logger.Error("assertion nonce store unavailable", "error", err)
+		}
+
+		return "nonce_store_error"
+	}
+
+	if !claimed {
+		return "nonce_replayed"
+	}
+
+	return ""
+}
+
+// verifyAssertionHeaders checks headers and config; returns (identity, "") on success
+// or (zero identity, reason) on failure.
 func verifyAssertionHeaders(
 	headers http.Header,
 	cfg appconfig.AssertionConfig,
 	method, path string,
-) (userID, planTier, failureReason string) {
-	userID = strings.TrimSpace(headers.Get(headerUserID))
+) (identity assertedIdentity, failureReason string) {
+	userID := strings.TrimSpace(headers.Get(headerUserID))
 	timestamp := strings.TrimSpace(headers.Get(headerTimestamp))
 	signature := strings.TrimSpace(headers.Get(headerSignature))
+	workspaceID := strings.TrimSpace(headers.Get(headerWorkspaceID))
+	keyID := strings.TrimSpace(headers.Get(headerKeyID))
+	nonce := strings.TrimSpace(headers.Get(headerNonce))
 
-	planTier = strings.TrimSpace(headers.Get(headerPlanTier))
+	planTier := strings.TrimSpace(headers.Get(headerPlanTier))
 	if planTier == "" {
 		planTier = defaultPlanTier
 	} else if !plans.IsValidTier(planTier) {
-		return "", "", "invalid_plan_tier"
+		return assertedIdentity{}, "invalid_plan_tier"
 	}
 
 	if userID == "" || timestamp == "" || signature == "" {
-		return "", "", "missing_headers"
+		return assertedIdentity{}, "missing_headers"
 	}
 
-	if cfg.Secret == "" {
-		return "", "", "empty_secret"
+	if nonce == "" {
+		if cfg.Nonce.Required {
+			return assertedIdentity{}, "missing_nonce"
+		}
+	} else if !isValidNonce(nonce) {
+		return assertedIdentity{}, "invalid_nonce"
 	}
 
 	ts, err := parseTimestamp(timestamp)
 	if err != nil {
-		return "", "", "timestamp_parse_error"
+		return assertedIdentity{}, "timestamp_parse_error"
+	}
+
+	secret, failureReason := selectSecret(cfg, keyID, ts)
+	if failureReason != "" {
+		return assertedIdentity{}, failureReason
 	}
 
 	skew := time.Duration(cfg.TimestampSkewSeconds) * time.Second
 	elapsed := time.Since(ts)
 	if elapsed > skew {
-		return "", "", "timestamp_too_old"
+		return assertedIdentity{}, "timestamp_too_old"
 	}
 	if elapsed < -skew {
-		return "", "", "timestamp_too_new"
+		return assertedIdentity{}, "timestamp_too_new"
 	}
 
+	// The nonce is always the last segment; it keeps the workspace slot (possibly empty) so the
+	// payload stays unambiguous. Requests without a nonce sign the original payload.
 	payload := method + ":" + path + ":" + userID + ":" + timestamp + ":" + planTier
-	expected := computeHMAC(cfg.Secret, payload)
+	if nonce != "" {
+		payload += ":" + workspaceID + ":" + nonce
+	} else if workspaceID != "" {
+		payload += ":" + workspaceID
+	}
+
+	expected := computeHMAC(secret, payload)
 
 	sigBytes, err := hex.DecodeString(signature)
 	if err != nil {
-		return "", "", "signature_not_hex"
+		return assertedIdentity{}, "signature_not_hex"
 	}
 
 	if !hmacEqual(sigBytes, expected) {
-		return "", "", "signature_mismatch"
+		return assertedIdentity{}, "signature_mismatch"
+	}
+
+	return assertedIdentity{userID: userID, planTier: planTier, workspaceID: workspaceID, nonce: nonce}, ""
+}
+
+// selectSecret returns the signing secret for keyID, checking the key's validity window against the
+// asserted timestamp. An empty keyID selects the legacy single secret.
+func selectSecret(cfg appconfig.AssertionConfig, keyID string, signedAt time.Time) (secret, failureReason string) {
+	if keyID == "" {
+		if cfg.Secret != "" {
+			return cfg.Secret, ""
+		}
+
+		if len(cfg.Keys) > 0 {
+			return "", "missing_key_id"
+		}
+
+		return "", "empty_secret"
+	}
+
+	for _, key := range cfg.Keys {
+		if key.ID != keyID {
+			continue
+		}
+
+		switch {
+		case !key.NotBefore.IsZero() && signedAt.Before(key.NotBefore):
+			return "", "key_not_yet_valid"
+		case !key.NotAfter.IsZero() && !signedAt.Before(key.NotAfter):
+			return "", "key_expired"
+		}
+
+		return key.Secret, ""
+	}
+
+	return "", "unknown_key_id"
+}
+
+// isValidNonce accepts URL-safe tokens long enough to be unguessable
+func isValidNonce(nonce string) bool {
+	if len(nonce) < minNonceLength || len(nonce) > maxNonceLength {
+		return false
+	}
+
+	for _, r := range nonce {
+		isAlnum := (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') || (r >= '0' && r <= '9')
+		if !isAlnum && r != '-' && r != '_' {
+			return false
+		}
 	}
 
-	return userID, planTier, ""
+	return true
 }
 
 func (m *Middleware) logFailure(c echo.Context, reason string) {
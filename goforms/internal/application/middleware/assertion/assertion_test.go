@@ -428,3 +428,229 @@ func TestVerify_WrongPath_Returns401(t *testing.T) {
 	assert.Equal(t, http.StatusUnauthorized, rec.Code)
 	assert.Contains(t, rec.Body.String(), "unauthorized")
 }
+
+func TestVerify_SignedWorkspace_SetsWorkspaceInContext(t *testing.T) {
+	secret := "test-secret"
+	userID := "user-123"
+	workspaceID := "ws-456"
+	timestamp := time.Now().UTC().Format(time.RFC3339)
+	signature := signPayload(secret, "GET", "/test", userID, timestamp, "pro:"+workspaceID)
+
+	cfg := &appconfig.Config{
+		Security: appconfig.SecurityConfig{
+			Assertion: appconfig.AssertionConfig{
+				Secret:               secret,
+				TimestampSkewSeconds: 60,
+			},
+		},
+	}
+	mw := assertion.NewMiddleware(cfg, nil)
+	e := echo.New()
+	e.Use(mw.Verify())
+
+	var capturedWorkspace string
+	e.GET("/test", func(c echo.Context) error {
+		capturedWorkspace, _ = context.GetWorkspaceID(c)
+		return c.String(http.StatusOK, "ok")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.Header.Set("X-User-Id", userID)
+	req.Header.Set("X-Timestamp", timestamp)
+	req.Header.Set("X-Signature", signature)
+	req.Header.Set("X-Plan-Tier", "pro")
+	req.Header.Set("X-Workspace-Id", workspaceID)
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Equal(t, workspaceID, capturedWorkspace)
+}
+
+func TestVerify_UnsignedWorkspace_Returns401(t *testing.T) {
+	secret := "test-secret"
+	userID := "user-123"
+	timestamp := time.Now().UTC().Format(time.RFC3339)
+
+	// Signature does not cover the workspace header that is sent
+	signature := signPayload(secret, "GET", "/test", userID, timestamp, "free")
+
+	cfg := &appconfig.Config{
+		Security: appconfig.SecurityConfig{
+			Assertion: appconfig.AssertionConfig{
+				Secret:               secret,
+				TimestampSkewSeconds: 60,
+			},
+		},
+	}
+	mw := assertion.NewMiddleware(cfg, nil)
+	e := echo.New()
+	e.Use(mw.Verify())
+	e.GET("/test", func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	req.Header.Set("X-User-Id", userID)
+	req.Header.Set("X-Timestamp", timestamp)
+	req.Header.Set("X-Signature", signature)
+	req.Header.Set("X-Plan-Tier", "free")
+	req.Header.Set("X-Workspace-Id", "ws-other")
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	assert.Equal(t, http.StatusUnauthorized, rec.Code)
+}
+
+// serveAsserted runs one GET /test request through mw and returns the status and failure reason.
+func serveAsserted(t *testing.T, mw *assertion.Middleware, headers map[string]string) (status int, reason string) {
+	t.Helper()
+
+	e := echo.New()
+	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			err := next(c)
+			reason, _ = c.Get(assertion.FailureReasonContextKey).(string)
+
+			return err
+		}
+	})
+	e.Use(mw.Verify())
+	e.GET("/test", func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})
+
+	req := httptest.NewRequest(http.MethodGet, "/test", http.NoBody)
+	for name, value := range headers {
+		req.Header.Set(name, value)
+	}
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	return rec.Code, reason
+}
+
+func TestVerify_KeyID_SelectsRotatingKey(t *testing.T) {
+	now := time.Now()
+	cfg := &appconfig.Config{
+		Security: appconfig.SecurityConfig{
+			Assertion: appconfig.AssertionConfig{
+				TimestampSkewSeconds: 60,
+				Keys: []appconfig.AssertionKey{
+					{ID: "current", Secret: "current-secret", NotBefore: now.Add(-time.Hour)},
+					{ID: "retired", Secret: "retired-secret", NotAfter: now.Add(-time.Minute)},
+					{ID: "next", Secret: "next-secret", NotBefore: now.Add(time.Hour)},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		keyID      string
+		secret     string
+		wantStatus int
+		wantReason string
+	}{
+		{name: "active key", keyID: "current", secret: "current-secret", wantStatus: http.StatusOK},
+		{name: "expired key", keyID: "retired", secret: "retired-secret", wantStatus: http.StatusUnauthorized, wantReason: "key_expired"},
+		{name: "future key", keyID: "next", secret: "next-secret", wantStatus: http.StatusUnauthorized, wantReason: "key_not_yet_valid"},
+		{name: "unknown key", keyID: "other", secret: "current-secret", wantStatus: http.StatusUnauthorized, wantReason: "unknown_key_id"},
+		{name: "wrong secret for key", keyID: "current", secret: "next-secret", wantStatus: http.StatusUnauthorized, wantReason: "signature_mismatch"},
+		{name: "no key id without legacy secret", secret: "current-secret", wantStatus: http.StatusUnauthorized, wantReason: "missing_key_id"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			timestamp := time.Now().Format(time.RFC3339)
+			headers := map[string]string{
+				"X-User-Id":   "user-123",
+				"X-Timestamp": timestamp,
+				"X-Signature": signPayload(tt.secret, "GET", "/test", "user-123", timestamp, "free"),
+			}
+			if tt.keyID != "" {
+				headers["X-Key-Id"] = tt.keyID
+			}
+
+			status, reason := serveAsserted(t, assertion.NewMiddleware(cfg, nil), headers)
+			assert.Equal(t, tt.wantStatus, status)
+			assert.Equal(t, tt.wantReason, reason)
+		})
+	}
+}
+
+func TestVerify_Nonce_RejectsReplay(t *testing.T) {
+	secret := "test-secret-key"
+	cfg := &appconfig.Config{
+		Security: appconfig.SecurityConfig{
+			Assertion: appconfig.AssertionConfig{Secret: secret, TimestampSkewSeconds: 60},
+		},
+	}
+	mw := assertion.NewMiddleware(cfg, nil)
+
+	timestamp := time.Now().Format(time.RFC3339)
+	nonce := "b3f1c2d4e5a6978812345678"
+	// With a nonce the workspace slot is always signed, empty here
+	headers := map[string]string{
+		"X-User-Id":   "user-123",
+		"X-Timestamp": timestamp,
+		"X-Nonce":     nonce,
+		"X-Signature": signPayload(secret, "GET", "/test", "user-123", timestamp, "free::"+nonce),
+	}
+
+	status, reason := serveAsserted(t, mw, headers)
+	require.Equal(t, http.StatusOK, status, reason)
+
+	status, reason = serveAsserted(t, mw, headers)
+	assert.Equal(t, http.StatusUnauthorized, status)
+	assert.Equal(t, "nonce_replayed", reason)
+}
+
+func TestVerify_Nonce_TamperedNonceReturns401(t *testing.T) {
+	secret := "test-secret-key"
+	cfg := &appconfig.Config{
+		Security: appconfig.SecurityConfig{
+			Assertion: appconfig.AssertionConfig{Secret: secret, TimestampSkewSeconds: 60},
+		},
+	}
+
+	timestamp := time.Now().Format(time.RFC3339)
+	status, reason := serveAsserted(t, assertion.NewMiddleware(cfg, nil), map[string]string{
+		"X-User-Id":   "user-123",
+		"X-Timestamp": timestamp,
+		"X-Nonce":     "another-nonce-value-123",
+		"X-Signature": signPayload(secret, "GET", "/test", "user-123", timestamp, "free::original-nonce-value-1"),
+	})
+
+	assert.Equal(t, http.StatusUnauthorized, status)
+	assert.Equal(t, "signature_mismatch", reason)
+}
+
+func TestVerify_Nonce_RequiredAndFormat(t *testing.T) {
+	secret := "test-secret-key"
+	cfg := &appconfig.Config{
+		Security: appconfig.SecurityConfig{
+			Assertion: appconfig.AssertionConfig{
+				Secret:               secret,
+				TimestampSkewSeconds: 60,
+				Nonce:                appconfig.AssertionNonceConfig{Required: true},
+			},
+		},
+	}
+	timestamp := time.Now().Format(time.RFC3339)
+	base := map[string]string{
+		"X-User-Id":   "user-123",
+		"X-Timestamp": timestamp,
+		"X-Signature": signPayload(secret, "GET", "/test", "user-123", timestamp, "free"),
+	}
+
+	status, reason := serveAsserted(t, assertion.NewMiddleware(cfg, nil), base)
+	assert.Equal(t, http.StatusUnauthorized, status)
+	assert.Equal(t, "missing_nonce", reason)
+
+	base["X-Nonce"] = "short"
+	status, reason = serveAsserted(t, assertion.NewMiddleware(cfg, nil), base)
+	assert.Equal(t, http.StatusUnauthorized, status)
+	assert.Equal(t, "invalid_nonce", reason)
+}
@@ -0,0 +1,78 @@
+package assertion
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	appconfig "github.com/goformx/goforms/internal/infrastructure/config"
+)
+
+// NonceStore remembers nonces for as long as the signed request carrying them could be replayed.
+type NonceStore interface {
+	// Claim records the nonce for ttl. It reports false when the nonce was already claimed and has not expired.
+	Claim(ctx context.Context, nonce string, ttl time.Duration) (bool, error)
+}
+
+// NewNonceStore creates the nonce store selected by configuration
+func NewNonceStore(cfg appconfig.AssertionNonceConfig) (NonceStore, error) {
+	switch cfg.Store {
+	case "", "memory":
+		return NewMemoryNonceStore(), nil
+	case "redis":
+		return NewRedisNonceStore(cfg), nil
+	default:
+		return nil, fmt.Errorf("unsupported assertion nonce store %q", cfg.Store)
+	}
+}
+
+// sweepInterval bounds how often expired nonces are purged from a MemoryNonceStore
+const sweepInterval = time.Minute
+
+// MemoryNonceStore is a process-local NonceStore. It only protects a single instance;
+// deployments running several replicas should use the Redis store.
+type MemoryNonceStore struct {
+	mu        sync.Mutex
+	expiries  map[string]time.Time
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemoryNonceStore creates an empty in-memory nonce store
+func NewMemoryNonceStore() *MemoryNonceStore {
+	return &MemoryNonceStore{
+		expiries: make(map[string]time.Time),
+		now:      time.Now,
+	}
+}
+
+// Claim records the nonce unless it is already present and unexpired
+func (s *MemoryNonceStore) Claim(_ context.Context, nonce string, ttl time.Duration) (bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.Sub(s.lastSweep) >= sweepInterval {
+		s.sweep(now)
+	}
+
+	if expiry, ok := s.expiries[nonce]; ok && now.Before(expiry) {
+		return false, nil
+	}
+
+	s.expiries[nonce] = now.Add(ttl)
+
+	return true, nil
+}
+
+// sweep removes expired nonces; callers must hold the lock
+func (s *MemoryNonceStore) sweep(now time.Time) {
+	for nonce, expiry := range s.expiries {
+		if !now.Before(expiry) {
+			delete(s.expiries, nonce)
+		}
+	}
+
+	s.lastSweep = now
+}
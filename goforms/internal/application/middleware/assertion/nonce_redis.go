@@ -0,0 +1,51 @@
+package assertion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	appconfig "github.com/goformx/goforms/internal/infrastructure/config"
+)
+
+// RedisNonceStore is a NonceStore shared by all replicas through Redis. Each claim is a single
+// SET NX PX command.
+type RedisNonceStore struct {
+	client *redis.Client
+	prefix string
+}
+
+// NewRedisNonceStore creates a Redis-backed nonce store; connections are opened on first use
+func NewRedisNonceStore(cfg appconfig.AssertionNonceConfig) *RedisNonceStore {
+	return &RedisNonceStore{
+		client: redis.NewClient(&redis.Options{Addr: cfg.RedisAddr, Password: cfg.RedisPassword, DB: cfg.RedisDB}),
+		prefix: cfg.KeyPrefix,
+	}
+}
+
+// Claim sets the nonce key only if it does not exist, expiring it after ttl
+func (s *RedisNonceStore) Claim(ctx context.Context, nonce string, ttl time.Duration) (bool, error) {
+	// SET NX replies OK when the key was set and a null reply when it already existed
+	err := s.client.SetArgs(ctx, s.prefix+nonce, "1", redis.SetArgs{Mode: "NX", TTL: ttl}).Err()
+	if errors.Is(err, redis.Nil) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, fmt.Errorf("claim nonce: %w", err)
+	}
+
+	return true, nil
+}
+
+// Close closes the connections to Redis
+func (s *RedisNonceStore) Close() error {
+	if err := s.client.Close(); err != nil {
+		return fmt.Errorf("close redis nonce store: %w", err)
+	}
+
+	return nil
+}
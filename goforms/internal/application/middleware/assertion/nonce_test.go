@@ -0,0 +1,45 @@
+package assertion_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goformx/goforms/internal/application/middleware/assertion"
+	appconfig "github.com/goformx/goforms/internal/infrastructure/config"
+)
+
+func TestMemoryNonceStore_Claim(t *testing.T) {
+	store := assertion.NewMemoryNonceStore()
+	ctx := context.Background()
+
+	claimed, err := store.Claim(ctx, "nonce-a", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	claimed, err = store.Claim(ctx, "nonce-a", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, claimed, "a nonce is single-use within its TTL")
+
+	claimed, err = store.Claim(ctx, "nonce-b", time.Nanosecond)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	time.Sleep(time.Millisecond)
+
+	claimed, err = store.Claim(ctx, "nonce-b", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, claimed, "an expired nonce may be claimed again")
+}
+
+func TestNewNonceStore_RejectsUnknownStore(t *testing.T) {
+	_, err := assertion.NewNonceStore(appconfig.AssertionNonceConfig{Store: "memcached"})
+	require.Error(t, err)
+
+	store, err := assertion.NewNonceStore(appconfig.AssertionNonceConfig{})
+	require.NoError(t, err)
+	assert.IsType(t, &assertion.MemoryNonceStore{}, store)
+}
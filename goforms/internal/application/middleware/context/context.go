@@ -42,12 +42,15 @@ const (
 	FormIDKey Key = "form_id"
 	// PlanTierKey is the context key for the user's subscription plan tier
 	PlanTierKey Key = "plan_tier"
+	// WorkspaceIDKey is the context key for the active workspace ID
+	WorkspaceIDKey Key = "workspace_id"
 )
 
 // Middleware provides context handling for HTTP requests
 type Middleware struct {
 	logger         logging.Logger
 	requestTimeout time.Duration
+	skipTimeout    func(c echo.Context) bool
 }
 
 // NewMiddleware creates a new context middleware
@@ -58,6 +61,14 @@ func NewMiddleware(logger logging.Logger, requestTimeout time.Duration) *Middlew
 	}
 }
 
+// WithTimeoutSkipper exempts the requests skip selects from the request timeout, for responses
+// that stay open, such as event streams
+func (m *Middleware) WithTimeoutSkipper(skip func(c echo.Context) bool) *Middleware {
+	m.skipTimeout = skip
+
+	return m
+}
+
 // WithContext adds context to the request
 func (m *Middleware) WithContext() echo.MiddlewareFunc {
 	return func(next echo.HandlerFunc) echo.HandlerFunc {
@@ -70,7 +81,10 @@ func (m *Middleware) WithContext() echo.MiddlewareFunc {
 			}
 
 			// Create request context with timeout
-			ctx, cancel := context.WithTimeout(c.Request().Context(), m.requestTimeout)
+			ctx, cancel := c.Request().Context(), context.CancelFunc(func() {})
+			if m.skipTimeout == nil || !m.skipTimeout(c) {
+				ctx, cancel = context.WithTimeout(ctx, m.requestTimeout)
+			}
 			defer cancel()
 
 			// Add request ID and logger to context
@@ -265,3 +279,20 @@ func GetPlanTier(c echo.Context) (string, bool) {
 func SetPlanTier(c echo.Context, tier string) {
 	c.Set(string(PlanTierKey), tier)
 }
+
+// GetWorkspaceID retrieves the active workspace ID from Echo context.
+// Returns the ID and true if set, or empty string and false for personal scope.
+func GetWorkspaceID(c echo.Context) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+
+	workspaceID, ok := c.Get(string(WorkspaceIDKey)).(string)
+
+	return workspaceID, ok && workspaceID != ""
+}
+
+// SetWorkspaceID sets the active workspace ID in Echo context
+func SetWorkspaceID(c echo.Context, workspaceID string) {
+	c.Set(string(WorkspaceIDKey), workspaceID)
+}
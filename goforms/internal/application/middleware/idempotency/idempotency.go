@@ -0,0 +1,296 @@
+// Package idempotency provides middleware that makes unsafe requests safe to retry. A client
+// sends an Idempotency-Key header, or an HTML form posts the key in the FormField field; the first
+// response is stored with a fingerprint of the request, identical retries receive the stored
+// response, and a different request under the same key is rejected.
+package idempotency
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/goformx/goforms/internal/application/constants"
+	apikeymw "github.com/goformx/goforms/internal/application/middleware/apikey"
+	"github.com/goformx/goforms/internal/application/response"
+	appconfig "github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+)
+
+const (
+	// HeaderKey is the request header carrying the client's idempotency key
+	HeaderKey = "Idempotency-Key"
+	// HeaderReplayed is set on responses served from the store
+	HeaderReplayed = "Idempotent-Replayed"
+	// FormField is the urlencoded form field carrying the key when a browser form, which cannot set
+	// headers, posts the request
+	FormField = "_idempotency_key"
+
+	// MaxKeyLength is the longest accepted idempotency key
+	MaxKeyLength = 255
+	// MaxRequestBodySize is the largest request body read for fingerprinting; larger requests are
+	// rejected with 413
+	MaxRequestBodySize = constants.MaxFormSchemaSize
+	// MaxStoredBodySize caps the response body kept for replay; larger responses are not stored
+	MaxStoredBodySize = 1 << 20
+	// LockTimeout bounds how long a reservation blocks retries when its request never finishes,
+	// for example because the instance handling it crashed
+	LockTimeout = time.Minute
+
+	// inProgressRetryAfter is the Retry-After hint, in seconds, for requests racing an in-flight one
+	inProgressRetryAfter = 1
+)
+
+// replayedHeaders are the response headers stored with a response and restored on replay
+var replayedHeaders = []string{echo.HeaderContentType, echo.HeaderLocation, "Content-Language"}
+
+// Middleware stores and replays responses for requests carrying an Idempotency-Key
+type Middleware struct {
+	store  Store
+	ttl    time.Duration
+	logger logging.Logger
+	now    func() time.Time
+}
+
+// NewMiddleware creates an idempotency middleware that replays responses for ttl, or for
+// appconfig.DefaultIdempotencyTTL when ttl is not positive
+func NewMiddleware(store Store, ttl time.Duration, logger logging.Logger) *Middleware {
+	if ttl <= 0 {
+		ttl = appconfig.DefaultIdempotencyTTL
+	}
+
+	return &Middleware{store: store, ttl: ttl, logger: logger, now: time.Now}
+}
+
+// Handle returns route middleware. It must run after authentication: keys are scoped to the
+// caller and the route, so two users sending the same key never see each other's responses.
+// Requests without a key pass through untouched.
+func (m *Middleware) Handle() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+
+			key := req.Header.Get(HeaderKey)
+			if key == "" && !isFormPost(req) {
+				return next(c)
+			}
+
+			req.Body = http.MaxBytesReader(c.Response(), req.Body, MaxRequestBodySize)
+
+			body, err := io.ReadAll(req.Body)
+			if err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					return response.ErrorResponse(c, http.StatusRequestEntityTooLarge, "Request body is too large")
+				}
+
+				return response.ErrorResponse(c, http.StatusBadRequest, "Failed to read request body")
+			}
+
+			req.Body = io.NopCloser(bytes.NewReader(body))
+
+			if key == "" {
+				if key = formKey(body); key == "" {
+					return next(c)
+				}
+			}
+
+			if !validKey(key) {
+				return response.ErrorResponse(c, http.StatusBadRequest,
+					"Idempotency-Key must be 1 to 255 printable ASCII characters")
+			}
+
+			record := &Record{
+				KeyHash:     hashKey(c, key),
+				Fingerprint: fingerprint(c.Request(), body),
+				Status:      StatusPending,
+				ExpiresAt:   m.now().Add(LockTimeout),
+			}
+
+			ctx := req.Context()
+
+			if reserveErr := m.store.Reserve(ctx, record); reserveErr != nil {
+				return m.handleExisting(c, record, reserveErr)
+			}
+
+			return m.serve(c, next, record)
+		}
+	}
+}
+
+// serve runs the handler for a reserved key and stores its response. Server errors and rate limits
+// release the key so a retry runs the handler again.
+func (m *Middleware) serve(c echo.Context, next echo.HandlerFunc, record *Record) error {
+	res := c.Response()
+	recorder := &bodyRecorder{ResponseWriter: res.Writer}
+	res.Writer = recorder
+
+	handlerErr := next(c)
+
+	res.Writer = recorder.ResponseWriter
+
+	// The client may have gone away; the outcome must still be recorded
+	ctx := context.WithoutCancel(c.Request().Context())
+
+	if handlerErr != nil || !res.Committed || res.Status >= http.StatusInternalServerError ||
+		res.Status == http.StatusTooManyRequests || recorder.overflow {
+		if err := m.store.Release(ctx, record.KeyHash); err != nil {
+			m.logger.Error("failed to release idempotency key", "error", err)
+		}
+
+		return handlerErr
+	}
+
+	record.Status = StatusCompleted
+	record.ResponseStatus = res.Status
+	record.ResponseHeaders = make(http.Header)
+
+	for _, name := range replayedHeaders {
+		if value := res.Header().Get(name); value != "" {
+			record.ResponseHeaders.Set(name, value)
+		}
+	}
+
+	record.ResponseBody = recorder.body.Bytes()
+	record.ExpiresAt = m.now().Add(m.ttl)
+
+	if err := m.store.Complete(ctx, record); err != nil {
+		m.logger.Error("failed to store idempotent response", "error", err)
+	}
+
+	return nil
+}
+
+// handleExisting answers a request whose key is already reserved: it replays the stored response,
+// or rejects the request when it differs from the original or the original is still running
+func (m *Middleware) handleExisting(c echo.Context, record *Record, reserveErr error) error {
+	if !errors.Is(reserveErr, ErrKeyExists) {
+		m.logger.Error("failed to reserve idempotency key", "error", reserveErr)
+
+		return response.ErrorResponse(c, http.StatusServiceUnavailable, "Idempotency store unavailable")
+	}
+
+	existing, err := m.store.Get(c.Request().Context(), record.KeyHash)
+
+	switch {
+	case errors.Is(err, ErrNotFound):
+		// The holder was released or expired between Reserve and Get; the client can retry right away
+		return m.inProgress(c)
+	case err != nil:
+		m.logger.Error("failed to load idempotency key", "error", err)
+
+		return response.ErrorResponse(c, http.StatusServiceUnavailable, "Idempotency store unavailable")
+	case existing.Fingerprint != record.Fingerprint:
+		return response.ErrorResponse(c, http.StatusUnprocessableEntity,
+			"Idempotency-Key was already used with a different request")
+	case existing.Status != StatusCompleted:
+		return m.inProgress(c)
+	}
+
+	headers := c.Response().Header()
+	for _, name := range replayedHeaders {
+		if value := existing.ResponseHeaders.Get(name); value != "" {
+			headers.Set(name, value)
+		}
+	}
+
+	headers.Set(HeaderReplayed, "true")
+	c.Response().WriteHeader(existing.ResponseStatus)
+
+	if _, err = c.Response().Write(existing.ResponseBody); err != nil {
+		return fmt.Errorf("replay idempotent response: %w", err)
+	}
+
+	return nil
+}
+
+// inProgress rejects a request racing another with the same key
+func (m *Middleware) inProgress(c echo.Context) error {
+	c.Response().Header().Set("Retry-After", strconv.Itoa(inProgressRetryAfter))
+
+	return response.ErrorResponse(c, http.StatusConflict, "A request with this Idempotency-Key is still in progress")
+}
+
+// isFormPost reports whether the request was posted by an HTML form, which may carry its key in FormField
+func isFormPost(req *http.Request) bool {
+	return strings.HasPrefix(req.Header.Get(echo.HeaderContentType), echo.MIMEApplicationForm)
+}
+
+// formKey returns the key posted in FormField of a urlencoded body, if any
+func formKey(body []byte) string {
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return ""
+	}
+
+	return values.Get(FormField)
+}
+
+// validKey accepts 1 to MaxKeyLength printable ASCII characters
+func validKey(key string) bool {
+	if len(key) > MaxKeyLength {
+		return false
+	}
+
+	for i := range len(key) {
+		if key[i] < ' ' || key[i] > '~' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// hashKey scopes a key to the caller and the route it was sent to
+func hashKey(c echo.Context, key string) string {
+	actor := ""
+	if userID, ok := c.Get("user_id").(string); ok {
+		actor = "user:" + userID
+	} else if apiKey, ok := apikeymw.GetKey(c); ok {
+		actor = "api_key:" + apiKey.ID
+	}
+
+	sum := sha256.Sum256([]byte(actor + "\n" + c.Request().Method + "\n" + c.Request().URL.Path + "\n" + key))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// fingerprint identifies a request by its target, content type and body
+func fingerprint(req *http.Request, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(req.Method + "\n" + req.URL.RequestURI() + "\n" + req.Header.Get(echo.HeaderContentType) + "\n"))
+	h.Write(body)
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// bodyRecorder copies what the handler writes, up to MaxStoredBodySize
+type bodyRecorder struct {
+	http.ResponseWriter
+	body     bytes.Buffer
+	overflow bool
+}
+
+// Write passes the bytes through and keeps a copy unless the body grew too large to store
+func (r *bodyRecorder) Write(b []byte) (int, error) {
+	if !r.overflow {
+		if r.body.Len()+len(b) > MaxStoredBodySize {
+			r.overflow = true
+			r.body.Reset()
+		} else {
+			r.body.Write(b)
+		}
+	}
+
+	return r.ResponseWriter.Write(b) //nolint:wrapcheck // writer errors are passed through unchanged
+}
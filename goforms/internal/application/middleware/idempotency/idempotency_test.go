@@ -0,0 +1,199 @@
+package idempotency_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goformx/goforms/internal/application/middleware/idempotency"
+	appconfig "github.com/goformx/goforms/internal/infrastructure/config"
+	mocklogging "github.com/goformx/goforms/test/mocks/logging"
+)
+
+// newServer routes POST /forms/:id/submit through the middleware to handler, authenticating
+// requests as the user named in the X-User header
+func newServer(t *testing.T, handler echo.HandlerFunc) *echo.Echo {
+	t.Helper()
+
+	logger := mocklogging.NewMockLogger(gomock.NewController(t))
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	mw := idempotency.NewMiddleware(idempotency.NewMemoryStore(), time.Hour, logger)
+
+	e := echo.New()
+	e.POST("/forms/:id/submit", handler, func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if user := c.Request().Header.Get("X-User"); user != "" {
+				c.Set("user_id", user)
+			}
+
+			return next(c)
+		}
+	}, mw.Handle())
+
+	return e
+}
+
+func post(e *echo.Echo, key, user, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, "/forms/form-1/submit", strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	if key != "" {
+		req.Header.Set(idempotency.HeaderKey, key)
+	}
+
+	if user != "" {
+		req.Header.Set("X-User", user)
+	}
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	return rec
+}
+
+// countingHandler answers 201 with its call number so replays are distinguishable from new calls
+func countingHandler(calls *int) echo.HandlerFunc {
+	return func(c echo.Context) error {
+		*calls++
+
+		return c.JSON(http.StatusCreated, map[string]any{"call": *calls})
+	}
+}
+
+func TestMiddleware_ReplaysFirstResponse(t *testing.T) {
+	calls := 0
+	e := newServer(t, countingHandler(&calls))
+
+	first := post(e, "key-1", "", `{"name":"Ada"}`)
+	require.Equal(t, http.StatusCreated, first.Code)
+	assert.Empty(t, first.Header().Get(idempotency.HeaderReplayed))
+
+	retry := post(e, "key-1", "", `{"name":"Ada"}`)
+	assert.Equal(t, http.StatusCreated, retry.Code)
+	assert.Equal(t, first.Body.String(), retry.Body.String())
+	assert.Equal(t, "true", retry.Header().Get(idempotency.HeaderReplayed))
+	assert.Equal(t, first.Header().Get(echo.HeaderContentType), retry.Header().Get(echo.HeaderContentType))
+	assert.Equal(t, 1, calls)
+}
+
+func TestMiddleware_WithoutKeyPassesThrough(t *testing.T) {
+	calls := 0
+	e := newServer(t, countingHandler(&calls))
+
+	post(e, "", "", `{}`)
+	post(e, "", "", `{}`)
+	assert.Equal(t, 2, calls)
+}
+
+func TestMiddleware_RejectsDifferentPayloadUnderSameKey(t *testing.T) {
+	calls := 0
+	e := newServer(t, countingHandler(&calls))
+
+	post(e, "key-1", "", `{"name":"Ada"}`)
+
+	rec := post(e, "key-1", "", `{"name":"Grace"}`)
+	assert.Equal(t, http.StatusUnprocessableEntity, rec.Code)
+	assert.Equal(t, 1, calls)
+}
+
+func TestMiddleware_ScopesKeysToCaller(t *testing.T) {
+	calls := 0
+	e := newServer(t, countingHandler(&calls))
+
+	post(e, "key-1", "user-a", `{}`)
+	rec := post(e, "key-1", "user-b", `{}`)
+	assert.Empty(t, rec.Header().Get(idempotency.HeaderReplayed))
+	assert.Equal(t, 2, calls)
+}
+
+func TestMiddleware_ServerErrorReleasesKey(t *testing.T) {
+	calls := 0
+	e := newServer(t, func(c echo.Context) error {
+		calls++
+		if calls == 1 {
+			return c.JSON(http.StatusInternalServerError, map[string]string{"error": "boom"})
+		}
+
+		return c.JSON(http.StatusCreated, map[string]string{"status": "ok"})
+	})
+
+	assert.Equal(t, http.StatusInternalServerError, post(e, "key-1", "", `{}`).Code)
+	assert.Equal(t, http.StatusCreated, post(e, "key-1", "", `{}`).Code)
+	assert.Equal(t, 2, calls)
+}
+
+func TestMiddleware_RejectsConcurrentRetry(t *testing.T) {
+	var e *echo.Echo
+
+	var nested *httptest.ResponseRecorder
+
+	e = newServer(t, func(c echo.Context) error {
+		if nested == nil {
+			// A retry arriving while the first request is still being handled
+			nested = post(e, "key-1", "", `{}`)
+		}
+
+		return c.NoContent(http.StatusCreated)
+	})
+
+	require.Equal(t, http.StatusCreated, post(e, "key-1", "", `{}`).Code)
+	require.NotNil(t, nested)
+	assert.Equal(t, http.StatusConflict, nested.Code)
+	assert.Equal(t, "1", nested.Header().Get("Retry-After"))
+}
+
+func TestMiddleware_RejectsInvalidKey(t *testing.T) {
+	calls := 0
+	e := newServer(t, countingHandler(&calls))
+
+	rec := post(e, strings.Repeat("k", idempotency.MaxKeyLength+1), "", `{}`)
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Equal(t, 0, calls)
+}
+
+func TestNewStore_RejectsUnknownStore(t *testing.T) {
+	_, err := idempotency.NewStore(appconfig.IdempotencyConfig{Store: "memcached"}, nil, nil)
+	require.Error(t, err)
+}
+
+func TestMiddleware_RejectsOversizedBody(t *testing.T) {
+	calls := 0
+	e := newServer(t, countingHandler(&calls))
+
+	rec := post(e, "key-1", "", `{"name":"`+strings.Repeat("a", idempotency.MaxRequestBodySize)+`"}`)
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rec.Code)
+	assert.Equal(t, 0, calls)
+}
+
+func TestMiddleware_ReadsKeyFromHTMLForm(t *testing.T) {
+	calls := 0
+	e := newServer(t, countingHandler(&calls))
+
+	postForm := func(body string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, "/forms/form-1/submit", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	keyed := "name=Ada&" + idempotency.FormField + "=key-1"
+	require.Equal(t, http.StatusCreated, postForm(keyed).Code)
+	assert.Equal(t, "true", postForm(keyed).Header().Get(idempotency.HeaderReplayed))
+	assert.Equal(t, 1, calls)
+
+	// Forms posted without the field are not deduplicated
+	postForm("name=Ada")
+	postForm("name=Ada")
+	assert.Equal(t, 3, calls)
+}
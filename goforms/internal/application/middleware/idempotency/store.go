@@ -0,0 +1,159 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	appconfig "github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/database"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+)
+
+// Record states
+const (
+	// StatusPending marks a key whose first request is still being handled
+	StatusPending = "pending"
+	// StatusCompleted marks a key whose response is stored for replay
+	StatusCompleted = "completed"
+)
+
+var (
+	// ErrKeyExists is returned by Reserve when an unexpired record already holds the key
+	ErrKeyExists = errors.New("idempotency key already in use")
+	// ErrNotFound is returned by Get when no unexpired record holds the key
+	ErrNotFound = errors.New("idempotency key not found")
+)
+
+// Record is the stored outcome of the first request made with an idempotency key.
+// Keys are stored hashed together with the caller and route they were used on.
+type Record struct {
+	KeyHash         string      `gorm:"column:key_hash;primaryKey;size:64"`
+	Fingerprint     string      `gorm:"size:64;not null"`
+	Status          string      `gorm:"size:16;not null"`
+	ResponseStatus  int         `gorm:"not null;default:0"`
+	ResponseHeaders http.Header `gorm:"serializer:json;type:text"`
+	ResponseBody    []byte      `gorm:"column:response_body"`
+	CreatedAt       time.Time   `gorm:"not null"`
+	ExpiresAt       time.Time   `gorm:"not null;index"`
+}
+
+// TableName specifies the table name for the Record model
+func (Record) TableName() string {
+	return "idempotency_keys"
+}
+
+// Store persists idempotency records. Implementations shared by all replicas, such as the
+// database store, make retries safe across instances.
+type Store interface {
+	// Reserve inserts a pending record, replacing an expired one. It returns ErrKeyExists when an
+	// unexpired record already holds the key.
+	Reserve(ctx context.Context, record *Record) error
+	// Get returns the unexpired record holding the key, or ErrNotFound
+	Get(ctx context.Context, keyHash string) (*Record, error)
+	// Complete stores the response of a reserved record and extends its expiry
+	Complete(ctx context.Context, record *Record) error
+	// Release removes a pending record so the request can be retried
+	Release(ctx context.Context, keyHash string) error
+}
+
+// NewStore creates the idempotency store selected by configuration
+func NewStore(cfg appconfig.IdempotencyConfig, db database.DB, logger logging.Logger) (Store, error) {
+	switch cfg.Store {
+	case "", "database":
+		return NewDatabaseStore(db, logger), nil
+	case "memory":
+		return NewMemoryStore(), nil
+	default:
+		return nil, fmt.Errorf("unsupported idempotency store %q", cfg.Store)
+	}
+}
+
+// sweepInterval bounds how often expired records are purged
+const sweepInterval = time.Minute
+
+// MemoryStore is a process-local Store. It only protects a single instance; deployments
+// running several replicas should use the database store.
+type MemoryStore struct {
+	mu        sync.Mutex
+	records   map[string]Record
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewMemoryStore creates an empty in-memory idempotency store
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		records: make(map[string]Record),
+		now:     time.Now,
+	}
+}
+
+// Reserve inserts a pending record unless an unexpired one holds the key
+func (s *MemoryStore) Reserve(_ context.Context, record *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	if now.Sub(s.lastSweep) >= sweepInterval {
+		s.sweep(now)
+	}
+
+	if existing, ok := s.records[record.KeyHash]; ok && now.Before(existing.ExpiresAt) {
+		return ErrKeyExists
+	}
+
+	record.CreatedAt = now
+	s.records[record.KeyHash] = *record
+
+	return nil
+}
+
+// Get returns a copy of the unexpired record holding the key
+func (s *MemoryStore) Get(_ context.Context, keyHash string) (*Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	record, ok := s.records[keyHash]
+	if !ok || !s.now().Before(record.ExpiresAt) {
+		return nil, ErrNotFound
+	}
+
+	return &record, nil
+}
+
+// Complete replaces the reserved record with its response
+func (s *MemoryStore) Complete(_ context.Context, record *Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.records[record.KeyHash] = *record
+
+	return nil
+}
+
+// Release removes the record if it is still pending
+func (s *MemoryStore) Release(_ context.Context, keyHash string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if record, ok := s.records[keyHash]; ok && record.Status == StatusPending {
+		delete(s.records, keyHash)
+	}
+
+	return nil
+}
+
+// sweep removes expired records; callers must hold the lock
+func (s *MemoryStore) sweep(now time.Time) {
+	for key, record := range s.records {
+		if !now.Before(record.ExpiresAt) {
+			delete(s.records, key)
+		}
+	}
+
+	s.lastSweep = now
+}
@@ -0,0 +1,119 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/goformx/goforms/internal/infrastructure/database"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+)
+
+// DatabaseStore is a Store shared by all replicas through the idempotency_keys table.
+// Reservation relies on the primary key: of two concurrent inserts only one succeeds.
+type DatabaseStore struct {
+	db     database.DB
+	logger logging.Logger
+
+	mu        sync.Mutex
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewDatabaseStore creates a database-backed idempotency store
+func NewDatabaseStore(db database.DB, logger logging.Logger) *DatabaseStore {
+	return &DatabaseStore{
+		db:     db,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Reserve inserts a pending record, first deleting an expired record for the same key
+func (s *DatabaseStore) Reserve(ctx context.Context, record *Record) error {
+	db := s.db.GetDB().WithContext(ctx)
+	now := s.now()
+
+	s.sweep(db, now)
+
+	if err := db.Where("key_hash = ? AND expires_at <= ?", record.KeyHash, now).Delete(&Record{}).Error; err != nil {
+		return fmt.Errorf("delete expired idempotency key: %w", err)
+	}
+
+	record.CreatedAt = now
+
+	result := db.Clauses(clause.OnConflict{DoNothing: true}).Create(record)
+	if result.Error != nil {
+		return fmt.Errorf("reserve idempotency key: %w", result.Error)
+	}
+
+	if result.RowsAffected == 0 {
+		return ErrKeyExists
+	}
+
+	return nil
+}
+
+// Get returns the unexpired record holding the key
+func (s *DatabaseStore) Get(ctx context.Context, keyHash string) (*Record, error) {
+	var record Record
+	if err := s.db.GetDB().WithContext(ctx).
+		Where("key_hash = ? AND expires_at > ?", keyHash, s.now()).
+		First(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrNotFound
+		}
+
+		return nil, fmt.Errorf("get idempotency key: %w", err)
+	}
+
+	return &record, nil
+}
+
+// Complete stores the response of a reserved record
+func (s *DatabaseStore) Complete(ctx context.Context, record *Record) error {
+	if err := s.db.GetDB().WithContext(ctx).
+		Model(&Record{}).
+		Where("key_hash = ?", record.KeyHash).
+		Select("status", "response_status", "response_headers", "response_body", "expires_at").
+		Updates(record).Error; err != nil {
+		return fmt.Errorf("complete idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+// Release deletes the record if it is still pending
+func (s *DatabaseStore) Release(ctx context.Context, keyHash string) error {
+	if err := s.db.GetDB().WithContext(ctx).
+		Where("key_hash = ? AND status = ?", keyHash, StatusPending).
+		Delete(&Record{}).Error; err != nil {
+		return fmt.Errorf("release idempotency key: %w", err)
+	}
+
+	return nil
+}
+
+// sweep purges expired records at most once per sweepInterval. Failures are logged
+// and retried on a later sweep; they never fail a reservation.
+func (s *DatabaseStore) sweep(db *gorm.DB, now time.Time) {
+	s.mu.Lock()
+	due := now.Sub(s.lastSweep) >= sweepInterval
+	if due {
+		s.lastSweep = now
+	}
+	s.mu.Unlock()
+
+	if !due {
+		return
+	}
+
+	if err := db.Where("expires_at <= ?", now).Delete(&Record{}).Error; err != nil {
+		s.logger.Warn("failed to purge expired idempotency keys", "error", err)
+	}
+}
@@ -19,6 +19,7 @@ import (
 	contextmw "github.com/goformx/goforms/internal/application/middleware/context"
 	"github.com/goformx/goforms/internal/application/middleware/security"
 	"github.com/goformx/goforms/internal/application/middleware/session"
+	"github.com/goformx/goforms/internal/application/openapi"
 	formdomain "github.com/goformx/goforms/internal/domain/form"
 	"github.com/goformx/goforms/internal/domain/user"
 	appconfig "github.com/goformx/goforms/internal/infrastructure/config"
@@ -98,6 +99,7 @@ func (pc *PathChecker) containsPath(path string, paths []string) bool {
 type Manager struct {
 	logger            logging.Logger
 	config            *ManagerConfig
+	policy            *appconfig.SecurityPolicy
 	contextMiddleware *contextmw.Middleware
 	pathChecker       *PathChecker
 }
@@ -111,6 +113,11 @@ type ManagerConfig struct {
 	SessionManager *session.Manager
 	AccessManager  *access.Manager
 	Sanitizer      sanitization.ServiceInterface
+	// SecurityPolicy supplies the reloadable security settings; when nil they are fixed at startup
+	SecurityPolicy *appconfig.SecurityPolicy
+	// OpenAPI is the document requests and responses are checked against when
+	// app.openapi_validation is on in development
+	OpenAPI *openapi.Document
 }
 
 // Validate ensures all required configuration is present
@@ -137,10 +144,16 @@ func NewManager(cfg *ManagerConfig) *Manager {
 		panic(fmt.Sprintf("invalid config: %v", err))
 	}
 
+	policy := cfg.SecurityPolicy
+	if policy == nil {
+		policy = appconfig.NewSecurityPolicy(cfg.Config)
+	}
+
 	return &Manager{
 		logger:            cfg.Logger,
 		config:            cfg,
-		contextMiddleware: contextmw.NewMiddleware(cfg.Logger, cfg.Config.App.RequestTimeout),
+		policy:            policy,
+		contextMiddleware: contextmw.NewMiddleware(cfg.Logger, cfg.Config.App.RequestTimeout).WithTimeoutSkipper(isEventStream),
 		pathChecker:       NewPathChecker(),
 	}
 }
@@ -172,6 +185,7 @@ func (m *Manager) Setup(e *echo.Echo) {
 	m.setupBasicMiddleware(e)
 	m.setupSecurityMiddleware(e)
 	m.setupAuthMiddleware(e)
+	m.setupContractValidation(e)
 
 	m.logger.Info("middleware setup completed")
 }
@@ -180,8 +194,10 @@ func (m *Manager) setupBasicMiddleware(e *echo.Echo) {
 	// Recovery middleware first
 	e.Use(Recovery(m.logger, m.config.Sanitizer))
 
-	// Timeout middleware (using context-based timeout to avoid data races)
+	// Timeout middleware (using context-based timeout to avoid data races); event streams stay
+	// open until the client leaves
 	e.Use(echomw.ContextTimeoutWithConfig(echomw.ContextTimeoutConfig{
+		Skipper: isEventStream,
 		Timeout: m.config.Config.App.RequestTimeout,
 	}))
 
@@ -254,33 +270,19 @@ func (m *Manager) setupBasicMiddleware(e *echo.Echo) {
 }
 
 func (m *Manager) setupSecurityMiddleware(e *echo.Echo) {
-	// CORS middleware
-	if m.config.Config.Security.CORS.Enabled {
-		corsConfig := echomw.CORSConfig{
-			AllowOrigins:     m.config.Config.Security.CORS.AllowedOrigins,
-			AllowMethods:     m.config.Config.Security.CORS.AllowedMethods,
-			AllowHeaders:     m.config.Config.Security.CORS.AllowedHeaders,
-			AllowCredentials: m.config.Config.Security.CORS.AllowCredentials,
-			MaxAge:           m.config.Config.Security.CORS.MaxAge,
-			Skipper:          shouldSkipGlobalCORS,
-		}
-		e.Use(echomw.CORSWithConfig(corsConfig))
-	}
+	// CORS, security headers and rate limits follow the reloadable security policy
+	e.Use(security.PolicyMiddleware(m.policy,
+		func(s *appconfig.SecurityConfig) appconfig.CORSConfig { return s.CORS },
+		newCORSMiddleware))
 
-	// Secure middleware
-	e.Use(echomw.SecureWithConfig(echomw.SecureConfig{
-		XSSProtection:         m.config.Config.Security.SecurityHeaders.XXSSProtection,
-		ContentTypeNosniff:    m.config.Config.Security.SecurityHeaders.XContentTypeOptions,
-		XFrameOptions:         m.config.Config.Security.SecurityHeaders.XFrameOptions,
-		HSTSMaxAge:            constants.HSTSOneYear,
-		HSTSExcludeSubdomains: false,
-		ContentSecurityPolicy: m.config.Config.Security.GetCSPDirectives(&m.config.Config.App),
-	}))
+	e.Use(security.PolicyMiddleware(m.policy,
+		func(s *appconfig.SecurityConfig) echomw.SecureConfig { return m.secureConfig(s) },
+		echomw.SecureWithConfig))
 
 	// Set security config in context
 	e.Use(func(next echo.HandlerFunc) echo.HandlerFunc {
 		return func(c echo.Context) error {
-			c.Set("security_config", m.config.Config.Security)
+			c.Set("security_config", m.policy.Current())
 			return next(c)
 		}
 	})
@@ -309,9 +311,35 @@ func (m *Manager) setupSecurityMiddleware(e *echo.Echo) {
 	}
 
 	// Rate limiting
-	if m.config.Config.Security.RateLimit.Enabled {
-		rateLimiter := security.NewRateLimiter(m.logger, m.config.Config, m.pathChecker)
-		e.Use(rateLimiter.Setup())
+	rateLimiter := security.NewRateLimiter(m.logger, m.config.Config, m.pathChecker).WithPolicy(m.policy)
+	e.Use(rateLimiter.Setup())
+}
+
+// newCORSMiddleware creates the global CORS middleware for a CORS configuration
+func newCORSMiddleware(cfg appconfig.CORSConfig) echo.MiddlewareFunc {
+	if !cfg.Enabled {
+		return func(next echo.HandlerFunc) echo.HandlerFunc { return next }
+	}
+
+	return echomw.CORSWithConfig(echomw.CORSConfig{
+		AllowOrigins:     cfg.AllowedOrigins,
+		AllowMethods:     cfg.AllowedMethods,
+		AllowHeaders:     cfg.AllowedHeaders,
+		AllowCredentials: cfg.AllowCredentials,
+		MaxAge:           cfg.MaxAge,
+		Skipper:          shouldSkipGlobalCORS,
+	})
+}
+
+// secureConfig returns the security headers set on every response under a security configuration
+func (m *Manager) secureConfig(s *appconfig.SecurityConfig) echomw.SecureConfig {
+	return echomw.SecureConfig{
+		XSSProtection:         s.SecurityHeaders.XXSSProtection,
+		ContentTypeNosniff:    s.SecurityHeaders.XContentTypeOptions,
+		XFrameOptions:         s.SecurityHeaders.XFrameOptions,
+		HSTSMaxAge:            constants.HSTSOneYear,
+		HSTSExcludeSubdomains: false,
+		ContentSecurityPolicy: s.GetCSPDirectives(&m.config.Config.App),
 	}
 }
 
@@ -323,6 +351,24 @@ func (m *Manager) setupAuthMiddleware(e *echo.Echo) {
 	e.Use(access.Middleware(m.config.AccessManager, m.logger))
 }
 
+// setupContractValidation checks requests and responses against the OpenAPI document. It
+// buffers bodies, so it only runs in development.
+func (m *Manager) setupContractValidation(e *echo.Echo) {
+	if !m.config.Config.App.OpenAPIValidation || m.config.OpenAPI == nil {
+		return
+	}
+
+	if !m.config.Config.App.IsDevelopment() {
+		m.logger.Warn("OpenAPI validation is only available in development, ignoring it",
+			"environment", m.config.Config.App.Environment)
+
+		return
+	}
+
+	e.Use(openapi.NewValidator(m.config.OpenAPI, m.logger).Middleware())
+	m.logger.Info("OpenAPI contract validation enabled")
+}
+
 // isNoisePath checks if the path should be suppressed from logging
 func isNoisePath(c echo.Context) bool {
 	path := c.Request().URL.Path
@@ -365,3 +411,9 @@ func isPublicFormCORSPath(method, requestPath string) bool {
 		return false
 	}
 }
+
+// isEventStream reports whether c is a request for an event stream, which stays open as long as
+// the client does and so is exempt from request timeouts
+func isEventStream(c echo.Context) bool {
+	return c.Path() == constants.PathAPISubmissionStream
+}
@@ -1,13 +1,17 @@
 package middleware_test
 
 import (
+	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/mock/gomock"
 
 	"github.com/goformx/goforms/internal/application/middleware"
@@ -111,6 +115,8 @@ func TestManager_RateLimiter_Scenarios(t *testing.T) {
 		requests       int
 		burst          int
 		window         time.Duration
+		environment    string
+		disabled       bool
 		requestCount   int
 		expectedStatus []int
 	}{
@@ -138,6 +144,26 @@ func TestManager_RateLimiter_Scenarios(t *testing.T) {
 			requestCount:   3,
 			expectedStatus: []int{http.StatusOK, http.StatusOK, http.StatusOK},
 		},
+		{
+			name:           "disabling outside development still limits",
+			requests:       1,
+			burst:          1,
+			window:         time.Second,
+			environment:    "production",
+			disabled:       true,
+			requestCount:   2,
+			expectedStatus: []int{http.StatusOK, http.StatusTooManyRequests},
+		},
+		{
+			name:           "development may disable rate limiting",
+			requests:       1,
+			burst:          1,
+			window:         time.Second,
+			environment:    "development",
+			disabled:       true,
+			requestCount:   2,
+			expectedStatus: []int{http.StatusOK, http.StatusOK},
+		},
 	}
 
 	for _, tt := range tests {
@@ -150,6 +176,11 @@ func TestManager_RateLimiter_Scenarios(t *testing.T) {
 			cfg.Security.RateLimit.Requests = tt.requests
 			cfg.Security.RateLimit.Burst = tt.burst
 			cfg.Security.RateLimit.Window = tt.window
+			cfg.Security.RateLimit.Enabled = !tt.disabled
+
+			if tt.environment != "" {
+				cfg.App.Environment = tt.environment
+			}
 
 			e := echo.New()
 			logger := createTestLogger(ctrl)
@@ -187,3 +218,69 @@ func TestManager_RateLimiter_Scenarios(t *testing.T) {
 		})
 	}
 }
+
+func TestManager_ReloadedSecurityPolicyAppliesToNextRequest(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeConfig := func(origin string, burst int) {
+		doc := fmt.Sprintf(`
+app: {environment: test}
+database: {password: database-password}
+security:
+  csrf: {enabled: false}
+  assertion: {secret: assertion-secret-that-is-at-least-32-chars}
+  cors: {allowed_origins: [%q]}
+  rate_limit: {rps: 1, burst: %d}
+session: {secret: session-secret-that-is-at-least-32-characters}
+`, origin, burst)
+		require.NoError(t, os.WriteFile(path, []byte(doc), 0o600))
+	}
+
+	writeConfig("https://one.example", 1)
+
+	source := appconfig.NewViperConfig()
+	source.SetConfigFile(path)
+
+	cfg, err := source.Load()
+	require.NoError(t, err)
+
+	logger := createTestLogger(ctrl)
+	policy := appconfig.NewSecurityPolicy(cfg)
+
+	e := echo.New()
+	middleware.NewManager(&middleware.ManagerConfig{
+		Logger:         logger,
+		Config:         cfg,
+		AccessManager:  createTestAccessManager(),
+		Sanitizer:      sanitization.NewService(),
+		SecurityPolicy: policy,
+	}).Setup(e)
+
+	e.GET("/", func(c echo.Context) error {
+		return c.String(http.StatusOK, "ok")
+	})
+
+	serve := func(origin string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "/", http.NoBody)
+		req.Header.Set("Origin", origin)
+		req.Header.Set("X-Real-IP", "192.168.1.1")
+
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+
+		return rec
+	}
+
+	rec := serve("https://two.example")
+	assert.Equal(t, http.StatusOK, rec.Code)
+	assert.Empty(t, rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+	assert.Equal(t, http.StatusTooManyRequests, serve("https://two.example").Code)
+
+	writeConfig("https://two.example", 5)
+	require.NoError(t, appconfig.NewReloader(source, policy, logger).Reload("test"))
+
+	rec = serve("https://two.example")
+	assert.Equal(t, http.StatusOK, rec.Code, "the raised burst replaces the exhausted limiter")
+	assert.Equal(t, "https://two.example", rec.Header().Get(echo.HeaderAccessControlAllowOrigin))
+}
@@ -4,6 +4,7 @@ package middleware
 import (
 	"context"
 	"fmt"
+	"io"
 
 	"go.uber.org/fx"
 
@@ -12,9 +13,11 @@ import (
 	"github.com/goformx/goforms/internal/application/middleware/auth"
 	"github.com/goformx/goforms/internal/application/middleware/core"
 	"github.com/goformx/goforms/internal/application/middleware/session"
+	"github.com/goformx/goforms/internal/application/openapi"
 	formdomain "github.com/goformx/goforms/internal/domain/form"
 	"github.com/goformx/goforms/internal/domain/user"
 	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/database"
 	"github.com/goformx/goforms/internal/infrastructure/logging"
 	"github.com/goformx/goforms/internal/infrastructure/sanitization"
 )
@@ -47,20 +50,36 @@ var Module = fx.Module("middleware",
 			func(
 				logger logging.Logger,
 				cfg *config.Config,
+				db database.DB,
 				lc fx.Lifecycle,
 				accessManager *access.Manager,
 				pathManager *constants.PathManager,
 			) (*session.Manager, error) {
+				storage, err := session.NewStorage(&cfg.Session, db, logger)
+				if err != nil {
+					return nil, fmt.Errorf("create session storage: %w", err)
+				}
+
+				if closer, ok := storage.(io.Closer); ok {
+					lc.Append(fx.Hook{
+						OnStop: func(_ context.Context) error {
+							return closer.Close()
+						},
+					})
+				}
+
 				sessionConfig := &session.Config{
 					SessionConfig: &cfg.Session,
 					Config:        cfg,
 					PublicPaths:   pathManager.PublicPaths,
 					StaticPaths:   pathManager.StaticPaths,
-					// Laravel assertion auth: no session cookie; auth via X-User-Id/X-Signature
-					ExemptPaths: []string{constants.PathAPIFormsLaravel},
+					// Laravel assertion auth and API-key auth: no session cookie; auth via headers
+					ExemptPaths: []string{
+						constants.PathAPIFormsLaravel, constants.PathAPIWorkspaces, constants.PathAPIServerForms,
+					},
 				}
 
-				return session.NewManager(logger, sessionConfig, lc, accessManager)
+				return session.NewManager(logger, sessionConfig, storage, accessManager), nil
 			},
 		),
 
@@ -108,6 +127,8 @@ var Module = fx.Module("middleware",
 				sessionManager *session.Manager,
 				accessManager *access.Manager,
 				sanitizer sanitization.ServiceInterface,
+				policy *config.SecurityPolicy,
+				doc *openapi.Document,
 			) *Manager {
 				return NewManager(&ManagerConfig{
 					Logger:         logger,
@@ -117,6 +138,8 @@ var Module = fx.Module("middleware",
 					SessionManager: sessionManager,
 					AccessManager:  accessManager,
 					Sanitizer:      sanitizer,
+					SecurityPolicy: policy,
+					OpenAPI:        doc,
 				})
 			},
 		),
@@ -276,6 +299,7 @@ func generateAccessRules(pathManager *constants.PathManager) []access.Rule {
 		{Path: constants.PathFormsPublic + "/:id/validation", AccessLevel: access.Public},
 		{Path: constants.PathFormsPublic + "/:id/submit", AccessLevel: access.Public},
 		{Path: constants.PathFormsPublic + "/:id/embed", AccessLevel: access.Public},
+		{Path: constants.PathFormsPublic + "/:id/html", AccessLevel: access.Public},
 	}
 	rules = append(rules, publicFormRules...)
 
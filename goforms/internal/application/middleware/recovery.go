@@ -71,8 +71,7 @@ func handleError(c echo.Context, err error, logger logging.Logger, sanitizer san
 			"error_type", "panic_domain_error",
 		)
 
-		statusCode := domainerrors.GetHTTPStatus(domainErr.Code)
-		if jsonErr := response.ErrorResponse(c, statusCode, domainErr.Message); jsonErr != nil {
+		if jsonErr := response.DomainErrorResponse(c, domainErr); jsonErr != nil {
 			logger.Error("failed to send error response",
 				"error", jsonErr,
 				"error_type", "response_error",
@@ -12,6 +12,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/goformx/goforms/internal/application/response"
 )
 
 // Response represents an HTTP response abstraction that is framework-agnostic.
@@ -214,16 +216,12 @@ func NewErrorResponse(statusCode int, err error) Response {
 
 	resp := NewResponse(statusCode).SetError(err)
 
-	// Set default error content type
+	// The error itself may describe internals; the body names only its status
 	if err != nil {
-		resp.SetContentType("application/json")
+		resp.SetContentType(response.ProblemContentType)
 
-		errorBody := map[string]any{
-			"error":   err.Error(),
-			"code":    statusCode,
-			"message": http.StatusText(statusCode),
-		}
-		if jsonData, jsonErr := json.Marshal(errorBody); jsonErr == nil {
+		problem := response.NewProblem(statusCode, response.CodeForStatus(statusCode), http.StatusText(statusCode))
+		if jsonData, jsonErr := json.Marshal(problem); jsonErr == nil {
 			resp.SetBodyBytes(jsonData)
 		}
 	}
@@ -17,29 +17,54 @@ const (
 	ErrMsgAPIInvalid = "Invalid API key"
 )
 
+// KeyValidator validates keys that are not in the configured list, such as database-backed keys
+type KeyValidator interface {
+	ValidateAPIKey(c echo.Context, apiKey string) bool
+}
+
 // APIKeyAuth handles API key authentication middleware setup
 type APIKeyAuth struct {
-	logger logging.Logger
-	config *appconfig.Config
+	logger    logging.Logger
+	policy    *appconfig.SecurityPolicy
+	validator KeyValidator
 }
 
 // NewAPIKeyAuth creates a new API key authenticator
 func NewAPIKeyAuth(logger logging.Logger, config *appconfig.Config) *APIKeyAuth {
 	return &APIKeyAuth{
 		logger: logger,
-		config: config,
+		policy: appconfig.NewSecurityPolicy(config),
 	}
 }
 
+// WithValidator adds a fallback validator consulted when a key is not in the configured list
+func (a *APIKeyAuth) WithValidator(validator KeyValidator) *APIKeyAuth {
+	a.validator = validator
+
+	return a
+}
+
+// WithPolicy reads the API key settings from a reloadable security policy instead of the startup configuration
+func (a *APIKeyAuth) WithPolicy(policy *appconfig.SecurityPolicy) *APIKeyAuth {
+	a.policy = policy
+
+	return a
+}
+
 // Setup creates and configures API key authentication middleware
 func (a *APIKeyAuth) Setup() echo.MiddlewareFunc {
-	apiKeyConfig := a.config.Security.APIKey
+	return PolicyMiddleware(a.policy,
+		func(s *appconfig.SecurityConfig) appconfig.APIKeyConfig { return s.APIKey },
+		a.build)
+}
 
+// build creates the API key authentication middleware for one API key configuration
+func (a *APIKeyAuth) build(apiKeyConfig appconfig.APIKeyConfig) echo.MiddlewareFunc {
 	if !apiKeyConfig.Enabled {
 		return noopMiddleware()
 	}
 
-	if len(apiKeyConfig.Keys) == 0 {
+	if len(apiKeyConfig.Keys) == 0 && a.validator == nil {
 		a.logger.Error("API key authentication enabled but no keys configured - rejecting all requests")
 
 		return func(next echo.HandlerFunc) echo.HandlerFunc {
@@ -59,6 +84,7 @@ func (a *APIKeyAuth) Setup() echo.MiddlewareFunc {
 		"enabled", apiKeyConfig.Enabled,
 		"header_name", headerName,
 		"keys_count", len(apiKeyConfig.Keys),
+		"fallback_validator", a.validator != nil,
 		"skip_paths", apiKeyConfig.SkipPaths,
 		"skip_methods", apiKeyConfig.SkipMethods,
 	)
@@ -83,7 +109,8 @@ func (a *APIKeyAuth) Setup() echo.MiddlewareFunc {
 			}
 
 			// Validate API key
-			if !a.validateAPIKey(apiKey, apiKeyConfig.Keys) {
+			if !a.validateAPIKey(apiKey, apiKeyConfig.Keys) &&
+				(a.validator == nil || !a.validator.ValidateAPIKey(c, apiKey)) {
 				a.logger.Warn("Invalid API key",
 					"path", c.Request().URL.Path,
 					"method", c.Request().Method,
@@ -9,6 +9,7 @@ import (
 	echomw "github.com/labstack/echo/v4/middleware"
 
 	"github.com/goformx/goforms/internal/application/constants"
+	"github.com/goformx/goforms/internal/application/response"
 	appconfig "github.com/goformx/goforms/internal/infrastructure/config"
 	"github.com/goformx/goforms/internal/infrastructure/logging"
 )
@@ -249,7 +250,7 @@ func CreateCSRFErrorHandler(
 			)
 		}
 
-		return c.NoContent(http.StatusForbidden)
+		return response.ErrorResponse(c, http.StatusForbidden, "Invalid or missing CSRF token")
 	}
 }
 
@@ -0,0 +1,66 @@
+package security
+
+import (
+	"reflect"
+	"sync"
+	"sync/atomic"
+
+	"github.com/labstack/echo/v4"
+
+	appconfig "github.com/goformx/goforms/internal/infrastructure/config"
+)
+
+// policyBuild is a middleware built from one section of a security policy
+type policyBuild[T any] struct {
+	source     *appconfig.SecurityConfig
+	section    T
+	middleware echo.MiddlewareFunc
+}
+
+// PolicyMiddleware serves the middleware build creates from a section of the security policy, building
+// it again on the first request after a reload changes that section. Echo middleware capture their
+// configuration when created, so this is how a reload reaches them. A reload that leaves the section
+// unchanged keeps the existing middleware and any state it holds, such as rate limit counters.
+func PolicyMiddleware[T any](
+	policy *appconfig.SecurityPolicy,
+	section func(*appconfig.SecurityConfig) T,
+	build func(T) echo.MiddlewareFunc,
+) echo.MiddlewareFunc {
+	var (
+		current atomic.Pointer[policyBuild[T]]
+		mu      sync.Mutex
+	)
+
+	initial := policy.Current()
+	current.Store(&policyBuild[T]{source: initial, section: section(initial), middleware: build(section(initial))})
+
+	resolve := func() echo.MiddlewareFunc {
+		source := policy.Current()
+		if built := current.Load(); built.source == source {
+			return built.middleware
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		built := current.Load()
+		if built.source == source {
+			return built.middleware
+		}
+
+		rebuilt := &policyBuild[T]{source: source, section: section(source), middleware: built.middleware}
+		if !reflect.DeepEqual(rebuilt.section, built.section) {
+			rebuilt.middleware = build(rebuilt.section)
+		}
+
+		current.Store(rebuilt)
+
+		return rebuilt.middleware
+	}
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			return resolve()(next)(c)
+		}
+	}
+}
@@ -24,8 +24,10 @@ const (
 // RateLimiter handles rate limiting middleware setup
 type RateLimiter struct {
 	logger      logging.Logger
-	config      *appconfig.Config
+	policy      *appconfig.SecurityPolicy
 	pathChecker PathChecker
+	// development allows turning rate limiting off; other environments always limit
+	development bool
 }
 
 // PathChecker interface for checking path types
@@ -38,21 +40,36 @@ type PathChecker interface {
 func NewRateLimiter(logger logging.Logger, config *appconfig.Config, pathChecker PathChecker) *RateLimiter {
 	return &RateLimiter{
 		logger:      logger,
-		config:      config,
+		policy:      appconfig.NewSecurityPolicy(config),
 		pathChecker: pathChecker,
+		development: config.App.IsDevelopment(),
 	}
 }
 
-// Setup creates and configures rate limiting middleware
+// WithPolicy reads the rate limits from a reloadable security policy instead of the startup configuration
+func (rl *RateLimiter) WithPolicy(policy *appconfig.SecurityPolicy) *RateLimiter {
+	rl.policy = policy
+
+	return rl
+}
+
+// Setup creates and configures rate limiting middleware. A reload that changes the rate limits
+// starts counting afresh under the new limits.
 func (rl *RateLimiter) Setup() echo.MiddlewareFunc {
-	rateLimitConfig := rl.config.Security.RateLimit
+	return PolicyMiddleware(rl.policy,
+		func(s *appconfig.SecurityConfig) appconfig.RateLimitConfig { return s.RateLimit },
+		rl.build)
+}
 
+// build creates the rate limiting middleware for one rate limit configuration. Only development
+// may disable it; elsewhere enabled=false still limits requests.
+func (rl *RateLimiter) build(rateLimitConfig appconfig.RateLimitConfig) echo.MiddlewareFunc {
 	if err := rl.validateConfig(rateLimitConfig); err != nil {
 		rl.logger.Error("Invalid rate limit configuration", "error", err)
 		return noopMiddleware()
 	}
 
-	if rl.config.App.IsDevelopment() && !rateLimitConfig.Enabled {
+	if rl.development && !rateLimitConfig.Enabled {
 		rl.logger.Info("Rate limiting disabled in development mode")
 		return noopMiddleware()
 	}
@@ -5,186 +5,118 @@ import (
 	"context"
 	"crypto/rand"
 	"encoding/base64"
+	"errors"
 	"fmt"
 	"time"
 
-	"go.uber.org/fx"
-
 	"github.com/goformx/goforms/internal/application/middleware/access"
 	"github.com/goformx/goforms/internal/infrastructure/logging"
 )
 
-// NewManager creates a new session manager
+// NewManager creates a new session manager keeping sessions in storage
 func NewManager(
 	logger logging.Logger,
 	cfg *Config,
-	lc fx.Lifecycle,
+	storage Storage,
 	accessManager *access.Manager,
-) (*Manager, error) {
-	storage, err := NewFileStorage(cfg.StoreFile, logger)
-	if err != nil {
-		return nil, fmt.Errorf("create session file storage: %w", err)
-	}
-
-	sm := &Manager{
+) *Manager {
+	return &Manager{
 		logger:        logger,
 		storage:       storage,
-		sessions:      make(map[string]*Session),
 		expiryTime:    cfg.MaxAge,
 		secureCookie:  cfg.Secure,
 		cookieName:    cfg.CookieName,
-		stopChan:      make(chan struct{}),
 		config:        cfg,
 		accessManager: accessManager,
 	}
-
-	// Register lifecycle hooks
-	lc.Append(fx.Hook{
-		OnStart: func(_ context.Context) error {
-			if initErr := sm.initialize(); initErr != nil {
-				return fmt.Errorf("failed to initialize session store: %w", initErr)
-			}
-
-			go sm.cleanupRoutine()
-
-			return nil
-		},
-		OnStop: func(_ context.Context) error {
-			close(sm.stopChan)
-
-			if saveErr := sm.saveSessions(); saveErr != nil {
-				sm.logger.Error("failed to save sessions during shutdown", "error", saveErr)
-			}
-
-			return nil
-		},
-	})
-
-	return sm, nil
 }
 
-// initialize sets up the session store
-func (sm *Manager) initialize() error {
-	// Load existing sessions
-	sessions, err := sm.storage.Load()
-	if err != nil {
-		sm.logger.Error("failed to load sessions", "error", err)
-
-		return fmt.Errorf("failed to load sessions: %w", err)
+// newSessionID generates a random session ID
+func newSessionID() (string, error) {
+	sessionID := make([]byte, SessionIDLength)
+	if _, err := rand.Read(sessionID); err != nil {
+		return "", fmt.Errorf("failed to generate session ID: %w", err)
 	}
 
-	sm.mutex.Lock()
-	sm.sessions = sessions
-	sm.mutex.Unlock()
-
-	return nil
+	return base64.URLEncoding.EncodeToString(sessionID), nil
 }
 
-// cleanupRoutine periodically cleans up expired sessions
-func (sm *Manager) cleanupRoutine() {
-	ticker := time.NewTicker(cleanupInterval)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			sm.cleanupExpiredSessions()
-		case <-sm.stopChan:
-			return
-		}
+// CreateSession creates a new session for a user
+func (sm *Manager) CreateSession(ctx context.Context, userID, email, role string) (string, error) {
+	sessionID, err := newSessionID()
+	if err != nil {
+		return "", err
 	}
-}
-
-// cleanupExpiredSessions removes expired sessions
-func (sm *Manager) cleanupExpiredSessions() {
-	sm.mutex.Lock()
-	defer sm.mutex.Unlock()
 
 	now := time.Now()
-	expiredCount := 0
 
-	for id, session := range sm.sessions {
-		if session.ExpiresAt.Before(now) {
-			delete(sm.sessions, id)
-
-			expiredCount++
-		}
+	session := &Session{
+		UserID:    userID,
+		Email:     email,
+		Role:      role,
+		CreatedAt: now,
+		ExpiresAt: now.Add(sm.expiryTime),
 	}
 
-	if expiredCount > 0 {
-		sm.logger.Info("cleaned up expired sessions", "count", expiredCount, "remaining", len(sm.sessions))
+	if err = sm.storage.Save(ctx, sessionID, session); err != nil {
+		sm.logger.Error("failed to save session", "error", err)
 
-		// Save sessions after cleanup
-		if err := sm.saveSessions(); err != nil {
-			sm.logger.Error("failed to save sessions after cleanup", "error", err)
-		}
+		return "", fmt.Errorf("failed to save session: %w", err)
 	}
+
+	return sessionID, nil
 }
 
-// saveSessions saves sessions to the store
-func (sm *Manager) saveSessions() error {
-	sm.mutex.RLock()
-	defer sm.mutex.RUnlock()
+// GetSession retrieves an unexpired session by ID. It returns ErrSessionNotFound when there is
+// none, and other errors when the store cannot be read.
+func (sm *Manager) GetSession(ctx context.Context, sessionID string) (*Session, error) {
+	session, err := sm.storage.Get(ctx, sessionID)
+	if err != nil {
+		if errors.Is(err, ErrSessionNotFound) {
+			return nil, ErrSessionNotFound
+		}
 
-	if err := sm.storage.Save(sm.sessions); err != nil {
-		return fmt.Errorf("save sessions to storage: %w", err)
+		return nil, fmt.Errorf("failed to load session: %w", err)
 	}
 
-	return nil
+	return session, nil
 }
 
-// CreateSession creates a new session for a user
-func (sm *Manager) CreateSession(userID, email, role string) (string, error) {
-	// Generate random session ID
-	sessionID := make([]byte, SessionIDLength)
-	if _, err := rand.Read(sessionID); err != nil {
-		return "", fmt.Errorf("failed to generate session ID: %w", err)
-	}
-
-	sessionIDStr := base64.URLEncoding.EncodeToString(sessionID)
-
-	// Create session
-	session := &Session{
-		UserID:    userID,
-		Email:     email,
-		Role:      role,
-		CreatedAt: time.Now(),
-		ExpiresAt: time.Now().Add(sm.expiryTime),
+// RotateSession starts a session for the user under a new ID and removes sessionID, the session
+// the request arrived with. Call it when a user logs in and whenever their privileges change, so
+// a session ID captured before cannot be used afterwards. The old session is removed before the
+// new one is saved: when that fails no new ID is handed out, and the caller must treat the
+// rotation as failed. An empty or unknown sessionID starts a fresh session.
+func (sm *Manager) RotateSession(ctx context.Context, sessionID, userID, email, role string) (string, error) {
+	if sessionID != "" {
+		if err := sm.storage.Delete(ctx, sessionID); err != nil {
+			sm.logger.Error("failed to delete rotated session", "error", err)
+
+			return "", fmt.Errorf("failed to delete rotated session: %w", err)
+		}
 	}
 
-	// Store session
-	sm.mutex.Lock()
-	sm.sessions[sessionIDStr] = session
-	sm.mutex.Unlock()
-
-	// Save sessions to file
-	if err := sm.saveSessions(); err != nil {
-		sm.logger.Error("failed to save sessions", "error", err)
+	return sm.CreateSession(ctx, userID, email, role)
+}
 
-		return "", fmt.Errorf("failed to save session: %w", err)
+// DeleteSession removes a session
+func (sm *Manager) DeleteSession(ctx context.Context, sessionID string) {
+	if err := sm.storage.Delete(ctx, sessionID); err != nil {
+		sm.logger.Error("failed to delete session", "error", err)
 	}
-
-	return sessionIDStr, nil
 }
 
-// GetSession retrieves a session by ID
-func (sm *Manager) GetSession(sessionID string) (*Session, bool) {
-	sm.mutex.RLock()
-	defer sm.mutex.RUnlock()
+// DeleteUserSessions logs a user out everywhere by removing all of the user's sessions, and
+// returns how many were removed
+func (sm *Manager) DeleteUserSessions(ctx context.Context, userID string) (int, error) {
+	removed, err := sm.storage.DeleteUser(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to delete user sessions: %w", err)
+	}
 
-	return sm.sessions[sessionID], sm.sessions[sessionID] != nil
-}
+	sm.logger.Info("deleted user sessions", "user_id", userID, "count", removed)
 
-// DeleteSession removes a session
-func (sm *Manager) DeleteSession(sessionID string) {
-	sm.mutex.Lock()
-	delete(sm.sessions, sessionID)
-	sm.mutex.Unlock()
-
-	// Save sessions to file
-	if err := sm.saveSessions(); err != nil {
-		sm.logger.Error("failed to save sessions", "error", err)
-	}
+	return removed, nil
 }
 
 // GetCookieName returns the name of the session cookie
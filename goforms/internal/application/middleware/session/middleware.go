@@ -1,6 +1,7 @@
 package session
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 	"strings"
@@ -66,26 +67,21 @@ func (sm *Manager) processSession(c echo.Context, path string, next echo.Handler
 		return sm.handleAuthError(c, "no session found")
 	}
 
-	// Get session from manager
-	session, exists := sm.GetSession(cookie.Value)
-	if !exists {
-		// For public paths, continue without authentication
-		if sm.isPublicPath(path) {
-			return next(c)
-		}
+	// Get session from manager; the store only returns unexpired sessions
+	session, err := sm.GetSession(c.Request().Context(), cookie.Value)
+	if err != nil {
+		if !errors.Is(err, ErrSessionNotFound) {
+			sm.logger.Error("failed to load session", "error", err)
 
-		return sm.handleAuthError(c, "invalid session")
-	}
+			return response.ErrorResponse(c, http.StatusInternalServerError, "Failed to load session")
+		}
 
-	// Check if session is expired
-	if time.Now().After(session.ExpiresAt) {
-		sm.DeleteSession(cookie.Value)
 		// For public paths, continue without authentication
 		if sm.isPublicPath(path) {
 			return next(c)
 		}
 
-		return sm.handleAuthError(c, "session expired")
+		return sm.handleAuthError(c, "invalid session")
 	}
 
 	// Store session in context (always do this if we have a valid session)
@@ -97,14 +93,17 @@ func (sm *Manager) processSession(c echo.Context, path string, next echo.Handler
 	return next(c)
 }
 
-// Laravel assertion API path (no session cookie; auth via X-User-Id/X-Signature).
-const pathAPIFormsLaravel = "/api/forms"
+// Header-authenticated API paths (no session cookie): Laravel assertion auth via X-User-Id/X-Signature
+// and server-to-server auth via scoped API keys.
+var headerAuthAPIPaths = []string{"/api/forms", "/api/workspaces", "/api/server/forms"}
 
 // shouldSkipSession checks if a path should skip session processing entirely
 func (sm *Manager) shouldSkipSession(path string) bool {
-	// Laravel assertion auth: skip session for /api/forms and below
-	if path == pathAPIFormsLaravel || strings.HasPrefix(path, pathAPIFormsLaravel+"/") {
-		return true
+	// Header auth: skip session for /api/forms, /api/workspaces, /api/server/forms and below
+	for _, prefix := range headerAuthAPIPaths {
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
 	}
 
 	// Early returns for different path types
@@ -236,7 +235,7 @@ func (sm *Manager) handleAuthError(c echo.Context, message string) error {
 	hasValidSession := false
 
 	if err == nil {
-		if session, exists := sm.GetSession(cookie.Value); exists && time.Now().Before(session.ExpiresAt) {
+		if _, getErr := sm.GetSession(c.Request().Context(), cookie.Value); getErr == nil {
 			hasValidSession = true
 		}
 	}
@@ -1,161 +1,126 @@
 package session
 
 import (
-	"encoding/json"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
-	"os"
-	"path/filepath"
+	"sync"
 	"time"
 
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/database"
 	"github.com/goformx/goforms/internal/infrastructure/logging"
 )
 
-// FileStorage implements Storage using file system
-type FileStorage struct {
-	storeFile string
-	logger    logging.Logger
+// ErrSessionNotFound is returned by Storage.Get when no unexpired session has the ID
+var ErrSessionNotFound = errors.New("session not found")
+
+// NewStorage creates the session store selected by configuration
+func NewStorage(cfg *config.SessionConfig, db database.DB, logger logging.Logger) (Storage, error) {
+	switch cfg.Store {
+	case "", config.SessionStoreDatabase:
+		return NewDatabaseStorage(db, logger), nil
+	case config.SessionStoreMemory:
+		return NewMemoryStorage(), nil
+	case config.SessionStoreRedis:
+		return NewRedisStorage(cfg.Redis), nil
+	default:
+		return nil, fmt.Errorf("unsupported session store %q", cfg.Store)
+	}
 }
 
-// NewFileStorage creates a new file-based session storage.
-// It ensures the parent directory exists so the process owner
-// (not Docker root) owns the directory tree.
-func NewFileStorage(storeFile string, logger logging.Logger) (*FileStorage, error) {
-	dir := filepath.Dir(storeFile)
-	if err := os.MkdirAll(dir, 0o750); err != nil {
-		return nil, fmt.Errorf("create session storage directory %s: %w", dir, err)
-	}
+// hashSessionID is the key shared stores keep a session under, so their contents never include
+// a usable session cookie
+func hashSessionID(sessionID string) string {
+	sum := sha256.Sum256([]byte(sessionID))
 
-	return &FileStorage{
-		storeFile: storeFile,
-		logger:    logger,
-	}, nil
+	return hex.EncodeToString(sum[:])
 }
 
-// Load implements Storage.Load
-func (fs *FileStorage) Load() (map[string]*Session, error) {
-	// Read file
-	data, readErr := os.ReadFile(fs.storeFile)
-	if readErr != nil {
-		if os.IsNotExist(readErr) {
-			return make(map[string]*Session), nil
-		}
+// sweepInterval bounds how often expired sessions are purged from stores without native expiry
+const sweepInterval = time.Minute
 
-		return nil, fmt.Errorf("failed to read sessions file: %w", readErr)
-	}
+// MemoryStorage is a process-local Storage. Sessions are lost on restart and are not shared
+// between replicas; deployments running several replicas should use the database or Redis store.
+type MemoryStorage struct {
+	mu        sync.Mutex
+	sessions  map[string]Session
+	lastSweep time.Time
+	now       func() time.Time
+}
 
-	// Parse sessions
-	var tempSessions map[string]map[string]any
-	if unmarshalErr := json.Unmarshal(data, &tempSessions); unmarshalErr != nil {
-		return nil, fmt.Errorf("failed to unmarshal sessions: %w", unmarshalErr)
+// NewMemoryStorage creates an empty in-memory session store
+func NewMemoryStorage() *MemoryStorage {
+	return &MemoryStorage{
+		sessions: make(map[string]Session),
+		now:      time.Now,
 	}
+}
 
-	// Convert to sessions
-	sessions := make(map[string]*Session)
-	now := time.Now()
-
-	for id, data := range tempSessions {
-		session, parseErr := fs.parseSessionData(data)
-		if parseErr != nil {
-			fs.logger.Warn("failed to parse session data", "session_id", id, "error", parseErr)
-
-			continue
-		}
-
-		if session.ExpiresAt.Before(now) {
-			continue
-		}
-
-		sessions[id] = session
-	}
+// Get returns a copy of the unexpired session with the ID
+func (s *MemoryStorage) Get(_ context.Context, sessionID string) (*Session, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	// Write file
-	if writeErr := os.WriteFile(fs.storeFile, data, 0o600); writeErr != nil {
-		return nil, fmt.Errorf("failed to write session store: %w", writeErr)
+	session, ok := s.sessions[sessionID]
+	if !ok || !s.now().Before(session.ExpiresAt) {
+		return nil, ErrSessionNotFound
 	}
 
-	return sessions, nil
+	return &session, nil
 }
 
-// Save implements Storage.Save
-func (fs *FileStorage) Save(sessions map[string]*Session) error {
-	sessionsMap := make(map[string]map[string]any)
-	for id, session := range sessions {
-		sessionsMap[id] = map[string]any{
-			"user_id":    session.UserID,
-			"email":      session.Email,
-			"role":       session.Role,
-			"created_at": session.CreatedAt.Format(time.RFC3339),
-			"expires_at": session.ExpiresAt.Format(time.RFC3339),
-		}
-	}
+// Save stores a copy of the session, purging expired sessions at most once per sweepInterval
+func (s *MemoryStorage) Save(_ context.Context, sessionID string, session *Session) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	data, marshalErr := json.MarshalIndent(sessionsMap, "", "  ")
-	if marshalErr != nil {
-		return fmt.Errorf("failed to marshal sessions: %w", marshalErr)
+	if now := s.now(); now.Sub(s.lastSweep) >= sweepInterval {
+		s.sweep(now)
 	}
 
-	if writeErr := os.WriteFile(fs.storeFile, data, 0o600); writeErr != nil {
-		return fmt.Errorf("failed to write session store: %w", writeErr)
-	}
+	s.sessions[sessionID] = *session
 
 	return nil
 }
 
-// Delete implements Storage.Delete
-func (fs *FileStorage) Delete(sessionID string) error {
-	sessions, err := fs.Load()
-	if err != nil {
-		return err
-	}
+// Delete removes the session with the ID
+func (s *MemoryStorage) Delete(_ context.Context, sessionID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	delete(sessions, sessionID)
+	delete(s.sessions, sessionID)
 
-	return fs.Save(sessions)
+	return nil
 }
 
-// parseSessionData parses session data into a Session object
-func (fs *FileStorage) parseSessionData(data map[string]any) (*Session, error) {
-	createdAt, ok := data["created_at"].(string)
-	if !ok {
-		return nil, errors.New("invalid created_at type")
-	}
+// DeleteUser removes every session of the user
+func (s *MemoryStorage) DeleteUser(_ context.Context, userID string) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
 
-	expiresAt, ok := data["expires_at"].(string)
-	if !ok {
-		return nil, errors.New("invalid expires_at type")
-	}
+	removed := 0
 
-	userID, ok := data["user_id"].(string)
-	if !ok {
-		return nil, errors.New("invalid user_id type")
-	}
+	for id, session := range s.sessions {
+		if session.UserID == userID {
+			delete(s.sessions, id)
 
-	email, ok := data["email"].(string)
-	if !ok {
-		return nil, errors.New("invalid email type")
-	}
-
-	role, ok := data["role"].(string)
-	if !ok {
-		return nil, errors.New("invalid role type")
+			removed++
+		}
 	}
 
-	createdAtTime, err := time.Parse(time.RFC3339, createdAt)
-	if err != nil {
-		return nil, fmt.Errorf("invalid created_at format: %w", err)
-	}
+	return removed, nil
+}
 
-	expiresAtTime, err := time.Parse(time.RFC3339, expiresAt)
-	if err != nil {
-		return nil, fmt.Errorf("invalid expires_at format: %w", err)
+// sweep removes expired sessions; callers must hold the lock
+func (s *MemoryStorage) sweep(now time.Time) {
+	for id, session := range s.sessions {
+		if !now.Before(session.ExpiresAt) {
+			delete(s.sessions, id)
+		}
 	}
 
-	return &Session{
-		UserID:    userID,
-		Email:     email,
-		Role:      role,
-		CreatedAt: createdAtTime,
-		ExpiresAt: expiresAtTime,
-	}, nil
+	s.lastSweep = now
 }
@@ -0,0 +1,134 @@
+package session
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/goformx/goforms/internal/infrastructure/database"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+)
+
+// sessionRecord is a row of the sessions table
+type sessionRecord struct {
+	IDHash    string    `gorm:"column:id_hash;primaryKey;size:64"`
+	UserID    string    `gorm:"size:255;not null;index"`
+	Email     string    `gorm:"size:255;not null"`
+	Role      string    `gorm:"size:32;not null"`
+	CreatedAt time.Time `gorm:"not null"`
+	ExpiresAt time.Time `gorm:"not null;index"`
+}
+
+// TableName specifies the table name for the sessionRecord model
+func (sessionRecord) TableName() string {
+	return "sessions"
+}
+
+// DatabaseStorage is a Storage shared by all replicas through the sessions table. Reads ignore
+// expired rows, which are purged now and then as sessions are saved.
+type DatabaseStorage struct {
+	db     database.DB
+	logger logging.Logger
+
+	mu        sync.Mutex
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// NewDatabaseStorage creates a database-backed session store
+func NewDatabaseStorage(db database.DB, logger logging.Logger) *DatabaseStorage {
+	return &DatabaseStorage{
+		db:     db,
+		logger: logger,
+		now:    time.Now,
+	}
+}
+
+// Get returns the unexpired session with the ID
+func (s *DatabaseStorage) Get(ctx context.Context, sessionID string) (*Session, error) {
+	var record sessionRecord
+	if err := s.db.GetDB().WithContext(ctx).
+		Where("id_hash = ? AND expires_at > ?", hashSessionID(sessionID), s.now()).
+		First(&record).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, ErrSessionNotFound
+		}
+
+		return nil, fmt.Errorf("get session: %w", err)
+	}
+
+	return &Session{
+		UserID:    record.UserID,
+		Email:     record.Email,
+		Role:      record.Role,
+		CreatedAt: record.CreatedAt,
+		ExpiresAt: record.ExpiresAt,
+	}, nil
+}
+
+// Save inserts the session, or replaces the row already holding its ID
+func (s *DatabaseStorage) Save(ctx context.Context, sessionID string, session *Session) error {
+	db := s.db.GetDB().WithContext(ctx)
+
+	s.sweep(db, s.now())
+
+	record := &sessionRecord{
+		IDHash:    hashSessionID(sessionID),
+		UserID:    session.UserID,
+		Email:     session.Email,
+		Role:      session.Role,
+		CreatedAt: session.CreatedAt,
+		ExpiresAt: session.ExpiresAt,
+	}
+
+	if err := db.Clauses(clause.OnConflict{UpdateAll: true}).Create(record).Error; err != nil {
+		return fmt.Errorf("save session: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the session with the ID
+func (s *DatabaseStorage) Delete(ctx context.Context, sessionID string) error {
+	if err := s.db.GetDB().WithContext(ctx).
+		Where("id_hash = ?", hashSessionID(sessionID)).
+		Delete(&sessionRecord{}).Error; err != nil {
+		return fmt.Errorf("delete session: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteUser removes every session of the user
+func (s *DatabaseStorage) DeleteUser(ctx context.Context, userID string) (int, error) {
+	result := s.db.GetDB().WithContext(ctx).Where("user_id = ?", userID).Delete(&sessionRecord{})
+	if result.Error != nil {
+		return 0, fmt.Errorf("delete user sessions: %w", result.Error)
+	}
+
+	return int(result.RowsAffected), nil
+}
+
+// sweep purges expired sessions at most once per sweepInterval. Failures are logged and
+// retried on a later sweep; they never fail a save.
+func (s *DatabaseStorage) sweep(db *gorm.DB, now time.Time) {
+	s.mu.Lock()
+	due := now.Sub(s.lastSweep) >= sweepInterval
+	if due {
+		s.lastSweep = now
+	}
+	s.mu.Unlock()
+
+	if !due {
+		return
+	}
+
+	if err := db.Where("expires_at <= ?", now).Delete(&sessionRecord{}).Error; err != nil {
+		s.logger.Warn("failed to purge expired sessions", "error", err)
+	}
+}
@@ -0,0 +1,165 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"github.com/goformx/goforms/internal/infrastructure/config"
+)
+
+// RedisStorage is a Storage shared by all replicas through Redis. Each session is a key that
+// Redis expires at the session's ExpiresAt; a set per user indexes the user's sessions so they
+// can all be removed at once.
+type RedisStorage struct {
+	client *redis.Client
+	prefix string
+	now    func() time.Time
+}
+
+// NewRedisStorage creates a Redis-backed session store; connections are opened on first use
+func NewRedisStorage(cfg config.SessionRedisConfig) *RedisStorage {
+	return &RedisStorage{
+		client: redis.NewClient(&redis.Options{Addr: cfg.Addr, Password: cfg.Password, DB: cfg.DB}),
+		prefix: cfg.KeyPrefix,
+		now:    time.Now,
+	}
+}
+
+// sessionKey is the key holding the session with the hashed ID
+func (s *RedisStorage) sessionKey(idHash string) string {
+	return s.prefix + idHash
+}
+
+// userKey is the set of the hashed IDs of a user's sessions
+func (s *RedisStorage) userKey(userID string) string {
+	return s.prefix + "user:" + userID
+}
+
+// Get returns the unexpired session with the ID
+func (s *RedisStorage) Get(ctx context.Context, sessionID string) (*Session, error) {
+	encoded, err := s.client.Get(ctx, s.sessionKey(hashSessionID(sessionID))).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrSessionNotFound
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("get session: %w", err)
+	}
+
+	var session Session
+	if err = json.Unmarshal([]byte(encoded), &session); err != nil {
+		return nil, fmt.Errorf("decode session: %w", err)
+	}
+
+	// Redis expires keys with millisecond precision; the session's own expiry is authoritative
+	if !s.now().Before(session.ExpiresAt) {
+		return nil, ErrSessionNotFound
+	}
+
+	return &session, nil
+}
+
+// Save stores the session with a TTL ending at its expiry and adds it to the user's index.
+// The index lives as long as the user's newest session.
+func (s *RedisStorage) Save(ctx context.Context, sessionID string, session *Session) error {
+	ttl := session.ExpiresAt.Sub(s.now())
+	if ttl <= 0 {
+		return s.Delete(ctx, sessionID)
+	}
+
+	encoded, err := json.Marshal(session)
+	if err != nil {
+		return fmt.Errorf("encode session: %w", err)
+	}
+
+	idHash, userKey := hashSessionID(sessionID), s.userKey(session.UserID)
+	ttl = max(ttl, time.Millisecond)
+
+	if _, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Set(ctx, s.sessionKey(idHash), encoded, ttl)
+		pipe.SAdd(ctx, userKey, idHash)
+		pipe.PExpire(ctx, userKey, ttl)
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("save session: %w", err)
+	}
+
+	return nil
+}
+
+// Delete removes the session with the ID and drops it from its user's index
+func (s *RedisStorage) Delete(ctx context.Context, sessionID string) error {
+	idHash := hashSessionID(sessionID)
+
+	encoded, err := s.client.Get(ctx, s.sessionKey(idHash)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("delete session: %w", err)
+	}
+
+	if _, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, s.sessionKey(idHash))
+
+		var session Session
+		if json.Unmarshal([]byte(encoded), &session) == nil {
+			pipe.SRem(ctx, s.userKey(session.UserID), idHash)
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("delete session: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteUser removes every session in the user's index, and the index itself
+func (s *RedisStorage) DeleteUser(ctx context.Context, userID string) (int, error) {
+	userKey := s.userKey(userID)
+
+	idHashes, err := s.client.SMembers(ctx, userKey).Result()
+	if err != nil {
+		return 0, fmt.Errorf("list user sessions: %w", err)
+	}
+
+	if len(idHashes) == 0 {
+		return 0, nil
+	}
+
+	keys := make([]string, len(idHashes))
+	for i, idHash := range idHashes {
+		keys[i] = s.sessionKey(idHash)
+	}
+
+	var removed *redis.IntCmd
+
+	if _, err = s.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		removed = pipe.Del(ctx, keys...)
+		pipe.Del(ctx, userKey)
+
+		return nil
+	}); err != nil {
+		return 0, fmt.Errorf("delete user sessions: %w", err)
+	}
+
+	// The index may name sessions that already expired; only those still present are counted
+	return int(removed.Val()), nil
+}
+
+// Close closes the connections to Redis
+func (s *RedisStorage) Close() error {
+	if err := s.client.Close(); err != nil {
+		return fmt.Errorf("close redis session store: %w", err)
+	}
+
+	return nil
+}
@@ -1,7 +1,7 @@
 package session
 
 import (
-	"sync"
+	"context"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -19,8 +19,6 @@ const (
 	// SessionKey is a key used in the context
 	SessionKey     = "session"
 	sessionTimeout = 5 * time.Second
-	// cleanupInterval is how often to run session cleanup
-	cleanupInterval = 1 * time.Hour
 )
 
 // Session represents a user session
@@ -32,11 +30,17 @@ type Session struct {
 	ExpiresAt time.Time `json:"expires_at"`
 }
 
-// Storage defines the interface for session storage operations
+// Storage persists sessions one at a time. Sessions expire on their own once ExpiresAt passes,
+// so stores never need a sweep to stop serving them.
 type Storage interface {
-	Load() (map[string]*Session, error)
-	Save(sessions map[string]*Session) error
-	Delete(sessionID string) error
+	// Get returns the unexpired session with the ID, or ErrSessionNotFound
+	Get(ctx context.Context, sessionID string) (*Session, error)
+	// Save stores the session under the ID until it expires
+	Save(ctx context.Context, sessionID string, session *Session) error
+	// Delete removes the session with the ID; removing a missing session is not an error
+	Delete(ctx context.Context, sessionID string) error
+	// DeleteUser removes every session of a user and returns how many were removed
+	DeleteUser(ctx context.Context, userID string) (int, error)
 }
 
 // Config extends the base config with additional session-specific settings
@@ -53,12 +57,9 @@ type Config struct {
 type Manager struct {
 	logger        logging.Logger
 	storage       Storage
-	sessions      map[string]*Session
-	mutex         sync.RWMutex
 	expiryTime    time.Duration
 	secureCookie  bool
 	cookieName    string
-	stopChan      chan struct{}
 	config        *Config
 	accessManager *access.Manager
 }
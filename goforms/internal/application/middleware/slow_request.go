@@ -120,6 +120,11 @@ func NewSlowRequestSkipper() func(c echo.Context) bool {
 			return true
 		}
 
+		// Skip event streams, which last as long as the client stays
+		if isEventStream(c) {
+			return true
+		}
+
 		return false
 	}
 }
@@ -14,6 +14,7 @@ import (
 	"github.com/goformx/goforms/internal/application/middleware/access"
 	"github.com/goformx/goforms/internal/application/middleware/request"
 	"github.com/goformx/goforms/internal/application/middleware/session"
+	"github.com/goformx/goforms/internal/application/openapi"
 	"github.com/goformx/goforms/internal/application/response"
 	"github.com/goformx/goforms/internal/application/validation"
 	"github.com/goformx/goforms/internal/domain/form"
@@ -95,6 +96,7 @@ var Module = fx.Module("application",
 		provideRequestUtils,
 		provideErrorHandler,
 		provideRecoveryMiddleware,
+		provideOpenAPIDocument,
 	),
 	validation.Module,
 )
@@ -117,6 +119,11 @@ func provideRecoveryMiddleware(logger logging.Logger, sanitizer sanitization.Ser
 	return middleware.Recovery(logger, sanitizer)
 }
 
+// provideOpenAPIDocument builds the OpenAPI document for the running version
+func provideOpenAPIDocument(cfg *config.Config) *openapi.Document {
+	return openapi.NewDocument(cfg.App.Version)
+}
+
 // New creates a new application instance
 func New(lc fx.Lifecycle, deps Dependencies) *Application {
 	app := &Application{
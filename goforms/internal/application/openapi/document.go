@@ -0,0 +1,227 @@
+// Package openapi describes the HTTP API as an OpenAPI 3.1 document and checks
+// requests and responses against it. The document is built in code next to the
+// routes it describes, served at /openapi.json, and used in development to catch
+// drift between the handlers, the document and the clients built from it.
+package openapi
+
+import (
+	"strings"
+
+	"github.com/goformx/goforms/internal/infrastructure/jsonschema"
+)
+
+// Version is the OpenAPI version of the document
+const Version = "3.1.0"
+
+// Security scheme names used by operations
+const (
+	SchemeAssertion = "assertion"
+	SchemeAPIKey    = "apiKey"
+	SchemeBearerKey = "bearerKey"
+	SchemeSession   = "session"
+)
+
+// Document is an OpenAPI document
+type Document struct {
+	OpenAPI    string               `json:"openapi"`
+	Info       Info                 `json:"info"`
+	Tags       []Tag                `json:"tags,omitempty"`
+	Paths      map[string]*PathItem `json:"paths"`
+	Components Components           `json:"components"`
+}
+
+// Info describes the API
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// Tag groups operations
+type Tag struct {
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// PathItem holds the operations of one path, keyed by lowercase HTTP method
+type PathItem map[string]*Operation
+
+// Operation is one method on one path
+type Operation struct {
+	OperationID string                `json:"operationId"`
+	Summary     string                `json:"summary"`
+	Description string                `json:"description,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Parameters  []*Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []SecurityRequirement `json:"security"`
+}
+
+// Parameter is a path, query or header parameter
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody is the body an operation accepts, keyed by media type
+type RequestBody struct {
+	Required bool                  `json:"required,omitempty"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Response is one response of an operation
+type Response struct {
+	Description string                `json:"description"`
+	Headers     map[string]*Header    `json:"headers,omitempty"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// Header is a response header
+type Header struct {
+	Description string  `json:"description,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// MediaType holds the schema of one media type
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// SecurityRequirement names the schemes an operation accepts; an empty requirement makes
+// authentication optional
+type SecurityRequirement map[string][]string
+
+// Components holds the reusable schemas and security schemes
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes"`
+}
+
+// SecurityScheme describes how a request authenticates
+type SecurityScheme struct {
+	Type        string `json:"type"`
+	Description string `json:"description,omitempty"`
+	Name        string `json:"name,omitempty"`
+	In          string `json:"in,omitempty"`
+	Scheme      string `json:"scheme,omitempty"`
+}
+
+// Schema, Types and ValidationError are the JSON Schema subset shared with the event schemas
+type (
+	Schema          = jsonschema.Schema
+	Types           = jsonschema.Types
+	ValidationError = jsonschema.ValidationError
+)
+
+// Lookup returns the operation for a method and an Echo route path such as /api/forms/:id
+func (d *Document) Lookup(method, routePath string) (*Operation, bool) {
+	item, ok := d.Paths[PathFromRoute(routePath)]
+	if !ok {
+		return nil, false
+	}
+
+	op, ok := (*item)[strings.ToLower(method)]
+
+	return op, ok
+}
+
+// Resolve follows a $ref to a component schema; other schemas are returned as they are
+func (d *Document) Resolve(schema *Schema) *Schema {
+	for schema != nil && schema.Ref != "" {
+		schema = d.Components.Schemas[strings.TrimPrefix(schema.Ref, refPrefix)]
+	}
+
+	return schema
+}
+
+// PathFromRoute converts an Echo route path to an OpenAPI path: :id becomes {id} and a
+// trailing * becomes {path}
+func PathFromRoute(routePath string) string {
+	segments := strings.Split(routePath, "/")
+	for i, segment := range segments {
+		switch {
+		case strings.HasPrefix(segment, ":"):
+			segments[i] = "{" + segment[1:] + "}"
+		case segment == "*":
+			segments[i] = "{path}"
+		}
+	}
+
+	return strings.Join(segments, "/")
+}
+
+// NewDocument builds the document describing every route the server registers
+func NewDocument(version string) *Document {
+	doc := &Document{
+		OpenAPI: Version,
+		Info: Info{
+			Title:   "GoFormX API",
+			Version: version,
+			Description: "Successful JSON responses wrap their payload in {success, message, data}. " +
+				"Errors are RFC 7807 problem details with a stable type URI and code.",
+		},
+		Tags: []Tag{
+			{Name: tagForms, Description: "Forms of the signed-in user or their active workspace"},
+			{Name: tagSubmissions, Description: "Responses to forms"},
+			{Name: tagBulk, Description: "Operations on many submissions at once"},
+			{Name: tagReview, Description: "Review state and internal notes of submissions"},
+			{Name: tagAudit, Description: "The tamper-evident audit log"},
+			{Name: tagAPIKeys, Description: "Scoped API keys for server-to-server access"},
+			{Name: tagWorkspaces, Description: "Workspaces and their members"},
+			{Name: tagPublic, Description: "Routes embeds and plain HTML forms call from browsers"},
+			{Name: tagServer, Description: "Routes backends call with scoped API keys"},
+			{Name: tagSessions, Description: "Dashboard sessions and user roles"},
+			{Name: tagSystem, Description: "Health, configuration and this document"},
+		},
+		Paths: map[string]*PathItem{},
+		Components: Components{
+			Schemas:         componentSchemas(),
+			SecuritySchemes: securitySchemes(),
+		},
+	}
+
+	doc.addFormRoutes()
+	doc.addWorkspaceRoutes()
+	doc.addPublicRoutes()
+	doc.addServerRoutes()
+	doc.addSessionRoutes()
+	doc.addSystemRoutes()
+
+	return doc
+}
+
+// securitySchemes describes the ways requests authenticate
+func securitySchemes() map[string]*SecurityScheme {
+	return map[string]*SecurityScheme{
+		SchemeAssertion: {
+			Type: "apiKey",
+			In:   "header",
+			Name: "X-Signature",
+			Description: "Signed user assertion from the Laravel app. Send X-User-Id, X-Timestamp (RFC 3339 or Unix seconds) " +
+				"and X-Plan-Tier, optionally X-Workspace-Id, X-Key-Id and X-Nonce, and X-Signature: the hex HMAC-SHA256 of " +
+				"METHOD:PATH:USER_ID:TIMESTAMP:PLAN_TIER, followed by :WORKSPACE_ID:NONCE when a nonce is sent or by " +
+				":WORKSPACE_ID when only a workspace is. The key is the shared secret, or the secret of X-Key-Id.",
+		},
+		SchemeAPIKey: {
+			Type:        "apiKey",
+			In:          "header",
+			Name:        "X-API-Key",
+			Description: "A configured API key, or a database key scoped to the form or its workspace",
+		},
+		SchemeBearerKey: {
+			Type:        "http",
+			Scheme:      "bearer",
+			Description: "A scoped API key sent as a bearer token",
+		},
+		SchemeSession: {
+			Type:        "apiKey",
+			In:          "cookie",
+			Name:        "session",
+			Description: "Session cookie of a signed-in administrator",
+		},
+	}
+}
@@ -0,0 +1,303 @@
+package openapi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/goformx/goforms/internal/application/response"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+)
+
+// maxCapturedBody bounds how much of a request or response body is buffered for validation;
+// larger bodies are passed through unchecked
+const maxCapturedBody = 1 << 20
+
+// Validator checks requests and responses against the document
+type Validator struct {
+	doc    *Document
+	logger logging.Logger
+}
+
+// NewValidator creates a validator for the document
+func NewValidator(doc *Document, logger logging.Logger) *Validator {
+	return &Validator{doc: doc, logger: logger}
+}
+
+// Middleware rejects requests that break the document with a validation problem, and logs
+// responses that break it. Routes the document does not describe pass through. It buffers
+// bodies, so it is meant for development.
+func (v *Validator) Middleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			operation, ok := v.doc.Lookup(c.Request().Method, c.Path())
+			if !ok {
+				return next(c)
+			}
+
+			if errs := v.ValidateRequest(c, operation); len(errs) > 0 {
+				v.logger.Warn("request does not match the OpenAPI document",
+					"operation", operation.OperationID, "errors", errorStrings(errs))
+
+				return response.ValidationErrorResponse(c, "Request does not match the API contract", fieldErrors(errs))
+			}
+
+			capture := &bodyCapture{ResponseWriter: c.Response().Writer}
+			c.Response().Writer = capture
+
+			err := next(c)
+			if err != nil {
+				// Render the error now so its problem is checked too; the error handler skips
+				// the committed response when the error is returned
+				c.Error(err)
+			}
+
+			if capture.truncated {
+				return err
+			}
+
+			res := c.Response()
+			if errs := v.ValidateResponse(operation, res.Status, res.Header().Get(echo.HeaderContentType), capture.body.Bytes()); len(errs) > 0 {
+				v.logger.Warn("response does not match the OpenAPI document",
+					"operation", operation.OperationID, "status", res.Status, "errors", errorStrings(errs))
+			}
+
+			return err
+		}
+	}
+}
+
+// ValidateRequest checks the parameters and body of a request against its operation. The body
+// is read and replaced, so handlers can still bind it.
+func (v *Validator) ValidateRequest(c echo.Context, operation *Operation) []ValidationError {
+	var errs []ValidationError
+
+	req := c.Request()
+
+	for _, param := range operation.Parameters {
+		var raw string
+
+		switch param.In {
+		case "query":
+			raw = c.QueryParam(param.Name)
+		case "header":
+			raw = req.Header.Get(param.Name)
+		case "path":
+			raw = c.Param(param.Name)
+		}
+
+		if raw == "" {
+			if param.Required {
+				errs = append(errs, ValidationError{Path: param.In + "." + param.Name, Message: "is required", Rule: "required"})
+			}
+
+			continue
+		}
+
+		errs = append(errs, v.doc.ValidateParameter(param, raw)...)
+	}
+
+	if operation.RequestBody == nil {
+		return errs
+	}
+
+	body, err := readBody(req)
+	if err != nil {
+		return append(errs, ValidationError{Path: "body", Message: "could not be read", Rule: "body"})
+	}
+
+	if body == nil {
+		return errs
+	}
+
+	return append(errs, v.validateRequestBody(operation.RequestBody, req.Header.Get(echo.HeaderContentType), body)...)
+}
+
+func (v *Validator) validateRequestBody(requestBody *RequestBody, contentType string, body []byte) []ValidationError {
+	if len(bytes.TrimSpace(body)) == 0 {
+		if requestBody.Required {
+			return []ValidationError{{Path: "body", Message: "is required", Rule: "required"}}
+		}
+
+		return nil
+	}
+
+	mediaType := baseMediaType(contentType)
+
+	media, ok := requestBody.Content[mediaType]
+	if !ok {
+		return []ValidationError{{
+			Path:    "body",
+			Message: fmt.Sprintf("content type %q is not accepted", mediaType),
+			Rule:    "contentType",
+		}}
+	}
+
+	var value any
+
+	switch {
+	case mediaType == formContentType:
+		form, err := url.ParseQuery(string(body))
+		if err != nil {
+			return []ValidationError{{Path: "body", Message: "is not a valid form encoding", Rule: "format"}}
+		}
+
+		fields := make(map[string]any, len(form))
+		for name := range form {
+			fields[name] = form.Get(name)
+		}
+
+		value = fields
+	case isJSON(mediaType):
+		if err := json.Unmarshal(body, &value); err != nil {
+			return []ValidationError{{Path: "body", Message: "is not valid JSON", Rule: "format"}}
+		}
+	default:
+		return nil
+	}
+
+	return v.doc.Validate(media.Schema, value, "body")
+}
+
+// ValidateResponse checks a response's status, media type and JSON body against its operation
+func (v *Validator) ValidateResponse(operation *Operation, status int, contentType string, body []byte) []ValidationError {
+	resp, ok := operation.Responses[strconv.Itoa(status)]
+	if !ok {
+		resp, ok = operation.Responses["default"]
+	}
+
+	if !ok {
+		return []ValidationError{{Message: fmt.Sprintf("status %d is not documented", status), Rule: "status"}}
+	}
+
+	if len(bytes.TrimSpace(body)) == 0 {
+		return nil
+	}
+
+	mediaType := baseMediaType(contentType)
+
+	media, ok := resp.Content[mediaType]
+	if !ok {
+		media, ok = resp.Content["*/*"]
+	}
+
+	if !ok {
+		return []ValidationError{{
+			Message: fmt.Sprintf("content type %q is not documented for status %d", mediaType, status),
+			Rule:    "contentType",
+		}}
+	}
+
+	if media.Schema == nil || !isJSON(mediaType) {
+		return nil
+	}
+
+	var value any
+	if err := json.Unmarshal(body, &value); err != nil {
+		return []ValidationError{{Path: "body", Message: "is not valid JSON", Rule: "format"}}
+	}
+
+	return v.doc.Validate(media.Schema, value, "body")
+}
+
+// readBody reads a request body up to maxCapturedBody and puts it back for the handler. It
+// returns nil when there is no body or it is too large to check.
+func readBody(req *http.Request) ([]byte, error) {
+	if req.Body == nil || req.Body == http.NoBody {
+		return nil, nil //nolint:nilnil // no body is not an error
+	}
+
+	body, err := io.ReadAll(io.LimitReader(req.Body, maxCapturedBody+1))
+	if err != nil {
+		return nil, fmt.Errorf("read request body: %w", err)
+	}
+
+	if len(body) > maxCapturedBody {
+		req.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), req.Body), req.Body}
+
+		return nil, nil
+	}
+
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	return body, nil
+}
+
+// bodyCapture copies what a handler writes so the response can be checked once it is sent
+type bodyCapture struct {
+	http.ResponseWriter
+	body      bytes.Buffer
+	truncated bool
+}
+
+func (w *bodyCapture) Write(p []byte) (int, error) {
+	if !w.truncated {
+		if w.body.Len()+len(p) > maxCapturedBody {
+			w.truncated = true
+			w.body.Reset()
+		} else {
+			w.body.Write(p)
+		}
+	}
+
+	n, err := w.ResponseWriter.Write(p)
+	if err != nil {
+		return n, fmt.Errorf("write response: %w", err)
+	}
+
+	return n, nil
+}
+
+// Flush lets streaming handlers flush through the capture
+func (w *bodyCapture) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Unwrap exposes the underlying writer to http.ResponseController
+func (w *bodyCapture) Unwrap() http.ResponseWriter {
+	return w.ResponseWriter
+}
+
+func baseMediaType(contentType string) string {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return strings.ToLower(strings.TrimSpace(contentType))
+	}
+
+	return mediaType
+}
+
+func isJSON(mediaType string) bool {
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
+
+func fieldErrors(errs []ValidationError) []response.FieldError {
+	fields := make([]response.FieldError, len(errs))
+	for i, err := range errs {
+		fields[i] = response.FieldError{Field: err.Path, Message: err.Message, Rule: err.Rule}
+	}
+
+	return fields
+}
+
+func errorStrings(errs []ValidationError) []string {
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Error()
+	}
+
+	return messages
+}
@@ -0,0 +1,131 @@
+package openapi_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goformx/goforms/internal/application/openapi"
+	"github.com/goformx/goforms/internal/application/response"
+	mocklogging "github.com/goformx/goforms/test/mocks/logging"
+)
+
+func newValidatedEcho(t *testing.T, logger *mocklogging.MockLogger) *echo.Echo {
+	t.Helper()
+
+	e := echo.New()
+	e.Use(openapi.NewValidator(openapi.NewDocument("1.0.0"), logger).Middleware())
+
+	return e
+}
+
+func serve(e *echo.Echo, method, target, body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, target, strings.NewReader(body))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+
+	return rec
+}
+
+func TestMiddleware_RejectsRequestsThatBreakTheContract(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	logger.EXPECT().Warn("request does not match the OpenAPI document", gomock.Any()).Times(1)
+
+	e := newValidatedEcho(t, logger)
+	e.POST("/api/forms", func(c echo.Context) error {
+		t.Fatal("handler must not run for an invalid request")
+
+		return nil
+	})
+
+	rec := serve(e, http.MethodPost, "/api/forms", `{"title":"","extra":true}`)
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentType), "application/problem+json")
+	assert.Contains(t, rec.Body.String(), "body.title")
+	assert.Contains(t, rec.Body.String(), "body.extra")
+}
+
+func TestMiddleware_PassesValidRequestsWithTheirBody(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+
+	e := newValidatedEcho(t, logger)
+	e.POST("/api/forms", func(c echo.Context) error {
+		body, err := io.ReadAll(c.Request().Body)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"title":"Contact"}`, string(body))
+
+		return c.JSON(http.StatusCreated, response.APIResponse{Success: true, Data: map[string]any{"form": map[string]any{
+			"id": "f1", "title": "Contact", "description": "", "status": "draft", "schema": map[string]any{}, "tags": nil,
+			"created_at": "2026-01-02T03:04:05Z", "updated_at": "2026-01-02T03:04:05Z",
+		}}})
+	})
+
+	rec := serve(e, http.MethodPost, "/api/forms", `{"title":"Contact"}`)
+
+	assert.Equal(t, http.StatusCreated, rec.Code)
+}
+
+func TestMiddleware_LogsResponsesThatBreakTheContract(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	logger.EXPECT().Warn("response does not match the OpenAPI document",
+		"operation", "countForms", "status", http.StatusOK, "errors", []string{"body.data.count: must be integer"}).Times(1)
+
+	e := newValidatedEcho(t, logger)
+	e.GET("/api/forms/usage/forms-count", func(c echo.Context) error {
+		return response.Success(c, map[string]any{"count": "five"})
+	})
+
+	rec := serve(e, http.MethodGet, "/api/forms/usage/forms-count", "")
+
+	// Response drift is reported, not enforced
+	assert.Equal(t, http.StatusOK, rec.Code)
+}
+
+func TestMiddleware_ChecksRenderedErrors(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+
+	e := newValidatedEcho(t, logger)
+	e.HTTPErrorHandler = func(err error, c echo.Context) {
+		if c.Response().Committed {
+			return
+		}
+
+		_ = response.ErrorResponse(c, http.StatusNotFound, err.Error())
+	}
+	e.GET("/api/forms/:id", func(c echo.Context) error {
+		return echo.ErrNotFound
+	})
+
+	rec := serve(e, http.MethodGet, "/api/forms/f1", "")
+
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Contains(t, rec.Header().Get(echo.HeaderContentType), "application/problem+json")
+}
+
+func TestMiddleware_IgnoresUndocumentedRoutes(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+
+	e := newValidatedEcho(t, logger)
+	e.POST("/internal/debug", func(c echo.Context) error {
+		return c.String(http.StatusTeapot, "not described")
+	})
+
+	rec := serve(e, http.MethodPost, "/internal/debug", `{"anything":true}`)
+
+	assert.Equal(t, http.StatusTeapot, rec.Code)
+}
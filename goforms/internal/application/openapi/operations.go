@@ -0,0 +1,392 @@
+package openapi
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/goformx/goforms/internal/application/constants"
+	"github.com/goformx/goforms/internal/application/response"
+	formdomain "github.com/goformx/goforms/internal/domain/form"
+)
+
+// Operation tags
+const (
+	tagForms       = "forms"
+	tagSubmissions = "submissions"
+	tagBulk        = "bulk"
+	tagReview      = "review"
+	tagAudit       = "audit"
+	tagAPIKeys     = "api-keys"
+	tagWorkspaces  = "workspaces"
+	tagPublic      = "public"
+	tagServer      = "server"
+	tagSessions    = "sessions"
+	tagSystem      = "system"
+)
+
+const (
+	idempotencyKeyHeader = "Idempotency-Key"
+	jsonContentType      = "application/json"
+	formContentType      = "application/x-www-form-urlencoded"
+	htmlContentType      = "text/html"
+)
+
+var (
+	assertionAuth = []SecurityRequirement{{SchemeAssertion: {}}}
+	serverKeyAuth = []SecurityRequirement{{SchemeAPIKey: {}}, {SchemeBearerKey: {}}}
+	// publicKeyAuth is optional: the configured API key is only checked when API key auth is enabled
+	publicKeyAuth = []SecurityRequirement{{}, {SchemeAPIKey: {}}}
+	sessionAuth   = []SecurityRequirement{{SchemeSession: {}}}
+	noAuth        = []SecurityRequirement{}
+)
+
+// addFormRoutes documents the /api/forms routes the Laravel app calls with signed assertions
+func (d *Document) addFormRoutes() {
+	base := constants.PathAPIFormsLaravel
+
+	d.add(http.MethodGet, base, op("listForms", "List the forms of the user or active workspace", tagForms).
+		params(formListParams()...).ok(ref("FormPage")))
+	d.add(http.MethodPost, base, op("createForm", "Create a form", tagForms).
+		idempotent().body(ref("FormCreateRequest")).created(object([]string{"form"}, props{"form": ref("Form")})))
+	d.add(http.MethodGet, base+"/usage/forms-count", op("countForms", "Count the user's forms", tagForms).ok(ref("Count")))
+	d.add(http.MethodGet, base+"/usage/submissions-count", op("countSubmissions", "Count the user's submissions in a month", tagForms).
+		params(query("month", withDescription(str(), "Month as YYYY-MM; defaults to the current month"))).ok(ref("Count")))
+
+	d.add(http.MethodGet, base+"/audit", op("listAuditLog", "List the audit log of the user's resources", tagAudit).
+		params(auditParams()...).ok(ref("AuditPage")))
+	d.add(http.MethodGet, base+"/audit/verify", op("verifyAuditLog", "Verify the audit log hash chain (administrators only)", tagAudit).
+		ok(ref("AuditVerification")).
+		respond(http.StatusConflict, "The hash chain is broken", jsonContentType, envelope(ref("AuditVerification"))))
+
+	formPath := base + "/{id}"
+	formResult := object([]string{"form"}, props{"form": ref("Form")})
+
+	d.add(http.MethodGet, formPath, op("getForm", "Get a form", tagForms).params(pathParam("id")).ok(formResult))
+	d.add(http.MethodPut, formPath, op("updateForm", "Update a form", tagForms).
+		params(pathParam("id")).body(ref("FormUpdateRequest")).ok(formResult))
+	d.add(http.MethodDelete, formPath, op("deleteForm", "Delete a form", tagForms).params(pathParam("id")).noContent())
+	d.add(http.MethodGet, formPath+"/submissions", op("listSubmissions", "List a form's submissions", tagSubmissions).
+		params(append([]*Parameter{pathParam("id")}, submissionListParams()...)...).ok(ref("SubmissionPage")))
+	d.add(http.MethodGet, formPath+"/submissions/{sid}", op("getSubmission", "Get a submission", tagSubmissions).
+		params(pathParam("id"), pathParam("sid")).ok(ref("Submission")))
+	d.add(http.MethodGet, formPath+"/submissions/stream", op("streamSubmissions", "Stream a form's submission events", tagSubmissions).
+		params(pathParam("id"), &Parameter{
+			Name:        "Last-Event-ID",
+			In:          "header",
+			Description: "ID of the last event received; the events missed since are replayed, or a reset event is sent",
+			Schema:      str(),
+		}).
+		respond(http.StatusOK, "Server-sent events: ready, reset, submission, status, assignment and analytics", "text/event-stream", str()).
+		respond(http.StatusTooManyRequests, "The user has too many streams open", response.ProblemContentType, ref("Problem")))
+	d.add(http.MethodGet, formPath+"/audit", op("listFormAuditLog", "List a form's audit log", tagAudit).
+		params(append([]*Parameter{pathParam("id")}, auditParams()...)...).ok(ref("AuditPage")))
+
+	d.addBulkRoutes(formPath)
+	d.addReviewRoutes(formPath)
+	d.addAPIKeyRoutes(formPath, "Form")
+}
+
+// addBulkRoutes documents the bulk submission job routes
+func (d *Document) addBulkRoutes(formPath string) {
+	bulkPath := formPath + "/submissions/bulk"
+	jobPath := bulkPath + "/{jobId}"
+	queued := "The job is running; poll the Location header"
+
+	d.add(http.MethodGet, bulkPath, op("listBulkJobs", "List a form's recent bulk jobs", tagBulk).
+		params(pathParam("id"), query("limit", atLeast(integer(), 1))).ok(ref("BulkJobList")))
+	d.add(http.MethodPost, bulkPath, op("startBulkJob", "Apply an operation to selected submissions", tagBulk).
+		params(pathParam("id")).body(ref("BulkRequest")).ok(ref("BulkJobStatus")).
+		accepted(queued, ref("BulkJobStatus")))
+	d.add(http.MethodGet, jobPath, op("getBulkJob", "Report a bulk job's progress", tagBulk).
+		params(pathParam("id"), pathParam("jobId")).ok(ref("BulkJobStatus")))
+	d.add(http.MethodPost, jobPath+"/retry", op("retryBulkJob", "Resume a failed bulk job", tagBulk).
+		params(pathParam("id"), pathParam("jobId")).ok(ref("BulkJobStatus")).
+		accepted(queued, ref("BulkJobStatus")))
+	d.add(http.MethodGet, jobPath+"/export", op("downloadBulkExport", "Download a finished export", tagBulk).
+		params(pathParam("id"), pathParam("jobId")).
+		respond(http.StatusOK, "The exported submissions", "text/csv", binary()).
+		respond(http.StatusOK, "The exported submissions", "application/x-ndjson", binary()))
+}
+
+// addReviewRoutes documents the submission review and note routes
+func (d *Document) addReviewRoutes(formPath string) {
+	submissionPath := formPath + "/submissions/{sid}"
+	notesPath := submissionPath + "/notes"
+
+	d.add(http.MethodPatch, submissionPath+"/review", op("updateSubmissionReview", "Change a submission's review state", tagReview).
+		params(pathParam("id"), pathParam("sid")).body(ref("ReviewRequest")).ok(ref("SubmissionReview")))
+	d.add(http.MethodGet, notesPath, op("listSubmissionNotes", "List a submission's internal notes", tagReview).
+		params(pathParam("id"), pathParam("sid")).ok(ref("NoteList")))
+	d.add(http.MethodPost, notesPath, op("addSubmissionNote", "Add an internal note to a submission", tagReview).
+		params(pathParam("id"), pathParam("sid")).body(ref("NoteRequest")).created(ref("Note")))
+	d.add(http.MethodDelete, notesPath+"/{noteId}", op("deleteSubmissionNote", "Delete one of the user's notes", tagReview).
+		params(pathParam("id"), pathParam("sid"), pathParam("noteId")).noContent())
+}
+
+// addAPIKeyRoutes documents the API key routes of a form or workspace
+func (d *Document) addAPIKeyRoutes(ownerPath, owner string) {
+	keysPath := ownerPath + "/api-keys"
+	scope := strings.ToLower(owner)
+
+	d.add(http.MethodGet, keysPath, op("list"+owner+"APIKeys", "List the "+scope+"'s API keys", tagAPIKeys).
+		params(pathParam("id")).ok(ref("APIKeyList")))
+	d.add(http.MethodPost, keysPath, op("create"+owner+"APIKey", "Create an API key scoped to the "+scope, tagAPIKeys).
+		params(pathParam("id")).body(ref("APIKeyCreateRequest")).created(ref("CreatedAPIKey")))
+	d.add(http.MethodDelete, keysPath+"/{keyId}", op("revoke"+owner+"APIKey", "Revoke an API key", tagAPIKeys).
+		params(pathParam("id"), pathParam("keyId")).noContent())
+}
+
+// addWorkspaceRoutes documents the /api/workspaces routes
+func (d *Document) addWorkspaceRoutes() {
+	base := constants.PathAPIWorkspaces
+	workspacePath := base + "/{id}"
+	memberPath := workspacePath + "/members/{userId}"
+
+	d.add(http.MethodGet, base, op("listWorkspaces", "List the user's workspaces", tagWorkspaces).ok(ref("WorkspaceList")))
+	d.add(http.MethodPost, base, op("createWorkspace", "Create a workspace owned by the user", tagWorkspaces).
+		body(ref("WorkspaceRequest")).created(object([]string{"workspace"}, props{"workspace": ref("Workspace")})))
+	d.add(http.MethodGet, workspacePath, op("getWorkspace", "Get a workspace and the user's role in it", tagWorkspaces).
+		params(pathParam("id")).ok(ref("WorkspaceDetail")))
+	d.add(http.MethodGet, workspacePath+"/members", op("listMembers", "List a workspace's members", tagWorkspaces).
+		params(pathParam("id")).ok(object([]string{"members"}, props{"members": nullable(arrayOf(ref("Member")))})))
+	d.add(http.MethodPut, memberPath, op("setMemberRole", "Add a member or change their role", tagWorkspaces).
+		params(pathParam("id"), pathParam("userId")).body(ref("MemberRequest")).
+		ok(object([]string{"member"}, props{"member": ref("Member")})))
+	d.add(http.MethodDelete, memberPath, op("removeMember", "Remove a member", tagWorkspaces).
+		params(pathParam("id"), pathParam("userId")).noContent())
+
+	d.addAPIKeyRoutes(workspacePath, "Workspace")
+}
+
+// addPublicRoutes documents the /forms routes embeds and plain HTML forms call from browsers
+func (d *Document) addPublicRoutes() {
+	formPath := constants.PathFormsPublic + "/{id}"
+
+	d.add(http.MethodGet, formPath+"/schema", op("getFormSchema", "Get a form's Form.io schema", tagPublic).
+		secured(publicKeyAuth).params(pathParam("id")).ok(ref("FormSchema")))
+	d.add(http.MethodGet, formPath+"/validation", op("getFormValidation", "Get a form's client-side validation rules", tagPublic).
+		secured(publicKeyAuth).params(pathParam("id")).ok(ref("FormValidation")))
+
+	submit := op("submitForm", "Submit a response to a form", tagPublic).
+		secured(publicKeyAuth).idempotent().params(pathParam("id")).
+		body(ref("SubmissionData")).
+		ok(ref("SubmissionReceipt")).
+		respond(http.StatusSeeOther, "A plain HTML form was accepted; redirects back to the form", "", nil).
+		respond(http.StatusBadRequest, "A plain HTML form was rejected; the form is shown with the errors", htmlContentType, str()).
+		respond(http.StatusUnprocessableEntity, "A plain HTML form was invalid; the form is shown with the errors", htmlContentType, str())
+	submit.RequestBody.Content[formContentType] = &MediaType{Schema: withValues(freeObject(), str())}
+	d.add(http.MethodPost, formPath+"/submit", submit)
+
+	d.add(http.MethodGet, formPath+"/embed", op("getFormEmbed", "Render the embeddable form page", tagPublic).
+		secured(publicKeyAuth).params(pathParam("id"), query("origin", withDescription(str(), "Origin of the embedding page"))).
+		respond(http.StatusOK, "The embed page", htmlContentType, str()))
+	d.add(http.MethodGet, formPath+"/html", op("getFormHTML", "Render the form as plain HTML", tagPublic).
+		secured(publicKeyAuth).params(pathParam("id"), query("submitted", enum("1"))).
+		respond(http.StatusOK, "The form page", htmlContentType, str()))
+	d.add(http.MethodGet, constants.PathEmbedAssets+"/{version}/{path}", op("getEmbedAsset", "Download a renderer asset", tagPublic).
+		secured(noAuth).params(pathParam("version"), pathParam("path")).
+		respond(http.StatusOK, "The asset", "*/*", binary()))
+}
+
+// addServerRoutes documents the /api/server/forms routes backends call with scoped API keys
+func (d *Document) addServerRoutes() {
+	formPath := constants.PathAPIServerForms + "/{id}"
+	formResult := object([]string{"form"}, props{"form": ref("Form")})
+
+	d.add(http.MethodGet, formPath, op("serverGetForm", "Get a form", tagServer).
+		secured(serverKeyAuth).params(pathParam("id")).ok(formResult))
+	d.add(http.MethodPut, formPath, op("serverUpdateForm", "Update a form", tagServer).
+		secured(serverKeyAuth).params(pathParam("id")).body(ref("FormUpdateRequest")).ok(formResult))
+	d.add(http.MethodGet, formPath+"/submissions", op("serverListSubmissions", "List a form's submissions", tagServer).
+		secured(serverKeyAuth).params(append([]*Parameter{pathParam("id")}, submissionListParams()...)...).
+		ok(ref("SubmissionPage")))
+	d.add(http.MethodPost, formPath+"/submissions", op("serverCreateSubmission", "Create a submission", tagServer).
+		secured(serverKeyAuth).params(pathParam("id")).body(ref("SubmissionData")).ok(ref("SubmissionReceipt")))
+	d.add(http.MethodGet, formPath+"/submissions/{sid}", op("serverGetSubmission", "Get a submission", tagServer).
+		secured(serverKeyAuth).params(pathParam("id"), pathParam("sid")).ok(ref("Submission")))
+}
+
+// addSessionRoutes documents dashboard sign-in, sign-out and role changes
+func (d *Document) addSessionRoutes() {
+	userResult := object([]string{"user"}, props{"user": ref("SessionUser")})
+
+	d.add(http.MethodPost, constants.PathLoginPost, op("login", "Start a session; the session cookie is set with a new ID", tagSessions).
+		secured(noAuth).body(ref("LoginRequest")).ok(userResult))
+	d.add(http.MethodPost, constants.PathLogout, op("logout", "End the current session", tagSessions).
+		secured(sessionAuth).noContent())
+	d.add(http.MethodPost, constants.PathAPISessionsLogoutAll, op("logoutAll", "End every session of the signed-in user", tagSessions).
+		secured(sessionAuth).ok(object([]string{"sessions_ended"}, props{"sessions_ended": integer()})))
+	d.add(http.MethodPut, constants.PathAPIAdminUsers+"/{id}/role",
+		op("updateUserRole", "Change a user's role and end the user's sessions (administrators only)", tagSessions).
+			secured(sessionAuth).params(pathParam("id")).body(ref("RoleRequest")).
+			ok(object([]string{"user", "sessions_ended"}, props{"user": ref("SessionUser"), "sessions_ended": integer()})))
+}
+
+// addSystemRoutes documents health, configuration and the document itself
+func (d *Document) addSystemRoutes() {
+	health := func(id string) *Operation {
+		return op(id, "Report that the server is up", tagSystem).secured(noAuth).ok(ref("Health"))
+	}
+
+	d.add(http.MethodGet, constants.PathHealth, health("getHealth"))
+	d.add(http.MethodHead, constants.PathHealth, health("headHealth"))
+	d.add(http.MethodGet, constants.PathAPIAdminConfig, op("getAdminConfig", "Show the security configuration in effect", tagSystem).
+		secured(sessionAuth).ok(ref("AdminConfig")))
+	d.add(http.MethodGet, constants.PathOpenAPI, op("getOpenAPI", "Get this document", tagSystem).
+		secured(noAuth).respond(http.StatusOK, "The OpenAPI document", jsonContentType, freeObject()))
+}
+
+func formListParams() []*Parameter {
+	return append(pageParams(formdomain.SortCreated, formdomain.SortUpdated, formdomain.SortTitle, formdomain.SortSubmissions),
+		query("status", formStatus()),
+		query("tag", str()),
+		query("q", withDescription(str(), "Search in titles")),
+	)
+}
+
+func submissionListParams() []*Parameter {
+	return append(pageParams(formdomain.SortSubmitted, formdomain.SortCreated, formdomain.SortUpdated),
+		query("status", submissionStatus()),
+		query("review_status", str()),
+		query("assignee", withDescription(str(), "A user ID, me or none")),
+		query("tag", str()),
+	)
+}
+
+func pageParams(sorts ...string) []*Parameter {
+	return []*Parameter{
+		query("limit", withDescription(atLeast(integer(), 1),
+			"Page size, capped at "+strconv.Itoa(formdomain.MaxPageSize)+"; defaults to "+strconv.Itoa(formdomain.DefaultPageSize)+
+				". Follow next_cursor for the rest.")),
+		query("cursor", withDescription(str(), "next_cursor or prev_cursor of the previous page")),
+		query("sort", enum(sorts...)),
+		query("order", enum("asc", "desc")),
+	}
+}
+
+func auditParams() []*Parameter {
+	return []*Parameter{
+		query("actor", str()),
+		query("action", str()),
+		query("resource_type", str()),
+		query("resource_id", str()),
+		query("from", dateTime()),
+		query("to", dateTime()),
+		query("limit", atLeast(integer(), 0)),
+		query("offset", atLeast(integer(), 0)),
+	}
+}
+
+// add registers an operation on a path; every operation may fail with a problem
+func (d *Document) add(method, path string, operation *Operation) {
+	if _, ok := operation.Responses["default"]; !ok {
+		operation.Responses["default"] = &Response{
+			Description: "The request failed",
+			Content:     map[string]*MediaType{response.ProblemContentType: {Schema: ref("Problem")}},
+		}
+	}
+
+	item, ok := d.Paths[path]
+	if !ok {
+		item = &PathItem{}
+		d.Paths[path] = item
+	}
+
+	(*item)[strings.ToLower(method)] = operation
+}
+
+// op starts an operation that needs a signed assertion; secured replaces the requirement
+func op(id, summary, tag string) *Operation {
+	return &Operation{
+		OperationID: id,
+		Summary:     summary,
+		Tags:        []string{tag},
+		Responses:   map[string]*Response{},
+		Security:    assertionAuth,
+	}
+}
+
+func (o *Operation) secured(requirements []SecurityRequirement) *Operation {
+	o.Security = requirements
+
+	return o
+}
+
+func (o *Operation) params(parameters ...*Parameter) *Operation {
+	o.Parameters = append(o.Parameters, parameters...)
+
+	return o
+}
+
+func (o *Operation) body(schema *Schema) *Operation {
+	o.RequestBody = &RequestBody{Required: true, Content: map[string]*MediaType{jsonContentType: {Schema: schema}}}
+
+	return o
+}
+
+// idempotent documents the Idempotency-Key header and the responses of a reused key
+func (o *Operation) idempotent() *Operation {
+	o.Parameters = append(o.Parameters, &Parameter{
+		Name:        idempotencyKeyHeader,
+		In:          "header",
+		Description: "Retries with the same key and body replay the first response",
+		Schema:      withLength(withDescription(str(), "1 to 255 printable ASCII characters"), 1, 255),
+	})
+
+	return o
+}
+
+func (o *Operation) ok(data *Schema) *Operation {
+	return o.respond(http.StatusOK, "Success", jsonContentType, envelope(data))
+}
+
+func (o *Operation) created(data *Schema) *Operation {
+	return o.respond(http.StatusCreated, "Created", jsonContentType, envelope(data))
+}
+
+func (o *Operation) accepted(description string, data *Schema) *Operation {
+	o.respond(http.StatusAccepted, description, jsonContentType, envelope(data))
+	o.Responses[strconv.Itoa(http.StatusAccepted)].Headers = map[string]*Header{
+		"Location": {Description: "Path to poll", Schema: str()},
+	}
+
+	return o
+}
+
+func (o *Operation) noContent() *Operation {
+	return o.respond(http.StatusNoContent, "Done", "", nil)
+}
+
+// respond documents a response; responses with the same status collect their media types
+func (o *Operation) respond(status int, description, contentType string, schema *Schema) *Operation {
+	key := strconv.Itoa(status)
+
+	resp, ok := o.Responses[key]
+	if !ok {
+		resp = &Response{Description: description}
+		o.Responses[key] = resp
+	}
+
+	if contentType != "" {
+		if resp.Content == nil {
+			resp.Content = map[string]*MediaType{}
+		}
+
+		resp.Content[contentType] = &MediaType{Schema: schema}
+	}
+
+	return o
+}
+
+func pathParam(name string) *Parameter {
+	return &Parameter{Name: name, In: "path", Required: true, Schema: str()}
+}
+
+func query(name string, schema *Schema) *Parameter {
+	return &Parameter{Name: name, In: "query", Schema: schema}
+}
+
+func binary() *Schema {
+	return &Schema{Type: Types{"string"}, Format: "binary"}
+}
@@ -0,0 +1,544 @@
+package openapi
+
+import (
+	"github.com/goformx/goforms/internal/application/constants"
+	"github.com/goformx/goforms/internal/domain/apikey"
+	"github.com/goformx/goforms/internal/domain/bulk"
+	"github.com/goformx/goforms/internal/domain/common/plans"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/domain/workspace"
+)
+
+const refPrefix = "#/components/schemas/"
+
+// Title and description limits enforced by the form request processor
+const (
+	maxTitleLength       = 255
+	maxDescriptionLength = 1000
+)
+
+// Request body schemas are closed: a field the server ignores is a client bug. Response
+// schemas stay open so the server can add fields without breaking the contract.
+
+// componentSchemas returns the reusable schemas referenced by operations
+func componentSchemas() map[string]*Schema {
+	return map[string]*Schema{
+		"Problem":    problemSchema(),
+		"FieldError": object([]string{"field", "message"}, props{"field": str(), "message": str(), "rule": str()}),
+
+		"Form":           formSchema(),
+		"FormSummary":    formSummarySchema(),
+		"FormPage":       pageSchema("forms", ref("FormSummary")),
+		"FormSchema":     withDescription(freeObject(), "The Form.io schema of the form"),
+		"FormValidation": withDescription(freeObject(), "Client-side validation rules keyed by component key"),
+		"Pagination":     paginationSchema(),
+		"ReviewWorkflow": reviewWorkflowSchema(),
+		"Count":          object([]string{"count"}, props{"count": integer(), "month": str()}),
+
+		"Submission":        submissionSchema(),
+		"SubmissionPage":    pageSchema("submissions", ref("Submission")),
+		"SubmissionData":    withDescription(freeObject(), "Submitted values keyed by the form's component keys"),
+		"SubmissionReceipt": submissionReceiptSchema(),
+		"SubmissionReview":  submissionReviewSchema(),
+		"Note":              noteSchema(),
+		"NoteList":          listSchema("notes", arrayOf(ref("Note"))),
+
+		"BulkJob":       bulkJobSchema(),
+		"BulkJobStatus": bulkJobStatusSchema(),
+		"BulkJobList":   listSchema("jobs", arrayOf(ref("BulkJobStatus"))),
+
+		"AuditEntry":        auditEntrySchema(),
+		"AuditPage":         auditPageSchema(),
+		"AuditVerification": auditVerificationSchema(),
+
+		"APIKey":        apiKeySchema(),
+		"APIKeyList":    listSchema("api_keys", nullable(arrayOf(ref("APIKey")))),
+		"CreatedAPIKey": createdAPIKeySchema(),
+
+		"Workspace":       workspaceSchema(),
+		"WorkspaceList":   listSchema("workspaces", nullable(arrayOf(ref("Workspace")))),
+		"WorkspaceDetail": object([]string{"workspace", "role"}, props{"workspace": ref("Workspace"), "role": role()}),
+		"Member":          memberSchema(),
+
+		"Health":      object([]string{"status", "time"}, props{"status": str(), "time": dateTime()}),
+		"AdminConfig": adminConfigSchema(),
+		"SessionUser": object([]string{"id", "email", "role"}, props{"id": str(), "email": str(), "role": userRole()}),
+
+		"FormCreateRequest":   formCreateRequestSchema(),
+		"FormUpdateRequest":   formUpdateRequestSchema(),
+		"APIKeyCreateRequest": apiKeyCreateRequestSchema(),
+		"BulkRequest":         bulkRequestSchema(),
+		"ReviewRequest":       reviewRequestSchema(),
+		"NoteRequest":         closed(object([]string{"body"}, props{"body": withLength(str(), 1, 0)})),
+		"WorkspaceRequest":    closed(object([]string{"name"}, props{"name": withLength(str(), 1, 0)})),
+		"MemberRequest":       closed(object([]string{"role"}, props{"role": role()})),
+		"LoginRequest": closed(object([]string{"email", "password"}, props{
+			"email":    withLength(str(), 1, 0),
+			"password": withLength(str(), 1, 0),
+		})),
+		"RoleRequest": closed(object([]string{"role"}, props{"role": userRole()})),
+	}
+}
+
+func problemSchema() *Schema {
+	return object([]string{"type", "title", "status", "code"}, props{
+		"type":          withDescription(str(), "Stable URI naming the problem, derived from code"),
+		"title":         str(),
+		"status":        integer(),
+		"detail":        str(),
+		"instance":      withDescription(str(), "The request ID"),
+		"code":          str(),
+		"errors":        arrayOf(ref("FieldError")),
+		"limit_type":    str(),
+		"limit":         integer(),
+		"current":       integer(),
+		"feature":       str(),
+		"required_tier": enum(plans.TierFree, plans.TierPro, plans.TierBusiness, plans.TierGrowth, plans.TierEnterprise),
+	})
+}
+
+func formSchema() *Schema {
+	return object([]string{"id", "title", "description", "status", "schema", "tags", "created_at", "updated_at"}, props{
+		"id":              str(),
+		"title":           str(),
+		"description":     str(),
+		"status":          formStatus(),
+		"schema":          ref("FormSchema"),
+		"cors_origins":    nullable(freeObject()),
+		"tags":            nullable(arrayOf(str())),
+		"review_workflow": ref("ReviewWorkflow"),
+		"workspace_id":    str(),
+		"created_at":      dateTime(),
+		"updated_at":      dateTime(),
+	})
+}
+
+func formSummarySchema() *Schema {
+	return object([]string{"id", "title", "description", "status", "tags", "submission_count", "created_at", "updated_at"}, props{
+		"id":               str(),
+		"title":            str(),
+		"description":      str(),
+		"status":           formStatus(),
+		"tags":             nullable(arrayOf(str())),
+		"submission_count": integer(),
+		"created_at":       dateTime(),
+		"updated_at":       dateTime(),
+	})
+}
+
+func paginationSchema() *Schema {
+	return object([]string{"total", "limit", "sort", "order", "next_cursor", "prev_cursor"}, props{
+		"total":       integer(),
+		"limit":       withDescription(integer(), "Page size"),
+		"sort":        str(),
+		"order":       enum("asc", "desc"),
+		"next_cursor": withDescription(str(), "Cursor of the next page; empty on the last page"),
+		"prev_cursor": withDescription(str(), "Cursor of the previous page; empty on the first page"),
+	})
+}
+
+func reviewWorkflowSchema() *Schema {
+	status := object([]string{"key", "label"}, props{"key": withLength(str(), 1, 0), "label": str()})
+
+	workflow := object([]string{"statuses", "initial"}, props{
+		"statuses":    arrayOf(status),
+		"initial":     str(),
+		"transitions": withValues(freeObject(), arrayOf(str())),
+	})
+	workflow.Description = "Review statuses, the status of new submissions and the moves allowed from each status"
+
+	return workflow
+}
+
+func submissionSchema() *Schema {
+	return object([]string{"id", "form_id", "status", "review_status", "submitted_at", "data"}, props{
+		"id":            str(),
+		"form_id":       str(),
+		"status":        submissionStatus(),
+		"review_status": str(),
+		"assignee_id":   str(),
+		"tags":          nullable(arrayOf(str())),
+		"submitted_at":  dateTime(),
+		"data":          ref("SubmissionData"),
+	})
+}
+
+func submissionReviewSchema() *Schema {
+	return object([]string{"id", "form_id", "review_status", "assignee_id", "tags"}, props{
+		"id":            str(),
+		"form_id":       str(),
+		"review_status": str(),
+		"assignee_id":   str(),
+		"tags":          arrayOf(str()),
+	})
+}
+
+func noteSchema() *Schema {
+	return object([]string{"id", "submission_id", "author_id", "body", "created_at"}, props{
+		"id":            str(),
+		"submission_id": str(),
+		"author_id":     str(),
+		"body":          str(),
+		"created_at":    dateTime(),
+	})
+}
+
+func bulkJobSchema() *Schema {
+	selection := object(nil, props{
+		"ids":              arrayOf(str()),
+		"status":           submissionStatus(),
+		"submitted_after":  dateTime(),
+		"submitted_before": dateTime(),
+	})
+
+	required := []string{"id", "form_id", "created_by", "operation", "selection", "status", "total", "processed", "created_at", "updated_at"}
+
+	return object(required, props{
+		"id":            str(),
+		"form_id":       str(),
+		"created_by":    str(),
+		"operation":     bulkOperation(),
+		"selection":     selection,
+		"target_status": submissionStatus(),
+		"export_format": enum(bulk.FormatCSV, bulk.FormatNDJSON),
+		"status":        bulkJobStatus(),
+		"total":         integer(),
+		"processed":     integer(),
+		"error":         str(),
+		"created_at":    dateTime(),
+		"updated_at":    dateTime(),
+		"started_at":    dateTime(),
+		"completed_at":  dateTime(),
+	})
+}
+
+func bulkJobStatusSchema() *Schema {
+	return object([]string{"job", "progress", "url"}, props{
+		"job":          ref("BulkJob"),
+		"progress":     withRange(integer(), 0, 100),
+		"url":          withDescription(str(), "Path to poll for the job's progress"),
+		"download_url": withDescription(str(), "Path of the finished export"),
+	})
+}
+
+func auditEntrySchema() *Schema {
+	return object([]string{"id", "sequence", "occurred_at", "actor_id", "action", "resource_type", "resource_id", "prev_hash", "hash"}, props{
+		"id":            str(),
+		"sequence":      integer(),
+		"occurred_at":   dateTime(),
+		"actor_id":      str(),
+		"owner_id":      str(),
+		"ip_address":    str(),
+		"request_id":    str(),
+		"action":        str(),
+		"resource_type": str(),
+		"resource_id":   str(),
+		"before":        freeObject(),
+		"after":         freeObject(),
+		"prev_hash":     str(),
+		"hash":          str(),
+	})
+}
+
+func auditVerificationSchema() *Schema {
+	return object([]string{"valid", "checked", "last_sequence", "last_hash"}, props{
+		"valid":         boolean(),
+		"checked":       integer(),
+		"broken_at":     integer(),
+		"reason":        str(),
+		"last_sequence": integer(),
+		"last_hash":     str(),
+	})
+}
+
+func apiKeySchema() *Schema {
+	return object([]string{"id", "name", "prefix", "permissions", "created_by", "created_at", "updated_at"}, props{
+		"id":           str(),
+		"name":         str(),
+		"prefix":       withDescription(str(), "The first characters of the key, to tell keys apart"),
+		"form_id":      str(),
+		"workspace_id": str(),
+		"permissions":  nullable(arrayOf(apiKeyPermission())),
+		"created_by":   str(),
+		"expires_at":   dateTime(),
+		"last_used_at": dateTime(),
+		"revoked_at":   dateTime(),
+		"created_at":   dateTime(),
+		"updated_at":   dateTime(),
+	})
+}
+
+func submissionReceiptSchema() *Schema {
+	return object([]string{"submission_id", "status", "submitted_at"}, props{
+		"submission_id": str(),
+		"status":        submissionStatus(),
+		"submitted_at":  dateTime(),
+	})
+}
+
+func auditPageSchema() *Schema {
+	return object([]string{"entries", "total", "limit", "offset"}, props{
+		"entries": nullable(arrayOf(ref("AuditEntry"))),
+		"total":   integer(),
+		"limit":   integer(),
+		"offset":  integer(),
+	})
+}
+
+func createdAPIKeySchema() *Schema {
+	return object([]string{"api_key", "key"}, props{
+		"api_key": ref("APIKey"),
+		"key":     withDescription(str(), "The key itself; it is shown only once"),
+	})
+}
+
+func workspaceSchema() *Schema {
+	return object([]string{"id", "name", "created_by", "plan_tier", "created_at", "updated_at"}, props{
+		"id":         str(),
+		"name":       str(),
+		"created_by": str(),
+		"plan_tier":  withDescription(str(), "Plan of the workspace's owners, which its forms count against"),
+		"created_at": dateTime(),
+		"updated_at": dateTime(),
+	})
+}
+
+func memberSchema() *Schema {
+	return object([]string{"workspace_id", "user_id", "role", "created_at", "updated_at"}, props{
+		"workspace_id": str(),
+		"user_id":      str(),
+		"role":         role(),
+		"created_at":   dateTime(),
+		"updated_at":   dateTime(),
+	})
+}
+
+func adminConfigSchema() *Schema {
+	return object([]string{"security", "loaded_at", "reloadable"}, props{
+		"security":   withDescription(freeObject(), "The security configuration in effect, with secrets redacted"),
+		"loaded_at":  dateTime(),
+		"reloadable": arrayOf(str()),
+	})
+}
+
+func formCreateRequestSchema() *Schema {
+	return closed(object([]string{"title"}, props{
+		"title": withLength(str(), 1, maxTitleLength),
+		"tags":  tags(),
+	}))
+}
+
+func formUpdateRequestSchema() *Schema {
+	status := enum("", "draft", "published", "archived")
+	status.Description = "Publishing requires cors_origins; empty keeps the current status"
+
+	return closed(object([]string{"title"}, props{
+		"title":           withLength(str(), 1, maxTitleLength),
+		"description":     withLength(str(), 0, maxDescriptionLength),
+		"status":          status,
+		"cors_origins":    withDescription(str(), "Comma-separated origins allowed to embed and submit the form"),
+		"schema":          ref("FormSchema"),
+		"tags":            withDescription(tags(), "Replaces the form's tags; omit to keep them"),
+		"review_workflow": ref("ReviewWorkflow"),
+	}))
+}
+
+func apiKeyCreateRequestSchema() *Schema {
+	return closed(object([]string{"name"}, props{
+		"name":        withLength(str(), 1, 100),
+		"permissions": arrayOf(apiKeyPermission()),
+		"expires_at":  nullable(dateTime()),
+	}))
+}
+
+func bulkRequestSchema() *Schema {
+	filter := closed(object(nil, props{
+		"status":           submissionStatus(),
+		"submitted_after":  nullable(dateTime()),
+		"submitted_before": nullable(dateTime()),
+	}))
+	filter.Description = "Selects submissions by status and submission time; {} selects all"
+
+	request := closed(object([]string{"operation"}, props{
+		"operation": bulkOperation(),
+		"ids":       arrayOf(str()),
+		"filter":    filter,
+		"status":    withDescription(submissionStatus(), "Target status of set_status"),
+		"format":    withDescription(enum(bulk.FormatCSV, bulk.FormatNDJSON), "File format of export"),
+	}))
+	request.Description = "Give either ids or filter"
+
+	return request
+}
+
+func reviewRequestSchema() *Schema {
+	return closed(object(nil, props{
+		"status":      nullable(str()),
+		"assignee_id": withDescription(nullable(str()), "A member who can review the form's submissions; empty unassigns"),
+		"tags":        arrayOf(str()),
+	}))
+}
+
+func formStatus() *Schema {
+	return enum("draft", "published", "archived")
+}
+
+func submissionStatus() *Schema {
+	return enum(
+		string(model.SubmissionStatusPending), string(model.SubmissionStatusProcessing),
+		string(model.SubmissionStatusCompleted), string(model.SubmissionStatusFailed), string(model.SubmissionStatusSpam),
+	)
+}
+
+func bulkOperation() *Schema {
+	return enum(
+		string(bulk.OperationDelete), string(bulk.OperationSetStatus), string(bulk.OperationMarkSpam),
+		string(bulk.OperationReplayWebhooks), string(bulk.OperationExport),
+	)
+}
+
+func bulkJobStatus() *Schema {
+	return enum(string(bulk.StatusQueued), string(bulk.StatusRunning), string(bulk.StatusCompleted), string(bulk.StatusFailed))
+}
+
+func apiKeyPermission() *Schema {
+	return enum(string(apikey.PermissionSubmit), string(apikey.PermissionReadSubmissions), string(apikey.PermissionManageForm))
+}
+
+// userRole is the role of a user account, as opposed to a workspace role
+func userRole() *Schema {
+	return enum(constants.UserRoleUser, constants.UserRoleModerator, constants.UserRoleAdmin)
+}
+
+func role() *Schema {
+	return enum(
+		string(workspace.RoleOwner), string(workspace.RoleEditor),
+		string(workspace.RoleViewer), string(workspace.RoleSubmissionsOnly),
+	)
+}
+
+// envelope wraps data in the APIResponse body every JSON success response uses
+func envelope(data *Schema) *Schema {
+	schema := object([]string{"success"}, props{"success": boolean(), "message": str()})
+	if data != nil {
+		schema.Properties["data"] = data
+		schema.Required = append(schema.Required, "data")
+	}
+
+	return schema
+}
+
+// pageSchema is one page of a keyset-paginated listing
+func pageSchema(name string, item *Schema) *Schema {
+	return object([]string{name, "count", "pagination"}, props{
+		name:         arrayOf(item),
+		"count":      integer(),
+		"pagination": ref("Pagination"),
+	})
+}
+
+// listSchema is an unpaginated listing with its length
+func listSchema(name string, items *Schema) *Schema {
+	return object([]string{name, "count"}, props{name: items, "count": integer()})
+}
+
+func tags() *Schema {
+	return arrayOf(withLength(str(), 0, model.MaxTagLength))
+}
+
+type props = map[string]*Schema
+
+func ref(name string) *Schema {
+	return &Schema{Ref: refPrefix + name}
+}
+
+func str() *Schema {
+	return &Schema{Type: Types{"string"}}
+}
+
+func dateTime() *Schema {
+	return &Schema{Type: Types{"string"}, Format: "date-time"}
+}
+
+func integer() *Schema {
+	return &Schema{Type: Types{"integer"}}
+}
+
+func boolean() *Schema {
+	return &Schema{Type: Types{"boolean"}}
+}
+
+func enum(values ...string) *Schema {
+	schema := str()
+	for _, value := range values {
+		schema.Enum = append(schema.Enum, value)
+	}
+
+	return schema
+}
+
+func arrayOf(items *Schema) *Schema {
+	return &Schema{Type: Types{"array"}, Items: items}
+}
+
+func object(required []string, properties props) *Schema {
+	return &Schema{Type: Types{"object"}, Required: required, Properties: properties}
+}
+
+func freeObject() *Schema {
+	return &Schema{Type: Types{"object"}}
+}
+
+// nullable also allows null, which Go writes for nil slices, maps and pointers
+func nullable(schema *Schema) *Schema {
+	schema.Type = append(schema.Type, "null")
+	if schema.Enum != nil {
+		schema.Enum = append(schema.Enum, nil)
+	}
+
+	return schema
+}
+
+func closed(schema *Schema) *Schema {
+	schema.AdditionalProperties = false
+
+	return schema
+}
+
+func withValues(schema, values *Schema) *Schema {
+	schema.AdditionalProperties = values
+
+	return schema
+}
+
+func withDescription(schema *Schema, description string) *Schema {
+	schema.Description = description
+
+	return schema
+}
+
+// withLength bounds a string's length; a zero maximum leaves it unbounded
+func withLength(schema *Schema, minLength, maxLength int) *Schema {
+	if minLength > 0 {
+		schema.MinLength = &minLength
+	}
+
+	if maxLength > 0 {
+		schema.MaxLength = &maxLength
+	}
+
+	return schema
+}
+
+func atLeast(schema *Schema, minimum float64) *Schema {
+	schema.Minimum = &minimum
+
+	return schema
+}
+
+func withRange(schema *Schema, minimum, maximum float64) *Schema {
+	schema.Minimum = &minimum
+	schema.Maximum = &maximum
+
+	return schema
+}
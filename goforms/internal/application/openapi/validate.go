@@ -0,0 +1,41 @@
+package openapi
+
+import (
+	"strconv"
+
+	"github.com/goformx/goforms/internal/infrastructure/jsonschema"
+)
+
+// Validate checks a value decoded from JSON against a schema, following $refs to the document's
+// components, and returns every violation
+func (d *Document) Validate(schema *Schema, value any, path string) []ValidationError {
+	return jsonschema.Validate(schema, value, path, d.Resolve)
+}
+
+// ValidateParameter checks the raw string value of a query, path or header parameter
+func (d *Document) ValidateParameter(param *Parameter, raw string) []ValidationError {
+	path := param.In + "." + param.Name
+	schema := d.Resolve(param.Schema)
+
+	var value any = raw
+
+	switch {
+	case schema == nil:
+	case schema.Type.Has("integer"):
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return []ValidationError{{Path: path, Message: "must be an integer", Rule: "type"}}
+		}
+
+		value = float64(n)
+	case schema.Type.Has("boolean"):
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return []ValidationError{{Path: path, Message: "must be true or false", Rule: "type"}}
+		}
+
+		value = b
+	}
+
+	return d.Validate(schema, value, path)
+}
@@ -0,0 +1,175 @@
+package openapi_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goformx/goforms/internal/application/openapi"
+)
+
+func decode(t *testing.T, body string) any {
+	t.Helper()
+
+	var value any
+	require.NoError(t, json.Unmarshal([]byte(body), &value))
+
+	return value
+}
+
+func requestSchema(t *testing.T, doc *openapi.Document, method, path string) *openapi.Schema {
+	t.Helper()
+
+	op, ok := doc.Lookup(method, path)
+	require.True(t, ok, "%s %s is not documented", method, path)
+	require.NotNil(t, op.RequestBody)
+
+	return op.RequestBody.Content["application/json"].Schema
+}
+
+func TestDocument_MarshalsAsOpenAPI31(t *testing.T) {
+	doc := openapi.NewDocument("1.0.0")
+
+	body, err := json.Marshal(doc)
+	require.NoError(t, err)
+
+	var raw map[string]any
+	require.NoError(t, json.Unmarshal(body, &raw))
+
+	assert.Equal(t, "3.1.0", raw["openapi"])
+
+	schemas := raw["components"].(map[string]any)["schemas"].(map[string]any)
+	problem := schemas["Problem"].(map[string]any)
+	assert.Equal(t, "object", problem["type"], "a single type marshals as a string")
+
+	for path, item := range doc.Paths {
+		for method, op := range *item {
+			assert.NotEmpty(t, op.OperationID, "%s %s has no operation ID", method, path)
+			assert.Contains(t, op.Responses, "default", "%s %s has no default response", method, path)
+		}
+	}
+}
+
+func TestDocument_OperationIDsAreUnique(t *testing.T) {
+	doc := openapi.NewDocument("1.0.0")
+	seen := map[string]string{}
+
+	for path, item := range doc.Paths {
+		for method, op := range *item {
+			where := method + " " + path
+			if previous, ok := seen[op.OperationID]; ok {
+				t.Errorf("operation ID %q is used by %s and %s", op.OperationID, previous, where)
+			}
+
+			seen[op.OperationID] = where
+		}
+	}
+}
+
+func TestDocument_LookupConvertsRoutePaths(t *testing.T) {
+	doc := openapi.NewDocument("1.0.0")
+
+	op, ok := doc.Lookup(http.MethodGet, "/api/forms/:id/submissions/:sid")
+	require.True(t, ok)
+	assert.Equal(t, "getSubmission", op.OperationID)
+
+	_, ok = doc.Lookup(http.MethodPatch, "/api/forms/:id")
+	assert.False(t, ok)
+
+	assert.Equal(t, "/assets/embed/{version}/{path}", openapi.PathFromRoute("/assets/embed/:version/*"))
+}
+
+func TestDocument_SecuritySchemes(t *testing.T) {
+	doc := openapi.NewDocument("1.0.0")
+
+	listForms, ok := doc.Lookup(http.MethodGet, "/api/forms")
+	require.True(t, ok)
+	assert.Equal(t, []openapi.SecurityRequirement{{openapi.SchemeAssertion: {}}}, listForms.Security)
+
+	spec, ok := doc.Lookup(http.MethodGet, "/openapi.json")
+	require.True(t, ok)
+	assert.Empty(t, spec.Security)
+	assert.NotNil(t, spec.Security, "an empty list overrides any global requirement")
+}
+
+func TestValidate_AcceptsValidRequest(t *testing.T) {
+	doc := openapi.NewDocument("1.0.0")
+	schema := requestSchema(t, doc, http.MethodPost, "/api/forms")
+
+	errs := doc.Validate(schema, decode(t, `{"title":"Contact","tags":["sales"]}`), "body")
+
+	assert.Empty(t, errs)
+}
+
+func TestValidate_ReportsEveryViolation(t *testing.T) {
+	doc := openapi.NewDocument("1.0.0")
+	schema := requestSchema(t, doc, http.MethodPost, "/api/forms")
+
+	errs := doc.Validate(schema, decode(t, `{"title":"","tags":["ok",7],"owner":"x"}`), "body")
+
+	require.Len(t, errs, 3)
+	assert.Equal(t, openapi.ValidationError{Path: "body.owner", Message: "is not allowed", Rule: "additionalProperties"}, errs[0])
+	assert.Equal(t, openapi.ValidationError{Path: "body.tags[1]", Message: "must be string", Rule: "type"}, errs[1])
+	assert.Equal(t, openapi.ValidationError{Path: "body.title", Message: "must not be empty", Rule: "minLength"}, errs[2])
+}
+
+func TestValidate_RequiredAndEnum(t *testing.T) {
+	doc := openapi.NewDocument("1.0.0")
+	schema := requestSchema(t, doc, http.MethodPut, "/api/forms/:id")
+
+	errs := doc.Validate(schema, decode(t, `{"status":"live"}`), "body")
+
+	require.Len(t, errs, 2)
+	assert.Equal(t, "body.title", errs[0].Path)
+	assert.Equal(t, "required", errs[0].Rule)
+	assert.Equal(t, "body.status", errs[1].Path)
+	assert.Equal(t, "enum", errs[1].Rule)
+	assert.Contains(t, errs[1].Message, "draft, published, archived")
+}
+
+func TestValidate_ResolvesReferencesAndNullable(t *testing.T) {
+	doc := openapi.NewDocument("1.0.0")
+	form := &openapi.Schema{Ref: "#/components/schemas/Form"}
+
+	const valid = `{"id":"f1","title":"Contact","description":"","status":"draft","schema":{},"tags":null,` +
+		`"created_at":"2026-01-02T03:04:05Z","updated_at":"2026-01-02T03:04:05Z"}`
+	assert.Empty(t, doc.Validate(form, decode(t, valid), "data"))
+
+	invalid := decode(t, strings.Replace(valid, `"created_at":"2026-01-02T03:04:05Z"`, `"created_at":"yesterday"`, 1))
+	errs := doc.Validate(form, invalid, "data")
+	require.Len(t, errs, 1)
+	assert.Equal(t, "data.created_at", errs[0].Path)
+	assert.Equal(t, "format", errs[0].Rule)
+}
+
+func TestValidateParameter_ConvertsTypes(t *testing.T) {
+	doc := openapi.NewDocument("1.0.0")
+
+	op, ok := doc.Lookup(http.MethodGet, "/api/forms")
+	require.True(t, ok)
+
+	var limit *openapi.Parameter
+
+	for _, param := range op.Parameters {
+		if param.Name == "limit" {
+			limit = param
+		}
+	}
+
+	require.NotNil(t, limit)
+
+	assert.Empty(t, doc.ValidateParameter(limit, "25"))
+
+	errs := doc.ValidateParameter(limit, "ten")
+	require.Len(t, errs, 1)
+	assert.Equal(t, "query.limit", errs[0].Path)
+	assert.Equal(t, "type", errs[0].Rule)
+
+	errs = doc.ValidateParameter(limit, "0")
+	require.Len(t, errs, 1)
+	assert.Equal(t, "minimum", errs[0].Rule)
+}
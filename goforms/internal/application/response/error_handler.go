@@ -3,6 +3,7 @@
 package response
 
 import (
+	"errors"
 	"fmt"
 	"net/http"
 
@@ -27,30 +28,20 @@ func NewErrorHandler(logger logging.Logger, sanitizer sanitization.ServiceInterf
 	}
 }
 
-// HandleError handles generic errors
-func (h *ErrorHandler) HandleError(_ error, c echo.Context, message string) error {
-	statusCode := http.StatusInternalServerError
-
-	// Check if this is an AJAX request
-	if h.isAJAXRequest(c) {
-		return ErrorResponse(c, statusCode, message)
+// HandleError sends err as a problem. Domain errors keep their code and message; other errors
+// are internal, and only message is exposed.
+func (h *ErrorHandler) HandleError(err error, c echo.Context, message string) error {
+	var domainErr *domainerrors.DomainError
+	if errors.As(err, &domainErr) {
+		return h.HandleDomainError(domainErr, c)
 	}
 
-	// For web requests, return a simple error response
-	return ErrorResponse(c, statusCode, message)
+	return ErrorResponse(c, http.StatusInternalServerError, message)
 }
 
-// HandleDomainError handles domain-specific errors
+// HandleDomainError sends a domain error as a problem
 func (h *ErrorHandler) HandleDomainError(err *domainerrors.DomainError, c echo.Context) error {
-	statusCode := h.getStatusCode(err.Code)
-
-	// Check if this is an AJAX request
-	if h.isAJAXRequest(c) {
-		return ErrorResponse(c, statusCode, err.Message)
-	}
-
-	// For web requests, return a simple error response
-	return ErrorResponse(c, statusCode, err.Message)
+	return DomainErrorResponse(c, err)
 }
 
 // HandleAuthError handles authentication errors
@@ -67,74 +58,6 @@ func (h *ErrorHandler) HandleNotFoundError(resource string, c echo.Context) erro
 	return h.HandleDomainError(notFoundErr, c)
 }
 
-// getStatusCode maps error codes to HTTP status codes
-func (h *ErrorHandler) getStatusCode(code domainerrors.ErrorCode) int {
-	// Map of error codes to HTTP status codes
-	statusCodeMap := map[domainerrors.ErrorCode]int{
-		// Validation errors
-		domainerrors.ErrCodeValidation:    http.StatusBadRequest,
-		domainerrors.ErrCodeRequired:      http.StatusBadRequest,
-		domainerrors.ErrCodeInvalid:       http.StatusBadRequest,
-		domainerrors.ErrCodeInvalidFormat: http.StatusBadRequest,
-		domainerrors.ErrCodeInvalidInput:  http.StatusBadRequest,
-		domainerrors.ErrCodeBadRequest:    http.StatusBadRequest,
-
-		// Authentication errors
-		domainerrors.ErrCodeUnauthorized:   http.StatusUnauthorized,
-		domainerrors.ErrCodeAuthentication: http.StatusUnauthorized,
-
-		// Authorization errors
-		domainerrors.ErrCodeForbidden:           http.StatusForbidden,
-		domainerrors.ErrCodeInsufficientRole:    http.StatusForbidden,
-		domainerrors.ErrCodeLimitExceeded:       http.StatusForbidden,
-		domainerrors.ErrCodeFeatureNotAvailable: http.StatusForbidden,
-
-		// Resource errors
-		domainerrors.ErrCodeNotFound:     http.StatusNotFound,
-		domainerrors.ErrCodeFormNotFound: http.StatusNotFound,
-		domainerrors.ErrCodeUserNotFound: http.StatusNotFound,
-
-		// Conflict errors
-		domainerrors.ErrCodeConflict:      http.StatusConflict,
-		domainerrors.ErrCodeAlreadyExists: http.StatusConflict,
-		domainerrors.ErrCodeUserExists:    http.StatusConflict,
-
-		// Server errors
-		domainerrors.ErrCodeServerError: http.StatusInternalServerError,
-		domainerrors.ErrCodeConfig:      http.StatusInternalServerError,
-		domainerrors.ErrCodeDatabase:    http.StatusInternalServerError,
-
-		// Service errors
-		domainerrors.ErrCodeStartup:  http.StatusServiceUnavailable,
-		domainerrors.ErrCodeShutdown: http.StatusServiceUnavailable,
-		domainerrors.ErrCodeTimeout:  http.StatusGatewayTimeout,
-
-		// Form errors
-		domainerrors.ErrCodeFormValidation:   http.StatusBadRequest,
-		domainerrors.ErrCodeFormInvalid:      http.StatusBadRequest,
-		domainerrors.ErrCodeFormExpired:      http.StatusBadRequest,
-		domainerrors.ErrCodeFormSubmission:   http.StatusBadRequest,
-		domainerrors.ErrCodeFormAccessDenied: http.StatusBadRequest,
-
-		// User errors
-		domainerrors.ErrCodeUserDisabled:     http.StatusBadRequest,
-		domainerrors.ErrCodeUserInvalid:      http.StatusBadRequest,
-		domainerrors.ErrCodeUserUnauthorized: http.StatusBadRequest,
-	}
-
-	if statusCode, exists := statusCodeMap[code]; exists {
-		return statusCode
-	}
-
-	return http.StatusInternalServerError
-}
-
-// isAJAXRequest checks if the request is an AJAX request
-func (h *ErrorHandler) isAJAXRequest(c echo.Context) bool {
-	return c.Request().Header.Get("X-Requested-With") == "XMLHttpRequest" ||
-		c.Request().Header.Get("Content-Type") == "application/json"
-}
-
 // ErrorHandlerInterface defines the interface for error handling
 type ErrorHandlerInterface interface {
 	HandleError(err error, c echo.Context, message string) error
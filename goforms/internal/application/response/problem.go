@@ -0,0 +1,214 @@
+package response
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	mwcontext "github.com/goformx/goforms/internal/application/middleware/context"
+	domainerrors "github.com/goformx/goforms/internal/domain/common/errors"
+)
+
+// ProblemContentType is the media type of RFC 7807 problem details
+const ProblemContentType = "application/problem+json"
+
+// ProblemTypeBase prefixes every problem type URI. The rest of the URI is derived from the
+// error code, so the type of a code never changes; clients switch on type or code, never on
+// detail, which is free text. Type URIs are names, not documentation links.
+const ProblemTypeBase = "urn:goformx:problem:"
+
+// FieldError is one invalid field of a request
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+	Rule    string `json:"rule,omitempty"`
+}
+
+// Problem is an RFC 7807 problem details object. Type, title and status are fixed by the
+// error code; the remaining members describe this occurrence.
+type Problem struct {
+	Type     string `json:"type"`
+	Title    string `json:"title"`
+	Status   int    `json:"status"`
+	Detail   string `json:"detail,omitempty"`
+	Instance string `json:"instance,omitempty"`
+
+	// Code is the stable error code the type URI is derived from
+	Code domainerrors.ErrorCode `json:"code"`
+	// Errors lists the invalid fields of a validation problem
+	Errors []FieldError `json:"errors,omitempty"`
+	// LimitType, Limit and Current describe a plan limit that was reached
+	LimitType string `json:"limit_type,omitempty"`
+	Limit     *int   `json:"limit,omitempty"`
+	Current   *int   `json:"current,omitempty"`
+	// Feature names a plan feature that is not available
+	Feature string `json:"feature,omitempty"`
+	// RequiredTier is the plan tier to upgrade to for a limit or feature problem
+	RequiredTier string `json:"required_tier,omitempty"`
+}
+
+// NewProblem creates a problem with the code's type and title
+func NewProblem(status int, code domainerrors.ErrorCode, detail string) *Problem {
+	return &Problem{
+		Type:   ProblemType(code),
+		Title:  ProblemTitle(code),
+		Status: status,
+		Detail: detail,
+		Code:   code,
+	}
+}
+
+// ProblemFromDomainError creates a problem from a domain error, turning the context of limit
+// and feature errors into typed members
+func ProblemFromDomainError(err *domainerrors.DomainError) *Problem {
+	problem := NewProblem(err.HTTPStatus(), err.Code, err.Message)
+
+	if limitType, ok := err.Context["limit_type"].(string); ok {
+		problem.LimitType = limitType
+	}
+
+	if limit, ok := err.Context["limit"].(int); ok {
+		problem.Limit = &limit
+	}
+
+	if current, ok := err.Context["current"].(int); ok {
+		problem.Current = &current
+	}
+
+	if feature, ok := err.Context["feature"].(string); ok {
+		problem.Feature = feature
+	}
+
+	if tier, ok := err.Context["required_tier"].(string); ok {
+		problem.RequiredTier = tier
+	}
+
+	return problem
+}
+
+// ProblemType returns the type URI of an error code: SUBMISSION_NOT_FOUND becomes
+// ProblemTypeBase + "submission-not-found"
+func ProblemType(code domainerrors.ErrorCode) string {
+	return ProblemTypeBase + strings.ReplaceAll(strings.ToLower(string(code)), "_", "-")
+}
+
+// ProblemTitle returns the short summary of an error code: FORM_NOT_FOUND becomes "Form not found"
+func ProblemTitle(code domainerrors.ErrorCode) string {
+	title := strings.ReplaceAll(strings.ToLower(string(code)), "_", " ")
+	if title == "" {
+		return http.StatusText(http.StatusInternalServerError)
+	}
+
+	return strings.ToUpper(title[:1]) + title[1:]
+}
+
+// CodeForStatus returns the generic error code of an HTTP status, for errors that carry no
+// code of their own
+func CodeForStatus(status int) domainerrors.ErrorCode {
+	switch status {
+	case http.StatusBadRequest:
+		return domainerrors.ErrCodeBadRequest
+	case http.StatusUnauthorized:
+		return domainerrors.ErrCodeUnauthorized
+	case http.StatusForbidden:
+		return domainerrors.ErrCodeForbidden
+	case http.StatusNotFound:
+		return domainerrors.ErrCodeNotFound
+	case http.StatusMethodNotAllowed:
+		return domainerrors.ErrCodeMethodNotAllowed
+	case http.StatusConflict:
+		return domainerrors.ErrCodeConflict
+	case http.StatusRequestEntityTooLarge:
+		return domainerrors.ErrCodePayloadTooLarge
+	case http.StatusUnprocessableEntity:
+		return domainerrors.ErrCodeUnprocessable
+	case http.StatusTooManyRequests:
+		return domainerrors.ErrCodeRateLimited
+	case http.StatusServiceUnavailable:
+		return domainerrors.ErrCodeUnavailable
+	case http.StatusGatewayTimeout:
+		return domainerrors.ErrCodeTimeout
+	}
+
+	if status < http.StatusInternalServerError {
+		return domainerrors.ErrCodeBadRequest
+	}
+
+	return domainerrors.ErrCodeServerError
+}
+
+// WriteProblem sends a problem as application/problem+json, with the request ID as its instance
+func WriteProblem(c echo.Context, problem *Problem) error {
+	if problem.Instance == "" {
+		problem.Instance = requestID(c)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, ProblemContentType)
+
+	return c.JSON(problem.Status, problem)
+}
+
+// DomainErrorResponse sends a domain error as a problem
+func DomainErrorResponse(c echo.Context, err *domainerrors.DomainError) error {
+	return WriteProblem(c, ProblemFromDomainError(err))
+}
+
+// ValidationErrorResponse sends a validation problem listing the invalid fields
+func ValidationErrorResponse(c echo.Context, detail string, fieldErrors []FieldError) error {
+	problem := NewProblem(http.StatusBadRequest, domainerrors.ErrCodeValidation, detail)
+	problem.Errors = fieldErrors
+
+	return WriteProblem(c, problem)
+}
+
+// HTTPErrorHandler renders errors returned by handlers and middleware as problems. Domain errors
+// keep their code; Echo HTTP errors get the generic code of their status; anything else is an
+// internal error whose message is not exposed.
+func HTTPErrorHandler(err error, c echo.Context) {
+	if c.Response().Committed {
+		return
+	}
+
+	var problem *Problem
+
+	var domainErr *domainerrors.DomainError
+
+	var httpErr *echo.HTTPError
+
+	switch {
+	case errors.As(err, &domainErr):
+		problem = ProblemFromDomainError(domainErr)
+	case errors.As(err, &httpErr):
+		detail, ok := httpErr.Message.(string)
+		if !ok {
+			detail = http.StatusText(httpErr.Code)
+		}
+
+		problem = NewProblem(httpErr.Code, CodeForStatus(httpErr.Code), detail)
+	default:
+		problem = NewProblem(http.StatusInternalServerError, domainerrors.ErrCodeServerError, "Internal server error")
+	}
+
+	if c.Request().Method == http.MethodHead {
+		_ = c.NoContent(problem.Status)
+
+		return
+	}
+
+	_ = WriteProblem(c, problem)
+}
+
+// requestID returns the ID of the request, as set by the request ID or context middleware
+func requestID(c echo.Context) string {
+	if id := c.Response().Header().Get(echo.HeaderXRequestID); id != "" {
+		return id
+	}
+
+	if id := mwcontext.GetRequestID(c.Request().Context()); id != "" {
+		return id
+	}
+
+	return c.Request().Header.Get(echo.HeaderXRequestID)
+}
@@ -0,0 +1,166 @@
+package response_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goformx/goforms/internal/application/response"
+	domainerrors "github.com/goformx/goforms/internal/domain/common/errors"
+)
+
+func newContext(method string) (echo.Context, *httptest.ResponseRecorder) {
+	req := httptest.NewRequest(method, "/api/forms", http.NoBody)
+	req.Header.Set(echo.HeaderXRequestID, "req-123")
+	rec := httptest.NewRecorder()
+
+	return echo.New().NewContext(req, rec), rec
+}
+
+func decodeProblem(t *testing.T, rec *httptest.ResponseRecorder) map[string]any {
+	t.Helper()
+
+	assert.Equal(t, response.ProblemContentType, rec.Header().Get(echo.HeaderContentType))
+
+	var problem map[string]any
+	require.NoError(t, json.Unmarshal(rec.Body.Bytes(), &problem))
+
+	return problem
+}
+
+func TestProblemTypeAndTitle(t *testing.T) {
+	assert.Equal(t, "urn:goformx:problem:form-not-found", response.ProblemType(domainerrors.ErrCodeFormNotFound))
+	assert.Equal(t, "Form not found", response.ProblemTitle(domainerrors.ErrCodeFormNotFound))
+	assert.Equal(t, "urn:goformx:problem:limit-exceeded", response.ProblemType(domainerrors.ErrCodeLimitExceeded))
+}
+
+func TestCodeForStatus_AgreesWithDomainStatus(t *testing.T) {
+	for _, status := range []int{
+		http.StatusBadRequest, http.StatusUnauthorized, http.StatusForbidden, http.StatusNotFound,
+		http.StatusMethodNotAllowed, http.StatusConflict, http.StatusRequestEntityTooLarge,
+		http.StatusUnprocessableEntity, http.StatusTooManyRequests, http.StatusInternalServerError,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout,
+	} {
+		assert.Equal(t, status, domainerrors.GetHTTPStatus(response.CodeForStatus(status)), status)
+	}
+
+	assert.Equal(t, domainerrors.ErrCodeBadRequest, response.CodeForStatus(http.StatusTeapot))
+	assert.Equal(t, domainerrors.ErrCodeServerError, response.CodeForStatus(http.StatusBadGateway))
+}
+
+func TestErrorResponse_WritesProblemWithRequestID(t *testing.T) {
+	c, rec := newContext(http.MethodGet)
+
+	require.NoError(t, response.ErrorResponse(c, http.StatusConflict, "Workspace must keep at least one owner"))
+
+	assert.Equal(t, http.StatusConflict, rec.Code)
+	assert.Equal(t, map[string]any{
+		"type":     "urn:goformx:problem:conflict",
+		"title":    "Conflict",
+		"status":   float64(http.StatusConflict),
+		"detail":   "Workspace must keep at least one owner",
+		"instance": "req-123",
+		"code":     "CONFLICT",
+	}, decodeProblem(t, rec))
+}
+
+func TestDomainErrorResponse_LimitExtensions(t *testing.T) {
+	c, rec := newContext(http.MethodPost)
+
+	require.NoError(t, response.DomainErrorResponse(c, domainerrors.NewLimitExceeded("forms", 3, 3, "pro")))
+
+	assert.Equal(t, http.StatusForbidden, rec.Code)
+
+	problem := decodeProblem(t, rec)
+	assert.Equal(t, "urn:goformx:problem:limit-exceeded", problem["type"])
+	assert.Equal(t, "LIMIT_EXCEEDED", problem["code"])
+	assert.Equal(t, "forms", problem["limit_type"])
+	assert.InDelta(t, 3, problem["limit"], 0)
+	assert.InDelta(t, 3, problem["current"], 0)
+	assert.Equal(t, "pro", problem["required_tier"])
+}
+
+func TestValidationErrorResponse_ListsFields(t *testing.T) {
+	c, rec := newContext(http.MethodPost)
+
+	require.NoError(t, response.ValidationErrorResponse(c, "Validation failed", []response.FieldError{
+		{Field: "email", Message: "Email is required", Rule: "required"},
+	}))
+
+	assert.Equal(t, http.StatusBadRequest, rec.Code)
+
+	problem := decodeProblem(t, rec)
+	assert.Equal(t, "VALIDATION_ERROR", problem["code"])
+	assert.Equal(t, []any{
+		map[string]any{"field": "email", "message": "Email is required", "rule": "required"},
+	}, problem["errors"])
+}
+
+func TestHTTPErrorHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantCode   string
+		wantDetail string
+	}{
+		{
+			name:       "echo error",
+			err:        echo.NewHTTPError(http.StatusTooManyRequests, "Rate limit exceeded"),
+			wantStatus: http.StatusTooManyRequests,
+			wantCode:   "RATE_LIMITED",
+			wantDetail: "Rate limit exceeded",
+		},
+		{
+			name:       "wrapped domain error",
+			err:        fmt.Errorf("get form: %w", domainerrors.New(domainerrors.ErrCodeFormNotFound, "Form not found", nil)),
+			wantStatus: http.StatusNotFound,
+			wantCode:   "FORM_NOT_FOUND",
+			wantDetail: "Form not found",
+		},
+		{
+			name:       "internal error is not exposed",
+			err:        errors.New("dial tcp 10.0.0.5:5432: connection refused"),
+			wantStatus: http.StatusInternalServerError,
+			wantCode:   "SERVER_ERROR",
+			wantDetail: "Internal server error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, rec := newContext(http.MethodGet)
+
+			response.HTTPErrorHandler(tt.err, c)
+
+			assert.Equal(t, tt.wantStatus, rec.Code)
+
+			problem := decodeProblem(t, rec)
+			assert.Equal(t, tt.wantCode, problem["code"])
+			assert.Equal(t, tt.wantDetail, problem["detail"])
+			assert.Equal(t, "req-123", problem["instance"])
+		})
+	}
+}
+
+func TestHTTPErrorHandler_SkipsCommittedAndHeadResponses(t *testing.T) {
+	c, rec := newContext(http.MethodGet)
+	require.NoError(t, c.NoContent(http.StatusNoContent))
+
+	response.HTTPErrorHandler(errors.New("late failure"), c)
+	assert.Equal(t, http.StatusNoContent, rec.Code)
+	assert.Empty(t, rec.Body.String())
+
+	c, rec = newContext(http.MethodHead)
+
+	response.HTTPErrorHandler(echo.ErrNotFound, c)
+	assert.Equal(t, http.StatusNotFound, rec.Code)
+	assert.Empty(t, rec.Body.String())
+}
@@ -24,11 +24,7 @@ func Success(c echo.Context, data any) error {
 	})
 }
 
-// ErrorResponse sends an error response with a custom status code
+// ErrorResponse sends an error as a problem carrying the generic error code of the status code
 func ErrorResponse(c echo.Context, statusCode int, message string) error {
-	return c.JSON(statusCode, APIResponse{
-		Success: false,
-		Message: message,
-		Data:    nil,
-	})
+	return WriteProblem(c, NewProblem(statusCode, CodeForStatus(statusCode), message))
 }
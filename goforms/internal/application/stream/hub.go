@@ -0,0 +1,483 @@
+// Package stream fans form events out to the live submission streams of form owners. A Hub
+// subscribes to the event bus, numbers each form's events, keeps the most recent ones for
+// clients resuming a stream and sends them to every open stream of the form.
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/goformx/goforms/internal/domain/common/events"
+	formevents "github.com/goformx/goforms/internal/domain/form/events"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+)
+
+// Names of the events sent on a stream
+const (
+	// EventReady starts a stream opened without Last-Event-ID; its ID is where the stream resumes from
+	EventReady = "ready"
+	// EventReset replaces the replay of a stream whose Last-Event-ID is too old or from an earlier
+	// process; clients reload the submissions, then follow the stream from its ID
+	EventReset = "reset"
+	// EventSubmission carries a new submission
+	EventSubmission = "submission"
+	// EventStatus carries a change of a submission's review status
+	EventStatus = "status"
+	// EventAssignment carries a change of a submission's assignee
+	EventAssignment = "assignment"
+	// EventAnalytics carries the analytics events of the form counted since the previous tick
+	EventAnalytics = "analytics"
+)
+
+var (
+	// ErrTooManyStreams is returned when a user already has the most streams allowed open
+	ErrTooManyStreams = errors.New("too many open streams")
+	// ErrHubClosed is returned for streams opened after the hub was closed
+	ErrHubClosed = errors.New("stream hub is closed")
+)
+
+const (
+	// subscriberBuffer is how many events a stream may fall behind before it is closed. The client
+	// reconnects and catches up from the replay buffer.
+	subscriberBuffer = 64
+	// replayRetention is how long the events of a form nobody streams are kept for resuming clients
+	replayRetention = 5 * time.Minute
+)
+
+// Message is one event of a stream. Data is the JSON sent as the event's data line.
+type Message struct {
+	ID    string
+	Event string
+	Data  json.RawMessage
+
+	seq uint64
+}
+
+// Subscription is an open stream of one form's events
+type Subscription struct {
+	// Backlog holds the messages to send before those from Messages: the events missed since
+	// Last-Event-ID, or a single ready or reset message
+	Backlog []Message
+
+	hub      *Hub
+	formID   string
+	userID   string
+	messages chan Message
+}
+
+// Messages returns the live events of the form. The channel is closed when the stream falls too
+// far behind, is closed or the hub shuts down.
+func (s *Subscription) Messages() <-chan Message {
+	return s.messages
+}
+
+// Close ends the stream and releases its connection slot
+func (s *Subscription) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	s.hub.dropLocked(s)
+}
+
+// formStream holds the events and open streams of one form
+type formStream struct {
+	seq         uint64
+	recent      []Message
+	subscribers map[*Subscription]struct{}
+	analytics   map[string]int
+	idleSince   time.Time
+}
+
+// Hub serves the live submission streams of this process. Events of a form are only numbered and
+// kept while it has streams open, or had until recently, so forms nobody watches cost nothing.
+type Hub struct {
+	bus    events.Subscriber
+	cfg    config.StreamConfig
+	logger logging.Logger
+	// epoch tells the event IDs of this hub from those of earlier processes and other replicas
+	epoch string
+
+	mu        sync.Mutex
+	forms     map[string]*formStream
+	users     map[string]int
+	lastTick  time.Time
+	closed    bool
+	stop      chan struct{}
+	startOnce sync.Once
+}
+
+// NewHub creates a hub for the events of bus. Zero settings in cfg take their defaults.
+func NewHub(bus events.Subscriber, cfg config.StreamConfig, logger logging.Logger) *Hub {
+	if cfg.MaxConnectionsPerUser == 0 {
+		cfg.MaxConnectionsPerUser = config.DefaultStreamMaxConnectionsPerUser
+	}
+
+	if cfg.ReplaySize == 0 {
+		cfg.ReplaySize = config.DefaultStreamReplaySize
+	}
+
+	if cfg.Heartbeat == 0 {
+		cfg.Heartbeat = config.DefaultStreamHeartbeat
+	}
+
+	if cfg.AnalyticsInterval == 0 {
+		cfg.AnalyticsInterval = config.DefaultStreamAnalyticsInterval
+	}
+
+	return &Hub{
+		bus:      bus,
+		cfg:      cfg,
+		logger:   logger,
+		epoch:    strings.ReplaceAll(uuid.New().String(), "-", "")[:12],
+		forms:    make(map[string]*formStream),
+		users:    make(map[string]int),
+		lastTick: time.Now(),
+		stop:     make(chan struct{}),
+	}
+}
+
+// Heartbeat returns how often idle streams send a keepalive
+func (h *Hub) Heartbeat() time.Duration {
+	return h.cfg.Heartbeat
+}
+
+// Start subscribes to the form events streamed and starts the analytics ticks
+func (h *Hub) Start(ctx context.Context) error {
+	handlers := map[formevents.EventType]func(ctx context.Context, event events.Event) error{
+		formevents.FormSubmittedEventType:       h.handleSubmitted,
+		formevents.ReviewStatusChangedEventType: h.handleReviewChange(EventStatus),
+		formevents.SubmissionAssignedEventType:  h.handleReviewChange(EventAssignment),
+		formevents.AnalyticsEventType:           h.handleAnalytics,
+	}
+
+	for eventType, handler := range handlers {
+		if err := h.bus.Subscribe(ctx, string(eventType), handler); err != nil {
+			return fmt.Errorf("subscribe stream hub to %s: %w", eventType, err)
+		}
+	}
+
+	h.startOnce.Do(func() {
+		go h.run()
+	})
+
+	return nil
+}
+
+// Close ends every open stream and stops the analytics ticks. Streams opened later are refused.
+func (h *Hub) Close() {
+	h.mu.Lock()
+
+	if h.closed {
+		h.mu.Unlock()
+
+		return
+	}
+
+	h.closed = true
+
+	for _, form := range h.forms {
+		for sub := range form.subscribers {
+			h.dropLocked(sub)
+		}
+	}
+
+	h.forms = make(map[string]*formStream)
+	h.mu.Unlock()
+
+	close(h.stop)
+}
+
+// Subscribe opens a stream of formID's events for userID. A lastEventID from the current process
+// replays the events missed since; one that cannot be resumed from gets a reset message.
+func (h *Hub) Subscribe(formID, userID, lastEventID string) (*Subscription, error) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.closed {
+		return nil, ErrHubClosed
+	}
+
+	if h.users[userID] >= h.cfg.MaxConnectionsPerUser {
+		return nil, ErrTooManyStreams
+	}
+
+	form, ok := h.forms[formID]
+	if !ok {
+		form = &formStream{subscribers: make(map[*Subscription]struct{}), analytics: make(map[string]int)}
+		h.forms[formID] = form
+	}
+
+	sub := &Subscription{
+		Backlog:  h.backlog(form, lastEventID),
+		hub:      h,
+		formID:   formID,
+		userID:   userID,
+		messages: make(chan Message, subscriberBuffer),
+	}
+
+	form.subscribers[sub] = struct{}{}
+	h.users[userID]++
+
+	return sub, nil
+}
+
+// backlog returns what a stream resuming from lastEventID is sent first
+func (h *Hub) backlog(form *formStream, lastEventID string) []Message {
+	head := h.control(form, EventReady)
+	if lastEventID == "" {
+		return []Message{head}
+	}
+
+	reset := h.control(form, EventReset)
+
+	epoch, seqText, ok := strings.Cut(lastEventID, "-")
+	if !ok || epoch != h.epoch {
+		return []Message{reset}
+	}
+
+	seq, err := strconv.ParseUint(seqText, 10, 64)
+	if err != nil || seq > form.seq {
+		return []Message{reset}
+	}
+
+	if seq == form.seq {
+		return nil
+	}
+
+	if len(form.recent) == 0 || form.recent[0].seq > seq+1 {
+		return []Message{reset}
+	}
+
+	missed := make([]Message, 0, form.seq-seq)
+	for _, msg := range form.recent {
+		if msg.seq > seq {
+			missed = append(missed, msg)
+		}
+	}
+
+	return missed
+}
+
+// control returns a message that is not an event of the form, carrying the ID of its latest event
+func (h *Hub) control(form *formStream, event string) Message {
+	return Message{ID: h.id(form.seq), Event: event, Data: json.RawMessage(`{}`), seq: form.seq}
+}
+
+func (h *Hub) id(seq uint64) string {
+	return h.epoch + "-" + strconv.FormatUint(seq, 10)
+}
+
+// dropLocked closes sub if it is still open
+func (h *Hub) dropLocked(sub *Subscription) {
+	form, ok := h.forms[sub.formID]
+	if !ok {
+		return
+	}
+
+	if _, open := form.subscribers[sub]; !open {
+		return
+	}
+
+	delete(form.subscribers, sub)
+	close(sub.messages)
+
+	if h.users[sub.userID]--; h.users[sub.userID] <= 0 {
+		delete(h.users, sub.userID)
+	}
+
+	if len(form.subscribers) == 0 {
+		form.idleSince = time.Now()
+	}
+}
+
+// publish numbers an event of formID, keeps it for replay and sends it to the form's streams
+func (h *Hub) publish(formID, event string, data any) {
+	encoded, err := json.Marshal(data)
+	if err != nil {
+		h.logger.Error("failed to encode stream event", "error", err, "form_id", formID, "event", event)
+
+		return
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	form, ok := h.forms[formID]
+	if !ok {
+		return
+	}
+
+	h.publishLocked(formID, form, event, encoded)
+}
+
+func (h *Hub) publishLocked(formID string, form *formStream, event string, data json.RawMessage) {
+	form.seq++
+	msg := Message{ID: h.id(form.seq), Event: event, Data: data, seq: form.seq}
+
+	if len(form.recent) >= h.cfg.ReplaySize {
+		copy(form.recent, form.recent[1:])
+		form.recent = form.recent[:len(form.recent)-1]
+	}
+
+	form.recent = append(form.recent, msg)
+
+	for sub := range form.subscribers {
+		select {
+		case sub.messages <- msg:
+		default:
+			h.logger.Warn("closing live submission stream that fell behind", "form_id", formID, "user_id", sub.userID)
+			h.dropLocked(sub)
+		}
+	}
+}
+
+// run sends the analytics ticks and forgets the events of forms nobody streamed for a while
+func (h *Hub) run() {
+	ticker := time.NewTicker(h.cfg.AnalyticsInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-h.stop:
+			return
+		case now := <-ticker.C:
+			h.tick(now)
+		}
+	}
+}
+
+// AnalyticsTick is the data of an analytics event: how many analytics events of each type the
+// form had between Since and Until
+type AnalyticsTick struct {
+	FormID string         `json:"form_id"`
+	Counts map[string]int `json:"counts"`
+	Since  time.Time      `json:"since"`
+	Until  time.Time      `json:"until"`
+}
+
+func (h *Hub) tick(now time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	since := h.lastTick
+	h.lastTick = now
+
+	for formID, form := range h.forms {
+		if len(form.subscribers) == 0 && now.Sub(form.idleSince) > replayRetention {
+			delete(h.forms, formID)
+
+			continue
+		}
+
+		if len(form.analytics) == 0 {
+			continue
+		}
+
+		data, err := json.Marshal(AnalyticsTick{FormID: formID, Counts: form.analytics, Since: since.UTC(), Until: now.UTC()})
+		if err != nil {
+			h.logger.Error("failed to encode analytics tick", "error", err, "form_id", formID)
+
+			continue
+		}
+
+		form.analytics = make(map[string]int)
+		h.publishLocked(formID, form, EventAnalytics, data)
+	}
+}
+
+// SubmissionData is the data of a submission event, the submission as the API returns it
+type SubmissionData struct {
+	ID           string         `json:"id"`
+	FormID       string         `json:"form_id"`
+	Status       string         `json:"status"`
+	ReviewStatus string         `json:"review_status"`
+	AssigneeID   string         `json:"assignee_id"`
+	Tags         []string       `json:"tags"`
+	SubmittedAt  string         `json:"submitted_at"`
+	Data         map[string]any `json:"data"`
+}
+
+func (h *Hub) handleSubmitted(_ context.Context, event events.Event) error {
+	var submission model.FormSubmission
+	if err := decode(event, &submission); err != nil {
+		h.logger.Warn("skipping undecodable submission event", "error", err)
+
+		return nil
+	}
+
+	h.publish(submission.FormID, EventSubmission, SubmissionData{
+		ID:           submission.ID,
+		FormID:       submission.FormID,
+		Status:       string(submission.Status),
+		ReviewStatus: submission.ReviewStatus,
+		AssigneeID:   submission.AssigneeID,
+		Tags:         submission.Tags,
+		SubmittedAt:  submission.SubmittedAt.Format(time.RFC3339),
+		Data:         submission.Data,
+	})
+
+	return nil
+}
+
+// handleReviewChange returns the handler streaming review changes as the named event
+func (h *Hub) handleReviewChange(name string) func(ctx context.Context, event events.Event) error {
+	return func(_ context.Context, event events.Event) error {
+		var change formevents.ReviewChange
+		if err := decode(event, &change); err != nil {
+			h.logger.Warn("skipping undecodable review event", "error", err, "event", event.Name())
+
+			return nil
+		}
+
+		h.publish(change.FormID, name, change)
+
+		return nil
+	}
+}
+
+func (h *Hub) handleAnalytics(_ context.Context, event events.Event) error {
+	var payload formevents.AnalyticsPayload
+	if err := decode(event, &payload); err != nil {
+		h.logger.Warn("skipping undecodable analytics event", "error", err)
+
+		return nil
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if form, ok := h.forms[payload.FormID]; ok {
+		form.analytics[payload.EventType]++
+	}
+
+	return nil
+}
+
+// decode reads the payload of event into v. Events from a broker decode their JSON themselves;
+// the payloads of in-process events are Go values, converted through JSON.
+func decode(event events.Event, v any) error {
+	if remote, ok := event.(interface{ Decode(v any) error }); ok {
+		return remote.Decode(v)
+	}
+
+	data, err := json.Marshal(event.Payload())
+	if err != nil {
+		return fmt.Errorf("encode %s payload: %w", event.Name(), err)
+	}
+
+	if err = json.Unmarshal(data, v); err != nil {
+		return fmt.Errorf("decode %s payload: %w", event.Name(), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,237 @@
+package stream_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goformx/goforms/internal/application/stream"
+	"github.com/goformx/goforms/internal/domain/common/events"
+	formevents "github.com/goformx/goforms/internal/domain/form/events"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/event"
+	mocklogging "github.com/goformx/goforms/test/mocks/logging"
+)
+
+// syncBus delivers published events to the subscribed handlers before Publish returns
+type syncBus struct {
+	handlers map[string][]func(context.Context, events.Event) error
+}
+
+func (b *syncBus) Subscribe(_ context.Context, name string, handler func(context.Context, events.Event) error) error {
+	b.handlers[name] = append(b.handlers[name], handler)
+
+	return nil
+}
+
+func (b *syncBus) Unsubscribe(_ context.Context, name string) error {
+	delete(b.handlers, name)
+
+	return nil
+}
+
+func (b *syncBus) publish(t *testing.T, e events.Event) {
+	t.Helper()
+
+	for _, handler := range b.handlers[e.Name()] {
+		require.NoError(t, handler(context.Background(), e))
+	}
+}
+
+func newHub(t *testing.T, cfg config.StreamConfig) (*stream.Hub, *syncBus) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	logger := mocklogging.NewMockLogger(ctrl)
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	bus := &syncBus{handlers: map[string][]func(context.Context, events.Event) error{}}
+	hub := stream.NewHub(bus, cfg, logger)
+	require.NoError(t, hub.Start(context.Background()))
+	t.Cleanup(hub.Close)
+
+	return hub, bus
+}
+
+func submitted(formID, id string) *formevents.Event {
+	return formevents.NewFormSubmittedEvent(&model.FormSubmission{
+		ID: id, FormID: formID, Data: model.JSON{"email": "ada@example.com"}, Status: model.SubmissionStatusPending,
+		ReviewStatus: "new", SubmittedAt: time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC),
+	})
+}
+
+func receive(t *testing.T, sub *stream.Subscription) stream.Message {
+	t.Helper()
+
+	select {
+	case msg, open := <-sub.Messages():
+		require.True(t, open, "stream closed")
+
+		return msg
+	case <-time.After(time.Second):
+		require.FailNow(t, "no stream event")
+
+		return stream.Message{}
+	}
+}
+
+func TestHub_StreamsTheEventsOfTheForm(t *testing.T) {
+	hub, bus := newHub(t, config.StreamConfig{})
+
+	sub, err := hub.Subscribe("form-1", "user-1", "")
+	require.NoError(t, err)
+	require.Len(t, sub.Backlog, 1)
+	assert.Equal(t, stream.EventReady, sub.Backlog[0].Event)
+
+	change := formevents.ReviewChange{FormID: "form-1", SubmissionID: "s1", From: "new", To: "done", ActorID: "user-1"}
+
+	// Events from a broker carry their payload as JSON
+	envelope, err := event.NewEnvelope(formevents.NewSubmissionAssignedEvent(change), "test", nil)
+	require.NoError(t, err)
+
+	bus.publish(t, submitted("form-2", "other"))
+	bus.publish(t, submitted("form-1", "s1"))
+	bus.publish(t, formevents.NewReviewStatusChangedEvent(change))
+	bus.publish(t, event.NewRemoteEvent(envelope))
+
+	msg := receive(t, sub)
+	assert.Equal(t, stream.EventSubmission, msg.Event)
+	assert.JSONEq(t, `{
+		"id": "s1", "form_id": "form-1", "status": "pending", "review_status": "new", "assignee_id": "",
+		"tags": null, "submitted_at": "2026-10-01T12:00:00Z", "data": {"email": "ada@example.com"}
+	}`, string(msg.Data))
+
+	status := receive(t, sub)
+	assert.Equal(t, stream.EventStatus, status.Event)
+	assert.JSONEq(t, `{"form_id":"form-1","submission_id":"s1","from":"new","to":"done","actor_id":"user-1"}`, string(status.Data))
+
+	assert.Equal(t, stream.EventAssignment, receive(t, sub).Event)
+	assert.NotEqual(t, msg.ID, status.ID)
+}
+
+func TestHub_ResumesFromLastEventID(t *testing.T) {
+	hub, bus := newHub(t, config.StreamConfig{ReplaySize: 3})
+
+	first, err := hub.Subscribe("form-1", "user-1", "")
+	require.NoError(t, err)
+
+	ids := make([]string, 0, 5)
+
+	for _, id := range []string{"s1", "s2", "s3", "s4", "s5"} {
+		bus.publish(t, submitted("form-1", id))
+		ids = append(ids, receive(t, first).ID)
+	}
+
+	first.Close()
+
+	resumed, err := hub.Subscribe("form-1", "user-1", ids[2])
+	require.NoError(t, err)
+	require.Len(t, resumed.Backlog, 2)
+	assert.Equal(t, ids[3], resumed.Backlog[0].ID)
+	assert.Equal(t, ids[4], resumed.Backlog[1].ID)
+
+	caughtUp, err := hub.Subscribe("form-1", "user-1", ids[4])
+	require.NoError(t, err)
+	assert.Empty(t, caughtUp.Backlog)
+
+	// Events older than the replay buffer, or from another process, cannot be resumed from
+	for _, lastEventID := range []string{ids[0], "0123456789ab-4", "garbage"} {
+		reset, subscribeErr := hub.Subscribe("form-1", "user-2", lastEventID)
+		require.NoError(t, subscribeErr)
+		require.Len(t, reset.Backlog, 1, lastEventID)
+		assert.Equal(t, stream.EventReset, reset.Backlog[0].Event)
+		assert.Equal(t, ids[4], reset.Backlog[0].ID, "a reset resumes from the latest event")
+		reset.Close()
+	}
+}
+
+func TestHub_LimitsStreamsPerUser(t *testing.T) {
+	hub, _ := newHub(t, config.StreamConfig{MaxConnectionsPerUser: 2})
+
+	first, err := hub.Subscribe("form-1", "user-1", "")
+	require.NoError(t, err)
+
+	_, err = hub.Subscribe("form-2", "user-1", "")
+	require.NoError(t, err)
+
+	_, err = hub.Subscribe("form-1", "user-1", "")
+	require.ErrorIs(t, err, stream.ErrTooManyStreams)
+
+	_, err = hub.Subscribe("form-1", "user-2", "")
+	require.NoError(t, err)
+
+	first.Close()
+	first.Close()
+
+	_, err = hub.Subscribe("form-1", "user-1", "")
+	require.NoError(t, err)
+}
+
+func TestHub_SendsAnalyticsTicks(t *testing.T) {
+	hub, bus := newHub(t, config.StreamConfig{AnalyticsInterval: 20 * time.Millisecond})
+
+	sub, err := hub.Subscribe("form-1", "user-1", "")
+	require.NoError(t, err)
+
+	bus.publish(t, formevents.NewAnalyticsEvent("form-1", "view"))
+	bus.publish(t, formevents.NewAnalyticsEvent("form-1", "view"))
+	bus.publish(t, formevents.NewAnalyticsEvent("form-1", "start"))
+	bus.publish(t, formevents.NewAnalyticsEvent("form-2", "view"))
+
+	msg := receive(t, sub)
+	assert.Equal(t, stream.EventAnalytics, msg.Event)
+
+	var tick stream.AnalyticsTick
+	require.NoError(t, json.Unmarshal(msg.Data, &tick))
+	assert.Equal(t, "form-1", tick.FormID)
+	assert.Equal(t, map[string]int{"view": 2, "start": 1}, tick.Counts)
+	assert.True(t, tick.Until.After(tick.Since))
+}
+
+func TestHub_ClosesStreamsThatFallBehind(t *testing.T) {
+	hub, bus := newHub(t, config.StreamConfig{})
+
+	slow, err := hub.Subscribe("form-1", "user-1", "")
+	require.NoError(t, err)
+
+	received := 0
+
+	for range 100 {
+		bus.publish(t, submitted("form-1", "s"))
+	}
+
+	for range slow.Messages() {
+		received++
+	}
+
+	assert.Less(t, received, 100)
+
+	// The slot of the closed stream is free again
+	for range config.DefaultStreamMaxConnectionsPerUser {
+		_, err = hub.Subscribe("form-1", "user-1", "")
+		require.NoError(t, err)
+	}
+}
+
+func TestHub_CloseEndsStreams(t *testing.T) {
+	hub, _ := newHub(t, config.StreamConfig{})
+
+	sub, err := hub.Subscribe("form-1", "user-1", "")
+	require.NoError(t, err)
+
+	hub.Close()
+	hub.Close()
+
+	_, open := <-sub.Messages()
+	assert.False(t, open)
+
+	_, err = hub.Subscribe("form-1", "user-1", "")
+	require.ErrorIs(t, err, stream.ErrHubClosed)
+}
@@ -12,6 +12,7 @@ import (
 type ComprehensiveValidator struct {
 	fieldValidator *FieldValidator
 	schemaParser   *SchemaParser
+	messages       *Messages
 }
 
 // NewComprehensiveValidator creates a new comprehensive form validator
@@ -19,18 +20,35 @@ func NewComprehensiveValidator() *ComprehensiveValidator {
 	return &ComprehensiveValidator{
 		fieldValidator: NewFieldValidator(),
 		schemaParser:   NewSchemaParser(),
+		messages:       NewMessages(),
 	}
 }
 
-// ValidateForm validates a form submission against its schema
+// Locale picks the message locale for a request's Accept-Language header and the form's
+// default language
+func (v *ComprehensiveValidator) Locale(schema model.JSON, acceptLanguage string) string {
+	return v.messages.Negotiate(acceptLanguage, FormLanguage(schema))
+}
+
+// ValidateForm validates a form submission against its schema, reporting errors in the
+// form's default language
 func (v *ComprehensiveValidator) ValidateForm(schema, submission model.JSON) Result {
+	return v.ValidateFormLocalized(schema, submission, "")
+}
+
+// ValidateFormLocalized validates a form submission, reporting errors in the language
+// negotiated from acceptLanguage (an Accept-Language header value)
+func (v *ComprehensiveValidator) ValidateFormLocalized(schema, submission model.JSON, acceptLanguage string) Result {
+	locale := v.Locale(schema, acceptLanguage)
 	result := Result{
 		IsValid: true,
 		Errors:  []Error{},
+		Locale:  locale,
 	}
+	catalog := v.messages.Catalog(locale)
 
-	// Extract components from schema
-	components, ok := v.schemaParser.ExtractComponents(schema)
+	// Extract input components from schema, including those nested in layout components
+	components, ok := v.schemaParser.ExtractInputComponents(schema)
 	if !ok {
 		result.IsValid = false
 		result.Errors = append(result.Errors, Error{
@@ -44,10 +62,8 @@ func (v *ComprehensiveValidator) ValidateForm(schema, submission model.JSON) Res
 
 	// Validate each component
 	for _, component := range components {
-		if componentMap, componentOk := component.(map[string]any); componentOk {
-			fieldErrors := v.validateComponent(componentMap, submission)
-			result.Errors = append(result.Errors, fieldErrors...)
-		}
+		fieldErrors := v.validateComponent(component, submission, catalog)
+		result.Errors = append(result.Errors, fieldErrors...)
 	}
 
 	// Check if any errors occurred
@@ -59,7 +75,7 @@ func (v *ComprehensiveValidator) ValidateForm(schema, submission model.JSON) Res
 }
 
 // validateComponent validates a single form component
-func (v *ComprehensiveValidator) validateComponent(component map[string]any, submission model.JSON) []Error {
+func (v *ComprehensiveValidator) validateComponent(component map[string]any, submission model.JSON, catalog map[string]string) []Error {
 	// Extract component key
 	key, ok := v.schemaParser.ExtractComponentKey(component)
 	if !ok {
@@ -74,30 +90,38 @@ func (v *ComprehensiveValidator) validateComponent(component map[string]any, sub
 
 	// Extract validation rules
 	validation := v.schemaParser.ExtractValidationRules(component)
+	validation.Localize(catalog)
 
 	// Validate field using field validator
 	return v.fieldValidator.ValidateField(key, fieldValue, &validation)
 }
 
-// GenerateClientValidation generates client-side validation rules from schema
+// GenerateClientValidation generates client-side validation rules from schema, with
+// messages in the form's default language
 func (v *ComprehensiveValidator) GenerateClientValidation(schema model.JSON) (map[string]any, error) {
+	return v.GenerateClientValidationLocalized(schema, "")
+}
+
+// GenerateClientValidationLocalized generates client-side validation rules with messages in
+// the language negotiated from acceptLanguage
+func (v *ComprehensiveValidator) GenerateClientValidationLocalized(schema model.JSON, acceptLanguage string) (map[string]any, error) {
 	clientRules := make(map[string]any)
+	catalog := v.messages.Catalog(v.Locale(schema, acceptLanguage))
 
-	components, ok := v.schemaParser.ExtractComponents(schema)
+	components, ok := v.schemaParser.ExtractInputComponents(schema)
 	if !ok {
 		return nil, errors.New("invalid schema: missing components")
 	}
 
 	for _, component := range components {
-		if componentMap, componentOk := component.(map[string]any); componentOk {
-			key, keyOk := v.schemaParser.ExtractComponentKey(componentMap)
-			if !keyOk {
-				continue
-			}
-
-			validation := v.schemaParser.ExtractValidationRules(componentMap)
-			clientRules[key] = v.schemaParser.ConvertToClientRules(&validation)
+		key, keyOk := v.schemaParser.ExtractComponentKey(component)
+		if !keyOk {
+			continue
 		}
+
+		validation := v.schemaParser.ExtractValidationRules(component)
+		validation.Localize(catalog)
+		clientRules[key] = v.schemaParser.ConvertToClientRules(&validation)
 	}
 
 	return clientRules, nil
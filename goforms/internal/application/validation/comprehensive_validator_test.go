@@ -294,3 +294,79 @@ func TestComprehensiveValidator_Integration(t *testing.T) {
 	require.False(t, result.IsValid)
 	assert.NotEmpty(t, result.Errors)
 }
+
+func TestComprehensiveValidator_ValidateForm_NestedLayoutComponents(t *testing.T) {
+	validator := setupTestComprehensiveValidator()
+
+	schema := model.JSON{
+		"components": []any{
+			map[string]any{
+				"type": "panel",
+				"key":  "contact",
+				"components": []any{
+					map[string]any{"key": "email", "type": "email", "validate": map[string]any{"required": true}},
+				},
+			},
+			map[string]any{
+				"type": "columns",
+				"key":  "row",
+				"columns": []any{
+					map[string]any{"components": []any{
+						map[string]any{"key": "terms", "type": "checkbox", "validate": map[string]any{"required": true}},
+					}},
+					map[string]any{"components": []any{
+						map[string]any{
+							"key":      "size",
+							"type":     "radio",
+							"values":   []any{map[string]any{"label": "Small", "value": "s"}},
+							"validate": map[string]any{"required": true},
+						},
+					}},
+				},
+			},
+		},
+	}
+
+	result := validator.ValidateForm(schema, model.JSON{"email": "", "terms": false, "size": "xl"})
+	require.False(t, result.IsValid)
+
+	rulesByField := make(map[string]string)
+	for _, validationErr := range result.Errors {
+		rulesByField[validationErr.Field] = validationErr.Rule
+	}
+
+	assert.Equal(t, map[string]string{"email": "required", "terms": "required", "size": "options"}, rulesByField)
+
+	result = validator.ValidateForm(schema, model.JSON{"email": "a@example.com", "terms": true, "size": "s"})
+	assert.True(t, result.IsValid, result.Errors)
+
+	rules, err := validator.GenerateClientValidation(schema)
+	require.NoError(t, err)
+	assert.Contains(t, rules, "email")
+	assert.NotContains(t, rules, "contact", "layout components have no rules")
+}
+
+func TestComprehensiveValidator_ValidateForm_FalseAnswersOnlyLeaveCheckboxesEmpty(t *testing.T) {
+	validator := setupTestComprehensiveValidator()
+
+	schema := model.JSON{
+		"components": []any{
+			map[string]any{"key": "terms", "type": "checkbox", "validate": map[string]any{"required": true}},
+			map[string]any{
+				"key":      "subscribed",
+				"type":     "radio",
+				"values":   []any{map[string]any{"label": "Yes", "value": true}, map[string]any{"label": "No", "value": false}},
+				"validate": map[string]any{"required": true},
+			},
+		},
+	}
+
+	result := validator.ValidateForm(schema, model.JSON{"terms": false, "subscribed": false})
+	require.False(t, result.IsValid)
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, "terms", result.Errors[0].Field)
+	assert.Equal(t, "required", result.Errors[0].Rule)
+
+	result = validator.ValidateForm(schema, model.JSON{"terms": true, "subscribed": false})
+	assert.True(t, result.IsValid, result.Errors)
+}
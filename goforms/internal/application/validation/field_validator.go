@@ -1,7 +1,6 @@
 package validation
 
 import (
-	"fmt"
 	"regexp"
 	"strconv"
 )
@@ -39,7 +38,7 @@ func (v *FieldValidator) ValidateField(fieldName string, value any, rules *Field
 	}
 
 	// Type validation
-	if typeErrors := v.ValidateFieldType(fieldName, value, rules.Type); typeErrors != nil {
+	if typeErrors := v.validateType(fieldName, value, rules); typeErrors != nil {
 		errors = append(errors, *typeErrors)
 	}
 
@@ -54,17 +53,17 @@ func (v *FieldValidator) ValidateField(fieldName string, value any, rules *Field
 	}
 
 	// Pattern validation
-	if patternErrors := v.validatePattern(fieldName, value, rules.Pattern); len(patternErrors) > 0 {
+	if patternErrors := v.validatePattern(fieldName, value, rules); len(patternErrors) > 0 {
 		errors = append(errors, patternErrors...)
 	}
 
 	// Options validation
-	if optionsErrors := v.validateOptions(fieldName, value, rules.Options); len(optionsErrors) > 0 {
+	if optionsErrors := v.validateOptions(fieldName, value, rules); len(optionsErrors) > 0 {
 		errors = append(errors, optionsErrors...)
 	}
 
 	// Custom rules validation
-	if customErrors := v.validateCustomRules(fieldName, value, rules.CustomRules); len(customErrors) > 0 {
+	if customErrors := v.validateCustomRules(fieldName, value, rules); len(customErrors) > 0 {
 		errors = append(errors, customErrors...)
 	}
 
@@ -73,10 +72,10 @@ func (v *FieldValidator) ValidateField(fieldName string, value any, rules *Field
 
 // validateRequired validates if a required field has a value
 func (v *FieldValidator) validateRequired(fieldName string, value any, rules *FieldValidation) []Error {
-	if rules.Required && (value == nil || value == "") {
+	if rules.Required && isEmptyValue(value, rules.Type) {
 		return []Error{{
 			Field:   fieldName,
-			Message: rules.getMessage("required", "This field is required"),
+			Message: rules.getMessage("required", nil),
 			Rule:    "required",
 		}}
 	}
@@ -84,6 +83,34 @@ func (v *FieldValidator) validateRequired(fieldName string, value any, rules *Fi
 	return nil
 }
 
+// isEmptyValue reports whether a value counts as missing for a required field of the given
+// component type. An unticked checkbox (false) and selectboxes with no option ticked are empty;
+// false is an answer to any other component, such as a yes/no radio.
+func isEmptyValue(value any, componentType string) bool {
+	switch val := value.(type) {
+	case nil:
+		return true
+	case string:
+		return val == ""
+	case bool:
+		return componentType == "checkbox" && !val
+	case []any:
+		return len(val) == 0
+	case []string:
+		return len(val) == 0
+	case map[string]any:
+		for _, flag := range val {
+			if ticked, ok := flag.(bool); !ok || ticked {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	return false
+}
+
 // validateStringField validates string-specific rules
 func (v *FieldValidator) validateStringField(fieldName string, value any, rules *FieldValidation) []Error {
 	var errors []Error
@@ -92,7 +119,7 @@ func (v *FieldValidator) validateStringField(fieldName string, value any, rules
 		if rules.MinLength > 0 && len(strValue) < rules.MinLength {
 			errors = append(errors, Error{
 				Field:   fieldName,
-				Message: rules.getMessage("minLength", fmt.Sprintf("Minimum length is %d characters", rules.MinLength)),
+				Message: rules.getMessage("minLength", nil),
 				Rule:    "minLength",
 			})
 		}
@@ -100,7 +127,7 @@ func (v *FieldValidator) validateStringField(fieldName string, value any, rules
 		if rules.MaxLength > 0 && len(strValue) > rules.MaxLength {
 			errors = append(errors, Error{
 				Field:   fieldName,
-				Message: rules.getMessage("maxLength", fmt.Sprintf("Maximum length is %d characters", rules.MaxLength)),
+				Message: rules.getMessage("maxLength", nil),
 				Rule:    "maxLength",
 			})
 		}
@@ -117,7 +144,7 @@ func (v *FieldValidator) validateNumericField(fieldName string, value any, rules
 		if rules.Min != 0 && numValue < rules.Min {
 			errors = append(errors, Error{
 				Field:   fieldName,
-				Message: rules.getMessage("min", fmt.Sprintf("Minimum value is %g", rules.Min)),
+				Message: rules.getMessage("min", nil),
 				Rule:    "min",
 			})
 		}
@@ -125,7 +152,7 @@ func (v *FieldValidator) validateNumericField(fieldName string, value any, rules
 		if rules.Max != 0 && numValue > rules.Max {
 			errors = append(errors, Error{
 				Field:   fieldName,
-				Message: rules.getMessage("max", fmt.Sprintf("Maximum value is %g", rules.Max)),
+				Message: rules.getMessage("max", nil),
 				Rule:    "max",
 			})
 		}
@@ -135,17 +162,17 @@ func (v *FieldValidator) validateNumericField(fieldName string, value any, rules
 }
 
 // validatePattern validates that a value matches a regex pattern
-func (v *FieldValidator) validatePattern(fieldName string, value any, pattern string) []Error {
-	if pattern == "" {
+func (v *FieldValidator) validatePattern(fieldName string, value any, rules *FieldValidation) []Error {
+	if rules.Pattern == "" {
 		return nil
 	}
 
 	if strValue, ok := value.(string); ok {
-		matched, err := regexp.MatchString(pattern, strValue)
+		matched, err := regexp.MatchString(rules.Pattern, strValue)
 		if err != nil {
 			return []Error{{
 				Field:   fieldName,
-				Message: fmt.Sprintf("Invalid regex pattern: %v", err),
+				Message: rules.getMessage("invalidPattern", map[string]any{"error": err}),
 				Rule:    "pattern",
 			}}
 		}
@@ -153,7 +180,7 @@ func (v *FieldValidator) validatePattern(fieldName string, value any, pattern st
 		if !matched {
 			return []Error{{
 				Field:   fieldName,
-				Message: "Value does not match required pattern",
+				Message: rules.getMessage("pattern", map[string]any{"pattern": rules.Pattern}),
 				Rule:    "pattern",
 			}}
 		}
@@ -163,13 +190,13 @@ func (v *FieldValidator) validatePattern(fieldName string, value any, pattern st
 }
 
 // validateOptions validates that a value is in the allowed options
-func (v *FieldValidator) validateOptions(fieldName string, value any, options []string) []Error {
-	if len(options) == 0 {
+func (v *FieldValidator) validateOptions(fieldName string, value any, rules *FieldValidation) []Error {
+	if len(rules.Options) == 0 {
 		return nil
 	}
 
 	if strValue, ok := value.(string); ok {
-		for _, option := range options {
+		for _, option := range rules.Options {
 			if strValue == option {
 				return nil
 			}
@@ -177,7 +204,7 @@ func (v *FieldValidator) validateOptions(fieldName string, value any, options []
 
 		return []Error{{
 			Field:   fieldName,
-			Message: "Invalid option selected",
+			Message: rules.getMessage("options", nil),
 			Rule:    "options",
 		}}
 	}
@@ -186,11 +213,11 @@ func (v *FieldValidator) validateOptions(fieldName string, value any, options []
 }
 
 // validateCustomRules validates custom validation rules
-func (v *FieldValidator) validateCustomRules(fieldName string, value any, rules []Rule) []Error {
+func (v *FieldValidator) validateCustomRules(fieldName string, value any, rules *FieldValidation) []Error {
 	var errors []Error
 
-	for _, rule := range rules {
-		if ruleError := v.validateCustomRule(fieldName, value, rule); ruleError != nil {
+	for _, rule := range rules.CustomRules {
+		if ruleError := v.validateCustomRule(fieldName, value, rule, rules); ruleError != nil {
 			errors = append(errors, *ruleError)
 		}
 	}
@@ -198,33 +225,38 @@ func (v *FieldValidator) validateCustomRules(fieldName string, value any, rules
 	return errors
 }
 
-// ValidateFieldType validates the type of a field
+// ValidateFieldType validates the type of a field, reporting errors in the default locale
 func (v *FieldValidator) ValidateFieldType(fieldName string, value any, fieldType string) *Error {
-	switch fieldType {
+	return v.validateType(fieldName, value, &FieldValidation{Type: fieldType, Label: fieldName})
+}
+
+// validateType validates the value against the field's type
+func (v *FieldValidator) validateType(fieldName string, value any, rules *FieldValidation) *Error {
+	switch rules.Type {
 	case "email":
-		return v.validateEmail(fieldName, value)
+		return v.validateEmail(fieldName, value, rules)
 	case "url":
-		return v.validateURL(fieldName, value)
+		return v.validateURL(fieldName, value, rules)
 	case "phoneNumber":
-		return v.validatePhoneNumber(fieldName, value)
+		return v.validatePhoneNumber(fieldName, value, rules)
 	case "date":
-		return v.validateDate(fieldName, value)
+		return v.validateDate(fieldName, value, rules)
 	case "number":
-		return v.validateNumber(fieldName, value)
+		return v.validateNumber(fieldName, value, rules)
 	case "integer":
-		return v.validateInteger(fieldName, value)
+		return v.validateInteger(fieldName, value, rules)
 	}
 
 	return nil
 }
 
 // validateEmail validates email format
-func (v *FieldValidator) validateEmail(fieldName string, value any) *Error {
+func (v *FieldValidator) validateEmail(fieldName string, value any, rules *FieldValidation) *Error {
 	if strValue, ok := value.(string); ok {
 		if !v.emailRegex.MatchString(strValue) {
 			return &Error{
 				Field:   fieldName,
-				Message: "Invalid email format",
+				Message: rules.getMessage("email", nil),
 				Rule:    "email",
 			}
 		}
@@ -234,12 +266,12 @@ func (v *FieldValidator) validateEmail(fieldName string, value any) *Error {
 }
 
 // validateURL validates URL format
-func (v *FieldValidator) validateURL(fieldName string, value any) *Error {
+func (v *FieldValidator) validateURL(fieldName string, value any, rules *FieldValidation) *Error {
 	if strValue, ok := value.(string); ok {
 		if !v.urlRegex.MatchString(strValue) {
 			return &Error{
 				Field:   fieldName,
-				Message: "Invalid URL format",
+				Message: rules.getMessage("url", nil),
 				Rule:    "url",
 			}
 		}
@@ -249,12 +281,12 @@ func (v *FieldValidator) validateURL(fieldName string, value any) *Error {
 }
 
 // validatePhoneNumber validates phone number format
-func (v *FieldValidator) validatePhoneNumber(fieldName string, value any) *Error {
+func (v *FieldValidator) validatePhoneNumber(fieldName string, value any, rules *FieldValidation) *Error {
 	if strValue, ok := value.(string); ok {
 		if !v.phoneRegex.MatchString(strValue) {
 			return &Error{
 				Field:   fieldName,
-				Message: "Invalid phone number format",
+				Message: rules.getMessage("phoneNumber", nil),
 				Rule:    "phoneNumber",
 			}
 		}
@@ -264,12 +296,12 @@ func (v *FieldValidator) validatePhoneNumber(fieldName string, value any) *Error
 }
 
 // validateDate validates date format
-func (v *FieldValidator) validateDate(fieldName string, value any) *Error {
+func (v *FieldValidator) validateDate(fieldName string, value any, rules *FieldValidation) *Error {
 	if strValue, ok := value.(string); ok {
 		if !v.dateRegex.MatchString(strValue) {
 			return &Error{
 				Field:   fieldName,
-				Message: "Invalid date format (YYYY-MM-DD)",
+				Message: rules.getMessage("date", nil),
 				Rule:    "date",
 			}
 		}
@@ -279,11 +311,11 @@ func (v *FieldValidator) validateDate(fieldName string, value any) *Error {
 }
 
 // validateNumber validates number format
-func (v *FieldValidator) validateNumber(fieldName string, value any) *Error {
+func (v *FieldValidator) validateNumber(fieldName string, value any, rules *FieldValidation) *Error {
 	if _, ok := v.toFloat64(value); !ok {
 		return &Error{
 			Field:   fieldName,
-			Message: "Value must be a number",
+			Message: rules.getMessage("number", nil),
 			Rule:    "number",
 		}
 	}
@@ -292,19 +324,19 @@ func (v *FieldValidator) validateNumber(fieldName string, value any) *Error {
 }
 
 // validateInteger validates integer format
-func (v *FieldValidator) validateInteger(fieldName string, value any) *Error {
+func (v *FieldValidator) validateInteger(fieldName string, value any, rules *FieldValidation) *Error {
 	if floatValue, ok := v.toFloat64(value); ok {
 		if floatValue != float64(int(floatValue)) {
 			return &Error{
 				Field:   fieldName,
-				Message: "Value must be an integer",
+				Message: rules.getMessage("integer", nil),
 				Rule:    "integer",
 			}
 		}
 	} else {
 		return &Error{
 			Field:   fieldName,
-			Message: "Value must be an integer",
+			Message: rules.getMessage("integer", nil),
 			Rule:    "integer",
 		}
 	}
@@ -313,10 +345,10 @@ func (v *FieldValidator) validateInteger(fieldName string, value any) *Error {
 }
 
 // validateCustomRule validates a custom validation rule
-func (v *FieldValidator) validateCustomRule(fieldName string, value any, rule Rule) *Error {
+func (v *FieldValidator) validateCustomRule(fieldName string, value any, rule Rule, rules *FieldValidation) *Error {
 	switch rule.Type {
 	case "regex":
-		return v.validateRegexRule(fieldName, value, rule)
+		return v.validateRegexRule(fieldName, value, rule, rules)
 	case "custom":
 		// Custom validation logic can be extended here
 		return nil
@@ -326,7 +358,7 @@ func (v *FieldValidator) validateCustomRule(fieldName string, value any, rule Ru
 }
 
 // validateRegexRule validates a regex rule
-func (v *FieldValidator) validateRegexRule(fieldName string, value any, rule Rule) *Error {
+func (v *FieldValidator) validateRegexRule(fieldName string, value any, rule Rule, rules *FieldValidation) *Error {
 	strValue, ok := value.(string)
 	if !ok {
 		return nil
@@ -341,15 +373,20 @@ func (v *FieldValidator) validateRegexRule(fieldName string, value any, rule Rul
 	if err != nil {
 		return &Error{
 			Field:   fieldName,
-			Message: fmt.Sprintf("Invalid regex pattern: %v", err),
+			Message: rules.getMessage("invalidPattern", map[string]any{"error": err}),
 			Rule:    rule.Type,
 		}
 	}
 
 	if !matched {
+		message := rule.Message
+		if message == "" {
+			message = rules.getMessage("pattern", map[string]any{"pattern": pattern})
+		}
+
 		return &Error{
 			Field:   fieldName,
-			Message: rule.Message,
+			Message: message,
 			Rule:    rule.Type,
 		}
 	}
@@ -0,0 +1,224 @@
+package validation
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DefaultLocale is used when neither the request nor the form selects a supported language.
+// Its catalog must define every message; other catalogs fall back to it for missing entries.
+const DefaultLocale = "en"
+
+//go:embed locales/*.json
+var localeFS embed.FS
+
+// formioErrorKeys maps Form.io `errors` override keys onto this package's rule names
+var formioErrorKeys = map[string]string{
+	"invalid_email": "email",
+	"invalid_url":   "url",
+	"invalid_date":  "date",
+	"invalid_regex": "pattern",
+}
+
+// placeholderPattern matches {{name}} placeholders, with optional inner spaces
+var placeholderPattern = regexp.MustCompile(`\{\{\s*(\w+)\s*\}\}`)
+
+// Messages holds the validation message catalog of each supported locale
+type Messages struct {
+	catalogs map[string]map[string]string
+}
+
+// defaultMessages is loaded once from the embedded catalogs
+var defaultMessages = mustLoadMessages()
+
+// NewMessages returns the built-in message catalogs
+func NewMessages() *Messages {
+	return defaultMessages
+}
+
+func mustLoadMessages() *Messages {
+	messages, err := loadMessages()
+	if err != nil {
+		panic(err)
+	}
+
+	return messages
+}
+
+// loadMessages reads every embedded catalog, filling gaps from the default locale
+func loadMessages() (*Messages, error) {
+	entries, err := localeFS.ReadDir("locales")
+	if err != nil {
+		return nil, fmt.Errorf("read validation locales: %w", err)
+	}
+
+	catalogs := make(map[string]map[string]string, len(entries))
+
+	for _, entry := range entries {
+		content, readErr := localeFS.ReadFile(path.Join("locales", entry.Name()))
+		if readErr != nil {
+			return nil, fmt.Errorf("read validation locale %s: %w", entry.Name(), readErr)
+		}
+
+		catalog := make(map[string]string)
+		if jsonErr := json.Unmarshal(content, &catalog); jsonErr != nil {
+			return nil, fmt.Errorf("parse validation locale %s: %w", entry.Name(), jsonErr)
+		}
+
+		catalogs[strings.ToLower(strings.TrimSuffix(entry.Name(), path.Ext(entry.Name())))] = catalog
+	}
+
+	fallback, ok := catalogs[DefaultLocale]
+	if !ok {
+		return nil, fmt.Errorf("validation locale %q is missing", DefaultLocale)
+	}
+
+	for _, catalog := range catalogs {
+		for rule, message := range fallback {
+			if _, exists := catalog[rule]; !exists {
+				catalog[rule] = message
+			}
+		}
+	}
+
+	return &Messages{catalogs: catalogs}, nil
+}
+
+// Locales lists the supported locales
+func (m *Messages) Locales() []string {
+	locales := make([]string, 0, len(m.catalogs))
+	for locale := range m.catalogs {
+		locales = append(locales, locale)
+	}
+
+	sort.Strings(locales)
+
+	return locales
+}
+
+// Catalog returns the messages of a supported locale, or of the default locale
+func (m *Messages) Catalog(locale string) map[string]string {
+	if catalog, ok := m.catalogs[locale]; ok {
+		return catalog
+	}
+
+	return m.catalogs[DefaultLocale]
+}
+
+// Negotiate picks the locale for a response. Languages from the Accept-Language header
+// are tried in preference order, matching a regional tag such as fr-CA to its base
+// language; then the form's default language; then DefaultLocale.
+func (m *Messages) Negotiate(acceptLanguage, formLanguage string) string {
+	for _, tag := range parseAcceptLanguage(acceptLanguage) {
+		if locale, ok := m.match(tag); ok {
+			return locale
+		}
+	}
+
+	if locale, ok := m.match(formLanguage); ok {
+		return locale
+	}
+
+	return DefaultLocale
+}
+
+// match finds the supported locale for a language tag
+func (m *Messages) match(tag string) (string, bool) {
+	tag = strings.ToLower(strings.TrimSpace(tag))
+	if tag == "" {
+		return "", false
+	}
+
+	if _, ok := m.catalogs[tag]; ok {
+		return tag, true
+	}
+
+	base, _, _ := strings.Cut(strings.ReplaceAll(tag, "_", "-"), "-")
+	if _, ok := m.catalogs[base]; ok {
+		return base, true
+	}
+
+	return "", false
+}
+
+// parseAcceptLanguage returns the tags of an Accept-Language header ordered by quality.
+// Wildcards and tags with q=0 are dropped.
+func parseAcceptLanguage(header string) []string {
+	type weightedTag struct {
+		tag     string
+		quality float64
+	}
+
+	var tags []weightedTag
+
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+		if tag == "" || tag == "*" {
+			continue
+		}
+
+		quality := 1.0
+
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				continue
+			}
+
+			quality = parsed
+		}
+
+		if quality > 0 {
+			tags = append(tags, weightedTag{tag: tag, quality: quality})
+		}
+	}
+
+	sort.SliceStable(tags, func(i, j int) bool { return tags[i].quality > tags[j].quality })
+
+	ordered := make([]string, len(tags))
+	for i, t := range tags {
+		ordered[i] = t.tag
+	}
+
+	return ordered
+}
+
+// FormLanguage reads the default language of a form from its schema's `language`
+// or `settings.language` property
+func FormLanguage(schema map[string]any) string {
+	if language, ok := schema["language"].(string); ok && language != "" {
+		return language
+	}
+
+	if settings, ok := schema["settings"].(map[string]any); ok {
+		if language, languageOk := settings["language"].(string); languageOk {
+			return language
+		}
+	}
+
+	return ""
+}
+
+// interpolate replaces {{name}} placeholders with params; unknown placeholders are kept
+func interpolate(message string, params map[string]any) string {
+	if !strings.Contains(message, "{{") {
+		return message
+	}
+
+	return placeholderPattern.ReplaceAllStringFunc(message, func(placeholder string) string {
+		name := placeholderPattern.FindStringSubmatch(placeholder)[1]
+
+		value, ok := params[name]
+		if !ok {
+			return placeholder
+		}
+
+		return fmt.Sprint(value)
+	})
+}
@@ -0,0 +1,200 @@
+package validation_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goformx/goforms/internal/application/validation"
+	"github.com/goformx/goforms/internal/domain/form/model"
+)
+
+func TestMessages_Negotiate(t *testing.T) {
+	messages := validation.NewMessages()
+
+	tests := []struct {
+		name           string
+		acceptLanguage string
+		formLanguage   string
+		want           string
+	}{
+		{name: "default", want: "en"},
+		{name: "exact match", acceptLanguage: "fr", want: "fr"},
+		{name: "regional tag matches base language", acceptLanguage: "de-AT", want: "de"},
+		{name: "quality order", acceptLanguage: "fr;q=0.5, es;q=0.9, en;q=0.1", want: "es"},
+		{name: "unsupported falls through to next", acceptLanguage: "ja, fr;q=0.8", want: "fr"},
+		{name: "q=0 is refused", acceptLanguage: "fr;q=0", formLanguage: "de", want: "de"},
+		{name: "form language when header unsupported", acceptLanguage: "ja, *", formLanguage: "es-MX", want: "es"},
+		{name: "header wins over form language", acceptLanguage: "de", formLanguage: "fr", want: "de"},
+		{name: "unsupported form language", formLanguage: "xx", want: "en"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, messages.Negotiate(tt.acceptLanguage, tt.formLanguage))
+		})
+	}
+}
+
+func TestMessages_CatalogsAreComplete(t *testing.T) {
+	messages := validation.NewMessages()
+	require.Contains(t, messages.Locales(), "en")
+
+	// Gaps are filled from English when the catalogs load, so the files are read directly
+	english := readCatalog(t, validation.DefaultLocale)
+
+	for _, locale := range messages.Locales() {
+		catalog := readCatalog(t, locale)
+		for rule := range english {
+			assert.NotEmpty(t, catalog[rule], "%s is missing %s", locale, rule)
+		}
+	}
+}
+
+func readCatalog(t *testing.T, locale string) map[string]string {
+	t.Helper()
+
+	content, err := os.ReadFile(filepath.Join("locales", locale+".json"))
+	require.NoError(t, err)
+
+	var catalog map[string]string
+	require.NoError(t, json.Unmarshal(content, &catalog))
+
+	return catalog
+}
+
+func TestComprehensiveValidator_FillsEveryCatalogPlaceholder(t *testing.T) {
+	validator := validation.NewComprehensiveValidator()
+	schema := model.JSON{"components": []any{
+		map[string]any{"key": "short", "type": "textfield", "validate": map[string]any{"minLength": float64(3)}},
+		map[string]any{"key": "long", "type": "textfield", "validate": map[string]any{"maxLength": float64(2)}},
+		map[string]any{"key": "code", "type": "textfield", "validate": map[string]any{"pattern": "("}},
+	}}
+
+	for _, locale := range validation.NewMessages().Locales() {
+		result := validator.ValidateFormLocalized(schema, model.JSON{"short": "ab", "long": "abc", "code": "x"}, locale)
+		require.Len(t, result.Errors, 3, locale)
+
+		for _, validationErr := range result.Errors {
+			assert.NotContains(t, validationErr.Message, "{{", "%s %s", locale, validationErr.Rule)
+		}
+	}
+}
+
+func messageSchema(component map[string]any) model.JSON {
+	return model.JSON{"components": []any{component}}
+}
+
+func TestComprehensiveValidator_LocalizedMessages(t *testing.T) {
+	validator := validation.NewComprehensiveValidator()
+	schema := messageSchema(map[string]any{
+		"key": "name", "label": "Name", "type": "textfield",
+		"validate": map[string]any{"required": true, "minLength": float64(3)},
+	})
+
+	result := validator.ValidateFormLocalized(schema, model.JSON{}, "fr-FR,fr;q=0.9")
+	require.False(t, result.IsValid)
+	assert.Equal(t, "fr", result.Locale)
+	assert.Equal(t, "Ce champ est obligatoire", result.Errors[0].Message)
+
+	result = validator.ValidateFormLocalized(schema, model.JSON{"name": "Al"}, "de")
+	require.Len(t, result.Errors, 1)
+	assert.Equal(t, "Die Mindestlänge beträgt 3 Zeichen", result.Errors[0].Message)
+
+	schema["language"] = "es"
+	result = validator.ValidateForm(schema, model.JSON{})
+	assert.Equal(t, "es", result.Locale, "form default language applies without a header")
+	assert.Equal(t, "Este campo es obligatorio", result.Errors[0].Message)
+}
+
+func TestComprehensiveValidator_SchemaMessageOverrides(t *testing.T) {
+	validator := validation.NewComprehensiveValidator()
+
+	tests := []struct {
+		name       string
+		component  map[string]any
+		submission model.JSON
+		want       string
+	}{
+		{
+			name: "per-rule errors override",
+			component: map[string]any{
+				"key": "age", "label": "Age", "type": "number",
+				"validate": map[string]any{"min": float64(18)},
+				"errors":   map[string]any{"min": "{{field}} must be at least {{min}}"},
+			},
+			submission: model.JSON{"age": float64(12)},
+			want:       "Age must be at least 18",
+		},
+		{
+			name: "Form.io error keys map to rules",
+			component: map[string]any{
+				"key": "email", "type": "email",
+				"errors": map[string]any{"invalid_email": "Check {{ field }}"},
+			},
+			submission: model.JSON{"email": "nope"},
+			want:       "Check email",
+		},
+		{
+			name: "customMessage replaces every rule",
+			component: map[string]any{
+				"key": "code", "label": "Code", "type": "textfield",
+				"validate": map[string]any{"maxLength": float64(2), "customMessage": "{{field}}: {{length}} max"},
+			},
+			submission: model.JSON{"code": "abc"},
+			want:       "Code: 2 max",
+		},
+		{
+			name: "per-rule override beats customMessage",
+			component: map[string]any{
+				"key": "name", "type": "textfield",
+				"validate": map[string]any{"required": true, "customMessage": "Generic"},
+				"errors":   map[string]any{"required": "Tell us your name"},
+			},
+			submission: model.JSON{},
+			want:       "Tell us your name",
+		},
+		{
+			name: "unknown placeholders are kept",
+			component: map[string]any{
+				"key": "name", "type": "textfield",
+				"validate": map[string]any{"required": true, "customMessage": "{{nope}} missing"},
+			},
+			submission: model.JSON{},
+			want:       "{{nope}} missing",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := validator.ValidateFormLocalized(messageSchema(tt.component), tt.submission, "fr")
+			require.Len(t, result.Errors, 1)
+			assert.Equal(t, tt.want, result.Errors[0].Message)
+		})
+	}
+}
+
+func TestComprehensiveValidator_ClientValidationMessages(t *testing.T) {
+	validator := validation.NewComprehensiveValidator()
+	schema := messageSchema(map[string]any{
+		"key": "email", "label": "Email", "type": "email",
+		"validate": map[string]any{"required": true, "maxLength": float64(50)},
+		"errors":   map[string]any{"required": "{{field}} please"},
+	})
+
+	rules, err := validator.GenerateClientValidationLocalized(schema, "es")
+	require.NoError(t, err)
+
+	field, ok := rules["email"].(map[string]any)
+	require.True(t, ok)
+
+	messages, ok := field["messages"].(map[string]string)
+	require.True(t, ok)
+	assert.Equal(t, "Email please", messages["required"])
+	assert.Equal(t, "La longitud máxima es de 50 caracteres", messages["maxLength"])
+	assert.Equal(t, "El formato del correo electrónico no es válido", messages["email"])
+}
@@ -32,9 +32,49 @@ func (p *SchemaParser) ExtractValidationRules(component map[string]any) FieldVal
 	// Extract options for select/radio/checkbox components
 	p.extractComponentOptions(component, &validation)
 
+	// Extract the field label and custom error messages
+	p.extractMessages(component, &validation)
+
 	return validation
 }
 
+// extractMessages reads the field label, validate.customMessage and the per-rule
+// errors overrides Form.io stores on a component
+func (p *SchemaParser) extractMessages(component map[string]any, validation *FieldValidation) {
+	validation.Label, _ = component["label"].(string)
+	if validation.Label == "" {
+		validation.Label, _ = component["key"].(string)
+	}
+
+	if validate, validateOk := component["validate"].(map[string]any); validateOk {
+		if customMessage, messageOk := validate["customMessage"].(string); messageOk {
+			validation.CustomMessage = customMessage
+		}
+	}
+
+	overrides, overridesOk := component["errors"].(map[string]any)
+	if !overridesOk {
+		return
+	}
+
+	for key, value := range overrides {
+		message, messageOk := value.(string)
+		if !messageOk || message == "" {
+			continue
+		}
+
+		if validation.Messages == nil {
+			validation.Messages = make(map[string]string)
+		}
+
+		if rule, mapped := formioErrorKeys[key]; mapped {
+			key = rule
+		}
+
+		validation.Messages[key] = message
+	}
+}
+
 // extractBasicValidation extracts basic validation properties
 func (p *SchemaParser) extractBasicValidation(component map[string]any, validation *FieldValidation) {
 	validate, validateOk := component["validate"].(map[string]any)
@@ -91,14 +131,21 @@ func (p *SchemaParser) extractComponentType(component map[string]any, validation
 	}
 }
 
-// extractComponentOptions extracts options for select/radio/checkbox components
+// extractComponentOptions extracts options for select/radio/checkbox components.
+// Select components keep their options under data.values; radio components use values.
+// Selectboxes submit an object of option flags rather than one option, so they are skipped.
 func (p *SchemaParser) extractComponentOptions(component map[string]any, validation *FieldValidation) {
-	data, dataOk := component["data"].(map[string]any)
-	if !dataOk {
+	if validation.Type == "selectboxes" {
 		return
 	}
 
-	values, valuesOk := data["values"].([]any)
+	values, valuesOk := component["values"].([]any)
+	if data, dataOk := component["data"].(map[string]any); dataOk {
+		if dataValues, dataValuesOk := data["values"].([]any); dataValuesOk {
+			values, valuesOk = dataValues, true
+		}
+	}
+
 	if !valuesOk {
 		return
 	}
@@ -127,6 +174,64 @@ func (p *SchemaParser) ExtractComponents(schema map[string]any) ([]any, bool) {
 	return components, ok
 }
 
+// layoutComponentTypes hold other components and submit no value of their own
+var layoutComponentTypes = map[string]bool{
+	"panel":    true,
+	"fieldset": true,
+	"well":     true,
+	"columns":  true,
+}
+
+// ExtractInputComponents returns the components that submit values, descending into
+// panels, fieldsets, wells and columns. Form.io stores their children's values flat,
+// keyed by the child component key.
+func (p *SchemaParser) ExtractInputComponents(schema map[string]any) ([]map[string]any, bool) {
+	components, ok := p.ExtractComponents(schema)
+	if !ok {
+		return nil, false
+	}
+
+	var inputs []map[string]any
+
+	p.collectInputComponents(components, &inputs)
+
+	return inputs, true
+}
+
+// collectInputComponents appends the input components found in components to inputs
+func (p *SchemaParser) collectInputComponents(components []any, inputs *[]map[string]any) {
+	for _, component := range components {
+		componentMap, ok := component.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		componentType, _ := componentMap["type"].(string)
+		if !layoutComponentTypes[componentType] {
+			*inputs = append(*inputs, componentMap)
+
+			continue
+		}
+
+		if children, childrenOk := componentMap["components"].([]any); childrenOk {
+			p.collectInputComponents(children, inputs)
+		}
+
+		columns, columnsOk := componentMap["columns"].([]any)
+		if !columnsOk {
+			continue
+		}
+
+		for _, column := range columns {
+			if columnMap, columnOk := column.(map[string]any); columnOk {
+				if children, childrenOk := columnMap["components"].([]any); childrenOk {
+					p.collectInputComponents(children, inputs)
+				}
+			}
+		}
+	}
+}
+
 // ExtractComponentKey extracts the key from a component
 func (p *SchemaParser) ExtractComponentKey(component map[string]any) (string, bool) {
 	key, ok := component["key"].(string)
@@ -170,5 +275,39 @@ func (p *SchemaParser) ConvertToClientRules(validation *FieldValidation) map[str
 		clientRules["options"] = validation.Options
 	}
 
+	if messages := p.clientMessages(validation, clientRules); len(messages) > 0 {
+		clientRules["messages"] = messages
+	}
+
 	return clientRules
 }
+
+// clientRuleTypes lists the field types whose format the client checks, keyed by their rule name
+var clientRuleTypes = map[string]bool{
+	"email":       true,
+	"url":         true,
+	"phoneNumber": true,
+	"date":        true,
+	"number":      true,
+	"integer":     true,
+}
+
+// clientMessages resolves the message of each rule sent to the client, so browser-side
+// errors read the same as the server's
+func (p *SchemaParser) clientMessages(validation *FieldValidation, clientRules map[string]any) map[string]string {
+	messages := make(map[string]string)
+
+	for rule := range clientRules {
+		if rule == "type" {
+			continue
+		}
+
+		messages[rule] = validation.getMessage(rule, nil)
+	}
+
+	if clientRuleTypes[validation.Type] {
+		messages[validation.Type] = validation.getMessage(validation.Type, nil)
+	}
+
+	return messages
+}
@@ -1,5 +1,7 @@
 package validation
 
+import "strconv"
+
 // Rule represents a validation rule for a form field
 type Rule struct {
 	Type      string `json:"type"`
@@ -20,6 +22,16 @@ type FieldValidation struct {
 	Options     []string       `json:"options,omitempty"`
 	CustomRules []Rule         `json:"custom_rules,omitempty"`
 	Conditional map[string]any `json:"conditional,omitempty"`
+
+	// Label names the field in {{field}} placeholders
+	Label string `json:"label,omitempty"`
+	// CustomMessage replaces every message of the field (Form.io validate.customMessage)
+	CustomMessage string `json:"custom_message,omitempty"`
+	// Messages overrides the message of individual rules (Form.io errors)
+	Messages map[string]string `json:"messages,omitempty"`
+
+	// catalog holds the locale's default messages; nil means DefaultLocale
+	catalog map[string]string
 }
 
 // Error represents a validation error for a specific field
@@ -33,6 +45,8 @@ type Error struct {
 type Result struct {
 	IsValid bool    `json:"is_valid"`
 	Errors  []Error `json:"errors,omitempty"`
+	// Locale is the language of the error messages
+	Locale string `json:"locale,omitempty"`
 }
 
 // FormValidatorInterface defines the interface for form validation
@@ -47,9 +61,50 @@ type FieldValidatorInterface interface {
 	ValidateFieldType(fieldName string, value any, fieldType string) *Error
 }
 
-// getMessage returns the default message for a validation rule.
-// Custom per-ruleType message lookup is not implemented; callers may extend this later.
-func (fv *FieldValidation) getMessage(ruleType, defaultMessage string) string {
-	_ = ruleType // reserved for future per-rule message overrides
-	return defaultMessage
+// Localize selects the message catalog used for the field's errors
+func (fv *FieldValidation) Localize(catalog map[string]string) {
+	fv.catalog = catalog
+}
+
+// getMessage resolves the message for a rule: a per-rule override from the schema, then the
+// field's custom message, then the locale catalog. Placeholders such as {{field}}, {{min}}
+// or {{maxLength}} are filled from the field's rules and params.
+func (fv *FieldValidation) getMessage(ruleType string, params map[string]any) string {
+	message, ok := fv.Messages[ruleType]
+	if !ok || message == "" {
+		message = fv.CustomMessage
+	}
+
+	if message == "" {
+		catalog := fv.catalog
+		if catalog == nil {
+			catalog = defaultMessages.Catalog(DefaultLocale)
+		}
+
+		message = catalog[ruleType]
+	}
+
+	return interpolate(message, fv.placeholders(ruleType, params))
+}
+
+// placeholders returns the values available to message templates. {{length}} is the
+// Form.io name of the limit of a minLength or maxLength rule, for schema overrides.
+func (fv *FieldValidation) placeholders(ruleType string, params map[string]any) map[string]any {
+	values := map[string]any{
+		"field":     fv.Label,
+		"min":       strconv.FormatFloat(fv.Min, 'f', -1, 64),
+		"max":       strconv.FormatFloat(fv.Max, 'f', -1, 64),
+		"minLength": fv.MinLength,
+		"maxLength": fv.MaxLength,
+	}
+
+	if ruleType == "minLength" || ruleType == "maxLength" {
+		values["length"] = values[ruleType]
+	}
+
+	for name, value := range params {
+		values[name] = value
+	}
+
+	return values
 }
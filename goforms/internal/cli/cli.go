@@ -0,0 +1,215 @@
+// Package cli implements the operational subcommands of the goforms binary. Commands
+// build the server's Fx graph without the HTTP layer, so they use the same configuration,
+// database connection and repositories as the running application.
+package cli
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	"go.uber.org/fx"
+
+	"github.com/goformx/goforms/internal/domain"
+	"github.com/goformx/goforms/internal/infrastructure"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+	"github.com/goformx/goforms/internal/infrastructure/sanitization"
+	"github.com/goformx/goforms/internal/infrastructure/version"
+)
+
+// Exit codes returned by Run
+const (
+	ExitOK    = 0
+	ExitError = 1
+	ExitUsage = 2
+)
+
+// usageError reports invalid arguments; Run exits with ExitUsage for it
+type usageError struct {
+	message string
+}
+
+func (e *usageError) Error() string {
+	return e.message
+}
+
+// newUsageError creates a usage error
+func newUsageError(format string, args ...any) error {
+	return &usageError{message: fmt.Sprintf(format, args...)}
+}
+
+// Streams are the standard streams a command reads from and writes to
+type Streams struct {
+	In  io.Reader
+	Out io.Writer
+	Err io.Writer
+}
+
+// command is one subcommand such as "migrate up"
+type command struct {
+	group       string
+	name        string
+	args        string
+	description string
+	run         func(ctx context.Context, streams Streams, args []string) error
+}
+
+// commands lists every subcommand in the order they are shown in the usage text
+var commands = []command{
+	{"migrate", "up", "[N]", "Apply all pending migrations, or the next N", runMigrateUp},
+	{"migrate", "down", "[N|all]", "Roll back the last migration, the last N, or all of them", runMigrateDown},
+	{"migrate", "status", "", "Show the applied version and pending migrations", runMigrateStatus},
+	{"migrate", "redo", "", "Roll back the last migration and apply it again", runMigrateRedo},
+	{"migrate", "force", "VERSION", "Record VERSION as applied after repairing a failed migration", runMigrateForce},
+	{"config", "validate", "", "Validate the configuration without starting the server", runConfigValidate},
+	{"config", "show", "", "Print the configuration with secrets redacted", runConfigShow},
+	{"secrets", "keygen", "", "Generate a master key for the encrypted secrets file", runSecretsKeygen},
+	{"secrets", "set", "KEY", "Store the secret read from stdin for a config key in the secrets file", runSecretsSet},
+	{"secrets", "unset", "KEY", "Remove a config key from the secrets file", runSecretsUnset},
+	{"secrets", "list", "", "List the config keys held in the secrets file", runSecretsList},
+	{"forms", "export", "", "Export forms as JSON", runFormsExport},
+	{"forms", "import", "FILE", "Import forms exported with forms export (- reads stdin)", runFormsImport},
+	{"submissions", "purge", "", "Delete submissions older than a retention cutoff", runSubmissionsPurge},
+}
+
+// Run executes the subcommand named by args and returns the process exit code
+func Run(ctx context.Context, args []string, streams Streams) int {
+	cmd, rest, ok := lookup(args)
+	if !ok {
+		if len(args) > 0 && args[0] != "help" && args[0] != "-h" && args[0] != "--help" {
+			fmt.Fprintf(streams.Err, "unknown command: %s\n\n", strings.Join(args, " "))
+			printUsage(streams.Err)
+
+			return ExitUsage
+		}
+
+		printUsage(streams.Out)
+
+		return ExitOK
+	}
+
+	if err := cmd.run(ctx, streams, rest); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return ExitOK
+		}
+
+		fmt.Fprintf(streams.Err, "%s %s: %v\n", cmd.group, cmd.name, err)
+
+		var usageErr *usageError
+		if errors.As(err, &usageErr) {
+			return ExitUsage
+		}
+
+		return ExitError
+	}
+
+	return ExitOK
+}
+
+// lookup finds the command named by the first two arguments
+func lookup(args []string) (command, []string, bool) {
+	if len(args) < 2 {
+		return command{}, nil, false
+	}
+
+	for _, cmd := range commands {
+		if cmd.group == args[0] && cmd.name == args[1] {
+			return cmd, args[2:], true
+		}
+	}
+
+	return command{}, nil, false
+}
+
+// printUsage lists the available commands
+func printUsage(w io.Writer) {
+	fmt.Fprintf(w, "Usage: goforms [serve] [--storage=database|memory] [--fixtures=FILE]\n       goforms <command> [flags]\n\nCommands:\n")
+
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-32s %s\n", strings.TrimSpace(cmd.group+" "+cmd.name+" "+cmd.args), cmd.description)
+	}
+
+	fmt.Fprintf(w, "\nRun a command with -h to see its flags.\n")
+}
+
+// newFlagSet creates the flag set of a command, printing its help to the error stream
+func newFlagSet(cmd string, streams Streams) *flag.FlagSet {
+	flags := flag.NewFlagSet(cmd, flag.ContinueOnError)
+	flags.SetOutput(streams.Err)
+
+	return flags
+}
+
+// parseFlags parses a command's flags, reporting invalid ones as usage errors
+func parseFlags(flags *flag.FlagSet, args []string) error {
+	if err := flags.Parse(args); err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			return err
+		}
+
+		return newUsageError("%v", err)
+	}
+
+	return nil
+}
+
+// withApp builds the application graph without the HTTP layer, populates targets from it,
+// and runs fn between starting and stopping the application
+func withApp(ctx context.Context, fn func() error, targets ...any) (err error) {
+	app := fx.New(
+		config.Module,
+		infrastructure.Module,
+		domain.Module,
+		fx.Decorate(newCommandLogger),
+		fx.Populate(targets...),
+		fx.NopLogger,
+	)
+
+	if err = app.Err(); err != nil {
+		return fmt.Errorf("initialize application: %w", err)
+	}
+
+	if err = app.Start(ctx); err != nil {
+		return fmt.Errorf("start application: %w", err)
+	}
+
+	defer func() {
+		if stopErr := app.Stop(context.WithoutCancel(ctx)); stopErr != nil && err == nil {
+			err = fmt.Errorf("stop application: %w", stopErr)
+		}
+	}()
+
+	return fn()
+}
+
+// newCommandLogger replaces the application logger with one writing to stderr, so logs
+// never mix with command output such as an export written to stdout
+func newCommandLogger(cfg *config.Config, sanitizer sanitization.ServiceInterface) (logging.Logger, error) {
+	factory, err := logging.NewFactory(&logging.FactoryConfig{
+		AppName:     cfg.App.Name,
+		Version:     version.Version,
+		Environment: cfg.App.Environment,
+		Fields: map[string]any{
+			"app":     cfg.App.Name,
+			"version": version.Version,
+			"env":     cfg.App.Environment,
+		},
+		LogLevel:         cfg.App.LogLevel,
+		OutputPaths:      []string{"stderr"},
+		ErrorOutputPaths: []string{"stderr"},
+	}, sanitizer)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create logger factory: %w", err)
+	}
+
+	logger, err := factory.CreateLogger()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create logger: %w", err)
+	}
+
+	return logger, nil
+}
@@ -0,0 +1,146 @@
+package cli_test
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goformx/goforms/internal/cli"
+)
+
+func run(t *testing.T, stdin string, args ...string) (code int, stdout, stderr string) {
+	t.Helper()
+
+	var out, errOut bytes.Buffer
+
+	code = cli.Run(context.Background(), args, cli.Streams{In: strings.NewReader(stdin), Out: &out, Err: &errOut})
+
+	return code, out.String(), errOut.String()
+}
+
+func TestRun_PrintsUsage(t *testing.T) {
+	code, stdout, _ := run(t, "", "help")
+	assert.Equal(t, cli.ExitOK, code)
+	assert.Contains(t, stdout, "migrate up [N]")
+	assert.Contains(t, stdout, "submissions purge")
+}
+
+func TestRun_RejectsUnknownCommand(t *testing.T) {
+	code, _, stderr := run(t, "", "migrate", "sideways")
+	assert.Equal(t, cli.ExitUsage, code)
+	assert.Contains(t, stderr, "unknown command: migrate sideways")
+}
+
+func TestRun_ArgumentErrorsAreUsageErrors(t *testing.T) {
+	tests := map[string][]string{
+		"invalid step count":    {"migrate", "down", "zero"},
+		"missing force version": {"migrate", "force"},
+		"unknown flag":          {"migrate", "up", "--fast"},
+		"no purge cutoff":       {"submissions", "purge"},
+		"two purge cutoffs":     {"submissions", "purge", "--older-than", "90d", "--before", "2026-01-01"},
+		"unknown purge status":  {"submissions", "purge", "--older-than", "90d", "--status", "archived"},
+		"invalid purge age":     {"submissions", "purge", "--older-than", "-3d"},
+		"no export selector":    {"forms", "export"},
+		"two export selectors":  {"forms", "export", "--user", "u1", "--form", "f1"},
+		"no import file":        {"forms", "import"},
+	}
+
+	for name, args := range tests {
+		t.Run(name, func(t *testing.T) {
+			code, _, _ := run(t, "", args...)
+			assert.Equal(t, cli.ExitUsage, code)
+		})
+	}
+}
+
+func TestConfigValidate_ReportsEveryError(t *testing.T) {
+	t.Setenv("DB_CONNECTION", "oracle")
+	t.Setenv("SESSION_SECRET", "")
+
+	code, stdout, stderr := run(t, "", "config", "validate")
+	assert.Equal(t, cli.ExitError, code)
+	assert.Contains(t, stdout, "database.driver: unsupported database driver (value: oracle)")
+	assert.Contains(t, stdout, "session.secret: session secret is required (value: ***)")
+	assert.Contains(t, stderr, "configuration is invalid")
+}
+
+func TestFormsImport_DryRunValidatesFile(t *testing.T) {
+	doc := `{"version":1,"exported_at":"2026-10-18T00:00:00Z","forms":[
+		{"id":"6f1c2b3a-0000-4000-8000-000000000001","user_id":"user-1","title":"Contact us","schema":{"display":"form","components":[]},
+		 "status":"published","tags":["support"]}
+	]}`
+
+	code, stdout, stderr := run(t, doc, "forms", "import", "--dry-run", "-")
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Contains(t, stdout, "1 forms are valid")
+}
+
+func TestFormsImport_RejectsInvalidForms(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := map[string]string{
+		"unknown version": `{"version":2,"forms":[]}`,
+		"unknown field":   `{"version":1,"forms":[],"submissions":[]}`,
+		"invalid form":    `{"version":1,"forms":[{"title":"x"}]}`,
+	}
+
+	for name, doc := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, strings.ReplaceAll(name, " ", "_")+".json")
+			require.NoError(t, os.WriteFile(path, []byte(doc), 0o600))
+
+			code, _, _ := run(t, "", "forms", "import", "--dry-run", path)
+			assert.Equal(t, cli.ExitError, code)
+		})
+	}
+}
+
+func TestSecrets_StoresSecretsInEncryptedFile(t *testing.T) {
+	code, masterKey, stderr := run(t, "", "secrets", "keygen")
+	require.Equal(t, cli.ExitOK, code, stderr)
+
+	path := filepath.Join(t.TempDir(), "secrets.json")
+	t.Setenv("GOFORMS_SECRETS_FILE", path)
+	t.Setenv("GOFORMS_MASTER_KEY", strings.TrimSpace(masterKey))
+
+	code, stdout, stderr := run(t, "session-secret-from-the-secrets-file\n", "secrets", "set", "session.secret")
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Equal(t, "stored session.secret\n", stdout)
+
+	code, _, stderr = run(t, "db.internal\n", "secrets", "set", "database.host")
+	assert.Equal(t, cli.ExitUsage, code)
+	assert.Contains(t, stderr, "database.host is not a secret setting")
+
+	code, stdout, _ = run(t, "", "secrets", "list")
+	require.Equal(t, cli.ExitOK, code)
+	assert.Equal(t, "session.secret\n", stdout)
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), "session-secret-from-the-secrets-file")
+
+	// config show resolves the secret from the file but never prints it
+	code, stdout, stderr = run(t, "", "config", "show")
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Contains(t, stdout, `"session.secret": "secrets file `+path+`"`)
+	assert.Contains(t, stdout, `"secret": "[REDACTED]"`)
+	assert.NotContains(t, stdout, "session-secret-from-the-secrets-file")
+
+	code, stdout, stderr = run(t, "", "secrets", "unset", "session.secret")
+	require.Equal(t, cli.ExitOK, code, stderr)
+	assert.Equal(t, "removed session.secret\n", stdout)
+}
+
+func TestSecrets_RequireMasterKey(t *testing.T) {
+	t.Setenv("GOFORMS_MASTER_KEY", "")
+
+	code, _, stderr := run(t, "", "secrets", "list", "--file", filepath.Join(t.TempDir(), "secrets.json"))
+	assert.Equal(t, cli.ExitUsage, code)
+	assert.Contains(t, stderr, "set GOFORMS_MASTER_KEY or GOFORMS_MASTER_KEY_FILE")
+}
@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/goformx/goforms/internal/infrastructure/config"
+)
+
+// errInvalidConfig is returned by config validate when the configuration has errors
+var errInvalidConfig = errors.New("configuration is invalid")
+
+// runConfigValidate loads the configuration the way the server does and reports every
+// validation error instead of stopping at the first. It does not need the Fx graph, which
+// cannot be built from an invalid configuration.
+func runConfigValidate(_ context.Context, streams Streams, args []string) error {
+	flags := newFlagSet("config validate", streams)
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	vc := config.NewViperConfig()
+
+	cfg, err := vc.LoadUnvalidated()
+	if err != nil {
+		return fmt.Errorf("load configuration: %w", err)
+	}
+
+	if path := vc.GetConfigFilePath(); path != "" {
+		fmt.Fprintf(streams.Out, "config file: %s\n", path)
+	}
+
+	result := config.ValidateConfig(cfg)
+	for _, validationErr := range result.Errors {
+		fmt.Fprintf(streams.Out, "  %s: %s (value: %v)\n", validationErr.Field, validationErr.Message, validationErr.Value)
+	}
+
+	// The server also refuses some combinations the detailed checks do not cover
+	startupErr := cfg.Validate()
+	if startupErr != nil {
+		fmt.Fprintf(streams.Out, "  startup: %v\n", startupErr)
+	}
+
+	if !result.IsValid || startupErr != nil {
+		return errInvalidConfig
+	}
+
+	fmt.Fprintln(streams.Out, "configuration is valid")
+
+	return nil
+}
+
+// configDump is the document written by config show
+type configDump struct {
+	ConfigFile    string            `json:"config_file,omitempty"`
+	SecretSources map[string]string `json:"secret_sources"`
+	Config        config.Config     `json:"config"`
+}
+
+// runConfigShow prints the configuration the server would load, with every secret redacted,
+// and where secrets resolved from files and secret stores came from
+func runConfigShow(_ context.Context, streams Streams, args []string) error {
+	flags := newFlagSet("config show", streams)
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	vc := config.NewViperConfig()
+
+	cfg, err := vc.LoadUnvalidated()
+	if err != nil {
+		return fmt.Errorf("load configuration: %w", err)
+	}
+
+	encoder := json.NewEncoder(streams.Out)
+	encoder.SetIndent("", "  ")
+
+	if err = encoder.Encode(configDump{
+		ConfigFile:    vc.GetConfigFilePath(),
+		SecretSources: vc.SecretSources(),
+		Config:        cfg.Redacted(),
+	}); err != nil {
+		return fmt.Errorf("write configuration: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// day is the length of the d suffix accepted by duration flags
+const day = 24 * time.Hour
+
+// flagDuration is a duration flag that also accepts whole days, as in 90d
+type flagDuration time.Duration
+
+var _ flag.Value = (*flagDuration)(nil)
+
+// Duration returns the flag's value
+func (d *flagDuration) Duration() time.Duration {
+	return time.Duration(*d)
+}
+
+// String formats the flag's value
+func (d *flagDuration) String() string {
+	return time.Duration(*d).String()
+}
+
+// Set parses a Go duration or a number of days
+func (d *flagDuration) Set(value string) error {
+	parsed, err := parseDuration(value)
+	if err != nil {
+		return err
+	}
+
+	*d = flagDuration(parsed)
+
+	return nil
+}
+
+// parseDuration parses a positive Go duration or a number of days such as 90d
+func parseDuration(value string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n <= 0 {
+			return 0, fmt.Errorf("invalid number of days %q", value)
+		}
+
+		return time.Duration(n) * day, nil
+	}
+
+	parsed, err := time.ParseDuration(value)
+	if err != nil || parsed <= 0 {
+		return 0, fmt.Errorf("invalid duration %q", value)
+	}
+
+	return parsed, nil
+}
+
+// flagTime is a time flag accepting RFC 3339 timestamps or dates
+type flagTime struct {
+	time.Time
+}
+
+var _ flag.Value = (*flagTime)(nil)
+
+// String formats the flag's value
+func (t *flagTime) String() string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Format(time.RFC3339)
+}
+
+// Set parses an RFC 3339 timestamp or a date, which is taken as midnight UTC
+func (t *flagTime) Set(value string) error {
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if parsed, err := time.Parse(layout, value); err == nil {
+			t.Time = parsed
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid time %q: use YYYY-MM-DD or RFC 3339", value)
+}
+
+// flagList is a flag collecting comma-separated values, which may be repeated
+type flagList []string
+
+var _ flag.Value = (*flagList)(nil)
+
+// String formats the flag's values
+func (l *flagList) String() string {
+	return strings.Join(*l, ",")
+}
+
+// Set appends the comma-separated values
+func (l *flagList) Set(value string) error {
+	for item := range strings.SplitSeq(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			*l = append(*l, item)
+		}
+	}
+
+	return nil
+}
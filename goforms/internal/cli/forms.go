@@ -0,0 +1,286 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"go.uber.org/fx"
+
+	"github.com/goformx/goforms/internal/domain/form"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+// formsExportVersion is bumped when the export document changes incompatibly
+const formsExportVersion = 1
+
+// exportFileMode is the permission of export files, which may hold personal data in schemas
+const exportFileMode = 0o600
+
+// formsExport is the document written by forms export and read by forms import
+type formsExport struct {
+	Version    int           `json:"version"`
+	ExportedAt time.Time     `json:"exported_at"`
+	Forms      []*model.Form `json:"forms"`
+}
+
+// formsDeps are the dependencies of the forms and submissions commands
+type formsDeps struct {
+	fx.In
+
+	Forms form.Repository
+}
+
+func runFormsExport(ctx context.Context, streams Streams, args []string) error {
+	var (
+		userID, workspaceID, output string
+		formIDs                     flagList
+	)
+
+	flags := newFlagSet("forms export", streams)
+	flags.StringVar(&userID, "user", "", "export the forms owned by this user")
+	flags.StringVar(&workspaceID, "workspace", "", "export the forms of this workspace")
+	flags.Var(&formIDs, "form", "export these forms (comma-separated, repeatable)")
+	flags.StringVar(&output, "output", "-", "file to write, - for stdout")
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	if countSet(userID, workspaceID, formIDs) != 1 {
+		return newUsageError("exactly one of --user, --workspace or --form is required")
+	}
+
+	var deps formsDeps
+
+	return withApp(ctx, func() error {
+		forms, err := selectForms(ctx, deps.Forms, userID, workspaceID, formIDs)
+		if err != nil {
+			return err
+		}
+
+		doc := formsExport{Version: formsExportVersion, ExportedAt: time.Now().UTC(), Forms: forms}
+
+		if err = writeJSON(streams.Out, output, doc); err != nil {
+			return err
+		}
+
+		fmt.Fprintf(streams.Err, "exported %d forms\n", len(forms))
+
+		return nil
+	}, &deps)
+}
+
+// countSet counts the export selectors that were given
+func countSet(userID, workspaceID string, formIDs flagList) int {
+	count := 0
+
+	for _, set := range []bool{userID != "", workspaceID != "", len(formIDs) > 0} {
+		if set {
+			count++
+		}
+	}
+
+	return count
+}
+
+// selectForms loads the forms chosen by the export flags, with their tags
+func selectForms(ctx context.Context, repo form.Repository, userID, workspaceID string, formIDs []string) ([]*model.Form, error) {
+	if len(formIDs) == 0 {
+		var (
+			listed []*model.Form
+			err    error
+		)
+
+		if userID != "" {
+			listed, err = repo.ListForms(ctx, userID)
+		} else {
+			listed, err = repo.ListFormsByWorkspace(ctx, workspaceID)
+		}
+
+		if err != nil {
+			return nil, fmt.Errorf("list forms: %w", err)
+		}
+
+		for _, f := range listed {
+			formIDs = append(formIDs, f.ID)
+		}
+	}
+
+	forms := make([]*model.Form, 0, len(formIDs))
+
+	// Listings do not load tags, so every form is read on its own
+	for _, id := range formIDs {
+		f, err := repo.GetFormByID(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("read form %s: %w", id, err)
+		}
+
+		forms = append(forms, f)
+	}
+
+	return forms, nil
+}
+
+// writeJSON writes v as indented JSON to path, or to out when path is -
+func writeJSON(out io.Writer, path string, v any) (err error) {
+	if path != "-" {
+		file, openErr := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, exportFileMode)
+		if openErr != nil {
+			return fmt.Errorf("create %s: %w", path, openErr)
+		}
+
+		defer func() {
+			if closeErr := file.Close(); closeErr != nil && err == nil {
+				err = fmt.Errorf("close %s: %w", path, closeErr)
+			}
+		}()
+
+		out = file
+	}
+
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+
+	if err = encoder.Encode(v); err != nil {
+		return fmt.Errorf("write export: %w", err)
+	}
+
+	return nil
+}
+
+func runFormsImport(ctx context.Context, streams Streams, args []string) error {
+	var (
+		userID, workspaceID string
+		newIDs, dryRun      bool
+	)
+
+	flags := newFlagSet("forms import", streams)
+	flags.StringVar(&userID, "user", "", "assign the imported forms to this user")
+	flags.StringVar(&workspaceID, "workspace", "", "assign the imported forms to this workspace")
+	flags.BoolVar(&newIDs, "new-ids", false, "give the imported forms new IDs instead of keeping the exported ones")
+	flags.BoolVar(&dryRun, "dry-run", false, "validate the file without importing")
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	if flags.NArg() != 1 {
+		return newUsageError("a single export file is required")
+	}
+
+	doc, err := readExport(streams.In, flags.Arg(0))
+	if err != nil {
+		return err
+	}
+
+	for i, f := range doc.Forms {
+		prepareImport(f, userID, workspaceID, newIDs)
+
+		if validateErr := f.Validate(); validateErr != nil {
+			return fmt.Errorf("form %d (%q): %w", i+1, f.Title, validateErr)
+		}
+	}
+
+	if dryRun {
+		fmt.Fprintf(streams.Out, "%d forms are valid\n", len(doc.Forms))
+
+		return nil
+	}
+
+	var deps formsDeps
+
+	return withApp(ctx, func() error {
+		imported, skipped, importErr := importForms(ctx, deps.Forms, doc.Forms, streams.Out)
+		fmt.Fprintf(streams.Out, "imported %d forms, skipped %d existing\n", imported, skipped)
+
+		return importErr
+	}, &deps)
+}
+
+// readExport reads and checks an export document from path, or from in when path is -
+func readExport(in io.Reader, path string) (*formsExport, error) {
+	var (
+		data []byte
+		err  error
+	)
+
+	if path == "-" {
+		data, err = io.ReadAll(in)
+	} else {
+		data, err = os.ReadFile(path)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("read %s: %w", path, err)
+	}
+
+	var doc formsExport
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	if err = decoder.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("parse export: %w", err)
+	}
+
+	if doc.Version != formsExportVersion {
+		return nil, fmt.Errorf("unsupported export version %d", doc.Version)
+	}
+
+	return &doc, nil
+}
+
+// prepareImport applies the import flags to an exported form
+func prepareImport(f *model.Form, userID, workspaceID string, newID bool) {
+	if userID != "" {
+		f.UserID = userID
+	}
+
+	if workspaceID != "" {
+		f.WorkspaceID = workspaceID
+	}
+
+	if newID {
+		f.ID = ""
+	}
+
+	// Legacy field rows are not part of the schema and are not imported
+	f.Fields = nil
+}
+
+// importForms creates the forms that do not exist yet, reporting each one
+func importForms(ctx context.Context, repo form.Repository, forms []*model.Form, out io.Writer) (imported, skipped int, err error) {
+	for _, f := range forms {
+		if f.ID != "" {
+			_, getErr := repo.GetFormByID(ctx, f.ID)
+			if getErr == nil {
+				fmt.Fprintf(out, "skipped %s (%s): already exists\n", f.ID, f.Title)
+
+				skipped++
+
+				continue
+			}
+
+			if !errors.Is(getErr, common.ErrNotFound) {
+				return imported, skipped, fmt.Errorf("check form %s: %w", f.ID, getErr)
+			}
+		}
+
+		if createErr := repo.CreateForm(ctx, f); createErr != nil {
+			return imported, skipped, fmt.Errorf("import form %q: %w", f.Title, createErr)
+		}
+
+		fmt.Fprintf(out, "imported %s (%s)\n", f.ID, f.Title)
+
+		imported++
+	}
+
+	return imported, skipped, nil
+}
@@ -0,0 +1,192 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/goformx/goforms/internal/infrastructure/migration"
+)
+
+// statusColumnPadding separates the columns of migrate status
+const statusColumnPadding = 2
+
+// migrateFlags parses the flags shared by the migrate commands and returns the positional arguments
+func migrateFlags(name string, streams Streams, args []string, maxArgs int) (lockTimeout flagDuration, rest []string, err error) {
+	flags := newFlagSet("migrate "+name, streams)
+	lockTimeout = flagDuration(migration.DefaultLockTimeout)
+	flags.Var(&lockTimeout, "lock-timeout", "how long to wait for another migration run to finish")
+
+	if err = parseFlags(flags, args); err != nil {
+		return 0, nil, err
+	}
+
+	if flags.NArg() > maxArgs {
+		return 0, nil, newUsageError("unexpected arguments: %v", flags.Args()[maxArgs:])
+	}
+
+	return lockTimeout, flags.Args(), nil
+}
+
+// withRunner runs fn with the migration runner of the configured database
+func withRunner(ctx context.Context, lockTimeout flagDuration, fn func(*migration.Runner) error) error {
+	var runner *migration.Runner
+
+	return withApp(ctx, func() error {
+		runner.SetLockTimeout(lockTimeout.Duration())
+
+		return fn(runner)
+	}, &runner)
+}
+
+// parseSteps parses an optional step count; all maps to 0, which selects every migration
+func parseSteps(args []string, allowAll bool, fallback int) (int, error) {
+	if len(args) == 0 {
+		return fallback, nil
+	}
+
+	if allowAll && args[0] == "all" {
+		return 0, nil
+	}
+
+	steps, err := strconv.Atoi(args[0])
+	if err != nil || steps <= 0 {
+		return 0, newUsageError("step count must be a positive number, got %q", args[0])
+	}
+
+	return steps, nil
+}
+
+func runMigrateUp(ctx context.Context, streams Streams, args []string) error {
+	lockTimeout, rest, err := migrateFlags("up", streams, args, 1)
+	if err != nil {
+		return err
+	}
+
+	steps, err := parseSteps(rest, false, 0)
+	if err != nil {
+		return err
+	}
+
+	return withRunner(ctx, lockTimeout, func(runner *migration.Runner) error {
+		applied, upErr := runner.Up(ctx, steps)
+		for _, m := range applied {
+			fmt.Fprintf(streams.Out, "applied %d_%s\n", m.Version, m.Name)
+		}
+
+		if upErr == nil && len(applied) == 0 {
+			fmt.Fprintln(streams.Out, "no pending migrations")
+		}
+
+		return upErr
+	})
+}
+
+func runMigrateDown(ctx context.Context, streams Streams, args []string) error {
+	lockTimeout, rest, err := migrateFlags("down", streams, args, 1)
+	if err != nil {
+		return err
+	}
+
+	steps, err := parseSteps(rest, true, 1)
+	if err != nil {
+		return err
+	}
+
+	return withRunner(ctx, lockTimeout, func(runner *migration.Runner) error {
+		reverted, downErr := runner.Down(ctx, steps)
+		for _, m := range reverted {
+			fmt.Fprintf(streams.Out, "rolled back %d_%s\n", m.Version, m.Name)
+		}
+
+		if downErr == nil && len(reverted) == 0 {
+			fmt.Fprintln(streams.Out, "no applied migrations")
+		}
+
+		return downErr
+	})
+}
+
+func runMigrateStatus(ctx context.Context, streams Streams, args []string) error {
+	lockTimeout, _, err := migrateFlags("status", streams, args, 0)
+	if err != nil {
+		return err
+	}
+
+	return withRunner(ctx, lockTimeout, func(runner *migration.Runner) error {
+		status, statusErr := runner.Status(ctx)
+		if statusErr != nil {
+			return statusErr
+		}
+
+		state := "clean"
+		if status.Dirty {
+			state = "dirty: repair the schema, then run migrate force VERSION"
+		}
+
+		fmt.Fprintf(streams.Out, "version: %d (%s)\npending: %d\n\n", status.Version, state, status.Pending())
+
+		w := tabwriter.NewWriter(streams.Out, 0, 0, statusColumnPadding, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tSTATUS")
+
+		for _, m := range status.Migrations {
+			applied := "pending"
+			if m.Applied {
+				applied = "applied"
+			}
+
+			fmt.Fprintf(w, "%d\t%s\t%s\n", m.Version, m.Name, applied)
+		}
+
+		if flushErr := w.Flush(); flushErr != nil {
+			return fmt.Errorf("write status: %w", flushErr)
+		}
+
+		return nil
+	})
+}
+
+func runMigrateRedo(ctx context.Context, streams Streams, args []string) error {
+	lockTimeout, _, err := migrateFlags("redo", streams, args, 0)
+	if err != nil {
+		return err
+	}
+
+	return withRunner(ctx, lockTimeout, func(runner *migration.Runner) error {
+		redone, redoErr := runner.Redo(ctx)
+		if redoErr != nil {
+			return redoErr
+		}
+
+		fmt.Fprintf(streams.Out, "redone %d_%s\n", redone.Version, redone.Name)
+
+		return nil
+	})
+}
+
+func runMigrateForce(ctx context.Context, streams Streams, args []string) error {
+	lockTimeout, rest, err := migrateFlags("force", streams, args, 1)
+	if err != nil {
+		return err
+	}
+
+	if len(rest) != 1 {
+		return newUsageError("a version is required")
+	}
+
+	version, err := strconv.ParseInt(rest[0], 10, 64)
+	if err != nil || version < 0 {
+		return newUsageError("invalid version %q", rest[0])
+	}
+
+	return withRunner(ctx, lockTimeout, func(runner *migration.Runner) error {
+		if forceErr := runner.Force(ctx, version); forceErr != nil {
+			return forceErr
+		}
+
+		fmt.Fprintf(streams.Out, "version forced to %d\n", version)
+
+		return nil
+	})
+}
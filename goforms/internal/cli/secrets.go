@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"github.com/goformx/goforms/internal/infrastructure/config"
+)
+
+// maxSecretSize bounds a secret read from stdin
+const maxSecretSize = 64 << 10
+
+func runSecretsKeygen(_ context.Context, streams Streams, args []string) error {
+	flags := newFlagSet("secrets keygen", streams)
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	key, err := config.GenerateMasterKey()
+	if err != nil {
+		return fmt.Errorf("generate master key: %w", err)
+	}
+
+	fmt.Fprintln(streams.Out, key)
+
+	return nil
+}
+
+func runSecretsSet(_ context.Context, streams Streams, args []string) error {
+	file, masterKey, key, err := openSecretsFile("secrets set", streams, args)
+	if err != nil {
+		return err
+	}
+
+	raw, err := io.ReadAll(io.LimitReader(streams.In, maxSecretSize+1))
+	if err != nil {
+		return fmt.Errorf("read secret: %w", err)
+	}
+
+	if len(raw) > maxSecretSize {
+		return fmt.Errorf("secret is larger than %d bytes", maxSecretSize)
+	}
+
+	// A secret piped with echo or typed at a terminal ends with a newline that is not part of it
+	value := strings.TrimRight(string(raw), "\r\n")
+	if value == "" {
+		return newUsageError("the secret is read from stdin, which was empty")
+	}
+
+	if err = file.Set(key, value); err != nil {
+		return newUsageError("%v; secret keys are: %s", err, strings.Join(config.SecretKeys(), ", "))
+	}
+
+	if err = file.Save(masterKey); err != nil {
+		return fmt.Errorf("save secrets file: %w", err)
+	}
+
+	fmt.Fprintf(streams.Out, "stored %s\n", key)
+
+	return nil
+}
+
+func runSecretsUnset(_ context.Context, streams Streams, args []string) error {
+	file, masterKey, key, err := openSecretsFile("secrets unset", streams, args)
+	if err != nil {
+		return err
+	}
+
+	if !slices.Contains(file.Keys(), key) {
+		return fmt.Errorf("%s is not in the secrets file", key)
+	}
+
+	file.Delete(key)
+
+	if err = file.Save(masterKey); err != nil {
+		return fmt.Errorf("save secrets file: %w", err)
+	}
+
+	fmt.Fprintf(streams.Out, "removed %s\n", key)
+
+	return nil
+}
+
+func runSecretsList(_ context.Context, streams Streams, args []string) error {
+	file, _, _, err := openSecretsFile("secrets list", streams, args)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range file.Keys() {
+		fmt.Fprintln(streams.Out, key)
+	}
+
+	return nil
+}
+
+// openSecretsFile parses the flags of a secrets command and decrypts the secrets file with the
+// master key from the environment. The master key is never taken from a flag, where it would
+// show up in the process list and shell history. key is the command's argument, if it takes one.
+func openSecretsFile(cmd string, streams Streams, args []string) (file *config.LocalSecretsFile, masterKey, key string, err error) {
+	envPath, envKey, err := config.LocalSecretsFromEnv()
+	if err != nil {
+		return nil, "", "", fmt.Errorf("secrets file settings: %w", err)
+	}
+
+	var path string
+
+	flags := newFlagSet(cmd, streams)
+	flags.StringVar(&path, "file", envPath, "the encrypted secrets file, by default $"+config.EnvSecretsFile)
+
+	if err = parseFlags(flags, args); err != nil {
+		return nil, "", "", err
+	}
+
+	if takesKey := cmd != "secrets list"; takesKey && flags.NArg() != 1 {
+		return nil, "", "", newUsageError("a single config key is required")
+	} else if !takesKey && flags.NArg() != 0 {
+		return nil, "", "", newUsageError("unexpected arguments: %s", strings.Join(flags.Args(), " "))
+	}
+
+	if path == "" {
+		return nil, "", "", newUsageError("no secrets file: pass --file or set %s", config.EnvSecretsFile)
+	}
+
+	if envKey == "" {
+		return nil, "", "", newUsageError("set %s or %s_FILE to the master key", config.EnvMasterKey, config.EnvMasterKey)
+	}
+
+	file, err = config.OpenLocalSecretsFile(path, envKey)
+	if err != nil {
+		return nil, "", "", fmt.Errorf("open secrets file: %w", err)
+	}
+
+	return file, envKey, flags.Arg(0), nil
+}
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/goformx/goforms/internal/domain/form"
+	"github.com/goformx/goforms/internal/domain/form/model"
+)
+
+func runSubmissionsPurge(ctx context.Context, streams Streams, args []string) error {
+	var (
+		olderThan      flagDuration
+		before         flagTime
+		formID, status string
+		dryRun         bool
+	)
+
+	flags := newFlagSet("submissions purge", streams)
+	flags.Var(&olderThan, "older-than", "delete submissions older than this age, such as 90d or 720h")
+	flags.Var(&before, "before", "delete submissions made before this date (YYYY-MM-DD or RFC 3339)")
+	flags.StringVar(&formID, "form", "", "only purge this form's submissions")
+	flags.StringVar(&status, "status", "", "only purge submissions in this status")
+	flags.BoolVar(&dryRun, "dry-run", false, "count the matching submissions without deleting them")
+
+	if err := parseFlags(flags, args); err != nil {
+		return err
+	}
+
+	filter, err := purgeFilter(olderThan.Duration(), before.Time, formID, status, time.Now().UTC())
+	if err != nil {
+		return err
+	}
+
+	var deps formsDeps
+
+	return withApp(ctx, func() error {
+		if dryRun {
+			count, countErr := deps.Forms.CountPurgeableSubmissions(ctx, filter)
+			if countErr != nil {
+				return fmt.Errorf("count submissions: %w", countErr)
+			}
+
+			fmt.Fprintf(streams.Out, "%d submissions made before %s would be deleted\n",
+				count, filter.SubmittedBefore.Format(time.RFC3339))
+
+			return nil
+		}
+
+		deleted, purgeErr := deps.Forms.PurgeSubmissions(ctx, filter)
+		fmt.Fprintf(streams.Out, "deleted %d submissions made before %s\n", deleted, filter.SubmittedBefore.Format(time.RFC3339))
+
+		if purgeErr != nil {
+			return fmt.Errorf("purge submissions: %w", purgeErr)
+		}
+
+		return nil
+	}, &deps)
+}
+
+// purgeFilter builds the purge filter from exactly one of an age and a cutoff time
+func purgeFilter(olderThan time.Duration, before time.Time, formID, status string, now time.Time) (form.SubmissionPurgeFilter, error) {
+	if (olderThan > 0) == !before.IsZero() {
+		return form.SubmissionPurgeFilter{}, newUsageError("exactly one of --older-than or --before is required")
+	}
+
+	filter := form.SubmissionPurgeFilter{
+		SubmittedBefore: before,
+		FormID:          formID,
+		Status:          model.SubmissionStatus(status),
+	}
+
+	if olderThan > 0 {
+		filter.SubmittedBefore = now.Add(-olderThan)
+	}
+
+	if err := filter.Validate(); err != nil {
+		return form.SubmissionPurgeFilter{}, newUsageError("%v", err)
+	}
+
+	return filter, nil
+}
@@ -0,0 +1,229 @@
+// Package apikey provides database-backed API keys scoped to a single form or
+// workspace. Keys are shown once at creation and only their SHA-256 hash is stored.
+package apikey
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql/driver"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrKeyNotFound is returned when a key does not exist
+	ErrKeyNotFound = errors.New("api key not found")
+	// ErrInvalidKey is returned when a presented key does not match any stored key
+	ErrInvalidKey = errors.New("invalid api key")
+	// ErrKeyExpired is returned when a presented key is past its expiry
+	ErrKeyExpired = errors.New("api key expired")
+	// ErrKeyRevoked is returned when a presented key has been revoked
+	ErrKeyRevoked = errors.New("api key revoked")
+	// ErrNameRequired is returned when a key is created without a name
+	ErrNameRequired = errors.New("api key name is required")
+	// ErrInvalidScope is returned unless exactly one of form or workspace is set
+	ErrInvalidScope = errors.New("api key must be scoped to exactly one form or workspace")
+	// ErrInvalidPermission is returned for unknown or missing permissions
+	ErrInvalidPermission = errors.New("invalid api key permission")
+	// ErrInvalidExpiry is returned when the expiry is not in the future
+	ErrInvalidExpiry = errors.New("api key expiry must be in the future")
+)
+
+const (
+	// TokenPrefix marks GoFormX API keys so they are recognizable in logs and secret scanners
+	TokenPrefix = "gfx_"
+	// tokenBytes is the amount of randomness in a key
+	tokenBytes = 32
+	// displayPrefixLength is how many leading characters are kept to identify a key in listings
+	displayPrefixLength = 12
+)
+
+// Permission is a capability granted to a key
+type Permission string
+
+const (
+	// PermissionSubmit allows creating submissions
+	PermissionSubmit Permission = "submit"
+	// PermissionReadSubmissions allows listing and reading submissions
+	PermissionReadSubmissions Permission = "read_submissions"
+	// PermissionManageForm allows reading and updating form definitions
+	PermissionManageForm Permission = "manage_form"
+)
+
+// IsValid reports whether the permission is known
+func (p Permission) IsValid() bool {
+	switch p {
+	case PermissionSubmit, PermissionReadSubmissions, PermissionManageForm:
+		return true
+	default:
+		return false
+	}
+}
+
+// Permissions is a set of permissions stored as a comma-separated column
+type Permissions []Permission
+
+// Value implements the driver.Valuer interface
+func (p Permissions) Value() (driver.Value, error) {
+	parts := make([]string, len(p))
+	for i, permission := range p {
+		parts[i] = string(permission)
+	}
+
+	return strings.Join(parts, ","), nil
+}
+
+// Scan implements the sql.Scanner interface
+func (p *Permissions) Scan(value any) error {
+	var raw string
+
+	switch v := value.(type) {
+	case nil:
+		*p = nil
+
+		return nil
+	case []byte:
+		raw = string(v)
+	case string:
+		raw = v
+	default:
+		return errors.New("type assertion to []byte or string failed")
+	}
+
+	*p = nil
+
+	for _, part := range strings.Split(raw, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			*p = append(*p, Permission(part))
+		}
+	}
+
+	return nil
+}
+
+// Contains reports whether the set includes the permission
+func (p Permissions) Contains(permission Permission) bool {
+	for _, granted := range p {
+		if granted == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Key is a stored API key. The plaintext is never persisted.
+type Key struct {
+	ID          string      `gorm:"column:uuid;primaryKey;type:uuid" json:"id"`
+	Name        string      `gorm:"not null;size:100"                json:"name"`
+	Prefix      string      `gorm:"not null;size:16"                 json:"prefix"`
+	Hash        string      `gorm:"not null;size:64;uniqueIndex"     json:"-"`
+	FormID      string      `gorm:"size:36;index"                    json:"form_id,omitempty"`
+	WorkspaceID string      `gorm:"size:36;index"                    json:"workspace_id,omitempty"`
+	Permissions Permissions `gorm:"not null;size:255"                json:"permissions"`
+	CreatedBy   string      `gorm:"not null;size:255"                json:"created_by"`
+	ExpiresAt   *time.Time  `gorm:"index"                            json:"expires_at,omitempty"`
+	LastUsedAt  *time.Time  `gorm:"column:last_used_at"              json:"last_used_at,omitempty"`
+	RevokedAt   *time.Time  `gorm:"column:revoked_at"                json:"revoked_at,omitempty"`
+	CreatedAt   time.Time   `gorm:"not null;autoCreateTime"          json:"created_at"`
+	UpdatedAt   time.Time   `gorm:"not null;autoUpdateTime"          json:"updated_at"`
+}
+
+// TableName specifies the table name for the Key model
+func (Key) TableName() string {
+	return "api_keys"
+}
+
+// BeforeCreate is a GORM hook that generates a UUID before inserting a new key
+func (k *Key) BeforeCreate(_ *gorm.DB) error {
+	if k.ID == "" {
+		k.ID = uuid.New().String()
+	}
+
+	return nil
+}
+
+// Validate validates the key's name, scope and permissions
+func (k *Key) Validate() error {
+	if strings.TrimSpace(k.Name) == "" {
+		return ErrNameRequired
+	}
+
+	if (k.FormID == "") == (k.WorkspaceID == "") {
+		return ErrInvalidScope
+	}
+
+	if len(k.Permissions) == 0 {
+		return ErrInvalidPermission
+	}
+
+	for _, permission := range k.Permissions {
+		if !permission.IsValid() {
+			return fmt.Errorf("%w: %s", ErrInvalidPermission, permission)
+		}
+	}
+
+	return nil
+}
+
+// IsRevoked reports whether the key has been revoked
+func (k *Key) IsRevoked() bool {
+	return k.RevokedAt != nil
+}
+
+// IsExpired reports whether the key is past its expiry at the given time
+func (k *Key) IsExpired(now time.Time) bool {
+	return k.ExpiresAt != nil && !now.Before(*k.ExpiresAt)
+}
+
+// Can reports whether the key grants the permission
+func (k *Key) Can(permission Permission) bool {
+	return k != nil && k.Permissions.Contains(permission)
+}
+
+// AppliesTo reports whether the key's scope covers a form in the given workspace
+func (k *Key) AppliesTo(formID, workspaceID string) bool {
+	if k == nil {
+		return false
+	}
+
+	if k.FormID != "" {
+		return k.FormID == formID
+	}
+
+	return workspaceID != "" && k.WorkspaceID == workspaceID
+}
+
+// GenerateToken returns a new random plaintext key
+func GenerateToken() (string, error) {
+	buf := make([]byte, tokenBytes)
+	if _, err := rand.Read(buf); err != nil {
+		return "", fmt.Errorf("generate api key: %w", err)
+	}
+
+	return TokenPrefix + base64.RawURLEncoding.EncodeToString(buf), nil
+}
+
+// HashToken returns the hex-encoded SHA-256 digest stored for a plaintext key.
+// Keys carry 256 bits of randomness, so a fast unsalted hash is sufficient.
+func HashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+
+	return hex.EncodeToString(sum[:])
+}
+
+// DisplayPrefix returns the leading characters used to identify a key without revealing it
+func DisplayPrefix(token string) string {
+	if len(token) <= displayPrefixLength {
+		return token
+	}
+
+	return token[:displayPrefixLength]
+}
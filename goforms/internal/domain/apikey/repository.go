@@ -0,0 +1,30 @@
+//go:generate mockgen -typed -source=repository.go -destination=../../../test/mocks/apikey/mock_repository.go -package=apikey
+
+package apikey
+
+import (
+	"context"
+	"time"
+)
+
+// Filter narrows a key listing to a single scope
+type Filter struct {
+	FormID      string
+	WorkspaceID string
+}
+
+// Repository defines storage for API keys
+type Repository interface {
+	// Create persists a new key
+	Create(ctx context.Context, key *Key) error
+	// GetByID returns a key by ID
+	GetByID(ctx context.Context, id string) (*Key, error)
+	// GetByHash returns the key whose plaintext hashes to hash
+	GetByHash(ctx context.Context, hash string) (*Key, error)
+	// List returns keys matching the filter, newest first
+	List(ctx context.Context, filter Filter) ([]*Key, error)
+	// Revoke marks a key revoked at the given time
+	Revoke(ctx context.Context, id string, at time.Time) error
+	// TouchLastUsed records when a key was last used
+	TouchLastUsed(ctx context.Context, id string, at time.Time) error
+}
@@ -0,0 +1,200 @@
+//go:generate mockgen -typed -source=service.go -destination=../../../test/mocks/apikey/mock_service.go -package=apikey -mock_names=Service=MockService
+
+package apikey
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+// lastUsedResolution limits how often a busy key's last-used timestamp is written
+const lastUsedResolution = time.Minute
+
+// CreateParams describes a key to be created
+type CreateParams struct {
+	Name        string
+	FormID      string
+	WorkspaceID string
+	Permissions []Permission
+	ExpiresAt   *time.Time
+	CreatedBy   string
+}
+
+// Service defines API key management and authentication
+type Service interface {
+	// Create stores a new key and returns it with its plaintext, which is not retrievable later
+	Create(ctx context.Context, params CreateParams) (*Key, string, error)
+	// Get returns a key by ID
+	Get(ctx context.Context, id string) (*Key, error)
+	// List returns the keys in a form or workspace scope
+	List(ctx context.Context, filter Filter) ([]*Key, error)
+	// Revoke revokes a key; revoking an already revoked key is a no-op
+	Revoke(ctx context.Context, id string) (*Key, error)
+	// Authenticate resolves a plaintext key, rejecting unknown, revoked and expired keys
+	Authenticate(ctx context.Context, token string) (*Key, error)
+}
+
+// service implements Service
+type service struct {
+	repository Repository
+	logger     logging.Logger
+	now        func() time.Time
+}
+
+// NewService creates a new API key service
+func NewService(repository Repository, logger logging.Logger) Service {
+	return &service{
+		repository: repository,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// Create stores a new key and returns it with its plaintext
+func (s *service) Create(ctx context.Context, params CreateParams) (*Key, string, error) {
+	if params.CreatedBy == "" {
+		return nil, "", errors.New("create api key: creator is required")
+	}
+
+	if params.ExpiresAt != nil && !params.ExpiresAt.After(s.now()) {
+		return nil, "", ErrInvalidExpiry
+	}
+
+	token, err := GenerateToken()
+	if err != nil {
+		return nil, "", err
+	}
+
+	key := &Key{
+		Name:        strings.TrimSpace(params.Name),
+		Prefix:      DisplayPrefix(token),
+		Hash:        HashToken(token),
+		FormID:      params.FormID,
+		WorkspaceID: params.WorkspaceID,
+		Permissions: dedupePermissions(params.Permissions),
+		CreatedBy:   params.CreatedBy,
+		ExpiresAt:   params.ExpiresAt,
+	}
+
+	if validateErr := key.Validate(); validateErr != nil {
+		return nil, "", fmt.Errorf("create api key: %w", validateErr)
+	}
+
+	if createErr := s.repository.Create(ctx, key); createErr != nil {
+		return nil, "", fmt.Errorf("create api key: %w", createErr)
+	}
+
+	s.logger.Info("api key created",
+		"key_id", key.ID,
+		"prefix", key.Prefix,
+		"form_id", key.FormID,
+		"workspace_id", key.WorkspaceID)
+
+	return key, token, nil
+}
+
+// Get returns a key by ID
+func (s *service) Get(ctx context.Context, id string) (*Key, error) {
+	key, err := s.repository.GetByID(ctx, id)
+	if err != nil {
+		if errors.Is(err, common.ErrNotFound) {
+			return nil, ErrKeyNotFound
+		}
+
+		return nil, fmt.Errorf("get api key: %w", err)
+	}
+
+	return key, nil
+}
+
+// List returns the keys in a form or workspace scope
+func (s *service) List(ctx context.Context, filter Filter) ([]*Key, error) {
+	if (filter.FormID == "") == (filter.WorkspaceID == "") {
+		return nil, ErrInvalidScope
+	}
+
+	keys, err := s.repository.List(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("list api keys: %w", err)
+	}
+
+	return keys, nil
+}
+
+// Revoke revokes a key
+func (s *service) Revoke(ctx context.Context, id string) (*Key, error) {
+	key, err := s.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if key.IsRevoked() {
+		return key, nil
+	}
+
+	revokedAt := s.now().UTC()
+	if revokeErr := s.repository.Revoke(ctx, id, revokedAt); revokeErr != nil {
+		return nil, fmt.Errorf("revoke api key: %w", revokeErr)
+	}
+
+	key.RevokedAt = &revokedAt
+
+	s.logger.Info("api key revoked", "key_id", key.ID, "prefix", key.Prefix)
+
+	return key, nil
+}
+
+// Authenticate resolves a plaintext key
+func (s *service) Authenticate(ctx context.Context, token string) (*Key, error) {
+	if !strings.HasPrefix(token, TokenPrefix) {
+		return nil, ErrInvalidKey
+	}
+
+	key, err := s.repository.GetByHash(ctx, HashToken(token))
+	if err != nil {
+		if errors.Is(err, common.ErrNotFound) {
+			return nil, ErrInvalidKey
+		}
+
+		return nil, fmt.Errorf("authenticate api key: %w", err)
+	}
+
+	now := s.now().UTC()
+
+	if key.IsRevoked() {
+		return nil, ErrKeyRevoked
+	}
+
+	if key.IsExpired(now) {
+		return nil, ErrKeyExpired
+	}
+
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= lastUsedResolution {
+		// Failing to record usage must not reject an otherwise valid key
+		if touchErr := s.repository.TouchLastUsed(ctx, key.ID, now); touchErr != nil {
+			s.logger.Warn("failed to record api key usage", "key_id", key.ID, "error", touchErr)
+		} else {
+			key.LastUsedAt = &now
+		}
+	}
+
+	return key, nil
+}
+
+// dedupePermissions removes repeated permissions while preserving order
+func dedupePermissions(permissions []Permission) Permissions {
+	result := make(Permissions, 0, len(permissions))
+	for _, permission := range permissions {
+		if !result.Contains(permission) {
+			result = append(result, permission)
+		}
+	}
+
+	return result
+}
@@ -0,0 +1,174 @@
+package apikey_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goformx/goforms/internal/domain/apikey"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+	mockapikey "github.com/goformx/goforms/test/mocks/apikey"
+	mocklogging "github.com/goformx/goforms/test/mocks/logging"
+)
+
+func TestService_Create_StoresHashAndReturnsPlaintextOnce(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mockapikey.NewMockRepository(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	var stored *apikey.Key
+	repo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, key *apikey.Key) error {
+		stored = key
+
+		return nil
+	})
+
+	key, token, err := apikey.NewService(repo, logger).Create(context.Background(), apikey.CreateParams{
+		Name:        " CRM sync ",
+		FormID:      "form-1",
+		Permissions: []apikey.Permission{apikey.PermissionSubmit, apikey.PermissionSubmit},
+		CreatedBy:   "user123",
+	})
+	require.NoError(t, err)
+
+	assert.True(t, strings.HasPrefix(token, apikey.TokenPrefix))
+	assert.Equal(t, apikey.HashToken(token), stored.Hash)
+	assert.NotContains(t, stored.Hash, token)
+	assert.True(t, strings.HasPrefix(token, stored.Prefix))
+	assert.Equal(t, "CRM sync", key.Name)
+	assert.Equal(t, apikey.Permissions{apikey.PermissionSubmit}, key.Permissions)
+}
+
+func TestService_Create_RejectsInvalidParams(t *testing.T) {
+	past := time.Now().Add(-time.Hour)
+
+	tests := []struct {
+		name   string
+		params apikey.CreateParams
+		want   error
+	}{
+		{
+			name:   "missing name",
+			params: apikey.CreateParams{FormID: "f", Permissions: []apikey.Permission{apikey.PermissionSubmit}},
+			want:   apikey.ErrNameRequired,
+		},
+		{
+			name:   "no scope",
+			params: apikey.CreateParams{Name: "k", Permissions: []apikey.Permission{apikey.PermissionSubmit}},
+			want:   apikey.ErrInvalidScope,
+		},
+		{
+			name: "both scopes",
+			params: apikey.CreateParams{
+				Name: "k", FormID: "f", WorkspaceID: "w", Permissions: []apikey.Permission{apikey.PermissionSubmit},
+			},
+			want: apikey.ErrInvalidScope,
+		},
+		{
+			name:   "unknown permission",
+			params: apikey.CreateParams{Name: "k", FormID: "f", Permissions: []apikey.Permission{"admin"}},
+			want:   apikey.ErrInvalidPermission,
+		},
+		{
+			name: "expiry in the past",
+			params: apikey.CreateParams{
+				Name: "k", FormID: "f", Permissions: []apikey.Permission{apikey.PermissionSubmit}, ExpiresAt: &past,
+			},
+			want: apikey.ErrInvalidExpiry,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mockapikey.NewMockRepository(ctrl)
+			logger := mocklogging.NewMockLogger(ctrl)
+
+			tt.params.CreatedBy = "user123"
+
+			_, _, err := apikey.NewService(repo, logger).Create(context.Background(), tt.params)
+			require.ErrorIs(t, err, tt.want)
+		})
+	}
+}
+
+func TestService_Authenticate(t *testing.T) {
+	token, err := apikey.GenerateToken()
+	require.NoError(t, err)
+
+	past := time.Now().Add(-time.Hour)
+	recent := time.Now().UTC()
+
+	tests := []struct {
+		name  string
+		key   *apikey.Key
+		touch bool
+		want  error
+	}{
+		{name: "valid key records usage", key: &apikey.Key{ID: "k1"}, touch: true},
+		{name: "recently used key skips usage write", key: &apikey.Key{ID: "k1", LastUsedAt: &recent}},
+		{name: "revoked key", key: &apikey.Key{ID: "k1", RevokedAt: &past}, want: apikey.ErrKeyRevoked},
+		{name: "expired key", key: &apikey.Key{ID: "k1", ExpiresAt: &past}, want: apikey.ErrKeyExpired},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			repo := mockapikey.NewMockRepository(ctrl)
+			logger := mocklogging.NewMockLogger(ctrl)
+
+			repo.EXPECT().GetByHash(gomock.Any(), apikey.HashToken(token)).Return(tt.key, nil)
+			if tt.touch {
+				repo.EXPECT().TouchLastUsed(gomock.Any(), "k1", gomock.Any()).Return(nil)
+			}
+
+			key, authErr := apikey.NewService(repo, logger).Authenticate(context.Background(), token)
+			if tt.want != nil {
+				require.ErrorIs(t, authErr, tt.want)
+
+				return
+			}
+
+			require.NoError(t, authErr)
+			assert.Equal(t, "k1", key.ID)
+			assert.NotNil(t, key.LastUsedAt)
+		})
+	}
+}
+
+func TestService_Authenticate_UnknownKey(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mockapikey.NewMockRepository(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+
+	repo.EXPECT().GetByHash(gomock.Any(), gomock.Any()).
+		Return(nil, common.NewNotFoundError("get_by_hash", "api_key", ""))
+
+	svc := apikey.NewService(repo, logger)
+
+	_, err := svc.Authenticate(context.Background(), apikey.TokenPrefix+"unknown")
+	require.ErrorIs(t, err, apikey.ErrInvalidKey)
+
+	// Tokens without the prefix are rejected without a lookup
+	_, err = svc.Authenticate(context.Background(), "not-a-key")
+	require.ErrorIs(t, err, apikey.ErrInvalidKey)
+}
+
+func TestKey_AppliesTo(t *testing.T) {
+	formKey := &apikey.Key{FormID: "form-1"}
+	workspaceKey := &apikey.Key{WorkspaceID: "ws-1"}
+
+	assert.True(t, formKey.AppliesTo("form-1", ""))
+	assert.True(t, formKey.AppliesTo("form-1", "ws-1"))
+	assert.False(t, formKey.AppliesTo("form-2", ""))
+
+	assert.True(t, workspaceKey.AppliesTo("form-2", "ws-1"))
+	assert.False(t, workspaceKey.AppliesTo("form-2", "ws-2"))
+	assert.False(t, workspaceKey.AppliesTo("form-2", ""))
+}
@@ -0,0 +1,123 @@
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// GenesisHash is the previous hash of the first entry in the chain
+const GenesisHash = "0000000000000000000000000000000000000000000000000000000000000000"
+
+// hashInput is the canonical representation of an entry used for hashing.
+// Field order is fixed by the struct and map keys in snapshots are sorted by encoding/json.
+type hashInput struct {
+	Sequence     int64    `json:"sequence"`
+	OccurredAt   string   `json:"occurred_at"`
+	ActorID      string   `json:"actor_id"`
+	OwnerID      string   `json:"owner_id"`
+	IPAddress    string   `json:"ip_address"`
+	RequestID    string   `json:"request_id"`
+	Action       Action   `json:"action"`
+	ResourceType string   `json:"resource_type"`
+	ResourceID   string   `json:"resource_id"`
+	Before       Snapshot `json:"before"`
+	After        Snapshot `json:"after"`
+	PrevHash     string   `json:"prev_hash"`
+}
+
+// ComputeHash returns the hex-encoded SHA-256 hash of the entry content chained to entry.PrevHash
+func ComputeHash(entry *Entry) (string, error) {
+	payload, err := json.Marshal(hashInput{
+		Sequence:     entry.Sequence,
+		OccurredAt:   entry.OccurredAt.UTC().Format(time.RFC3339Nano),
+		ActorID:      entry.ActorID,
+		OwnerID:      entry.OwnerID,
+		IPAddress:    entry.IPAddress,
+		RequestID:    entry.RequestID,
+		Action:       entry.Action,
+		ResourceType: entry.ResourceType,
+		ResourceID:   entry.ResourceID,
+		Before:       entry.Before,
+		After:        entry.After,
+		PrevHash:     entry.PrevHash,
+	})
+	if err != nil {
+		return "", fmt.Errorf("marshal audit entry: %w", err)
+	}
+
+	sum := sha256.Sum256(payload)
+
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// VerificationResult reports the outcome of a chain verification
+type VerificationResult struct {
+	Valid        bool   `json:"valid"`
+	Checked      int    `json:"checked"`
+	BrokenAt     int64  `json:"broken_at,omitempty"`
+	Reason       string `json:"reason,omitempty"`
+	LastSequence int64  `json:"last_sequence"`
+	LastHash     string `json:"last_hash"`
+}
+
+// verifier incrementally checks a sequence of entries against the hash chain
+type verifier struct {
+	result   VerificationResult
+	prevSeq  int64
+	prevHash string
+}
+
+func newVerifier() *verifier {
+	return &verifier{
+		result:   VerificationResult{Valid: true, LastHash: GenesisHash},
+		prevHash: GenesisHash,
+	}
+}
+
+// check verifies the next entry; it returns false once the chain is broken
+func (v *verifier) check(entry *Entry) bool {
+	fail := func(reason string) bool {
+		v.result.Valid = false
+		v.result.BrokenAt = entry.Sequence
+		v.result.Reason = reason
+
+		return false
+	}
+
+	if entry.Sequence != v.prevSeq+1 {
+		return fail("sequence gap")
+	}
+
+	if entry.PrevHash != v.prevHash {
+		return fail("previous hash mismatch")
+	}
+
+	hash, err := ComputeHash(entry)
+	if err != nil || hash != entry.Hash {
+		return fail("content hash mismatch")
+	}
+
+	v.prevSeq = entry.Sequence
+	v.prevHash = entry.Hash
+	v.result.Checked++
+	v.result.LastSequence = entry.Sequence
+	v.result.LastHash = entry.Hash
+
+	return true
+}
+
+// VerifyChain checks that entries, ordered by sequence starting at 1, form an unbroken hash chain
+func VerifyChain(entries []*Entry) VerificationResult {
+	v := newVerifier()
+
+	for _, entry := range entries {
+		if !v.check(entry) {
+			break
+		}
+	}
+
+	return v.result
+}
@@ -0,0 +1,164 @@
+// Package audit provides the tamper-evident audit log for mutating API operations.
+// Every entry is chained to its predecessor by a SHA-256 hash so that any
+// modification, insertion or deletion of historical rows is detectable.
+package audit
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Action identifies the kind of mutation recorded in the audit log
+type Action string
+
+const (
+	// ActionFormCreated is recorded when a form is created
+	ActionFormCreated Action = "form.created"
+	// ActionFormUpdated is recorded when a form is updated
+	ActionFormUpdated Action = "form.updated"
+	// ActionFormDeleted is recorded when a form is deleted
+	ActionFormDeleted Action = "form.deleted"
+	// ActionFormStateChanged is recorded when a form changes state
+	ActionFormStateChanged Action = "form.state_changed"
+	// ActionFormSettingsUpdated is recorded when form settings (CORS, plan) change
+	ActionFormSettingsUpdated Action = "form.settings_updated"
+	// ActionSubmissionCreated is recorded when a submission is created
+	ActionSubmissionCreated Action = "submission.created"
+	// ActionSubmissionUpdated is recorded when a submission is updated
+	ActionSubmissionUpdated Action = "submission.updated"
+	// ActionSubmissionsExported is recorded when submissions are exported
+	ActionSubmissionsExported Action = "submissions.exported"
+	// ActionSubmissionsBulkUpdated is recorded when a bulk job deletes, updates or replays submissions
+	ActionSubmissionsBulkUpdated Action = "submissions.bulk_updated"
+	// ActionAPIKeyCreated is recorded when an API key is created
+	ActionAPIKeyCreated Action = "api_key.created"
+	// ActionAPIKeyRevoked is recorded when an API key is revoked
+	ActionAPIKeyRevoked Action = "api_key.revoked"
+)
+
+// Resource types recorded in the audit log
+const (
+	ResourceForm       = "form"
+	ResourceSubmission = "submission"
+	ResourceAPIKey     = "api_key"
+	ResourceBulkJob    = "bulk_job"
+)
+
+// AnonymousActor is recorded when a mutation is performed without an authenticated user
+const AnonymousActor = "anonymous"
+
+// APIKeyActorPrefix prefixes the key ID recorded as actor for mutations made with an API key
+const APIKeyActorPrefix = "api_key:"
+
+// Snapshot is a JSON document capturing a resource before or after a mutation
+type Snapshot map[string]any
+
+// Value implements the driver.Valuer interface
+func (s Snapshot) Value() (driver.Value, error) {
+	if s == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+
+	return string(b), nil
+}
+
+// Scan implements the sql.Scanner interface
+func (s *Snapshot) Scan(value any) error {
+	if value == nil {
+		*s = nil
+
+		return nil
+	}
+
+	var data []byte
+
+	switch v := value.(type) {
+	case []byte:
+		data = v
+	case string:
+		data = []byte(v)
+	default:
+		return errors.New("type assertion to []byte or string failed")
+	}
+
+	return json.Unmarshal(data, s)
+}
+
+// Entry is a single immutable record in the audit log
+type Entry struct {
+	ID           string    `gorm:"column:uuid;primaryKey;type:uuid" json:"id"`
+	Sequence     int64     `gorm:"not null;uniqueIndex"            json:"sequence"`
+	OccurredAt   time.Time `gorm:"not null;index"                  json:"occurred_at"`
+	ActorID      string    `gorm:"size:255;index"                  json:"actor_id"`
+	OwnerID      string    `gorm:"size:255;index"                  json:"owner_id"`
+	IPAddress    string    `gorm:"size:45"                         json:"ip_address"`
+	RequestID    string    `gorm:"size:255"                        json:"request_id"`
+	Action       Action    `gorm:"not null;size:64;index"          json:"action"`
+	ResourceType string    `gorm:"not null;size:32"                json:"resource_type"`
+	ResourceID   string    `gorm:"size:255;index"                  json:"resource_id"`
+	Before       Snapshot  `gorm:"type:jsonb"                      json:"before,omitempty"`
+	After        Snapshot  `gorm:"type:jsonb"                      json:"after,omitempty"`
+	PrevHash     string    `gorm:"not null;size:64"                json:"prev_hash"`
+	Hash         string    `gorm:"not null;size:64"                json:"hash"`
+}
+
+// TableName specifies the table name for the Entry model
+func (Entry) TableName() string {
+	return "audit_logs"
+}
+
+// Record describes a mutation to be appended to the audit log.
+// Sequence, timestamps and hashes are assigned by the Service.
+type Record struct {
+	ActorID      string
+	OwnerID      string
+	IPAddress    string
+	RequestID    string
+	Action       Action
+	ResourceType string
+	ResourceID   string
+	Before       Snapshot
+	After        Snapshot
+}
+
+// Filter narrows down audit log queries
+type Filter struct {
+	OwnerID      string
+	ActorID      string
+	Action       Action
+	ResourceType string
+	ResourceID   string
+	From         time.Time
+	To           time.Time
+	Limit        int
+	Offset       int
+}
+
+const (
+	// DefaultListLimit is the number of entries returned when no limit is specified
+	DefaultListLimit = 50
+	// MaxListLimit caps the number of entries returned by a single query
+	MaxListLimit = 500
+)
+
+// Normalize applies default and maximum limits to the filter
+func (f *Filter) Normalize() {
+	if f.Limit <= 0 {
+		f.Limit = DefaultListLimit
+	}
+
+	if f.Limit > MaxListLimit {
+		f.Limit = MaxListLimit
+	}
+
+	if f.Offset < 0 {
+		f.Offset = 0
+	}
+}
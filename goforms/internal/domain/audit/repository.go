@@ -0,0 +1,30 @@
+//go:generate mockgen -typed -source=repository.go -destination=../../../test/mocks/audit/mock_repository.go -package=audit
+
+package audit
+
+import (
+	"context"
+	"errors"
+)
+
+var (
+	// ErrEmptyLog is returned by Repository.Last when no entries have been recorded yet
+	ErrEmptyLog = errors.New("audit log is empty")
+	// ErrSequenceTaken is returned by Repository.Append when another writer, possibly in another
+	// replica, appended an entry with the same sequence first
+	ErrSequenceTaken = errors.New("audit sequence already recorded")
+)
+
+// Repository defines append-only storage for audit entries
+type Repository interface {
+	// Append persists a fully chained entry, or returns ErrSequenceTaken when its sequence exists
+	Append(ctx context.Context, entry *Entry) error
+	// Last returns the entry with the highest sequence, or ErrEmptyLog
+	Last(ctx context.Context) (*Entry, error)
+	// List returns entries matching the filter, newest first
+	List(ctx context.Context, filter Filter) ([]*Entry, error)
+	// Count returns the number of entries matching the filter
+	Count(ctx context.Context, filter Filter) (int64, error)
+	// Range returns up to limit entries with sequence greater than afterSequence, oldest first
+	Range(ctx context.Context, afterSequence int64, limit int) ([]*Entry, error)
+}
@@ -0,0 +1,186 @@
+//go:generate mockgen -typed -source=service.go -destination=../../../test/mocks/audit/mock_service.go -package=audit -mock_names=Service=MockService
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+)
+
+const (
+	// verifyBatchSize is the number of entries loaded per round trip during verification
+	verifyBatchSize = 500
+	// maxAppendAttempts bounds how often an append is retried after losing its sequence to
+	// another writer
+	maxAppendAttempts = 10
+	// appendBackoff is the mean pause before the second attempt; later attempts wait longer, with
+	// jitter so writers that collided do not collide again
+	appendBackoff = 2 * time.Millisecond
+)
+
+// Service records and queries audit entries
+type Service interface {
+	// Record appends a mutation to the hash chain
+	Record(ctx context.Context, record Record) (*Entry, error)
+	// List returns entries matching the filter together with the total match count
+	List(ctx context.Context, filter Filter) ([]*Entry, int64, error)
+	// Verify walks the full chain and reports the first broken link, if any
+	Verify(ctx context.Context) (*VerificationResult, error)
+}
+
+// service implements Service
+type service struct {
+	repository Repository
+	logger     logging.Logger
+	now        func() time.Time
+}
+
+// NewService creates a new audit service
+func NewService(repository Repository, logger logging.Logger) Service {
+	return &service{
+		repository: repository,
+		logger:     logger,
+		now:        time.Now,
+	}
+}
+
+// Record appends a mutation to the hash chain. Nothing is locked while appending: concurrent
+// writers, in this process or another replica, are detected by the unique sequence and retried.
+func (s *service) Record(ctx context.Context, record Record) (*Entry, error) {
+	if record.Action == "" {
+		return nil, errors.New("record audit entry: action is required")
+	}
+
+	if record.ResourceType == "" {
+		return nil, errors.New("record audit entry: resource type is required")
+	}
+
+	if record.ActorID == "" {
+		record.ActorID = AnonymousActor
+	}
+
+	var err error
+
+	for attempt := range maxAppendAttempts {
+		if attempt > 0 {
+			if waitErr := backoff(ctx, attempt); waitErr != nil {
+				return nil, waitErr
+			}
+		}
+
+		var entry *Entry
+
+		entry, err = s.appendNext(ctx, record)
+		if !errors.Is(err, ErrSequenceTaken) {
+			return entry, err
+		}
+	}
+
+	return nil, fmt.Errorf("append audit entry after %d attempts: %w", maxAppendAttempts, err)
+}
+
+// backoff waits a random time that grows with attempt, or until ctx is done
+func backoff(ctx context.Context, attempt int) error {
+	timer := time.NewTimer(rand.N(2 * time.Duration(attempt) * appendBackoff))
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("wait to retry audit append: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
+
+// appendNext chains record to the latest entry and appends it
+func (s *service) appendNext(ctx context.Context, record Record) (*Entry, error) {
+	last, err := s.repository.Last(ctx)
+	if err != nil && !errors.Is(err, ErrEmptyLog) {
+		return nil, fmt.Errorf("load last audit entry: %w", err)
+	}
+
+	entry := &Entry{
+		ID: uuid.New().String(),
+		// Truncate to microseconds so the timestamp survives a database round trip unchanged
+		OccurredAt:   s.now().UTC().Truncate(time.Microsecond),
+		ActorID:      record.ActorID,
+		OwnerID:      record.OwnerID,
+		IPAddress:    record.IPAddress,
+		RequestID:    record.RequestID,
+		Action:       record.Action,
+		ResourceType: record.ResourceType,
+		ResourceID:   record.ResourceID,
+		Before:       record.Before,
+		After:        record.After,
+		Sequence:     1,
+		PrevHash:     GenesisHash,
+	}
+
+	if last != nil {
+		entry.Sequence = last.Sequence + 1
+		entry.PrevHash = last.Hash
+	}
+
+	hash, err := ComputeHash(entry)
+	if err != nil {
+		return nil, fmt.Errorf("hash audit entry: %w", err)
+	}
+
+	entry.Hash = hash
+
+	if appendErr := s.repository.Append(ctx, entry); appendErr != nil {
+		return nil, fmt.Errorf("append audit entry: %w", appendErr)
+	}
+
+	return entry, nil
+}
+
+// List returns entries matching the filter together with the total match count
+func (s *service) List(ctx context.Context, filter Filter) ([]*Entry, int64, error) {
+	filter.Normalize()
+
+	entries, err := s.repository.List(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("list audit entries: %w", err)
+	}
+
+	total, err := s.repository.Count(ctx, filter)
+	if err != nil {
+		return nil, 0, fmt.Errorf("count audit entries: %w", err)
+	}
+
+	return entries, total, nil
+}
+
+// Verify walks the full chain and reports the first broken link, if any
+func (s *service) Verify(ctx context.Context) (*VerificationResult, error) {
+	v := newVerifier()
+
+	for {
+		batch, err := s.repository.Range(ctx, v.prevSeq, verifyBatchSize)
+		if err != nil {
+			return nil, fmt.Errorf("load audit entries: %w", err)
+		}
+
+		for _, entry := range batch {
+			if !v.check(entry) {
+				s.logger.Error("audit chain verification failed",
+					"broken_at", v.result.BrokenAt,
+					"reason", v.result.Reason)
+
+				return &v.result, nil
+			}
+		}
+
+		if len(batch) < verifyBatchSize {
+			return &v.result, nil
+		}
+	}
+}
@@ -0,0 +1,199 @@
+package audit_test
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goformx/goforms/internal/domain/audit"
+	mockaudit "github.com/goformx/goforms/test/mocks/audit"
+	mocklogging "github.com/goformx/goforms/test/mocks/logging"
+)
+
+// recordChain records n entries through the service, feeding each appended entry back as the latest
+func recordChain(t *testing.T, n int) []*audit.Entry {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	repo := mockaudit.NewMockRepository(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+
+	var entries []*audit.Entry
+
+	repo.EXPECT().Last(gomock.Any()).DoAndReturn(func(context.Context) (*audit.Entry, error) {
+		if len(entries) == 0 {
+			return nil, audit.ErrEmptyLog
+		}
+
+		return entries[len(entries)-1], nil
+	}).Times(n)
+	repo.EXPECT().Append(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, e *audit.Entry) error {
+		entries = append(entries, e)
+
+		return nil
+	}).Times(n)
+
+	svc := audit.NewService(repo, logger)
+
+	for i := range n {
+		_, err := svc.Record(context.Background(), audit.Record{
+			ActorID:      "user123",
+			OwnerID:      "user123",
+			IPAddress:    "203.0.113.7",
+			RequestID:    "req-1",
+			Action:       audit.ActionFormUpdated,
+			ResourceType: audit.ResourceForm,
+			ResourceID:   "form-1",
+			Before:       audit.Snapshot{"title": "v" + strconv.Itoa(i)},
+			After:        audit.Snapshot{"title": "v" + strconv.Itoa(i+1)},
+		})
+		require.NoError(t, err)
+	}
+
+	return entries
+}
+
+func TestService_Record_ChainsEntries(t *testing.T) {
+	entries := recordChain(t, 3)
+
+	require.Len(t, entries, 3)
+	assert.Equal(t, int64(1), entries[0].Sequence)
+	assert.Equal(t, audit.GenesisHash, entries[0].PrevHash)
+	assert.Equal(t, entries[0].Hash, entries[1].PrevHash)
+	assert.Equal(t, entries[1].Hash, entries[2].PrevHash)
+
+	result := audit.VerifyChain(entries)
+	assert.True(t, result.Valid)
+	assert.Equal(t, 3, result.Checked)
+	assert.Equal(t, entries[2].Hash, result.LastHash)
+}
+
+func TestService_Record_DefaultsAnonymousActor(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mockaudit.NewMockRepository(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+
+	repo.EXPECT().Last(gomock.Any()).Return(nil, audit.ErrEmptyLog)
+	repo.EXPECT().Append(gomock.Any(), gomock.Any()).Return(nil)
+
+	entry, err := audit.NewService(repo, logger).Record(context.Background(), audit.Record{
+		Action:       audit.ActionSubmissionCreated,
+		ResourceType: audit.ResourceSubmission,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, audit.AnonymousActor, entry.ActorID)
+}
+
+func TestService_Record_RetriesWhenAnotherReplicaTakesTheSequence(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mockaudit.NewMockRepository(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+
+	first := &audit.Entry{Sequence: 1, Hash: "first"}
+	concurrent := &audit.Entry{Sequence: 2, Hash: "concurrent"}
+
+	gomock.InOrder(
+		repo.EXPECT().Last(gomock.Any()).Return(first, nil),
+		repo.EXPECT().Append(gomock.Any(), gomock.Any()).Return(audit.ErrSequenceTaken),
+		repo.EXPECT().Last(gomock.Any()).Return(concurrent, nil),
+		repo.EXPECT().Append(gomock.Any(), gomock.Any()).Return(nil),
+	)
+
+	entry, err := audit.NewService(repo, logger).Record(context.Background(), audit.Record{
+		Action: audit.ActionFormCreated, ResourceType: audit.ResourceForm, ResourceID: "form-1",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), entry.Sequence)
+	assert.Equal(t, "concurrent", entry.PrevHash)
+}
+
+func TestService_Record_GivesUpAfterRepeatedConflicts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mockaudit.NewMockRepository(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+
+	repo.EXPECT().Last(gomock.Any()).Return(nil, audit.ErrEmptyLog).Times(10)
+	repo.EXPECT().Append(gomock.Any(), gomock.Any()).Return(audit.ErrSequenceTaken).Times(10)
+
+	_, err := audit.NewService(repo, logger).Record(context.Background(), audit.Record{
+		Action: audit.ActionFormCreated, ResourceType: audit.ResourceForm,
+	})
+	require.ErrorIs(t, err, audit.ErrSequenceTaken)
+}
+
+func TestService_Record_RequiresAction(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mockaudit.NewMockRepository(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+
+	_, err := audit.NewService(repo, logger).Record(context.Background(), audit.Record{
+		ResourceType: audit.ResourceForm,
+	})
+	require.Error(t, err)
+}
+
+func TestVerifyChain_DetectsTampering(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func([]*audit.Entry) []*audit.Entry
+		reason string
+	}{
+		{
+			name: "modified content",
+			tamper: func(e []*audit.Entry) []*audit.Entry {
+				e[1].After = audit.Snapshot{"title": "forged"}
+
+				return e
+			},
+			reason: "content hash mismatch",
+		},
+		{
+			name: "deleted entry",
+			tamper: func(e []*audit.Entry) []*audit.Entry {
+				return append(e[:1], e[2:]...)
+			},
+			reason: "sequence gap",
+		},
+		{
+			name: "rewritten hash",
+			tamper: func(e []*audit.Entry) []*audit.Entry {
+				e[1].After = audit.Snapshot{"title": "forged"}
+				hash, err := audit.ComputeHash(e[1])
+				require.NoError(t, err)
+				e[1].Hash = hash
+
+				return e
+			},
+			reason: "previous hash mismatch",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			entries := tt.tamper(recordChain(t, 3))
+
+			result := audit.VerifyChain(entries)
+			assert.False(t, result.Valid)
+			assert.Equal(t, tt.reason, result.Reason)
+		})
+	}
+}
+
+func TestService_Verify_WalksRepository(t *testing.T) {
+	entries := recordChain(t, 2)
+
+	ctrl := gomock.NewController(t)
+	repo := mockaudit.NewMockRepository(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+
+	repo.EXPECT().Range(gomock.Any(), int64(0), gomock.Any()).Return(entries, nil)
+
+	result, err := audit.NewService(repo, logger).Verify(context.Background())
+	require.NoError(t, err)
+	assert.True(t, result.Valid)
+	assert.Equal(t, int64(2), result.LastSequence)
+}
@@ -0,0 +1,231 @@
+// Package bulk applies operations to many submissions of a form at once. Submissions
+// are selected by an explicit ID list or a filter and processed in chunks; each chunk
+// commits together with the job's progress, so a failed job can be retried from its
+// last committed chunk without applying any chunk twice.
+package bulk
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/goformx/goforms/internal/domain/form/model"
+)
+
+var (
+	// ErrJobNotFound is returned when a job does not exist or belongs to another form
+	ErrJobNotFound = errors.New("bulk job not found")
+	// ErrInvalidRequest is returned for unknown operations, statuses, formats or selections
+	ErrInvalidRequest = errors.New("invalid bulk request")
+	// ErrNotRetryable is returned when retrying a job that has not failed
+	ErrNotRetryable = errors.New("only failed bulk jobs can be retried")
+	// ErrNoOutput is returned when downloading the output of a job that is not a completed export
+	ErrNoOutput = errors.New("bulk job has no output")
+)
+
+const (
+	// ChunkSize is the number of submissions processed per transaction
+	ChunkSize = 200
+	// InlineLimit is the largest selection processed before the request returns;
+	// larger jobs run in the background and are followed through their progress resource
+	InlineLimit = ChunkSize
+	// MaxSelectionIDs caps the length of an explicit ID selection
+	MaxSelectionIDs = 10000
+	// MaxConcurrentJobs caps the number of jobs running in the background at once
+	MaxConcurrentJobs = 2
+	// StaleJobAge is how long a queued or running job goes without progress before Recover treats
+	// it as abandoned by a crashed process or one whose shutdown timed out
+	StaleJobAge = 10 * time.Minute
+)
+
+// Operation names what a job does to the selected submissions
+type Operation string
+
+const (
+	// OperationDelete deletes the submissions
+	OperationDelete Operation = "delete"
+	// OperationSetStatus changes the submissions' status to the job's target status
+	OperationSetStatus Operation = "set_status"
+	// OperationMarkSpam changes the submissions' status to spam
+	OperationMarkSpam Operation = "mark_spam"
+	// OperationReplayWebhooks re-announces the submissions so webhooks are delivered again
+	OperationReplayWebhooks Operation = "rerun_webhooks"
+	// OperationExport writes the submissions to a downloadable file
+	OperationExport Operation = "export"
+)
+
+// IsValid reports whether the operation is known
+func (o Operation) IsValid() bool {
+	switch o {
+	case OperationDelete, OperationSetStatus, OperationMarkSpam, OperationReplayWebhooks, OperationExport:
+		return true
+	default:
+		return false
+	}
+}
+
+// Status is the lifecycle state of a job
+type Status string
+
+const (
+	// StatusQueued jobs are waiting for a background worker
+	StatusQueued Status = "queued"
+	// StatusRunning jobs are processing chunks
+	StatusRunning Status = "running"
+	// StatusCompleted jobs processed every selected submission
+	StatusCompleted Status = "completed"
+	// StatusFailed jobs stopped at a chunk that could not be committed; they can be retried
+	StatusFailed Status = "failed"
+)
+
+// Export formats
+const (
+	FormatCSV    = "csv"
+	FormatNDJSON = "ndjson"
+)
+
+// IDList is a list of IDs stored as a JSON array
+type IDList []string
+
+// Value implements the driver.Valuer interface
+func (l IDList) Value() (driver.Value, error) {
+	if l == nil {
+		return nil, nil
+	}
+
+	b, err := json.Marshal([]string(l))
+	if err != nil {
+		return nil, fmt.Errorf("marshal id list: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// Scan implements the sql.Scanner interface
+func (l *IDList) Scan(value any) error {
+	switch v := value.(type) {
+	case nil:
+		*l = nil
+
+		return nil
+	case []byte:
+		return json.Unmarshal(v, (*[]string)(l))
+	case string:
+		return json.Unmarshal([]byte(v), (*[]string)(l))
+	default:
+		return errors.New("type assertion to []byte or string failed")
+	}
+}
+
+// Selection picks the submissions of a form a job applies to: the listed IDs, or
+// every submission matching the filter fields. An empty selection matches the whole form.
+type Selection struct {
+	IDs             IDList                 `gorm:"column:selection_ids;type:text"       json:"ids,omitempty"`
+	Status          model.SubmissionStatus `gorm:"column:selection_status;size:20"      json:"status,omitempty"`
+	SubmittedAfter  *time.Time             `gorm:"column:selection_submitted_after"     json:"submitted_after,omitempty"`
+	SubmittedBefore *time.Time             `gorm:"column:selection_submitted_before"    json:"submitted_before,omitempty"`
+}
+
+// Validate checks that IDs and filters are not mixed and that both are well formed
+func (s Selection) Validate() error {
+	hasFilter := s.Status != "" || s.SubmittedAfter != nil || s.SubmittedBefore != nil
+
+	switch {
+	case len(s.IDs) > 0 && hasFilter:
+		return fmt.Errorf("%w: select by ids or by filter, not both", ErrInvalidRequest)
+	case len(s.IDs) > MaxSelectionIDs:
+		return fmt.Errorf("%w: at most %d ids can be selected", ErrInvalidRequest, MaxSelectionIDs)
+	case s.Status != "" && !s.Status.IsValid():
+		return fmt.Errorf("%w: unknown status %q", ErrInvalidRequest, s.Status)
+	case s.SubmittedAfter != nil && s.SubmittedBefore != nil && !s.SubmittedAfter.Before(*s.SubmittedBefore):
+		return fmt.Errorf("%w: submitted_after must be before submitted_before", ErrInvalidRequest)
+	}
+
+	for _, id := range s.IDs {
+		if _, err := uuid.Parse(id); err != nil {
+			return fmt.Errorf("%w: invalid submission id %q", ErrInvalidRequest, id)
+		}
+	}
+
+	return nil
+}
+
+// Job is a bulk operation and its progress
+type Job struct {
+	ID        string    `gorm:"column:uuid;primaryKey;type:uuid" json:"id"`
+	FormID    string    `gorm:"not null;size:36;index"           json:"form_id"`
+	CreatedBy string    `gorm:"not null;size:255"                json:"created_by"`
+	Operation Operation `gorm:"not null;size:32"                 json:"operation"`
+	Selection Selection `gorm:"embedded"                         json:"selection"`
+
+	// TargetStatus is the status set by OperationSetStatus and OperationMarkSpam
+	TargetStatus model.SubmissionStatus `gorm:"size:20" json:"target_status,omitempty"`
+	// ExportFormat and ExportColumns shape the file written by OperationExport
+	ExportFormat  string `gorm:"size:10"   json:"export_format,omitempty"`
+	ExportColumns IDList `gorm:"type:text" json:"-"`
+
+	Status    Status `gorm:"not null;size:20" json:"status"`
+	Total     int64  `gorm:"not null"         json:"total"`
+	Processed int64  `gorm:"not null"         json:"processed"`
+	// Checkpoint is the ID of the last submission of the last committed chunk
+	Checkpoint string `gorm:"size:36"   json:"-"`
+	Error      string `gorm:"size:1000" json:"error,omitempty"`
+
+	CreatedAt   time.Time  `gorm:"not null;autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time  `gorm:"not null;autoUpdateTime" json:"updated_at"`
+	StartedAt   *time.Time `json:"started_at,omitempty"`
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+}
+
+// TableName specifies the table name for the Job model
+func (Job) TableName() string {
+	return "bulk_jobs"
+}
+
+// BeforeCreate is a GORM hook that generates a UUID before inserting a new job
+func (j *Job) BeforeCreate(_ *gorm.DB) error {
+	if j.ID == "" {
+		j.ID = uuid.New().String()
+	}
+
+	return nil
+}
+
+// Done reports whether the job has stopped, successfully or not
+func (j *Job) Done() bool {
+	return j.Status == StatusCompleted || j.Status == StatusFailed
+}
+
+// Progress returns the completed fraction of the job as a percentage
+func (j *Job) Progress() int {
+	switch {
+	case j.Status == StatusCompleted:
+		return 100
+	case j.Total == 0:
+		return 0
+	}
+
+	return int(min(j.Processed*100/j.Total, 100))
+}
+
+// clone returns a copy of the job that can be modified independently
+func (j *Job) clone() *Job {
+	c := *j
+
+	return &c
+}
+
+// Change is what committing a chunk does to its submissions
+type Change struct {
+	// Delete removes the submissions
+	Delete bool
+	// Status, when set, becomes the submissions' status
+	Status model.SubmissionStatus
+	// Output is appended to the job's export file
+	Output []byte
+}
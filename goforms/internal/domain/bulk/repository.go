@@ -0,0 +1,36 @@
+//go:generate mockgen -typed -source=repository.go -destination=../../../test/mocks/bulk/mock_repository.go -package=bulk
+
+package bulk
+
+import (
+	"context"
+	"time"
+
+	"github.com/goformx/goforms/internal/domain/form/model"
+)
+
+// Repository stores bulk jobs and applies their chunks
+type Repository interface {
+	// CreateJob persists a new job
+	CreateJob(ctx context.Context, job *Job) error
+	// GetJob returns a job by ID, or ErrJobNotFound
+	GetJob(ctx context.Context, id string) (*Job, error)
+	// ListJobs returns a form's most recent jobs, newest first
+	ListJobs(ctx context.Context, formID string, limit int) ([]*Job, error)
+	// UpdateJob saves a job's status, progress and timestamps
+	UpdateJob(ctx context.Context, job *Job) error
+	// TransitionJob saves a job's status, error and timestamps only while its stored status is
+	// from, reporting whether it did, so that only one process claims a job
+	TransitionJob(ctx context.Context, job *Job, from Status) (bool, error)
+	// ListStaleJobs returns the queued and running jobs last updated before the time
+	ListStaleJobs(ctx context.Context, before time.Time) ([]*Job, error)
+
+	// CountSelection returns the number of a form's submissions matching the selection
+	CountSelection(ctx context.Context, formID string, selection Selection) (int64, error)
+	// NextChunk returns up to limit matching submissions with IDs greater than afterID, ordered by ID
+	NextChunk(ctx context.Context, formID string, selection Selection, afterID string, limit int) ([]*model.FormSubmission, error)
+	// CommitChunk applies the change to the submissions and saves the job's progress in one transaction
+	CommitChunk(ctx context.Context, job *Job, submissionIDs []string, change Change) error
+	// Output returns the export file written by a job
+	Output(ctx context.Context, jobID string) ([]byte, error)
+}
@@ -0,0 +1,536 @@
+//go:generate mockgen -typed -source=service.go -destination=../../../test/mocks/bulk/mock_service.go -package=bulk -mock_names=Service=MockService
+
+package bulk
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goformx/goforms/internal/domain/common/events"
+	formevents "github.com/goformx/goforms/internal/domain/form/events"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+)
+
+// maxErrorLength bounds the error message stored on a failed job
+const maxErrorLength = 1000
+
+// Request describes a bulk operation to start
+type Request struct {
+	FormID    string
+	CreatedBy string
+	Operation Operation
+	Selection Selection
+	// Status is the target status of OperationSetStatus
+	Status model.SubmissionStatus
+	// Format is the export file format, FormatCSV by default
+	Format string
+	// Columns are the submission data keys written as CSV columns after id, submitted_at and status
+	Columns []string
+}
+
+// Service starts bulk jobs and reports their progress
+type Service interface {
+	// Start creates a job for the request. Selections of up to InlineLimit submissions are
+	// processed before Start returns; larger jobs are queued and run in the background.
+	Start(ctx context.Context, req Request) (*Job, error)
+	// Get returns a job of the form, or ErrJobNotFound
+	Get(ctx context.Context, formID, jobID string) (*Job, error)
+	// List returns up to limit of the form's most recent jobs
+	List(ctx context.Context, formID string, limit int) ([]*Job, error)
+	// Retry resumes a failed job from its last committed chunk
+	Retry(ctx context.Context, formID, jobID string) (*Job, error)
+	// Recover marks queued and running jobs that made no progress for StaleJobAge as failed, so
+	// jobs abandoned by a crash or an interrupted shutdown can be retried
+	Recover(ctx context.Context) error
+	// Output returns the file written by a completed export job, or ErrNoOutput
+	Output(ctx context.Context, formID, jobID string) ([]byte, error)
+	// Shutdown stops background jobs and waits for them to record where they stopped
+	Shutdown(ctx context.Context) error
+}
+
+// service implements Service
+type service struct {
+	repository Repository
+	publisher  events.Publisher
+	logger     logging.Logger
+	now        func() time.Time
+
+	// slots limits the number of background jobs running at once
+	slots chan struct{}
+	// ctx is cancelled on Shutdown to interrupt background jobs
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewService creates a new bulk service
+func NewService(repository Repository, publisher events.Publisher, logger logging.Logger) Service {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	return &service{
+		repository: repository,
+		publisher:  publisher,
+		logger:     logger,
+		now:        time.Now,
+		slots:      make(chan struct{}, MaxConcurrentJobs),
+		ctx:        ctx,
+		cancel:     cancel,
+	}
+}
+
+// Start creates a job for the request and runs it inline or in the background
+func (s *service) Start(ctx context.Context, req Request) (*Job, error) {
+	job, err := newJob(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Total, err = s.repository.CountSelection(ctx, job.FormID, job.Selection); err != nil {
+		return nil, fmt.Errorf("count bulk selection: %w", err)
+	}
+
+	if err = s.repository.CreateJob(ctx, job); err != nil {
+		return nil, fmt.Errorf("create bulk job: %w", err)
+	}
+
+	s.logger.Info("bulk job created",
+		"job_id", job.ID,
+		"form_id", job.FormID,
+		"operation", string(job.Operation),
+		"total", job.Total,
+	)
+
+	s.dispatch(ctx, job)
+
+	return job, nil
+}
+
+// Get returns a job of the form
+func (s *service) Get(ctx context.Context, formID, jobID string) (*Job, error) {
+	job, err := s.repository.GetJob(ctx, jobID)
+	if err != nil {
+		return nil, fmt.Errorf("get bulk job: %w", err)
+	}
+
+	if job.FormID != formID {
+		return nil, ErrJobNotFound
+	}
+
+	return job, nil
+}
+
+// List returns the form's most recent jobs
+func (s *service) List(ctx context.Context, formID string, limit int) ([]*Job, error) {
+	jobs, err := s.repository.ListJobs(ctx, formID, limit)
+	if err != nil {
+		return nil, fmt.Errorf("list bulk jobs: %w", err)
+	}
+
+	return jobs, nil
+}
+
+// Retry resumes a failed job from its checkpoint. Chunks committed before the failure
+// are not applied again.
+func (s *service) Retry(ctx context.Context, formID, jobID string) (*Job, error) {
+	job, err := s.Get(ctx, formID, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Status != StatusFailed {
+		return nil, ErrNotRetryable
+	}
+
+	job.Status = StatusQueued
+	job.Error = ""
+
+	// Two retries of the same job race for this update; only the first one runs it
+	queued, err := s.repository.TransitionJob(ctx, job, StatusFailed)
+	if err != nil {
+		return nil, fmt.Errorf("queue bulk job retry: %w", err)
+	}
+
+	if !queued {
+		return nil, ErrNotRetryable
+	}
+
+	s.logger.Info("bulk job retried", "job_id", job.ID, "form_id", job.FormID, "processed", job.Processed)
+
+	s.dispatch(ctx, job)
+
+	return job, nil
+}
+
+// Recover fails the jobs left queued or running by a process that stopped without recording
+// where they stopped. Jobs still making progress elsewhere are updated within StaleJobAge and
+// left alone.
+func (s *service) Recover(ctx context.Context) error {
+	jobs, err := s.repository.ListStaleJobs(ctx, s.now().Add(-StaleJobAge))
+	if err != nil {
+		return fmt.Errorf("list stale bulk jobs: %w", err)
+	}
+
+	for _, job := range jobs {
+		from := job.Status
+		job.Status = StatusFailed
+		job.Error = "interrupted before finishing; retry to resume from the last committed chunk"
+
+		failed, transitionErr := s.repository.TransitionJob(ctx, job, from)
+		if transitionErr != nil {
+			return fmt.Errorf("fail stale bulk job: %w", transitionErr)
+		}
+
+		if failed {
+			s.logger.Info("bulk job recovered as failed", "job_id", job.ID, "form_id", job.FormID, "processed", job.Processed)
+		}
+	}
+
+	return nil
+}
+
+// Output returns the file written by a completed export job
+func (s *service) Output(ctx context.Context, formID, jobID string) ([]byte, error) {
+	job, err := s.Get(ctx, formID, jobID)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Operation != OperationExport || job.Status != StatusCompleted {
+		return nil, ErrNoOutput
+	}
+
+	output, err := s.repository.Output(ctx, job.ID)
+	if err != nil {
+		return nil, fmt.Errorf("get bulk job output: %w", err)
+	}
+
+	return output, nil
+}
+
+// Shutdown interrupts background jobs and waits until they have recorded their state
+func (s *service) Shutdown(ctx context.Context) error {
+	s.cancel()
+
+	done := make(chan struct{})
+
+	go func() {
+		s.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("wait for bulk jobs: %w", ctx.Err())
+	}
+}
+
+// newJob validates a request and turns it into a queued job
+func newJob(req Request) (*Job, error) {
+	if req.FormID == "" {
+		return nil, fmt.Errorf("%w: form ID is required", ErrInvalidRequest)
+	}
+
+	if !req.Operation.IsValid() {
+		return nil, fmt.Errorf("%w: unknown operation %q", ErrInvalidRequest, req.Operation)
+	}
+
+	if err := req.Selection.Validate(); err != nil {
+		return nil, err
+	}
+
+	job := &Job{
+		FormID:    req.FormID,
+		CreatedBy: req.CreatedBy,
+		Operation: req.Operation,
+		Selection: req.Selection,
+		Status:    StatusQueued,
+	}
+
+	switch req.Operation {
+	case OperationSetStatus:
+		if !req.Status.IsValid() {
+			return nil, fmt.Errorf("%w: unknown status %q", ErrInvalidRequest, req.Status)
+		}
+
+		job.TargetStatus = req.Status
+	case OperationMarkSpam:
+		job.TargetStatus = model.SubmissionStatusSpam
+	case OperationExport:
+		job.ExportFormat = req.Format
+		if job.ExportFormat == "" {
+			job.ExportFormat = FormatCSV
+		}
+
+		if job.ExportFormat != FormatCSV && job.ExportFormat != FormatNDJSON {
+			return nil, fmt.Errorf("%w: unknown export format %q", ErrInvalidRequest, req.Format)
+		}
+
+		job.ExportColumns = req.Columns
+	case OperationDelete, OperationReplayWebhooks:
+	}
+
+	return job, nil
+}
+
+// dispatch runs small jobs with the caller's context and hands larger ones to a background worker
+func (s *service) dispatch(ctx context.Context, job *Job) {
+	if job.Total-job.Processed <= InlineLimit {
+		s.run(ctx, job)
+
+		return
+	}
+
+	s.wg.Add(1)
+
+	go func(job *Job) {
+		defer s.wg.Done()
+
+		select {
+		case s.slots <- struct{}{}:
+			defer func() { <-s.slots }()
+		case <-s.ctx.Done():
+			s.fail(job, errors.New("interrupted by shutdown before starting"))
+
+			return
+		}
+
+		s.run(s.ctx, job)
+	}(job.clone())
+}
+
+// run processes a job's remaining chunks, committing each one with the job's progress
+func (s *service) run(ctx context.Context, job *Job) {
+	running := job.clone()
+	running.Status = StatusRunning
+
+	if running.StartedAt == nil {
+		startedAt := s.now()
+		running.StartedAt = &startedAt
+	}
+
+	claimed, err := s.repository.TransitionJob(ctx, running, StatusQueued)
+	if err != nil {
+		s.fail(job, err)
+
+		return
+	}
+
+	if !claimed {
+		// Recover failed the job while it waited for a slot; it runs again once retried
+		s.logger.Info("bulk job is no longer queued", "job_id", job.ID, "form_id", job.FormID)
+
+		return
+	}
+
+	*job = *running
+
+	for {
+		if err := ctx.Err(); err != nil {
+			s.fail(job, fmt.Errorf("interrupted: %w", err))
+
+			return
+		}
+
+		submissions, err := s.repository.NextChunk(ctx, job.FormID, job.Selection, job.Checkpoint, ChunkSize)
+		if err != nil {
+			s.fail(job, err)
+
+			return
+		}
+
+		if len(submissions) == 0 {
+			break
+		}
+
+		if err = s.commit(ctx, job, submissions); err != nil {
+			s.fail(job, err)
+
+			return
+		}
+
+		if len(submissions) < ChunkSize {
+			break
+		}
+	}
+
+	completedAt := s.now()
+	job.Status = StatusCompleted
+	job.CompletedAt = &completedAt
+
+	if err := s.repository.UpdateJob(context.WithoutCancel(ctx), job); err != nil {
+		s.logger.Error("failed to complete bulk job", "job_id", job.ID, "error", err)
+
+		return
+	}
+
+	s.logger.Info("bulk job completed", "job_id", job.ID, "form_id", job.FormID, "processed", job.Processed)
+}
+
+// commit applies one chunk. The job only advances when the chunk's transaction commits,
+// so a failed chunk is picked up again by Retry.
+func (s *service) commit(ctx context.Context, job *Job, submissions []*model.FormSubmission) error {
+	change, err := s.change(ctx, job, submissions)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, len(submissions))
+	for i, submission := range submissions {
+		ids[i] = submission.ID
+	}
+
+	next := job.clone()
+	next.Processed += int64(len(submissions))
+	next.Checkpoint = ids[len(ids)-1]
+
+	if err = s.repository.CommitChunk(ctx, next, ids, change); err != nil {
+		return fmt.Errorf("commit bulk chunk: %w", err)
+	}
+
+	*job = *next
+
+	return nil
+}
+
+// change works out what committing a chunk of submissions does for the job's operation
+func (s *service) change(ctx context.Context, job *Job, submissions []*model.FormSubmission) (Change, error) {
+	switch job.Operation {
+	case OperationDelete:
+		return Change{Delete: true}, nil
+	case OperationSetStatus, OperationMarkSpam:
+		return Change{Status: job.TargetStatus}, nil
+	case OperationReplayWebhooks:
+		// Replayed events are delivered at least once: a chunk whose commit fails is replayed again on retry
+		for _, submission := range submissions {
+			if err := s.publisher.Publish(ctx, formevents.NewSubmissionReplayedEvent(submission)); err != nil {
+				return Change{}, fmt.Errorf("publish submission replay: %w", err)
+			}
+		}
+
+		return Change{}, nil
+	case OperationExport:
+		output, err := exportChunk(job, submissions)
+		if err != nil {
+			return Change{}, err
+		}
+
+		return Change{Output: output}, nil
+	default:
+		return Change{}, fmt.Errorf("%w: unknown operation %q", ErrInvalidRequest, job.Operation)
+	}
+}
+
+// fail records where a job stopped. It uses a context detached from cancellation so that
+// jobs interrupted by shutdown are still marked as failed and can be retried.
+func (s *service) fail(job *Job, cause error) {
+	job.Status = StatusFailed
+	job.Error = cause.Error()
+
+	if len(job.Error) > maxErrorLength {
+		job.Error = job.Error[:maxErrorLength]
+	}
+
+	s.logger.Error("bulk job failed", "job_id", job.ID, "form_id", job.FormID, "processed", job.Processed, "error", cause)
+
+	if err := s.repository.UpdateJob(context.WithoutCancel(s.ctx), job); err != nil {
+		s.logger.Error("failed to record bulk job failure", "job_id", job.ID, "error", err)
+	}
+}
+
+// exportChunk renders a chunk of submissions in the job's export format. The CSV header
+// is written with the first chunk.
+func exportChunk(job *Job, submissions []*model.FormSubmission) ([]byte, error) {
+	var buf bytes.Buffer
+
+	if job.ExportFormat == FormatNDJSON {
+		encoder := json.NewEncoder(&buf)
+
+		for _, submission := range submissions {
+			if err := encoder.Encode(map[string]any{
+				"id":           submission.ID,
+				"submitted_at": submission.SubmittedAt.UTC(),
+				"status":       submission.Status,
+				"data":         submission.Data,
+			}); err != nil {
+				return nil, fmt.Errorf("encode submission %s: %w", submission.ID, err)
+			}
+		}
+
+		return buf.Bytes(), nil
+	}
+
+	writer := csv.NewWriter(&buf)
+
+	if job.Checkpoint == "" {
+		header := []string{"id", "submitted_at", "status"}
+		for _, column := range job.ExportColumns {
+			header = append(header, neutralizeFormula(column))
+		}
+
+		if err := writer.Write(header); err != nil {
+			return nil, fmt.Errorf("write export header: %w", err)
+		}
+	}
+
+	for _, submission := range submissions {
+		record := make([]string, 0, len(job.ExportColumns)+3)
+		record = append(record, submission.ID, submission.SubmittedAt.UTC().Format(time.RFC3339), string(submission.Status))
+
+		for _, column := range job.ExportColumns {
+			record = append(record, exportValue(submission.Data[column]))
+		}
+
+		if err := writer.Write(record); err != nil {
+			return nil, fmt.Errorf("write submission %s: %w", submission.ID, err)
+		}
+	}
+
+	writer.Flush()
+
+	if err := writer.Error(); err != nil {
+		return nil, fmt.Errorf("flush export: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// exportValue renders a submission value as a CSV cell; structured values are written as JSON
+func exportValue(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return ""
+	case string:
+		return neutralizeFormula(v)
+	default:
+		b, err := json.Marshal(v)
+		if err != nil {
+			return fmt.Sprint(v)
+		}
+
+		return string(b)
+	}
+}
+
+// formulaPrefixes are the first characters that make a spreadsheet evaluate a cell as a formula
+const formulaPrefixes = "=+-@\t\r"
+
+// neutralizeFormula prefixes a cell that a spreadsheet would evaluate as a formula with a quote, so
+// a submitted "=HYPERLINK(...)" opens as text. Only submitted text and column names pass through
+// it; numbers such as -5 are written as they are.
+func neutralizeFormula(cell string) string {
+	if cell != "" && strings.ContainsRune(formulaPrefixes, rune(cell[0])) {
+		return "'" + cell
+	}
+
+	return cell
+}
@@ -0,0 +1,410 @@
+package bulk_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goformx/goforms/internal/domain/bulk"
+	"github.com/goformx/goforms/internal/domain/common/events"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	mockbulk "github.com/goformx/goforms/test/mocks/bulk"
+	mockevents "github.com/goformx/goforms/test/mocks/events"
+	mocklogging "github.com/goformx/goforms/test/mocks/logging"
+)
+
+func newBulkService(t *testing.T) (bulk.Service, *mockbulk.MockRepository, *mockevents.MockEventBus) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	repo := mockbulk.NewMockRepository(ctrl)
+	bus := mockevents.NewMockEventBus(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	return bulk.NewService(repo, bus, logger), repo, bus
+}
+
+// submissions returns n submissions with ascending IDs
+func submissions(n int, from int) []*model.FormSubmission {
+	subs := make([]*model.FormSubmission, n)
+	for i := range subs {
+		subs[i] = &model.FormSubmission{
+			ID:          fmt.Sprintf("sub-%05d", from+i),
+			FormID:      "form-1",
+			Status:      model.SubmissionStatusPending,
+			SubmittedAt: time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC),
+			Data:        model.JSON{"name": fmt.Sprintf("User %d", from+i), "tags": []any{"a", "b"}},
+		}
+	}
+
+	return subs
+}
+
+// expectClaim lets a queued job start running
+func expectClaim(repo *mockbulk.MockRepository) {
+	repo.EXPECT().TransitionJob(gomock.Any(), gomock.Any(), bulk.StatusQueued).Return(true, nil)
+}
+
+// expectCreate accepts a new job and gives it an ID
+func expectCreate(repo *mockbulk.MockRepository) {
+	repo.EXPECT().CreateJob(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, job *bulk.Job) error {
+		job.ID = "job-1"
+
+		return nil
+	})
+}
+
+func TestService_Start_InlineDeleteCommitsChunkWithProgress(t *testing.T) {
+	svc, repo, _ := newBulkService(t)
+	ids := bulk.IDList{uuid.NewString(), uuid.NewString()}
+
+	repo.EXPECT().CountSelection(gomock.Any(), "form-1", bulk.Selection{IDs: ids}).Return(int64(2), nil)
+	expectCreate(repo)
+	expectClaim(repo)
+	repo.EXPECT().UpdateJob(gomock.Any(), gomock.Any()).Return(nil)
+	repo.EXPECT().NextChunk(gomock.Any(), "form-1", bulk.Selection{IDs: ids}, "", bulk.ChunkSize).Return(submissions(2, 1), nil)
+	repo.EXPECT().CommitChunk(gomock.Any(), gomock.Any(), []string{"sub-00001", "sub-00002"}, bulk.Change{Delete: true}).
+		DoAndReturn(func(_ context.Context, job *bulk.Job, _ []string, _ bulk.Change) error {
+			assert.Equal(t, int64(2), job.Processed)
+			assert.Equal(t, "sub-00002", job.Checkpoint)
+
+			return nil
+		})
+
+	job, err := svc.Start(context.Background(), bulk.Request{
+		FormID:    "form-1",
+		CreatedBy: "user-1",
+		Operation: bulk.OperationDelete,
+		Selection: bulk.Selection{IDs: ids},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, bulk.StatusCompleted, job.Status)
+	assert.Equal(t, int64(2), job.Processed)
+	assert.Equal(t, 100, job.Progress())
+	assert.NotNil(t, job.StartedAt)
+	assert.NotNil(t, job.CompletedAt)
+}
+
+func TestService_Start_RejectsInvalidRequests(t *testing.T) {
+	after := time.Date(2026, 10, 2, 0, 0, 0, 0, time.UTC)
+	before := after.Add(-time.Hour)
+
+	for name, req := range map[string]bulk.Request{
+		"unknown operation": {FormID: "form-1", Operation: "archive"},
+		"ids and filter": {
+			FormID: "form-1", Operation: bulk.OperationDelete,
+			Selection: bulk.Selection{IDs: bulk.IDList{uuid.NewString()}, Status: "pending"},
+		},
+		"invalid id":     {FormID: "form-1", Operation: bulk.OperationDelete, Selection: bulk.Selection{IDs: bulk.IDList{"1; DROP"}}},
+		"unknown filter": {FormID: "form-1", Operation: bulk.OperationDelete, Selection: bulk.Selection{Status: "archived"}},
+		"inverted range": {
+			FormID: "form-1", Operation: bulk.OperationDelete,
+			Selection: bulk.Selection{SubmittedAfter: &after, SubmittedBefore: &before},
+		},
+		"missing status":      {FormID: "form-1", Operation: bulk.OperationSetStatus},
+		"unknown export type": {FormID: "form-1", Operation: bulk.OperationExport, Format: "xlsx"},
+	} {
+		t.Run(name, func(t *testing.T) {
+			svc, _, _ := newBulkService(t)
+
+			_, err := svc.Start(context.Background(), req)
+			require.ErrorIs(t, err, bulk.ErrInvalidRequest)
+		})
+	}
+}
+
+func TestService_Start_FailedChunkLeavesJobRetryable(t *testing.T) {
+	svc, repo, _ := newBulkService(t)
+
+	repo.EXPECT().CountSelection(gomock.Any(), "form-1", gomock.Any()).Return(int64(3), nil)
+	expectCreate(repo)
+	expectClaim(repo)
+
+	var saved []bulk.Job
+
+	repo.EXPECT().UpdateJob(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, job *bulk.Job) error {
+		saved = append(saved, *job)
+
+		return nil
+	})
+	repo.EXPECT().NextChunk(gomock.Any(), "form-1", gomock.Any(), "", bulk.ChunkSize).Return(submissions(3, 1), nil)
+	repo.EXPECT().CommitChunk(gomock.Any(), gomock.Any(), gomock.Any(), bulk.Change{Status: model.SubmissionStatusSpam}).
+		Return(assert.AnError)
+
+	job, err := svc.Start(context.Background(), bulk.Request{
+		FormID:    "form-1",
+		Operation: bulk.OperationMarkSpam,
+		Selection: bulk.Selection{Status: model.SubmissionStatusPending},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, bulk.StatusFailed, job.Status)
+	assert.Contains(t, job.Error, assert.AnError.Error())
+
+	require.Len(t, saved, 1)
+	assert.Equal(t, bulk.StatusFailed, saved[0].Status)
+	assert.Zero(t, saved[0].Processed, "progress must not advance past an uncommitted chunk")
+	assert.Empty(t, saved[0].Checkpoint)
+}
+
+func TestService_Retry_ResumesFromCheckpoint(t *testing.T) {
+	svc, repo, _ := newBulkService(t)
+
+	failed := &bulk.Job{
+		ID:           "job-1",
+		FormID:       "form-1",
+		Operation:    bulk.OperationSetStatus,
+		TargetStatus: model.SubmissionStatusCompleted,
+		Status:       bulk.StatusFailed,
+		Total:        300,
+		Processed:    200,
+		Checkpoint:   "sub-00200",
+		Error:        "connection reset",
+	}
+
+	repo.EXPECT().GetJob(gomock.Any(), "job-1").Return(failed, nil)
+	repo.EXPECT().TransitionJob(gomock.Any(), gomock.Any(), bulk.StatusFailed).Return(true, nil)
+	expectClaim(repo)
+	repo.EXPECT().UpdateJob(gomock.Any(), gomock.Any()).Return(nil)
+	repo.EXPECT().NextChunk(gomock.Any(), "form-1", gomock.Any(), "sub-00200", bulk.ChunkSize).Return(submissions(100, 201), nil)
+	repo.EXPECT().CommitChunk(gomock.Any(), gomock.Any(), gomock.Len(100), bulk.Change{Status: model.SubmissionStatusCompleted}).
+		DoAndReturn(func(_ context.Context, job *bulk.Job, _ []string, _ bulk.Change) error {
+			assert.Equal(t, int64(300), job.Processed)
+			assert.Equal(t, "sub-00300", job.Checkpoint)
+
+			return nil
+		})
+
+	job, err := svc.Retry(context.Background(), "form-1", "job-1")
+	require.NoError(t, err)
+	assert.Equal(t, bulk.StatusCompleted, job.Status)
+	assert.Empty(t, job.Error)
+}
+
+func TestService_Retry_OnlyFailedJobs(t *testing.T) {
+	svc, repo, _ := newBulkService(t)
+
+	repo.EXPECT().GetJob(gomock.Any(), "job-1").Return(&bulk.Job{ID: "job-1", FormID: "form-1", Status: bulk.StatusRunning}, nil)
+	_, err := svc.Retry(context.Background(), "form-1", "job-1")
+	require.ErrorIs(t, err, bulk.ErrNotRetryable)
+
+	repo.EXPECT().GetJob(gomock.Any(), "job-2").Return(&bulk.Job{ID: "job-2", FormID: "form-2", Status: bulk.StatusFailed}, nil)
+	_, err = svc.Retry(context.Background(), "form-1", "job-2")
+	require.ErrorIs(t, err, bulk.ErrJobNotFound)
+}
+
+func TestService_Retry_RacingRetryLoses(t *testing.T) {
+	svc, repo, _ := newBulkService(t)
+
+	repo.EXPECT().GetJob(gomock.Any(), "job-1").Return(&bulk.Job{ID: "job-1", FormID: "form-1", Status: bulk.StatusFailed}, nil)
+	// Another retry queued the job between reading and updating it
+	repo.EXPECT().TransitionJob(gomock.Any(), gomock.Any(), bulk.StatusFailed).Return(false, nil)
+
+	_, err := svc.Retry(context.Background(), "form-1", "job-1")
+	require.ErrorIs(t, err, bulk.ErrNotRetryable)
+}
+
+func TestService_Recover_FailsStaleJobs(t *testing.T) {
+	svc, repo, _ := newBulkService(t)
+
+	repo.EXPECT().ListStaleJobs(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, before time.Time) ([]*bulk.Job, error) {
+		assert.WithinDuration(t, time.Now().Add(-bulk.StaleJobAge), before, time.Minute)
+
+		return []*bulk.Job{
+			{ID: "job-1", FormID: "form-1", Status: bulk.StatusRunning, Processed: 200, Checkpoint: "sub-00200"},
+			{ID: "job-2", FormID: "form-1", Status: bulk.StatusQueued},
+		}, nil
+	})
+
+	var failed []string
+
+	for _, from := range []bulk.Status{bulk.StatusRunning, bulk.StatusQueued} {
+		repo.EXPECT().TransitionJob(gomock.Any(), gomock.Any(), from).DoAndReturn(func(_ context.Context, job *bulk.Job, _ bulk.Status) (bool, error) {
+			assert.Equal(t, bulk.StatusFailed, job.Status)
+			assert.NotEmpty(t, job.Error)
+			failed = append(failed, job.ID)
+
+			return true, nil
+		})
+	}
+
+	require.NoError(t, svc.Recover(context.Background()))
+	assert.Equal(t, []string{"job-1", "job-2"}, failed)
+}
+
+func TestService_Start_SkipsJobNoLongerQueued(t *testing.T) {
+	svc, repo, _ := newBulkService(t)
+
+	repo.EXPECT().CountSelection(gomock.Any(), "form-1", gomock.Any()).Return(int64(2), nil)
+	expectCreate(repo)
+	// Recover failed the job before it started; it must not be processed
+	repo.EXPECT().TransitionJob(gomock.Any(), gomock.Any(), bulk.StatusQueued).Return(false, nil)
+
+	_, err := svc.Start(context.Background(), bulk.Request{FormID: "form-1", Operation: bulk.OperationDelete})
+	require.NoError(t, err)
+}
+
+func TestService_Start_LargeJobRunsInBackgroundChunks(t *testing.T) {
+	svc, repo, _ := newBulkService(t)
+
+	repo.EXPECT().CountSelection(gomock.Any(), "form-1", gomock.Any()).Return(int64(450), nil)
+	expectCreate(repo)
+	expectClaim(repo)
+
+	done := make(chan bulk.Job, 1)
+
+	repo.EXPECT().UpdateJob(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, job *bulk.Job) error {
+		if job.Done() {
+			done <- *job
+		}
+
+		return nil
+	})
+
+	gomock.InOrder(
+		repo.EXPECT().NextChunk(gomock.Any(), "form-1", gomock.Any(), "", bulk.ChunkSize).Return(submissions(200, 1), nil),
+		repo.EXPECT().NextChunk(gomock.Any(), "form-1", gomock.Any(), "sub-00200", bulk.ChunkSize).Return(submissions(200, 201), nil),
+		repo.EXPECT().NextChunk(gomock.Any(), "form-1", gomock.Any(), "sub-00400", bulk.ChunkSize).Return(submissions(50, 401), nil),
+	)
+	repo.EXPECT().CommitChunk(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(3)
+
+	job, err := svc.Start(context.Background(), bulk.Request{FormID: "form-1", Operation: bulk.OperationDelete})
+	require.NoError(t, err)
+	assert.Equal(t, bulk.StatusQueued, job.Status, "the returned job is not touched by the worker")
+
+	select {
+	case finished := <-done:
+		assert.Equal(t, bulk.StatusCompleted, finished.Status)
+		assert.Equal(t, int64(450), finished.Processed)
+	case <-time.After(5 * time.Second):
+		t.Fatal("background job did not complete")
+	}
+
+	require.NoError(t, svc.Shutdown(context.Background()))
+}
+
+func TestService_Start_ExportWritesHeaderThenRows(t *testing.T) {
+	svc, repo, _ := newBulkService(t)
+
+	repo.EXPECT().CountSelection(gomock.Any(), "form-1", gomock.Any()).Return(int64(2), nil)
+	expectCreate(repo)
+	expectClaim(repo)
+	repo.EXPECT().UpdateJob(gomock.Any(), gomock.Any()).Return(nil)
+	repo.EXPECT().NextChunk(gomock.Any(), "form-1", gomock.Any(), "", bulk.ChunkSize).Return(submissions(2, 1), nil)
+
+	var output string
+
+	repo.EXPECT().CommitChunk(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ *bulk.Job, _ []string, change bulk.Change) error {
+			assert.False(t, change.Delete)
+			assert.Empty(t, change.Status)
+			output = string(change.Output)
+
+			return nil
+		})
+
+	job, err := svc.Start(context.Background(), bulk.Request{
+		FormID:    "form-1",
+		Operation: bulk.OperationExport,
+		Columns:   []string{"name", "tags", "missing"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, bulk.FormatCSV, job.ExportFormat)
+
+	assert.Equal(t, strings.Join([]string{
+		"id,submitted_at,status,name,tags,missing",
+		`sub-00001,2026-10-01T12:00:00Z,pending,User 1,"[""a"",""b""]",`,
+		`sub-00002,2026-10-01T12:00:00Z,pending,User 2,"[""a"",""b""]",`,
+		"",
+	}, "\n"), output)
+}
+
+func TestService_Start_ExportNeutralizesFormulas(t *testing.T) {
+	svc, repo, _ := newBulkService(t)
+
+	rows := submissions(1, 1)
+	rows[0].Data = model.JSON{
+		"=cmd": "x",
+		"name": "=HYPERLINK(\"https://evil.example\",\"click\")",
+		"note": "+1", "tag": "@SUM(A1)", "dash": "-2+3", "tab": "\tx", "cr": "\rx",
+		"score": -5, "plain": "safe",
+	}
+
+	repo.EXPECT().CountSelection(gomock.Any(), "form-1", gomock.Any()).Return(int64(1), nil)
+	expectCreate(repo)
+	expectClaim(repo)
+	repo.EXPECT().UpdateJob(gomock.Any(), gomock.Any()).Return(nil)
+	repo.EXPECT().NextChunk(gomock.Any(), "form-1", gomock.Any(), "", bulk.ChunkSize).Return(rows, nil)
+
+	var output string
+
+	repo.EXPECT().CommitChunk(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _ *bulk.Job, _ []string, change bulk.Change) error {
+			output = string(change.Output)
+
+			return nil
+		})
+
+	_, err := svc.Start(context.Background(), bulk.Request{
+		FormID:    "form-1",
+		Operation: bulk.OperationExport,
+		Columns:   []string{"=cmd", "name", "note", "tag", "dash", "tab", "cr", "score", "plain"},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, strings.Join([]string{
+		"id,submitted_at,status,'=cmd,name,note,tag,dash,tab,cr,score,plain",
+		`sub-00001,2026-10-01T12:00:00Z,pending,x,"'=HYPERLINK(""https://evil.example"",""click"")",'+1,'@SUM(A1),'-2+3,'	x,"'` +
+			"\rx" + `",-5,safe`,
+		"",
+	}, "\n"), output)
+}
+
+func TestService_Start_ReplayPublishesEventPerSubmission(t *testing.T) {
+	svc, repo, bus := newBulkService(t)
+
+	repo.EXPECT().CountSelection(gomock.Any(), "form-1", gomock.Any()).Return(int64(2), nil)
+	expectCreate(repo)
+	expectClaim(repo)
+	repo.EXPECT().UpdateJob(gomock.Any(), gomock.Any()).Return(nil)
+	repo.EXPECT().NextChunk(gomock.Any(), "form-1", gomock.Any(), "", bulk.ChunkSize).Return(submissions(2, 1), nil)
+	repo.EXPECT().CommitChunk(gomock.Any(), gomock.Any(), gomock.Len(2), bulk.Change{}).Return(nil)
+
+	var replayed []string
+
+	bus.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, event events.Event) error {
+		assert.Equal(t, "form.submission.replayed", event.Name())
+		replayed = append(replayed, event.Payload().(*model.FormSubmission).ID)
+
+		return nil
+	}).Times(2)
+
+	_, err := svc.Start(context.Background(), bulk.Request{FormID: "form-1", Operation: bulk.OperationReplayWebhooks})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"sub-00001", "sub-00002"}, replayed)
+}
+
+func TestService_Output_RequiresCompletedExport(t *testing.T) {
+	svc, repo, _ := newBulkService(t)
+
+	repo.EXPECT().GetJob(gomock.Any(), "job-1").
+		Return(&bulk.Job{ID: "job-1", FormID: "form-1", Operation: bulk.OperationExport, Status: bulk.StatusRunning}, nil)
+	_, err := svc.Output(context.Background(), "form-1", "job-1")
+	require.ErrorIs(t, err, bulk.ErrNoOutput)
+
+	repo.EXPECT().GetJob(gomock.Any(), "job-2").
+		Return(&bulk.Job{ID: "job-2", FormID: "form-1", Operation: bulk.OperationExport, Status: bulk.StatusCompleted}, nil)
+	repo.EXPECT().Output(gomock.Any(), "job-2").Return([]byte("id\n"), nil)
+
+	output, err := svc.Output(context.Background(), "form-1", "job-2")
+	require.NoError(t, err)
+	assert.Equal(t, "id\n", string(output))
+}
@@ -24,18 +24,21 @@ var errorCategories = map[ErrorCode][]ErrorCategory{
 	ErrCodeUserNotFound: {CategoryNotFound, CategoryUser},
 
 	// Validation errors
-	ErrCodeValidation:     {CategoryValidation},
-	ErrCodeRequired:       {CategoryValidation},
-	ErrCodeInvalid:        {CategoryValidation},
-	ErrCodeInvalidFormat:  {CategoryValidation},
-	ErrCodeInvalidInput:   {CategoryValidation},
-	ErrCodeBadRequest:     {CategoryValidation},
-	ErrCodeFormValidation: {CategoryValidation, CategoryForm},
-	ErrCodeFormInvalid:    {CategoryValidation, CategoryForm},
-	ErrCodeUserInvalid:    {CategoryValidation, CategoryUser},
-	ErrCodeFormSubmission: {CategoryValidation, CategoryForm},
-	ErrCodeFormExpired:    {CategoryValidation, CategoryForm},
-	ErrCodeUserDisabled:   {CategoryValidation, CategoryUser},
+	ErrCodeValidation:       {CategoryValidation},
+	ErrCodeRequired:         {CategoryValidation},
+	ErrCodeInvalid:          {CategoryValidation},
+	ErrCodeInvalidFormat:    {CategoryValidation},
+	ErrCodeInvalidInput:     {CategoryValidation},
+	ErrCodeBadRequest:       {CategoryValidation},
+	ErrCodeUnprocessable:    {CategoryValidation},
+	ErrCodePayloadTooLarge:  {CategoryValidation},
+	ErrCodeMethodNotAllowed: {CategoryValidation},
+	ErrCodeFormValidation:   {CategoryValidation, CategoryForm},
+	ErrCodeFormInvalid:      {CategoryValidation, CategoryForm},
+	ErrCodeUserInvalid:      {CategoryValidation, CategoryUser},
+	ErrCodeFormSubmission:   {CategoryValidation, CategoryForm},
+	ErrCodeFormExpired:      {CategoryValidation, CategoryForm},
+	ErrCodeUserDisabled:     {CategoryValidation, CategoryUser},
 
 	// Form errors
 	ErrCodeFormAccessDenied: {CategoryForm, CategoryForbidden},
@@ -53,6 +56,7 @@ var errorCategories = map[ErrorCode][]ErrorCategory{
 	ErrCodeForbidden:           {CategoryForbidden},
 	ErrCodeLimitExceeded:       {CategoryForbidden},
 	ErrCodeFeatureNotAvailable: {CategoryForbidden},
+	ErrCodeRateLimited:         {CategoryForbidden},
 
 	// Conflict errors
 	ErrCodeConflict:      {CategoryConflict},
@@ -65,6 +69,7 @@ var errorCategories = map[ErrorCode][]ErrorCategory{
 	ErrCodeStartup:     {CategorySystem},
 	ErrCodeShutdown:    {CategorySystem},
 	ErrCodeTimeout:     {CategorySystem},
+	ErrCodeUnavailable: {CategorySystem},
 }
 
 // HasCategory checks if an error belongs to a specific category
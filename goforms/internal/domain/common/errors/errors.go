@@ -52,6 +52,17 @@ const (
 	ErrCodeDatabase ErrorCode = "DB_ERROR"
 	// ErrCodeTimeout represents a timeout error
 	ErrCodeTimeout ErrorCode = "TIMEOUT"
+	// ErrCodeUnavailable represents a dependency, such as a store, being unavailable
+	ErrCodeUnavailable ErrorCode = "SERVICE_UNAVAILABLE"
+
+	// ErrCodeMethodNotAllowed represents a request method the resource does not support
+	ErrCodeMethodNotAllowed ErrorCode = "METHOD_NOT_ALLOWED"
+	// ErrCodePayloadTooLarge represents a request body over the size limit
+	ErrCodePayloadTooLarge ErrorCode = "PAYLOAD_TOO_LARGE"
+	// ErrCodeUnprocessable represents a well-formed request that cannot be processed
+	ErrCodeUnprocessable ErrorCode = "UNPROCESSABLE"
+	// ErrCodeRateLimited represents a rate limit being exceeded
+	ErrCodeRateLimited ErrorCode = "RATE_LIMITED"
 
 	// ErrCodeFormValidation represents a form validation error
 	ErrCodeFormValidation ErrorCode = "FORM_VALIDATION_ERROR"
@@ -91,13 +102,6 @@ type DomainError struct {
 	Context map[string]any
 }
 
-// ErrorResponse represents a standardized error response for HTTP handlers
-type ErrorResponse struct {
-	Code    string         `json:"code"`
-	Message string         `json:"message"`
-	Details map[string]any `json:"details,omitempty"`
-}
-
 func (e *DomainError) Error() string {
 	if e.Err != nil {
 		return fmt.Sprintf("%s: %s (%v)", e.Code, e.Message, e.Err)
@@ -129,11 +133,19 @@ func GetHTTPStatus(code ErrorCode) int {
 		return http.StatusForbidden
 	case ErrCodeNotFound, ErrCodeFormNotFound, ErrCodeUserNotFound:
 		return http.StatusNotFound
+	case ErrCodeMethodNotAllowed:
+		return http.StatusMethodNotAllowed
 	case ErrCodeConflict, ErrCodeAlreadyExists, ErrCodeUserExists:
 		return http.StatusConflict
+	case ErrCodePayloadTooLarge:
+		return http.StatusRequestEntityTooLarge
+	case ErrCodeUnprocessable:
+		return http.StatusUnprocessableEntity
+	case ErrCodeRateLimited:
+		return http.StatusTooManyRequests
 	case ErrCodeServerError, ErrCodeDatabase, ErrCodeConfig:
 		return http.StatusInternalServerError
-	case ErrCodeStartup, ErrCodeShutdown:
+	case ErrCodeStartup, ErrCodeShutdown, ErrCodeUnavailable:
 		return http.StatusServiceUnavailable
 	case ErrCodeTimeout:
 		return http.StatusGatewayTimeout
@@ -142,15 +154,6 @@ func GetHTTPStatus(code ErrorCode) int {
 	}
 }
 
-// ToResponse converts the DomainError to a standardized ErrorResponse
-func (e *DomainError) ToResponse() ErrorResponse {
-	return ErrorResponse{
-		Code:    string(e.Code),
-		Message: e.Message,
-		Details: e.Context,
-	}
-}
-
 // New creates a new domain error
 func New(code ErrorCode, message string, err error) *DomainError {
 	return &DomainError{
@@ -0,0 +1,232 @@
+package events
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/santhosh-tekuri/jsonschema/v6"
+)
+
+// SchemaURIPrefix starts the URI naming each payload schema, as in
+// urn:goformx:event-schema:form.submitted:1
+const SchemaURIPrefix = "urn:goformx:event-schema:"
+
+var (
+	// ErrSchemaNotFound is returned when no schema is registered for an event type and version
+	ErrSchemaNotFound = errors.New("event schema not found")
+	// ErrSchemaExists is returned when a version of an event type is registered twice
+	ErrSchemaExists = errors.New("event schema already registered")
+)
+
+// Schema is the JSON Schema (draft 2020-12) of one version of an event type's payload. Versions
+// only change when a payload changes incompatibly; adding an optional field keeps the version.
+type Schema struct {
+	Type    string
+	Version int
+	// Document is the schema as registered, for publishing to consumers
+	Document json.RawMessage
+
+	parsed *jsonschema.Schema
+}
+
+// URI names the schema; events carry it so consumers know which schema their payload follows
+func (s *Schema) URI() string {
+	return SchemaURIPrefix + s.Type + ":" + strconv.Itoa(s.Version)
+}
+
+// SchemaViolation is one way a payload breaks its schema
+type SchemaViolation struct {
+	// Path is the JSON pointer of the offending value, empty for the payload itself
+	Path string
+	// Keyword is the JSON pointer of the failing keyword in the schema, such as /properties/status/enum
+	Keyword string
+	Message string
+}
+
+// String formats the violation for errors and logs
+func (v SchemaViolation) String() string {
+	if v.Path == "" {
+		return v.Message
+	}
+
+	return v.Path + ": " + v.Message
+}
+
+// SchemaValidationError lists how a payload breaks the schema of its event type
+type SchemaValidationError struct {
+	Type    string
+	Version int
+	Errors  []SchemaViolation
+}
+
+// Error implements the error interface
+func (e *SchemaValidationError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, validationErr := range e.Errors {
+		messages = append(messages, validationErr.String())
+	}
+
+	return fmt.Sprintf("%s payload does not match schema version %d: %s", e.Type, e.Version, strings.Join(messages, "; "))
+}
+
+// SchemaRegistry holds the payload schemas of event types. Publishers check payloads against the
+// latest version of their type, and consumers against the version an event names.
+type SchemaRegistry struct {
+	mu      sync.RWMutex
+	schemas map[string]map[int]*Schema
+}
+
+// NewSchemaRegistry creates an empty registry
+func NewSchemaRegistry() *SchemaRegistry {
+	return &SchemaRegistry{schemas: make(map[string]map[int]*Schema)}
+}
+
+// Register adds version of eventType's payload schema. Documents are JSON Schema draft 2020-12
+// unless they name another draft in $schema; formats such as date-time are asserted.
+func (r *SchemaRegistry) Register(eventType string, version int, document []byte) error {
+	if eventType == "" || version < 1 {
+		return fmt.Errorf("register event schema %q version %d: type and a positive version are required", eventType, version)
+	}
+
+	schema := &Schema{Type: eventType, Version: version, Document: append(json.RawMessage(nil), document...)}
+
+	parsed, err := compileSchema(schema.URI(), document)
+	if err != nil {
+		return fmt.Errorf("register event schema %s version %d: %w", eventType, version, err)
+	}
+
+	schema.parsed = parsed
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	versions, ok := r.schemas[eventType]
+	if !ok {
+		versions = make(map[int]*Schema)
+		r.schemas[eventType] = versions
+	}
+
+	if _, exists := versions[version]; exists {
+		return fmt.Errorf("%w: %s version %d", ErrSchemaExists, eventType, version)
+	}
+
+	versions[version] = schema
+
+	return nil
+}
+
+// compileSchema compiles a schema document found at uri
+func compileSchema(uri string, document []byte) (*jsonschema.Schema, error) {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(document))
+	if err != nil {
+		return nil, fmt.Errorf("parse json schema: %w", err)
+	}
+
+	compiler := jsonschema.NewCompiler()
+	compiler.DefaultDraft(jsonschema.Draft2020)
+	compiler.AssertFormat()
+
+	if err = compiler.AddResource(uri, doc); err != nil {
+		return nil, fmt.Errorf("add json schema: %w", err)
+	}
+
+	compiled, err := compiler.Compile(uri)
+	if err != nil {
+		return nil, fmt.Errorf("compile json schema: %w", err)
+	}
+
+	return compiled, nil
+}
+
+// Lookup returns version of eventType's schema
+func (r *SchemaRegistry) Lookup(eventType string, version int) (*Schema, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	schema, ok := r.schemas[eventType][version]
+
+	return schema, ok
+}
+
+// Latest returns the highest registered version of eventType's schema
+func (r *SchemaRegistry) Latest(eventType string) (*Schema, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var latest *Schema
+
+	for _, schema := range r.schemas[eventType] {
+		if latest == nil || schema.Version > latest.Version {
+			latest = schema
+		}
+	}
+
+	return latest, latest != nil
+}
+
+// Types returns the event types with a registered schema, sorted
+func (r *SchemaRegistry) Types() []string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	types := make([]string, 0, len(r.schemas))
+	for eventType := range r.schemas {
+		types = append(types, eventType)
+	}
+
+	slices.Sort(types)
+
+	return types
+}
+
+// Validate checks a JSON payload against version of eventType's schema
+func (r *SchemaRegistry) Validate(eventType string, version int, payload []byte) error {
+	schema, ok := r.Lookup(eventType, version)
+	if !ok {
+		return fmt.Errorf("%w: %s version %d", ErrSchemaNotFound, eventType, version)
+	}
+
+	value, err := jsonschema.UnmarshalJSON(bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("decode %s payload: %w", eventType, err)
+	}
+
+	err = schema.parsed.Validate(value)
+
+	var validationErr *jsonschema.ValidationError
+	if errors.As(err, &validationErr) {
+		return &SchemaValidationError{Type: eventType, Version: version, Errors: schemaViolations(validationErr)}
+	}
+
+	if err != nil {
+		return fmt.Errorf("validate %s payload: %w", eventType, err)
+	}
+
+	return nil
+}
+
+// schemaViolations lists the failing keywords of a validation error, leaving out the keywords
+// that failed only because one of their subschemas did
+func schemaViolations(err *jsonschema.ValidationError) []SchemaViolation {
+	var violations []SchemaViolation
+
+	for _, unit := range err.BasicOutput().Errors {
+		if unit.Error == nil {
+			continue
+		}
+
+		violations = append(violations, SchemaViolation{
+			Path:    unit.InstanceLocation,
+			Keyword: unit.KeywordLocation,
+			Message: unit.Error.String(),
+		})
+	}
+
+	return violations
+}
@@ -31,9 +31,68 @@ const (
 	FieldEventType EventType = "form.field"
 	// AnalyticsEventType represents an analytics event
 	AnalyticsEventType EventType = "form.analytics"
+	// SubmissionReplayedEventType re-announces a stored submission so that
+	// webhook deliveries and other submission subscribers run again
+	SubmissionReplayedEventType EventType = "form.submission.replayed"
+	// ReviewStatusChangedEventType represents a submission moving to another review status
+	ReviewStatusChangedEventType EventType = "form.submission.review_status_changed"
+	// SubmissionAssignedEventType represents a submission being assigned or unassigned
+	SubmissionAssignedEventType EventType = "form.submission.assigned"
 )
 
-// Event represents a form-related event
+// ReviewChange describes a change to a submission's review state. From and To are
+// review statuses for status changes and assignee IDs for assignments.
+type ReviewChange struct {
+	FormID       string `json:"form_id"`
+	SubmissionID string `json:"submission_id"`
+	From         string `json:"from"`
+	To           string `json:"to"`
+	ActorID      string `json:"actor_id"`
+}
+
+// FormDeletedPayload is the payload of form.deleted
+type FormDeletedPayload struct {
+	FormID string `json:"form_id"`
+}
+
+// FormValidatedPayload is the payload of form.validated
+type FormValidatedPayload struct {
+	FormID  string `json:"form_id"`
+	IsValid bool   `json:"is_valid"`
+}
+
+// FormProcessedPayload is the payload of form.processed
+type FormProcessedPayload struct {
+	FormID       string `json:"form_id"`
+	ProcessingID string `json:"processing_id"`
+}
+
+// FormErrorPayload is the payload of form.error
+type FormErrorPayload struct {
+	FormID string `json:"form_id"`
+	Error  string `json:"error"`
+}
+
+// FormStatePayload is the payload of form.state
+type FormStatePayload struct {
+	FormID string `json:"form_id"`
+	State  string `json:"state"`
+}
+
+// FieldPayload is the payload of form.field
+type FieldPayload struct {
+	FormID  string `json:"form_id"`
+	FieldID string `json:"field_id"`
+}
+
+// AnalyticsPayload is the payload of form.analytics
+type AnalyticsPayload struct {
+	FormID    string `json:"form_id"`
+	EventType string `json:"event_type"`
+}
+
+// Event represents a form-related event. Its payload is one of the types the schema of its
+// event type describes: *model.Form, *model.FormSubmission, ReviewChange or a *Payload struct.
 type Event struct {
 	events.BaseEvent
 	payload any
@@ -67,7 +126,7 @@ func NewFormUpdatedEvent(form *model.Form) *Event {
 
 // NewFormDeletedEvent creates a new form deleted event
 func NewFormDeletedEvent(formID string) *Event {
-	return NewEvent(FormDeletedEventType, formID)
+	return NewEvent(FormDeletedEventType, FormDeletedPayload{FormID: formID})
 }
 
 // NewFormSubmittedEvent creates a new form submitted event
@@ -75,50 +134,47 @@ func NewFormSubmittedEvent(submission *model.FormSubmission) *Event {
 	return NewEvent(FormSubmittedEventType, submission)
 }
 
+// NewSubmissionReplayedEvent creates a new submission replayed event
+func NewSubmissionReplayedEvent(submission *model.FormSubmission) *Event {
+	return NewEvent(SubmissionReplayedEventType, submission)
+}
+
+// NewReviewStatusChangedEvent creates a new review status changed event
+func NewReviewStatusChangedEvent(change ReviewChange) *Event {
+	return NewEvent(ReviewStatusChangedEventType, change)
+}
+
+// NewSubmissionAssignedEvent creates a new submission assigned event
+func NewSubmissionAssignedEvent(change ReviewChange) *Event {
+	return NewEvent(SubmissionAssignedEventType, change)
+}
+
 // NewFormValidatedEvent creates a new form validated event
 func NewFormValidatedEvent(formID string, isValid bool) *Event {
-	return NewEvent(FormValidatedEventType, map[string]any{
-		"form_id":  formID,
-		"is_valid": isValid,
-	})
+	return NewEvent(FormValidatedEventType, FormValidatedPayload{FormID: formID, IsValid: isValid})
 }
 
 // NewFormProcessedEvent creates a new form processed event
 func NewFormProcessedEvent(formID, processingID string) *Event {
-	return NewEvent(FormProcessedEventType, map[string]string{
-		"form_id":       formID,
-		"processing_id": processingID,
-	})
+	return NewEvent(FormProcessedEventType, FormProcessedPayload{FormID: formID, ProcessingID: processingID})
 }
 
 // NewFormErrorEvent creates a new form error event
 func NewFormErrorEvent(formID string, err error) *Event {
-	return NewEvent(FormErrorEventType, map[string]any{
-		"form_id": formID,
-		"error":   err.Error(),
-	})
+	return NewEvent(FormErrorEventType, FormErrorPayload{FormID: formID, Error: err.Error()})
 }
 
 // NewFormStateEvent creates a new form state event
 func NewFormStateEvent(formID, state string) *Event {
-	return NewEvent(FormStateEventType, map[string]string{
-		"form_id": formID,
-		"state":   state,
-	})
+	return NewEvent(FormStateEventType, FormStatePayload{FormID: formID, State: state})
 }
 
 // NewFieldEvent creates a new field event
 func NewFieldEvent(formID, fieldID string) *Event {
-	return NewEvent(FieldEventType, map[string]string{
-		"form_id":  formID,
-		"field_id": fieldID,
-	})
+	return NewEvent(FieldEventType, FieldPayload{FormID: formID, FieldID: fieldID})
 }
 
 // NewAnalyticsEvent creates a new analytics event
 func NewAnalyticsEvent(formID, eventType string) *Event {
-	return NewEvent(AnalyticsEventType, map[string]string{
-		"form_id":    formID,
-		"event_type": eventType,
-	})
+	return NewEvent(AnalyticsEventType, AnalyticsPayload{FormID: formID, EventType: eventType})
 }
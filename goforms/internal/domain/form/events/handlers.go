@@ -42,6 +42,10 @@ func NewEventHandler(logger logging.Logger) *EventHandler {
 		string(FormStateEventType):     h.handleFormState,
 		string(FieldEventType):         h.handleFieldEvent,
 		string(AnalyticsEventType):     h.handleAnalyticsEvent,
+
+		string(SubmissionReplayedEventType):  h.handleSubmissionReplayed,
+		string(ReviewStatusChangedEventType): h.handleReviewChanged,
+		string(SubmissionAssignedEventType):  h.handleReviewChanged,
 	}
 
 	return h
@@ -133,6 +137,36 @@ func (h *EventHandler) handleFormProcessed(ctx context.Context, event events.Eve
 	return nil
 }
 
+// handleSubmissionReplayed handles submission replayed events
+func (h *EventHandler) handleSubmissionReplayed(ctx context.Context, event events.Event) error {
+	h.logger.Info("handling submission replayed event",
+		"event_name", event.Name(),
+		"timestamp", event.Timestamp(),
+		"request_id", ctx.Value("request_id"),
+	)
+
+	return nil
+}
+
+// handleReviewChanged handles review status changed and submission assigned events
+func (h *EventHandler) handleReviewChanged(ctx context.Context, event events.Event) error {
+	change, ok := event.Payload().(ReviewChange)
+	if !ok {
+		return ErrInvalidEventPayload
+	}
+
+	h.logger.Info("handling submission review event",
+		"event_name", event.Name(),
+		"form_id", change.FormID,
+		"submission_id", change.SubmissionID,
+		"from", change.From,
+		"to", change.To,
+		"request_id", ctx.Value("request_id"),
+	)
+
+	return nil
+}
+
 // handleFormError handles form error events
 func (h *EventHandler) handleFormError(ctx context.Context, event events.Event) error {
 	h.logger.Error("handling form error event",
@@ -0,0 +1,72 @@
+package form
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"strconv"
+	"strings"
+
+	"github.com/goformx/goforms/internal/domain/common/events"
+)
+
+// schemaFiles holds the payload schema of every form event type, named <type>.v<version>.json
+//
+//go:embed schemas/*.json
+var schemaFiles embed.FS
+
+// RegisterSchemas adds the payload schemas of the form event types to registry
+func RegisterSchemas(registry *events.SchemaRegistry) error {
+	entries, err := fs.ReadDir(schemaFiles, "schemas")
+	if err != nil {
+		return fmt.Errorf("read form event schemas: %w", err)
+	}
+
+	for _, entry := range entries {
+		eventType, version, ok := parseSchemaFileName(entry.Name())
+		if !ok {
+			return fmt.Errorf("form event schema %s is not named <type>.v<version>.json", entry.Name())
+		}
+
+		document, readErr := schemaFiles.ReadFile("schemas/" + entry.Name())
+		if readErr != nil {
+			return fmt.Errorf("read form event schema %s: %w", entry.Name(), readErr)
+		}
+
+		if registerErr := registry.Register(eventType, version, document); registerErr != nil {
+			return fmt.Errorf("register form event schemas: %w", registerErr)
+		}
+	}
+
+	return nil
+}
+
+// NewSchemaRegistry creates a registry holding the form event schemas
+func NewSchemaRegistry() (*events.SchemaRegistry, error) {
+	registry := events.NewSchemaRegistry()
+	if err := RegisterSchemas(registry); err != nil {
+		return nil, err
+	}
+
+	return registry, nil
+}
+
+// parseSchemaFileName splits form.submitted.v1.json into form.submitted and 1
+func parseSchemaFileName(name string) (eventType string, version int, ok bool) {
+	base, isJSON := strings.CutSuffix(name, ".json")
+	if !isJSON {
+		return "", 0, false
+	}
+
+	dot := strings.LastIndex(base, ".v")
+	if dot <= 0 {
+		return "", 0, false
+	}
+
+	version, err := strconv.Atoi(base[dot+2:])
+	if err != nil || version < 1 {
+		return "", 0, false
+	}
+
+	return base[:dot], version, true
+}
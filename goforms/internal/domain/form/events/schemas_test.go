@@ -0,0 +1,117 @@
+package form_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goformx/goforms/internal/domain/common/events"
+	formevents "github.com/goformx/goforms/internal/domain/form/events"
+	"github.com/goformx/goforms/internal/domain/form/model"
+)
+
+func newSchemaRegistry(t *testing.T) *events.SchemaRegistry {
+	t.Helper()
+
+	registry, err := formevents.NewSchemaRegistry()
+	require.NoError(t, err)
+
+	return registry
+}
+
+func TestSchemas_DescribeThePayloadOfEveryEvent(t *testing.T) {
+	registry := newSchemaRegistry(t)
+	now := time.Now()
+
+	form := &model.Form{ID: "form-1", UserID: "user-1", Title: "Contact", Status: "draft", CreatedAt: now, UpdatedAt: now}
+	submission := &model.FormSubmission{
+		ID: "sub-1", FormID: "form-1", Data: model.JSON{"email": "ada@example.com"},
+		Status: model.SubmissionStatusPending, ReviewStatus: "new", SubmittedAt: now, CreatedAt: now, UpdatedAt: now,
+	}
+	change := formevents.ReviewChange{FormID: "form-1", SubmissionID: "sub-1", From: "new", To: "done", ActorID: "user-1"}
+
+	published := []*formevents.Event{
+		formevents.NewFormCreatedEvent(form),
+		formevents.NewFormUpdatedEvent(form),
+		formevents.NewFormDeletedEvent("form-1"),
+		formevents.NewFormSubmittedEvent(submission),
+		formevents.NewSubmissionReplayedEvent(submission),
+		formevents.NewReviewStatusChangedEvent(change),
+		formevents.NewSubmissionAssignedEvent(change),
+		formevents.NewFormValidatedEvent("form-1", true),
+		formevents.NewFormProcessedEvent("form-1", "sub-1"),
+		formevents.NewFormErrorEvent("form-1", errors.New("processing failed")),
+		formevents.NewFormStateEvent("form-1", "published"),
+		formevents.NewFieldEvent("form-1", "email"),
+		formevents.NewAnalyticsEvent("form-1", "view"),
+	}
+
+	types := make([]string, 0, len(published))
+
+	for _, event := range published {
+		types = append(types, event.Name())
+
+		schema, ok := registry.Latest(event.Name())
+		require.True(t, ok, "no schema for %s", event.Name())
+		assert.Equal(t, "urn:goformx:event-schema:"+event.Name()+":1", schema.URI())
+
+		payload, err := json.Marshal(event.Payload())
+		require.NoError(t, err)
+		require.NoError(t, registry.Validate(event.Name(), schema.Version, payload), event.Name())
+	}
+
+	assert.ElementsMatch(t, types, registry.Types())
+}
+
+func TestSchemaRegistry_Validate(t *testing.T) {
+	registry := newSchemaRegistry(t)
+
+	err := registry.Validate("form.submitted", 1, []byte(`{"id":"sub-1","form_id":"form-1","data":{},"status":"archived"}`))
+
+	var schemaErr *events.SchemaValidationError
+	require.ErrorAs(t, err, &schemaErr)
+	assert.Equal(t, "form.submitted", schemaErr.Type)
+	assert.ErrorContains(t, err, "submitted_at")
+	assert.ErrorContains(t, err, "status")
+
+	require.ErrorIs(t, registry.Validate("form.submitted", 2, []byte(`{}`)), events.ErrSchemaNotFound)
+	require.ErrorIs(t, registry.Register("form.submitted", 1, []byte(`{}`)), events.ErrSchemaExists)
+	require.Error(t, registry.Register("form.archived", 1, []byte(`{"type":`)))
+	require.Error(t, registry.Register("form.archived", 1, []byte(`{"type":"no-such-type"}`)))
+}
+
+func TestSchemaRegistry_ValidatesEveryKeyword(t *testing.T) {
+	registry := events.NewSchemaRegistry()
+	require.NoError(t, registry.Register("form.archived", 1, []byte(`{
+		"$defs": {"id": {"type": "string", "minLength": 1}},
+		"type": "object",
+		"properties": {
+			"form_id": {"$ref": "#/$defs/id"},
+			"reason": {"const": "expired"},
+			"archived_by": {"oneOf": [{"type": "null"}, {"$ref": "#/$defs/id"}]}
+		},
+		"dependentRequired": {"archived_by": ["reason"]}
+	}`)))
+
+	require.NoError(t, registry.Validate("form.archived", 1, []byte(`{"form_id":"form-1","reason":"expired","archived_by":null}`)))
+
+	err := registry.Validate("form.archived", 1, []byte(`{"form_id":"","reason":"moved","archived_by":7}`))
+
+	var schemaErr *events.SchemaValidationError
+	require.ErrorAs(t, err, &schemaErr)
+
+	paths := make([]string, 0, len(schemaErr.Errors))
+	for _, violation := range schemaErr.Errors {
+		paths = append(paths, violation.Path)
+	}
+
+	assert.Subset(t, paths, []string{"/form_id", "/reason", "/archived_by"})
+
+	err = registry.Validate("form.archived", 1, []byte(`{"form_id":"form-1","archived_by":"user-1"}`))
+	require.ErrorAs(t, err, &schemaErr)
+	assert.ErrorContains(t, err, "reason")
+}
@@ -0,0 +1,179 @@
+package form
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+// ErrInvalidListFilter is returned when a listing is requested with an unknown sort,
+// order or status, or with a cursor issued for a different sort
+var ErrInvalidListFilter = errors.New("invalid list filter")
+
+const (
+	// DefaultPageSize is the number of items returned when no limit is specified
+	DefaultPageSize = 25
+	// MaxPageSize caps the number of items returned in a single page
+	MaxPageSize = 100
+)
+
+// Form listing sorts
+const (
+	SortCreated     = "created"
+	SortUpdated     = "updated"
+	SortTitle       = "title"
+	SortSubmissions = "submissions"
+)
+
+// SortSubmitted orders submission listings by submission time
+const SortSubmitted = "submitted"
+
+// AssigneeNone filters a submission listing down to unassigned submissions
+const AssigneeNone = "none"
+
+// formSorts and submissionSorts are the sorts each listing accepts
+var (
+	formSorts       = []string{SortCreated, SortUpdated, SortTitle, SortSubmissions}
+	submissionSorts = []string{SortSubmitted, SortCreated, SortUpdated}
+)
+
+// formStatuses are the states a form can be filtered by
+var formStatuses = []string{"draft", "published", "archived"}
+
+// FormListFilter narrows down and orders a page of forms. Forms are scoped to a
+// workspace when WorkspaceID is set, otherwise to the personal forms of UserID.
+type FormListFilter struct {
+	UserID      string
+	WorkspaceID string
+	Status      string
+	Tag         string
+	// Search matches a case-insensitive substring of the title
+	Search string
+	Sort   string
+	Order  string
+	Limit  int
+	Cursor *common.Cursor
+}
+
+// Normalize applies the default sort, order and page size
+func (f *FormListFilter) Normalize() {
+	f.Tag = strings.ToLower(strings.TrimSpace(f.Tag))
+	f.Search = strings.TrimSpace(f.Search)
+	f.Sort, f.Order, f.Limit = normalizePage(f.Sort, f.Order, f.Limit, SortCreated)
+}
+
+// Validate checks the filter after Normalize
+func (f *FormListFilter) Validate() error {
+	if f.Status != "" && !slices.Contains(formStatuses, f.Status) {
+		return fmt.Errorf("%w: unknown status %q", ErrInvalidListFilter, f.Status)
+	}
+
+	return validatePage(formSorts, f.Sort, f.Order, f.Cursor)
+}
+
+// FormPage is one page of a form listing
+type FormPage struct {
+	Forms []*model.Form
+	// Total counts every form matching the filter, across all pages
+	Total      int64
+	NextCursor string
+	PrevCursor string
+	// Sort, Order and Limit are those the page was listed with after defaults; Limit is 0
+	// when every form was listed
+	Sort  string
+	Order string
+	Limit int
+}
+
+// SubmissionListFilter narrows down and orders a page of a form's submissions
+type SubmissionListFilter struct {
+	FormID string
+	Status model.SubmissionStatus
+	// ReviewStatus matches a status of the form's review workflow
+	ReviewStatus string
+	// AssigneeID matches the assigned member, or unassigned submissions when AssigneeNone
+	AssigneeID string
+	Tag        string
+	Sort       string
+	Order      string
+	Limit      int
+	Cursor     *common.Cursor
+}
+
+// Normalize applies the default sort, order and page size
+func (f *SubmissionListFilter) Normalize() {
+	f.ReviewStatus = strings.TrimSpace(f.ReviewStatus)
+	f.AssigneeID = strings.TrimSpace(f.AssigneeID)
+	f.Tag = strings.ToLower(strings.TrimSpace(f.Tag))
+	f.Sort, f.Order, f.Limit = normalizePage(f.Sort, f.Order, f.Limit, SortSubmitted)
+}
+
+// Validate checks the filter after Normalize
+func (f *SubmissionListFilter) Validate() error {
+	if f.Status != "" && !f.Status.IsValid() {
+		return fmt.Errorf("%w: unknown status %q", ErrInvalidListFilter, f.Status)
+	}
+
+	return validatePage(submissionSorts, f.Sort, f.Order, f.Cursor)
+}
+
+// SubmissionPage is one page of a submission listing
+type SubmissionPage struct {
+	Submissions []*model.FormSubmission
+	// Total counts every submission matching the filter, across all pages
+	Total      int64
+	NextCursor string
+	PrevCursor string
+	// Sort, Order and Limit are those the page was listed with after defaults; Limit is 0
+	// when every submission was listed
+	Sort  string
+	Order string
+	Limit int
+}
+
+// normalizePage defaults to the newest items first; titles default to alphabetical order
+func normalizePage(sort, order string, limit int, defaultSort string) (normalizedSort, normalizedOrder string, pageSize int) {
+	sort = strings.ToLower(strings.TrimSpace(sort))
+	if sort == "" {
+		sort = defaultSort
+	}
+
+	order = strings.ToLower(strings.TrimSpace(order))
+	if order == "" {
+		order = common.OrderDesc
+		if sort == SortTitle {
+			order = common.OrderAsc
+		}
+	}
+
+	if limit <= 0 {
+		limit = DefaultPageSize
+	}
+
+	if limit > MaxPageSize {
+		limit = MaxPageSize
+	}
+
+	return sort, order, limit
+}
+
+// validatePage checks the sort and order, and that the cursor belongs to them
+func validatePage(sorts []string, sort, order string, cursor *common.Cursor) error {
+	if !slices.Contains(sorts, sort) {
+		return fmt.Errorf("%w: unknown sort %q", ErrInvalidListFilter, sort)
+	}
+
+	if order != common.OrderAsc && order != common.OrderDesc {
+		return fmt.Errorf("%w: unknown order %q", ErrInvalidListFilter, order)
+	}
+
+	if cursor != nil && !cursor.Matches(sort, order) {
+		return fmt.Errorf("%w: cursor does not match sort and order", ErrInvalidListFilter)
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"database/sql/driver"
@@ -22,6 +23,10 @@ const (
 	MaxDescriptionLength = 500
 	// MaxFields is the maximum number of fields allowed in a form
 	MaxFields = 50
+	// MaxTags is the maximum number of tags on a form
+	MaxTags = 20
+	// MaxTagLength is the maximum length of a single tag
+	MaxTagLength = 50
 )
 
 var (
@@ -68,11 +73,20 @@ type Form struct {
 	Fields      []Field        `gorm:"foreignKey:FormID"                                          json:"fields"`
 	Status      string         `gorm:"size:20;not null;default:'draft'"                           json:"status"`
 	PlanTier    string         `gorm:"size:20;not null;default:'free'"                            json:"plan_tier"`
+	WorkspaceID string         `gorm:"size:36;not null;default:'';index"                          json:"workspace_id,omitempty"`
 
 	// CORS settings for form embedding
 	CorsOrigins JSON `gorm:"type:json" json:"cors_origins"`
 	CorsMethods JSON `gorm:"type:json" json:"cors_methods"`
 	CorsHeaders JSON `gorm:"type:json" json:"cors_headers"`
+
+	// ReviewWorkflow configures the statuses submissions are triaged through; nil uses the default
+	ReviewWorkflow *ReviewWorkflow `gorm:"type:json" json:"review_workflow,omitempty"`
+
+	// Tags are stored in form_tags; nil leaves a form's tags unchanged on update
+	Tags []string `gorm:"-" json:"tags"`
+	// SubmissionCount is only populated by form listings
+	SubmissionCount int64 `gorm:"->;-:migration" json:"submission_count"`
 }
 
 // GetID returns the form's ID
@@ -156,8 +170,15 @@ func (j *JSON) Scan(value any) error {
 		return nil
 	}
 
-	bytes, ok := value.([]byte)
-	if !ok {
+	var bytes []byte
+
+	// SQLite returns JSON columns as text when they were written as text
+	switch v := value.(type) {
+	case []byte:
+		bytes = v
+	case string:
+		bytes = []byte(v)
+	default:
 		return fmt.Errorf("failed to unmarshal JSON value: %v", value)
 	}
 
@@ -185,18 +206,22 @@ func (j *JSON) Scan(value any) error {
 	return nil
 }
 
-// Value implements the driver.Valuer interface for JSON
-func (j *JSON) Value() (driver.Value, error) {
+// Value implements the driver.Valuer interface for JSON. GORM passes JSON fields to
+// database/sql by value, and a map value does not have the methods of a pointer receiver,
+// so with one the Valuer was never called: pgx encoded the map itself, while the MariaDB
+// and SQLite drivers rejected it. A nil map, such as unset metadata or CORS settings, is
+// stored as NULL rather than as the text "null".
+func (j JSON) Value() (driver.Value, error) {
 	if j == nil {
-		return nil, ErrInvalidJSON
+		return nil, nil
 	}
 
-	data, err := json.Marshal(*j)
+	data, err := json.Marshal(j)
 	if err != nil {
 		return nil, fmt.Errorf("marshal JSON value: %w", err)
 	}
 
-	return data, nil
+	return string(data), nil
 }
 
 // MarshalJSON implements the json.Marshaler interface
@@ -393,6 +418,22 @@ func (f *Form) Validate() error {
 		return fmt.Errorf("form cannot have more than %d fields", MaxFields)
 	}
 
+	if len(f.Tags) > MaxTags {
+		return fmt.Errorf("form cannot have more than %d tags", MaxTags)
+	}
+
+	for _, tag := range f.Tags {
+		if len(tag) > MaxTagLength {
+			return fmt.Errorf("tags must not exceed %d characters", MaxTagLength)
+		}
+	}
+
+	if f.ReviewWorkflow != nil {
+		if err := f.ReviewWorkflow.Validate(); err != nil {
+			return fmt.Errorf("invalid review workflow: %w", err)
+		}
+	}
+
 	for i := range f.Fields {
 		if err := f.Fields[i].Validate(); err != nil {
 			return fmt.Errorf("invalid field: %w", err)
@@ -414,6 +455,24 @@ func (f *Form) Update(title, description string, schema JSON) {
 	f.UpdatedAt = time.Now()
 }
 
+// NormalizeTags lowercases and trims tags, dropping empty and duplicate ones
+func NormalizeTags(tags []string) []string {
+	normalized := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || seen[tag] {
+			continue
+		}
+
+		seen[tag] = true
+		normalized = append(normalized, tag)
+	}
+
+	return normalized
+}
+
 // Deactivate marks the form as inactive
 func (f *Form) Deactivate() {
 	f.Active = false
@@ -21,6 +21,13 @@ type FormSubmission struct {
 	Metadata    JSON             `gorm:"type:jsonb"                                                 json:"metadata"`
 	CreatedAt   time.Time        `gorm:"not null;autoCreateTime"                                    json:"created_at"`
 	UpdatedAt   time.Time        `gorm:"not null;autoUpdateTime"                                    json:"updated_at"`
+
+	// ReviewStatus is the submission's step in its form's review workflow
+	ReviewStatus string `gorm:"size:50;not null;default:''"  json:"review_status"`
+	// AssigneeID is the user triaging the submission; empty when unassigned
+	AssigneeID string `gorm:"size:255;not null;default:''" json:"assignee_id,omitempty"`
+	// Tags are stored in submission_tags and only loaded by review reads and listings
+	Tags []string `gorm:"-" json:"tags,omitempty"`
 }
 
 // GetID returns the submission's ID
@@ -45,8 +52,21 @@ const (
 	SubmissionStatusCompleted SubmissionStatus = "completed"
 	// SubmissionStatusFailed indicates the submission processing failed
 	SubmissionStatusFailed SubmissionStatus = "failed"
+	// SubmissionStatusSpam indicates the submission was marked as spam
+	SubmissionStatusSpam SubmissionStatus = "spam"
 )
 
+// IsValid reports whether the status is known
+func (s SubmissionStatus) IsValid() bool {
+	switch s {
+	case SubmissionStatusPending, SubmissionStatusProcessing, SubmissionStatusCompleted,
+		SubmissionStatusFailed, SubmissionStatusSpam:
+		return true
+	default:
+		return false
+	}
+}
+
 // BeforeCreate is a GORM hook that generates a UUID before inserting a new submission
 func (fs *FormSubmission) BeforeCreate(_ *gorm.DB) error {
 	if fs.ID == "" {
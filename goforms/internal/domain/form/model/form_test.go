@@ -1,8 +1,10 @@
 package model_test
 
 import (
+	"database/sql/driver"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	"github.com/goformx/goforms/internal/domain/form/model"
@@ -185,3 +187,23 @@ func TestForm_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestJSON_Value(t *testing.T) {
+	// database/sql receives the map itself, so the value must carry the Valuer
+	var valuer driver.Valuer = model.JSON{"type": "object"}
+
+	value, err := valuer.Value()
+	require.NoError(t, err)
+
+	text, ok := value.(string)
+	require.True(t, ok, "JSON is stored as text")
+	assert.JSONEq(t, `{"type":"object"}`, text)
+
+	value, err = model.JSON(nil).Value()
+	require.NoError(t, err)
+	assert.Nil(t, value, "a nil map is stored as NULL")
+
+	var scanned model.JSON
+	require.NoError(t, scanned.Scan(`{"type":"object"}`))
+	assert.Equal(t, model.JSON{"type": "object"}, scanned)
+}
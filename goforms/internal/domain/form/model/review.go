@@ -0,0 +1,167 @@
+package model
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"time"
+)
+
+const (
+	// MaxReviewStatuses is the maximum number of statuses in a review workflow
+	MaxReviewStatuses = 20
+	// MaxReviewLabelLength is the maximum length of a review status label
+	MaxReviewLabelLength = 50
+)
+
+// Default review workflow statuses
+const (
+	ReviewStatusNew      = "new"
+	ReviewStatusInReview = "in_review"
+	ReviewStatusApproved = "approved"
+	ReviewStatusRejected = "rejected"
+)
+
+// reviewStatusKeyPattern restricts status keys to short lowercase identifiers
+var reviewStatusKeyPattern = regexp.MustCompile(`^[a-z][a-z0-9_]{0,49}$`)
+
+// ReviewStatus is one step of a form's review workflow
+type ReviewStatus struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+}
+
+// ReviewWorkflow is the set of statuses owners move submissions through while triaging them.
+// It is independent of the processing status of a submission.
+type ReviewWorkflow struct {
+	Statuses []ReviewStatus `json:"statuses"`
+	// Initial is the status given to new submissions
+	Initial string `json:"initial"`
+	// Transitions lists the statuses reachable from each status; when empty any move is allowed
+	Transitions map[string][]string `json:"transitions,omitempty"`
+}
+
+// DefaultReviewWorkflow returns the workflow used by forms that have not configured one
+func DefaultReviewWorkflow() ReviewWorkflow {
+	return ReviewWorkflow{
+		Statuses: []ReviewStatus{
+			{Key: ReviewStatusNew, Label: "New"},
+			{Key: ReviewStatusInReview, Label: "In review"},
+			{Key: ReviewStatusApproved, Label: "Approved"},
+			{Key: ReviewStatusRejected, Label: "Rejected"},
+		},
+		Initial: ReviewStatusNew,
+	}
+}
+
+// Has reports whether the workflow defines the status
+func (w ReviewWorkflow) Has(key string) bool {
+	return slices.ContainsFunc(w.Statuses, func(s ReviewStatus) bool { return s.Key == key })
+}
+
+// CanTransition reports whether a submission may move between two statuses. Submissions whose
+// current status is no longer part of the workflow may move to any status.
+func (w ReviewWorkflow) CanTransition(from, to string) bool {
+	if !w.Has(to) {
+		return false
+	}
+
+	if len(w.Transitions) == 0 || from == to || !w.Has(from) {
+		return true
+	}
+
+	return slices.Contains(w.Transitions[from], to)
+}
+
+// Validate checks status keys and labels, the initial status and every transition
+func (w ReviewWorkflow) Validate() error {
+	if len(w.Statuses) == 0 {
+		return errors.New("review workflow needs at least one status")
+	}
+
+	if len(w.Statuses) > MaxReviewStatuses {
+		return fmt.Errorf("review workflow cannot have more than %d statuses", MaxReviewStatuses)
+	}
+
+	seen := make(map[string]bool, len(w.Statuses))
+
+	for _, status := range w.Statuses {
+		if !reviewStatusKeyPattern.MatchString(status.Key) {
+			return fmt.Errorf("invalid review status key %q", status.Key)
+		}
+
+		if seen[status.Key] {
+			return fmt.Errorf("duplicate review status %q", status.Key)
+		}
+
+		if status.Label == "" || len(status.Label) > MaxReviewLabelLength {
+			return fmt.Errorf("review status %q needs a label of at most %d characters", status.Key, MaxReviewLabelLength)
+		}
+
+		seen[status.Key] = true
+	}
+
+	if !seen[w.Initial] {
+		return fmt.Errorf("initial review status %q is not in the workflow", w.Initial)
+	}
+
+	for from, targets := range w.Transitions {
+		if !seen[from] {
+			return fmt.Errorf("review transition from unknown status %q", from)
+		}
+
+		for _, to := range targets {
+			if !seen[to] {
+				return fmt.Errorf("review transition to unknown status %q", to)
+			}
+		}
+	}
+
+	return nil
+}
+
+// Value implements the driver.Valuer interface
+func (w ReviewWorkflow) Value() (driver.Value, error) {
+	b, err := json.Marshal(w)
+	if err != nil {
+		return nil, fmt.Errorf("marshal review workflow: %w", err)
+	}
+
+	return string(b), nil
+}
+
+// Scan implements the sql.Scanner interface
+func (w *ReviewWorkflow) Scan(value any) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, w)
+	case string:
+		return json.Unmarshal([]byte(v), w)
+	default:
+		return errors.New("type assertion to []byte or string failed")
+	}
+}
+
+// Workflow returns the form's review workflow, or the default one when none is configured
+func (f *Form) Workflow() ReviewWorkflow {
+	if f.ReviewWorkflow == nil {
+		return DefaultReviewWorkflow()
+	}
+
+	return *f.ReviewWorkflow
+}
+
+// SubmissionTag is a row of submission_tags; tags are stored lowercased and unique per submission
+type SubmissionTag struct {
+	SubmissionID string    `gorm:"column:submission_id;primaryKey"`
+	Tag          string    `gorm:"column:tag;primaryKey"`
+	CreatedAt    time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName specifies the table name for submission tags
+func (SubmissionTag) TableName() string {
+	return "submission_tags"
+}
@@ -0,0 +1,91 @@
+package model_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goformx/goforms/internal/domain/form/model"
+)
+
+func TestReviewWorkflow_Validate(t *testing.T) {
+	statuses := []model.ReviewStatus{{Key: "new", Label: "New"}, {Key: "done", Label: "Done"}}
+
+	tests := []struct {
+		name        string
+		workflow    model.ReviewWorkflow
+		errContains string
+	}{
+		{name: "default workflow", workflow: model.DefaultReviewWorkflow()},
+		{
+			name:     "custom workflow with transitions",
+			workflow: model.ReviewWorkflow{Statuses: statuses, Initial: "new", Transitions: map[string][]string{"new": {"done"}}},
+		},
+		{name: "no statuses", workflow: model.ReviewWorkflow{Initial: "new"}, errContains: "at least one status"},
+		{
+			name:        "invalid key",
+			workflow:    model.ReviewWorkflow{Statuses: []model.ReviewStatus{{Key: "In Review", Label: "x"}}, Initial: "In Review"},
+			errContains: "invalid review status key",
+		},
+		{
+			name:        "duplicate key",
+			workflow:    model.ReviewWorkflow{Statuses: append(statuses, statuses[0]), Initial: "new"},
+			errContains: "duplicate review status",
+		},
+		{
+			name:        "missing label",
+			workflow:    model.ReviewWorkflow{Statuses: []model.ReviewStatus{{Key: "new"}}, Initial: "new"},
+			errContains: "needs a label",
+		},
+		{
+			name:        "unknown initial status",
+			workflow:    model.ReviewWorkflow{Statuses: statuses, Initial: "open"},
+			errContains: "initial review status",
+		},
+		{
+			name:        "transition to unknown status",
+			workflow:    model.ReviewWorkflow{Statuses: statuses, Initial: "new", Transitions: map[string][]string{"new": {"open"}}},
+			errContains: "to unknown status",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.workflow.Validate()
+			if tt.errContains == "" {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.errContains)
+		})
+	}
+}
+
+func TestReviewWorkflow_CanTransition(t *testing.T) {
+	workflow := model.DefaultReviewWorkflow()
+	workflow.Transitions = map[string][]string{
+		model.ReviewStatusNew:      {model.ReviewStatusInReview},
+		model.ReviewStatusInReview: {model.ReviewStatusApproved, model.ReviewStatusRejected},
+	}
+
+	assert.True(t, workflow.CanTransition(model.ReviewStatusNew, model.ReviewStatusInReview))
+	assert.False(t, workflow.CanTransition(model.ReviewStatusNew, model.ReviewStatusApproved))
+	assert.False(t, workflow.CanTransition(model.ReviewStatusApproved, model.ReviewStatusNew))
+	assert.True(t, workflow.CanTransition("legacy", model.ReviewStatusApproved), "statuses dropped from the workflow may move anywhere")
+	assert.False(t, workflow.CanTransition(model.ReviewStatusNew, "archived"))
+
+	assert.True(t, model.DefaultReviewWorkflow().CanTransition(model.ReviewStatusApproved, model.ReviewStatusNew))
+}
+
+func TestForm_Workflow_DefaultsWhenUnset(t *testing.T) {
+	form := &model.Form{}
+	assert.Equal(t, model.DefaultReviewWorkflow(), form.Workflow())
+
+	custom := model.ReviewWorkflow{Statuses: []model.ReviewStatus{{Key: "open", Label: "Open"}}, Initial: "open"}
+	form.ReviewWorkflow = &custom
+	assert.Equal(t, "open", form.Workflow().Initial)
+}
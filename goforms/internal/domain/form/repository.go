@@ -19,6 +19,9 @@ type Repository interface {
 	CreateForm(ctx context.Context, form *model.Form) error
 	GetFormByID(ctx context.Context, id string) (*model.Form, error)
 	ListForms(ctx context.Context, userID string) ([]*model.Form, error)
+	ListFormsByWorkspace(ctx context.Context, workspaceID string) ([]*model.Form, error)
+	// ListFormsPage returns one page of the forms matching a normalized filter
+	ListFormsPage(ctx context.Context, filter FormListFilter) (*FormPage, error)
 	UpdateForm(ctx context.Context, form *model.Form) error
 	DeleteForm(ctx context.Context, id string) error
 	GetFormsByStatus(ctx context.Context, status string) ([]*model.Form, error)
@@ -27,6 +30,8 @@ type Repository interface {
 	CreateSubmission(ctx context.Context, submission *model.FormSubmission) error
 	GetSubmissionByID(ctx context.Context, id string) (*model.FormSubmission, error)
 	ListSubmissions(ctx context.Context, formID string) ([]*model.FormSubmission, error)
+	// ListSubmissionsPage returns one page of the submissions matching a normalized filter
+	ListSubmissionsPage(ctx context.Context, filter SubmissionListFilter) (*SubmissionPage, error)
 	UpdateSubmission(ctx context.Context, submission *model.FormSubmission) error
 	DeleteSubmission(ctx context.Context, id string) error
 	GetByFormID(ctx context.Context, formID string) ([]*model.FormSubmission, error)
@@ -37,8 +42,14 @@ type Repository interface {
 	) (*common.PaginationResult, error)
 	GetByFormAndUser(ctx context.Context, formID, userID string) (*model.FormSubmission, error)
 	GetSubmissionsByStatus(ctx context.Context, status model.SubmissionStatus) ([]*model.FormSubmission, error)
+	// CountPurgeableSubmissions counts the submissions a purge with the filter would delete
+	CountPurgeableSubmissions(ctx context.Context, filter SubmissionPurgeFilter) (int64, error)
+	// PurgeSubmissions deletes the submissions matching the filter in batches and returns how many it deleted
+	PurgeSubmissions(ctx context.Context, filter SubmissionPurgeFilter) (int64, error)
 
 	// Count operations for plan limit enforcement
 	CountFormsByUser(ctx context.Context, userID string) (int, error)
 	CountSubmissionsByUserMonth(ctx context.Context, userID string, year int, month int) (int, error)
+	CountFormsByWorkspace(ctx context.Context, workspaceID string) (int, error)
+	CountSubmissionsByWorkspaceMonth(ctx context.Context, workspaceID string, year int, month int) (int, error)
 }
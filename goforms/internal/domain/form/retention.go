@@ -0,0 +1,38 @@
+package form
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/goformx/goforms/internal/domain/form/model"
+)
+
+// PurgeBatchSize is the number of submissions deleted per statement by a purge
+const PurgeBatchSize = 500
+
+// ErrInvalidPurgeFilter is returned when a purge is requested without a cutoff or with an unknown status
+var ErrInvalidPurgeFilter = errors.New("invalid purge filter")
+
+// SubmissionPurgeFilter selects the submissions removed by a retention purge
+type SubmissionPurgeFilter struct {
+	// SubmittedBefore is required; submissions made at or after it are kept
+	SubmittedBefore time.Time
+	// FormID limits the purge to one form
+	FormID string
+	// Status limits the purge to submissions in one processing status
+	Status model.SubmissionStatus
+}
+
+// Validate checks the filter selects a bounded set of submissions
+func (f SubmissionPurgeFilter) Validate() error {
+	if f.SubmittedBefore.IsZero() {
+		return fmt.Errorf("%w: a cutoff time is required", ErrInvalidPurgeFilter)
+	}
+
+	if f.Status != "" && !f.Status.IsValid() {
+		return fmt.Errorf("%w: unknown status %q", ErrInvalidPurgeFilter, f.Status)
+	}
+
+	return nil
+}
@@ -32,13 +32,18 @@ type Service interface {
 	DeleteForm(ctx context.Context, formID string) error
 	GetForm(ctx context.Context, formID string) (*model.Form, error)
 	ListForms(ctx context.Context, userID string) ([]*model.Form, error)
+	ListWorkspaceForms(ctx context.Context, workspaceID string) ([]*model.Form, error)
+	ListFormsPage(ctx context.Context, filter FormListFilter) (*FormPage, error)
 	SubmitForm(ctx context.Context, submission *model.FormSubmission) error
 	GetFormSubmission(ctx context.Context, submissionID string) (*model.FormSubmission, error)
 	ListFormSubmissions(ctx context.Context, formID string) ([]*model.FormSubmission, error)
+	ListSubmissionsPage(ctx context.Context, filter SubmissionListFilter) (*SubmissionPage, error)
 	UpdateFormState(ctx context.Context, formID, state string) error
 	TrackFormAnalytics(ctx context.Context, formID, eventType string) error
 	CountFormsByUser(ctx context.Context, userID string) (int, error)
 	CountSubmissionsByUserMonth(ctx context.Context, userID string, year int, month int) (int, error)
+	CountFormsByWorkspace(ctx context.Context, workspaceID string) (int, error)
+	CountSubmissionsByWorkspaceMonth(ctx context.Context, workspaceID string, year int, month int) (int, error)
 }
 
 // formService handles form-related business logic
@@ -59,12 +64,14 @@ func NewService(repository Repository, eventBus events.EventBus, logger logging.
 
 // CreateForm creates a new form after enforcing plan limits.
 func (s *formService) CreateForm(ctx context.Context, form *model.Form, planTier string) error {
+	normalizeTags(form)
+
 	if err := form.Validate(); err != nil {
 		return fmt.Errorf("form validation failed: %w", err)
 	}
 
 	// Enforce plan limits
-	if err := s.enforcePlanLimits(ctx, form.UserID, planTier); err != nil {
+	if err := s.enforcePlanLimits(ctx, form, planTier); err != nil {
 		return err
 	}
 
@@ -93,8 +100,9 @@ func (s *formService) CreateForm(ctx context.Context, form *model.Form, planTier
 	return nil
 }
 
-// enforcePlanLimits checks whether the user has exceeded their plan's form limit.
-func (s *formService) enforcePlanLimits(ctx context.Context, userID, planTier string) error {
+// enforcePlanLimits checks whether the form's owner has exceeded their plan's form limit.
+// Forms in a workspace count against the workspace; personal forms count against the user.
+func (s *formService) enforcePlanLimits(ctx context.Context, form *model.Form, planTier string) error {
 	limits, err := plans.GetLimits(planTier)
 	if err != nil {
 		return fmt.Errorf("get plan limits: %w", err)
@@ -104,9 +112,15 @@ func (s *formService) enforcePlanLimits(ctx context.Context, userID, planTier st
 		return nil
 	}
 
-	count, err := s.repository.CountFormsByUser(ctx, userID)
+	var count int
+	if form.WorkspaceID != "" {
+		count, err = s.repository.CountFormsByWorkspace(ctx, form.WorkspaceID)
+	} else {
+		count, err = s.repository.CountFormsByUser(ctx, form.UserID)
+	}
+
 	if err != nil {
-		return fmt.Errorf("count user forms: %w", err)
+		return fmt.Errorf("count forms: %w", err)
 	}
 
 	if count >= limits.MaxForms {
@@ -120,6 +134,8 @@ func (s *formService) enforcePlanLimits(ctx context.Context, userID, planTier st
 
 // UpdateForm updates a form, enforcing feature gating on schema changes.
 func (s *formService) UpdateForm(ctx context.Context, form *model.Form, planTier string) error {
+	normalizeTags(form)
+
 	if validateErr := form.Validate(); validateErr != nil {
 		return fmt.Errorf("validate form: %w", validateErr)
 	}
@@ -145,6 +161,13 @@ func (s *formService) UpdateForm(ctx context.Context, form *model.Form, planTier
 	return nil
 }
 
+// normalizeTags cleans up tags being set; nil tags are left alone so updates keep the stored ones
+func normalizeTags(form *model.Form) {
+	if form.Tags != nil {
+		form.Tags = model.NormalizeTags(form.Tags)
+	}
+}
+
 // DeleteForm deletes a form
 func (s *formService) DeleteForm(ctx context.Context, formID string) error {
 	if formID == "" {
@@ -182,6 +205,36 @@ func (s *formService) ListForms(ctx context.Context, userID string) ([]*model.Fo
 	return forms, nil
 }
 
+// ListWorkspaceForms retrieves the forms owned by a workspace
+func (s *formService) ListWorkspaceForms(ctx context.Context, workspaceID string) ([]*model.Form, error) {
+	forms, err := s.repository.ListFormsByWorkspace(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list workspace forms: %w", err)
+	}
+
+	return forms, nil
+}
+
+// ListFormsPage retrieves one page of forms, sorted and filtered. A filter without a limit
+// returns the first DefaultPageSize items and a cursor to the rest. Invalid filters are rejected
+// with ErrInvalidListFilter.
+func (s *formService) ListFormsPage(ctx context.Context, filter FormListFilter) (*FormPage, error) {
+	filter.Normalize()
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	page, err := s.repository.ListFormsPage(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("list forms page: %w", err)
+	}
+
+	page.Sort, page.Order, page.Limit = filter.Sort, filter.Order, filter.Limit
+
+	return page, nil
+}
+
 // SubmitForm submits a form
 func (s *formService) SubmitForm(ctx context.Context, submission *model.FormSubmission) error {
 	// Validate submission BEFORE any database operations
@@ -199,6 +252,10 @@ func (s *formService) SubmitForm(ctx context.Context, submission *model.FormSubm
 		return errors.New("form not found")
 	}
 
+	if submission.ReviewStatus == "" {
+		submission.ReviewStatus = form.Workflow().Initial
+	}
+
 	// Create the submission (validation already passed above)
 	if createErr := s.repository.CreateSubmission(ctx, submission); createErr != nil {
 		return fmt.Errorf("create form submission: %w", createErr)
@@ -248,6 +305,26 @@ func (s *formService) ListFormSubmissions(ctx context.Context, formID string) ([
 	return submissions, nil
 }
 
+// ListSubmissionsPage retrieves one page of a form's submissions, sorted and filtered. A filter without a limit
+// returns the first DefaultPageSize items and a cursor to the rest. Invalid filters are rejected
+// with ErrInvalidListFilter.
+func (s *formService) ListSubmissionsPage(ctx context.Context, filter SubmissionListFilter) (*SubmissionPage, error) {
+	filter.Normalize()
+
+	if err := filter.Validate(); err != nil {
+		return nil, err
+	}
+
+	page, err := s.repository.ListSubmissionsPage(ctx, filter)
+	if err != nil {
+		return nil, fmt.Errorf("list submissions page: %w", err)
+	}
+
+	page.Sort, page.Order, page.Limit = filter.Sort, filter.Order, filter.Limit
+
+	return page, nil
+}
+
 // UpdateFormState updates the state of a form
 func (s *formService) UpdateFormState(ctx context.Context, formID, state string) error {
 	form, getErr := s.repository.GetFormByID(ctx, formID)
@@ -278,7 +355,7 @@ func (s *formService) TrackFormAnalytics(ctx context.Context, formID, eventType
 	return nil
 }
 
-// CountFormsByUser returns the number of forms owned by a user.
+// CountFormsByUser returns the number of personal forms of a user.
 func (s *formService) CountFormsByUser(ctx context.Context, userID string) (int, error) {
 	count, err := s.repository.CountFormsByUser(ctx, userID)
 	if err != nil {
@@ -288,7 +365,7 @@ func (s *formService) CountFormsByUser(ctx context.Context, userID string) (int,
 	return count, nil
 }
 
-// CountSubmissionsByUserMonth returns the number of submissions for a user in a given month.
+// CountSubmissionsByUserMonth returns the number of submissions to a user's personal forms in a given month.
 func (s *formService) CountSubmissionsByUserMonth(
 	ctx context.Context,
 	userID string,
@@ -302,3 +379,28 @@ func (s *formService) CountSubmissionsByUserMonth(
 
 	return count, nil
 }
+
+// CountFormsByWorkspace returns the number of forms owned by a workspace.
+func (s *formService) CountFormsByWorkspace(ctx context.Context, workspaceID string) (int, error) {
+	count, err := s.repository.CountFormsByWorkspace(ctx, workspaceID)
+	if err != nil {
+		return 0, fmt.Errorf("count forms by workspace: %w", err)
+	}
+
+	return count, nil
+}
+
+// CountSubmissionsByWorkspaceMonth returns the number of submissions for a workspace in a given month.
+func (s *formService) CountSubmissionsByWorkspaceMonth(
+	ctx context.Context,
+	workspaceID string,
+	year int,
+	month int,
+) (int, error) {
+	count, err := s.repository.CountSubmissionsByWorkspaceMonth(ctx, workspaceID, year, month)
+	if err != nil {
+		return 0, fmt.Errorf("count submissions by workspace month: %w", err)
+	}
+
+	return count, nil
+}
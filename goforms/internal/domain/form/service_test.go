@@ -15,6 +15,7 @@ import (
 	"github.com/goformx/goforms/internal/domain/common/plans"
 	domainform "github.com/goformx/goforms/internal/domain/form"
 	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
 	mockevents "github.com/goformx/goforms/test/mocks/events"
 	mockform "github.com/goformx/goforms/test/mocks/form"
 	mocklogging "github.com/goformx/goforms/test/mocks/logging"
@@ -234,6 +235,111 @@ func TestService_ListForms(t *testing.T) {
 	})
 }
 
+func TestService_ListFormsPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := mockform.NewMockRepository(ctrl)
+	eventBus := mockevents.NewMockEventBus(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	svc := domainform.NewService(repo, eventBus, logger)
+
+	t.Run("applies defaults", func(t *testing.T) {
+		cursor := &common.Cursor{Sort: domainform.SortCreated, Order: "desc", Value: "2026-01-01T00:00:00Z", ID: "form0"}
+		page := &domainform.FormPage{Forms: []*model.Form{{ID: "form1"}}, Total: 1}
+
+		repo.EXPECT().ListFormsPage(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, filter domainform.FormListFilter) (*domainform.FormPage, error) {
+				assert.Equal(t, domainform.SortCreated, filter.Sort)
+				assert.Equal(t, "desc", filter.Order)
+				assert.Equal(t, domainform.DefaultPageSize, filter.Limit)
+				assert.Equal(t, "billing", filter.Tag)
+
+				return page, nil
+			})
+
+		got, err := svc.ListFormsPage(t.Context(), domainform.FormListFilter{UserID: "user123", Tag: " Billing ", Cursor: cursor})
+		require.NoError(t, err)
+		assert.Same(t, page, got)
+		assert.Equal(t, domainform.SortCreated, got.Sort)
+		assert.Equal(t, "desc", got.Order)
+		assert.Equal(t, domainform.DefaultPageSize, got.Limit)
+	})
+
+	t.Run("returns one bounded page without a limit or cursor", func(t *testing.T) {
+		next := common.Cursor{Sort: domainform.SortCreated, Order: "desc", Value: "2026-01-01T00:00:00Z", ID: "form1"}
+
+		repo.EXPECT().ListFormsPage(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, filter domainform.FormListFilter) (*domainform.FormPage, error) {
+				assert.Equal(t, domainform.DefaultPageSize, filter.Limit)
+				assert.Nil(t, filter.Cursor)
+
+				return &domainform.FormPage{Forms: []*model.Form{{ID: "form1"}}, Total: 2, NextCursor: next.Encode()}, nil
+			})
+
+		got, err := svc.ListFormsPage(t.Context(), domainform.FormListFilter{UserID: "user123"})
+		require.NoError(t, err)
+		require.Len(t, got.Forms, 1)
+		assert.Equal(t, next.Encode(), got.NextCursor)
+		assert.Equal(t, domainform.DefaultPageSize, got.Limit)
+	})
+
+	t.Run("title sorts alphabetically and limits are capped", func(t *testing.T) {
+		repo.EXPECT().ListFormsPage(gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, filter domainform.FormListFilter) (*domainform.FormPage, error) {
+				assert.Equal(t, "asc", filter.Order)
+				assert.Equal(t, domainform.MaxPageSize, filter.Limit)
+
+				return &domainform.FormPage{}, nil
+			})
+
+		_, err := svc.ListFormsPage(t.Context(), domainform.FormListFilter{Sort: "Title", Limit: 1000})
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects invalid filters", func(t *testing.T) {
+		cursor := &common.Cursor{Sort: domainform.SortTitle, Order: "asc", Value: "a", ID: "form1"}
+
+		for name, filter := range map[string]domainform.FormListFilter{
+			"sort":   {Sort: "owner"},
+			"order":  {Order: "sideways"},
+			"status": {Status: "deleted"},
+			"cursor": {Sort: domainform.SortCreated, Cursor: cursor},
+		} {
+			_, err := svc.ListFormsPage(t.Context(), filter)
+			require.ErrorIs(t, err, domainform.ErrInvalidListFilter, name)
+		}
+	})
+}
+
+func TestService_ListSubmissionsPage(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	repo := mockform.NewMockRepository(ctrl)
+	eventBus := mockevents.NewMockEventBus(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	svc := domainform.NewService(repo, eventBus, logger)
+
+	repo.EXPECT().ListSubmissionsPage(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, filter domainform.SubmissionListFilter) (*domainform.SubmissionPage, error) {
+			assert.Equal(t, domainform.SortSubmitted, filter.Sort)
+			assert.Equal(t, "desc", filter.Order)
+			assert.Equal(t, domainform.DefaultPageSize, filter.Limit)
+
+			return nil, errors.New("database error")
+		})
+
+	_, err := svc.ListSubmissionsPage(t.Context(), domainform.SubmissionListFilter{FormID: "form1"})
+	require.ErrorContains(t, err, "list submissions page")
+
+	_, err = svc.ListSubmissionsPage(t.Context(), domainform.SubmissionListFilter{FormID: "form1", Sort: domainform.SortTitle})
+	require.ErrorIs(t, err, domainform.ErrInvalidListFilter)
+
+	_, err = svc.ListSubmissionsPage(t.Context(), domainform.SubmissionListFilter{FormID: "form1", Status: "archived"})
+	require.ErrorIs(t, err, domainform.ErrInvalidListFilter)
+}
+
 func TestService_UpdateForm(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	t.Cleanup(ctrl.Finish)
@@ -10,18 +10,29 @@
 package domain
 
 import (
+	"context"
 	"errors"
 
 	"go.uber.org/fx"
 
+	"github.com/goformx/goforms/internal/domain/apikey"
+	"github.com/goformx/goforms/internal/domain/audit"
+	"github.com/goformx/goforms/internal/domain/bulk"
 	"github.com/goformx/goforms/internal/domain/common/events"
 	"github.com/goformx/goforms/internal/domain/form"
+	"github.com/goformx/goforms/internal/domain/review"
 	"github.com/goformx/goforms/internal/domain/user"
+	"github.com/goformx/goforms/internal/domain/workspace"
 	"github.com/goformx/goforms/internal/infrastructure/database"
 	"github.com/goformx/goforms/internal/infrastructure/logging"
+	apikeystore "github.com/goformx/goforms/internal/infrastructure/repository/apikey"
+	auditstore "github.com/goformx/goforms/internal/infrastructure/repository/audit"
+	bulkstore "github.com/goformx/goforms/internal/infrastructure/repository/bulk"
 	formstore "github.com/goformx/goforms/internal/infrastructure/repository/form"
 	formsubmissionstore "github.com/goformx/goforms/internal/infrastructure/repository/form/submission"
+	reviewstore "github.com/goformx/goforms/internal/infrastructure/repository/review"
 	userstore "github.com/goformx/goforms/internal/infrastructure/repository/user"
+	workspacestore "github.com/goformx/goforms/internal/infrastructure/repository/workspace"
 )
 
 // UserServiceParams contains dependencies for creating a user service
@@ -71,6 +82,138 @@ func NewFormService(p FormServiceParams) (form.Service, error) {
 	return form.NewService(p.Repository, p.EventBus, p.Logger), nil
 }
 
+// AuditServiceParams contains dependencies for creating an audit service
+type AuditServiceParams struct {
+	fx.In
+
+	Repository audit.Repository
+	Logger     logging.Logger
+}
+
+// NewAuditService creates a new audit service with dependencies
+func NewAuditService(p AuditServiceParams) (audit.Service, error) {
+	if p.Repository == nil {
+		return nil, errors.New("audit repository is required")
+	}
+
+	if p.Logger == nil {
+		return nil, errors.New("logger is required")
+	}
+
+	return audit.NewService(p.Repository, p.Logger), nil
+}
+
+// WorkspaceServiceParams contains dependencies for creating a workspace service
+type WorkspaceServiceParams struct {
+	fx.In
+
+	Repository workspace.Repository
+	Logger     logging.Logger
+}
+
+// NewWorkspaceService creates a new workspace service with dependencies
+func NewWorkspaceService(p WorkspaceServiceParams) (workspace.Service, error) {
+	if p.Repository == nil {
+		return nil, errors.New("workspace repository is required")
+	}
+
+	if p.Logger == nil {
+		return nil, errors.New("logger is required")
+	}
+
+	return workspace.NewService(p.Repository, p.Logger), nil
+}
+
+// APIKeyServiceParams contains dependencies for creating an API key service
+type APIKeyServiceParams struct {
+	fx.In
+
+	Repository apikey.Repository
+	Logger     logging.Logger
+}
+
+// NewAPIKeyService creates a new API key service with dependencies
+func NewAPIKeyService(p APIKeyServiceParams) (apikey.Service, error) {
+	if p.Repository == nil {
+		return nil, errors.New("api key repository is required")
+	}
+
+	if p.Logger == nil {
+		return nil, errors.New("logger is required")
+	}
+
+	return apikey.NewService(p.Repository, p.Logger), nil
+}
+
+// BulkServiceParams contains dependencies for creating a bulk submission service
+type BulkServiceParams struct {
+	fx.In
+
+	Lifecycle  fx.Lifecycle
+	Repository bulk.Repository
+	EventBus   events.EventBus
+	Logger     logging.Logger
+}
+
+// NewBulkService creates a new bulk submission service that fails the jobs abandoned by an earlier
+// process when the application starts, and interrupts and checkpoints its background jobs when it stops
+func NewBulkService(p BulkServiceParams) (bulk.Service, error) {
+	if p.Repository == nil {
+		return nil, errors.New("bulk job repository is required")
+	}
+
+	if p.EventBus == nil {
+		return nil, errors.New("event bus is required")
+	}
+
+	if p.Logger == nil {
+		return nil, errors.New("logger is required")
+	}
+
+	service := bulk.NewService(p.Repository, p.EventBus, p.Logger)
+
+	p.Lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			if err := service.Recover(ctx); err != nil {
+				p.Logger.Error("failed to recover interrupted bulk jobs", "error", err)
+			}
+
+			return nil
+		},
+		OnStop: func(ctx context.Context) error {
+			return service.Shutdown(ctx)
+		},
+	})
+
+	return service, nil
+}
+
+// ReviewServiceParams contains dependencies for creating a submission review service
+type ReviewServiceParams struct {
+	fx.In
+
+	Repository review.Repository
+	EventBus   events.EventBus
+	Logger     logging.Logger
+}
+
+// NewReviewService creates a new submission review service
+func NewReviewService(p ReviewServiceParams) (review.Service, error) {
+	if p.Repository == nil {
+		return nil, errors.New("review repository is required")
+	}
+
+	if p.EventBus == nil {
+		return nil, errors.New("event bus is required")
+	}
+
+	if p.Logger == nil {
+		return nil, errors.New("logger is required")
+	}
+
+	return review.NewService(p.Repository, p.EventBus, p.Logger), nil
+}
+
 // StoreParams groups store dependencies
 type StoreParams struct {
 	fx.In
@@ -84,6 +227,11 @@ type Stores struct {
 	UserRepository           user.Repository
 	FormRepository           form.Repository
 	FormSubmissionRepository form.SubmissionRepository
+	AuditRepository          audit.Repository
+	WorkspaceRepository      workspace.Repository
+	APIKeyRepository         apikey.Repository
+	BulkJobRepository        bulk.Repository
+	ReviewRepository         review.Repository
 }
 
 // NewStores creates new store instances with proper validation and error handling
@@ -100,12 +248,18 @@ func NewStores(p StoreParams) (Stores, error) {
 	userRepo := userstore.NewStore(p.DB, p.Logger)
 	formRepo := formstore.NewStore(p.DB, p.Logger)
 	formSubmissionRepo := formsubmissionstore.NewStore(p.DB, p.Logger)
+	auditRepo := auditstore.NewStore(p.DB, p.Logger)
+	workspaceRepo := workspacestore.NewStore(p.DB, p.Logger)
+	apiKeyRepo := apikeystore.NewStore(p.DB, p.Logger)
+	bulkJobRepo := bulkstore.NewStore(p.DB, p.Logger)
+	reviewRepo := reviewstore.NewStore(p.DB, p.Logger)
 
 	// Validate repository instances
-	if userRepo == nil || formRepo == nil || formSubmissionRepo == nil {
+	if userRepo == nil || formRepo == nil || formSubmissionRepo == nil || auditRepo == nil || workspaceRepo == nil ||
+		apiKeyRepo == nil || bulkJobRepo == nil || reviewRepo == nil {
 		p.Logger.Error("failed to create repository",
 			"operation", "repository_initialization",
-			"repository_type", "user/form/submission",
+			"repository_type", "user/form/submission/audit/workspace/api_key/bulk_job/review",
 			"error_type", "nil_repository",
 		)
 
@@ -117,6 +271,11 @@ func NewStores(p StoreParams) (Stores, error) {
 		UserRepository:           userRepo,
 		FormRepository:           formRepo,
 		FormSubmissionRepository: formSubmissionRepo,
+		AuditRepository:          auditRepo,
+		WorkspaceRepository:      workspaceRepo,
+		APIKeyRepository:         apiKeyRepo,
+		BulkJobRepository:        bulkJobRepo,
+		ReviewRepository:         reviewRepo,
 	}, nil
 }
 
@@ -133,6 +292,31 @@ var Module = fx.Module("domain",
 			NewFormService,
 			fx.As(new(form.Service)),
 		),
+		// Audit service
+		fx.Annotate(
+			NewAuditService,
+			fx.As(new(audit.Service)),
+		),
+		// Workspace service
+		fx.Annotate(
+			NewWorkspaceService,
+			fx.As(new(workspace.Service)),
+		),
+		// API key service
+		fx.Annotate(
+			NewAPIKeyService,
+			fx.As(new(apikey.Service)),
+		),
+		// Bulk submission service
+		fx.Annotate(
+			NewBulkService,
+			fx.As(new(bulk.Service)),
+		),
+		// Submission review service
+		fx.Annotate(
+			NewReviewService,
+			fx.As(new(review.Service)),
+		),
 		NewStores,
 		// User ensurer (ensures Go user row exists for assertion-authenticated requests)
 		fx.Annotate(
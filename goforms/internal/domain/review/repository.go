@@ -0,0 +1,28 @@
+//go:generate mockgen -typed -source=repository.go -destination=../../../test/mocks/review/mock_repository.go -package=review
+
+package review
+
+import (
+	"context"
+
+	"github.com/goformx/goforms/internal/domain/form/model"
+)
+
+// Repository stores the review state of submissions
+type Repository interface {
+	// GetSubmission returns a form's submission with its tags, or ErrSubmissionNotFound
+	GetSubmission(ctx context.Context, formID, submissionID string) (*model.FormSubmission, error)
+	// SaveReview saves the submission's review status and assignee, and replaces its tags unless tags
+	// is nil, only while its stored review status is from, reporting whether it did, so that two
+	// concurrent updates cannot both apply a transition checked against the same status
+	SaveReview(ctx context.Context, submission *model.FormSubmission, from string, tags []string) (bool, error)
+
+	// CreateNote persists a new note
+	CreateNote(ctx context.Context, note *Note) error
+	// GetNote returns a note by ID, or ErrNoteNotFound
+	GetNote(ctx context.Context, noteID string) (*Note, error)
+	// ListNotes returns a submission's notes, oldest first
+	ListNotes(ctx context.Context, submissionID string) ([]*Note, error)
+	// DeleteNote removes a note
+	DeleteNote(ctx context.Context, noteID string) error
+}
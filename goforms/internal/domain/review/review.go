@@ -0,0 +1,64 @@
+// Package review lets form owners triage submissions: move them through the form's review
+// workflow, assign them to a member, tag them and leave internal notes.
+package review
+
+import (
+	"errors"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+// MaxNoteLength is the maximum length of a note body
+const MaxNoteLength = 5000
+
+var (
+	// ErrSubmissionNotFound is returned when a submission does not exist or belongs to another form
+	ErrSubmissionNotFound = errors.New("submission not found")
+	// ErrNoteNotFound is returned when a note does not exist or belongs to another submission
+	ErrNoteNotFound = errors.New("note not found")
+	// ErrUnknownStatus is returned for a status that is not part of the form's workflow
+	ErrUnknownStatus = errors.New("unknown review status")
+	// ErrInvalidTransition is returned when the workflow does not allow moving between two statuses
+	ErrInvalidTransition = errors.New("review status transition not allowed")
+	// ErrReviewConflict is returned when the review status changed between reading and saving an update
+	ErrReviewConflict = errors.New("submission review was changed by another update")
+	// ErrInvalidTags is returned when tags exceed the count or length limits
+	ErrInvalidTags = errors.New("invalid submission tags")
+	// ErrInvalidNote is returned for empty or oversized notes
+	ErrInvalidNote = errors.New("note must be between 1 and 5000 characters")
+	// ErrNotNoteAuthor is returned when deleting another user's note
+	ErrNotNoteAuthor = errors.New("only the author can delete a note")
+)
+
+// Update changes a submission's review state. Nil fields are left unchanged; an empty
+// AssigneeID unassigns the submission and an empty Tags list clears its tags.
+type Update struct {
+	Status     *string
+	AssigneeID *string
+	Tags       []string
+}
+
+// Note is an internal comment on a submission, visible only to the form's team
+type Note struct {
+	ID           string    `gorm:"column:uuid;primaryKey;type:uuid" json:"id"`
+	SubmissionID string    `gorm:"not null;size:36;index"           json:"submission_id"`
+	AuthorID     string    `gorm:"not null;size:255"                json:"author_id"`
+	Body         string    `gorm:"not null;type:text"               json:"body"`
+	CreatedAt    time.Time `gorm:"not null;autoCreateTime"          json:"created_at"`
+}
+
+// TableName specifies the table name for the Note model
+func (Note) TableName() string {
+	return "submission_notes"
+}
+
+// BeforeCreate is a GORM hook that generates a UUID before inserting a new note
+func (n *Note) BeforeCreate(_ *gorm.DB) error {
+	if n.ID == "" {
+		n.ID = uuid.New().String()
+	}
+
+	return nil
+}
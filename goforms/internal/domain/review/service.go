@@ -0,0 +1,202 @@
+//go:generate mockgen -typed -source=service.go -destination=../../../test/mocks/review/mock_service.go -package=review -mock_names=Service=MockService
+
+package review
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/goformx/goforms/internal/domain/common/events"
+	formevents "github.com/goformx/goforms/internal/domain/form/events"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+)
+
+// Service manages the review state of submissions
+type Service interface {
+	// Get returns a form's submission with its review state
+	Get(ctx context.Context, formID, submissionID string) (*model.FormSubmission, error)
+	// Update applies a review update on behalf of actorID and publishes status change and assignment events.
+	// Status changes must follow the form's workflow; ErrReviewConflict is returned when another
+	// update changed the status after it was read.
+	Update(ctx context.Context, form *model.Form, submissionID, actorID string, update Update) (*model.FormSubmission, error)
+	// AddNote adds an internal note to a form's submission
+	AddNote(ctx context.Context, formID, submissionID, authorID, body string) (*Note, error)
+	// ListNotes returns the notes on a form's submission, oldest first
+	ListNotes(ctx context.Context, formID, submissionID string) ([]*Note, error)
+	// DeleteNote deletes a note written by actorID
+	DeleteNote(ctx context.Context, formID, submissionID, noteID, actorID string) error
+}
+
+// service implements Service
+type service struct {
+	repository Repository
+	publisher  events.Publisher
+	logger     logging.Logger
+}
+
+// NewService creates a new review service
+func NewService(repository Repository, publisher events.Publisher, logger logging.Logger) Service {
+	return &service{
+		repository: repository,
+		publisher:  publisher,
+		logger:     logger,
+	}
+}
+
+// Get returns a form's submission with its review state
+func (s *service) Get(ctx context.Context, formID, submissionID string) (*model.FormSubmission, error) {
+	submission, err := s.repository.GetSubmission(ctx, formID, submissionID)
+	if err != nil {
+		return nil, fmt.Errorf("get submission for review: %w", err)
+	}
+
+	return submission, nil
+}
+
+// Update applies a review update and publishes the resulting events
+func (s *service) Update(
+	ctx context.Context,
+	form *model.Form,
+	submissionID, actorID string,
+	update Update,
+) (*model.FormSubmission, error) {
+	submission, err := s.Get(ctx, form.ID, submissionID)
+	if err != nil {
+		return nil, err
+	}
+
+	from := submission.ReviewStatus
+
+	var changes []*formevents.Event
+
+	if update.Status != nil && *update.Status != submission.ReviewStatus {
+		workflow := form.Workflow()
+		if !workflow.Has(*update.Status) {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownStatus, *update.Status)
+		}
+
+		if !workflow.CanTransition(submission.ReviewStatus, *update.Status) {
+			return nil, fmt.Errorf("%w: %q to %q", ErrInvalidTransition, submission.ReviewStatus, *update.Status)
+		}
+
+		changes = append(changes, formevents.NewReviewStatusChangedEvent(formevents.ReviewChange{
+			FormID: form.ID, SubmissionID: submission.ID, From: submission.ReviewStatus, To: *update.Status, ActorID: actorID,
+		}))
+		submission.ReviewStatus = *update.Status
+	}
+
+	if update.AssigneeID != nil && *update.AssigneeID != submission.AssigneeID {
+		changes = append(changes, formevents.NewSubmissionAssignedEvent(formevents.ReviewChange{
+			FormID: form.ID, SubmissionID: submission.ID, From: submission.AssigneeID, To: *update.AssigneeID, ActorID: actorID,
+		}))
+		submission.AssigneeID = *update.AssigneeID
+	}
+
+	var tags []string
+	if update.Tags != nil {
+		if tags, err = normalizeTags(update.Tags); err != nil {
+			return nil, err
+		}
+	}
+
+	saved, err := s.repository.SaveReview(ctx, submission, from, tags)
+	if err != nil {
+		return nil, fmt.Errorf("save submission review: %w", err)
+	}
+
+	if !saved {
+		return nil, ErrReviewConflict
+	}
+
+	if tags != nil {
+		submission.Tags = tags
+	}
+
+	for _, event := range changes {
+		if publishErr := s.publisher.Publish(ctx, event); publishErr != nil {
+			s.logger.Error("failed to publish review event", "event_name", event.Name(), "error", publishErr)
+		}
+	}
+
+	return submission, nil
+}
+
+// AddNote adds an internal note to a form's submission
+func (s *service) AddNote(ctx context.Context, formID, submissionID, authorID, body string) (*Note, error) {
+	body = strings.TrimSpace(body)
+	if body == "" || utf8.RuneCountInString(body) > MaxNoteLength {
+		return nil, ErrInvalidNote
+	}
+
+	if _, err := s.Get(ctx, formID, submissionID); err != nil {
+		return nil, err
+	}
+
+	note := &Note{SubmissionID: submissionID, AuthorID: authorID, Body: body}
+	if err := s.repository.CreateNote(ctx, note); err != nil {
+		return nil, fmt.Errorf("create submission note: %w", err)
+	}
+
+	return note, nil
+}
+
+// ListNotes returns the notes on a form's submission
+func (s *service) ListNotes(ctx context.Context, formID, submissionID string) ([]*Note, error) {
+	if _, err := s.Get(ctx, formID, submissionID); err != nil {
+		return nil, err
+	}
+
+	notes, err := s.repository.ListNotes(ctx, submissionID)
+	if err != nil {
+		return nil, fmt.Errorf("list submission notes: %w", err)
+	}
+
+	return notes, nil
+}
+
+// DeleteNote deletes a note written by actorID
+func (s *service) DeleteNote(ctx context.Context, formID, submissionID, noteID, actorID string) error {
+	if _, err := s.Get(ctx, formID, submissionID); err != nil {
+		return err
+	}
+
+	note, err := s.repository.GetNote(ctx, noteID)
+	if err != nil {
+		return fmt.Errorf("get submission note: %w", err)
+	}
+
+	if note.SubmissionID != submissionID {
+		return ErrNoteNotFound
+	}
+
+	if note.AuthorID != actorID {
+		return ErrNotNoteAuthor
+	}
+
+	if err = s.repository.DeleteNote(ctx, note.ID); err != nil {
+		return fmt.Errorf("delete submission note: %w", err)
+	}
+
+	return nil
+}
+
+// normalizeTags lowercases and deduplicates tags and enforces the form tag limits.
+// The result is never nil so that an empty list clears the submission's tags.
+func normalizeTags(tags []string) ([]string, error) {
+	normalized := model.NormalizeTags(tags)
+
+	if len(normalized) > model.MaxTags {
+		return nil, fmt.Errorf("%w: at most %d tags", ErrInvalidTags, model.MaxTags)
+	}
+
+	for _, tag := range normalized {
+		if len(tag) > model.MaxTagLength {
+			return nil, fmt.Errorf("%w: tags must not exceed %d characters", ErrInvalidTags, model.MaxTagLength)
+		}
+	}
+
+	return normalized, nil
+}
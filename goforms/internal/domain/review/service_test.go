@@ -0,0 +1,180 @@
+package review_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goformx/goforms/internal/domain/common/events"
+	formevents "github.com/goformx/goforms/internal/domain/form/events"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/domain/review"
+	mockevents "github.com/goformx/goforms/test/mocks/events"
+	mocklogging "github.com/goformx/goforms/test/mocks/logging"
+	mockreview "github.com/goformx/goforms/test/mocks/review"
+)
+
+func newReviewService(t *testing.T) (review.Service, *mockreview.MockRepository, *mockevents.MockEventBus) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	repo := mockreview.NewMockRepository(ctrl)
+	bus := mockevents.NewMockEventBus(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	logger.EXPECT().Error(gomock.Any(), gomock.Any()).AnyTimes()
+
+	return review.NewService(repo, bus, logger), repo, bus
+}
+
+// stagedForm returns a form whose workflow only moves forward one status at a time
+func stagedForm() *model.Form {
+	workflow := model.DefaultReviewWorkflow()
+	workflow.Transitions = map[string][]string{
+		model.ReviewStatusNew:      {model.ReviewStatusInReview},
+		model.ReviewStatusInReview: {model.ReviewStatusApproved, model.ReviewStatusRejected},
+	}
+
+	return &model.Form{ID: "form-1", ReviewWorkflow: &workflow}
+}
+
+func newSubmission() *model.FormSubmission {
+	return &model.FormSubmission{ID: "sub-1", FormID: "form-1", ReviewStatus: model.ReviewStatusNew, Tags: []string{"vip"}}
+}
+
+func ptr(s string) *string {
+	return &s
+}
+
+func TestService_Update_ChangesStatusAndAssigneeAndPublishesEvents(t *testing.T) {
+	svc, repo, bus := newReviewService(t)
+
+	repo.EXPECT().GetSubmission(gomock.Any(), "form-1", "sub-1").Return(newSubmission(), nil)
+	repo.EXPECT().SaveReview(gomock.Any(), gomock.Any(), model.ReviewStatusNew, nil).DoAndReturn(
+		func(_ context.Context, sub *model.FormSubmission, _ string, _ []string) (bool, error) {
+			assert.Equal(t, model.ReviewStatusInReview, sub.ReviewStatus)
+			assert.Equal(t, "user-2", sub.AssigneeID)
+
+			return true, nil
+		})
+
+	var published []events.Event
+
+	bus.EXPECT().Publish(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, event events.Event) error {
+		published = append(published, event)
+
+		return nil
+	}).Times(2)
+
+	sub, err := svc.Update(context.Background(), stagedForm(), "sub-1", "user-1", review.Update{
+		Status:     ptr(model.ReviewStatusInReview),
+		AssigneeID: ptr("user-2"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"vip"}, sub.Tags, "omitted tags are left unchanged")
+
+	require.Len(t, published, 2)
+	assert.Equal(t, string(formevents.ReviewStatusChangedEventType), published[0].Name())
+	assert.Equal(t, formevents.ReviewChange{
+		FormID: "form-1", SubmissionID: "sub-1", From: model.ReviewStatusNew, To: model.ReviewStatusInReview, ActorID: "user-1",
+	}, published[0].(*formevents.Event).Payload())
+	assert.Equal(t, string(formevents.SubmissionAssignedEventType), published[1].Name())
+}
+
+func TestService_Update_RejectsTransitionOutsideWorkflow(t *testing.T) {
+	svc, repo, _ := newReviewService(t)
+
+	repo.EXPECT().GetSubmission(gomock.Any(), "form-1", "sub-1").Return(newSubmission(), nil)
+
+	_, err := svc.Update(context.Background(), stagedForm(), "sub-1", "user-1", review.Update{Status: ptr(model.ReviewStatusApproved)})
+	require.ErrorIs(t, err, review.ErrInvalidTransition)
+}
+
+func TestService_Update_ReportsConflictWhenTheStatusChangedMeanwhile(t *testing.T) {
+	svc, repo, _ := newReviewService(t)
+
+	// Another update moved the submission on after it was read, so nothing is saved or published
+	repo.EXPECT().GetSubmission(gomock.Any(), "form-1", "sub-1").Return(newSubmission(), nil)
+	repo.EXPECT().SaveReview(gomock.Any(), gomock.Any(), model.ReviewStatusNew, nil).Return(false, nil)
+
+	_, err := svc.Update(context.Background(), stagedForm(), "sub-1", "user-1", review.Update{Status: ptr(model.ReviewStatusInReview)})
+	require.ErrorIs(t, err, review.ErrReviewConflict)
+}
+
+func TestService_Update_RejectsUnknownStatus(t *testing.T) {
+	svc, repo, _ := newReviewService(t)
+
+	repo.EXPECT().GetSubmission(gomock.Any(), "form-1", "sub-1").Return(newSubmission(), nil)
+
+	_, err := svc.Update(context.Background(), stagedForm(), "sub-1", "user-1", review.Update{Status: ptr("archived")})
+	require.ErrorIs(t, err, review.ErrUnknownStatus)
+}
+
+func TestService_Update_NormalizesAndClearsTags(t *testing.T) {
+	svc, repo, _ := newReviewService(t)
+
+	repo.EXPECT().GetSubmission(gomock.Any(), "form-1", "sub-1").Return(newSubmission(), nil).Times(2)
+	repo.EXPECT().SaveReview(gomock.Any(), gomock.Any(), model.ReviewStatusNew, []string{"urgent", "follow-up"}).Return(true, nil)
+	repo.EXPECT().SaveReview(gomock.Any(), gomock.Any(), model.ReviewStatusNew, []string{}).Return(true, nil)
+
+	sub, err := svc.Update(context.Background(), stagedForm(), "sub-1", "user-1", review.Update{
+		Tags: []string{" Urgent ", "follow-up", "urgent"},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"urgent", "follow-up"}, sub.Tags)
+
+	sub, err = svc.Update(context.Background(), stagedForm(), "sub-1", "user-1", review.Update{Tags: []string{}})
+	require.NoError(t, err)
+	assert.Empty(t, sub.Tags)
+}
+
+func TestService_Update_PublishFailureDoesNotFailUpdate(t *testing.T) {
+	svc, repo, bus := newReviewService(t)
+
+	repo.EXPECT().GetSubmission(gomock.Any(), "form-1", "sub-1").Return(newSubmission(), nil)
+	repo.EXPECT().SaveReview(gomock.Any(), gomock.Any(), model.ReviewStatusNew, nil).Return(true, nil)
+	bus.EXPECT().Publish(gomock.Any(), gomock.Any()).Return(errors.New("bus down"))
+
+	_, err := svc.Update(context.Background(), stagedForm(), "sub-1", "user-1", review.Update{AssigneeID: ptr("user-2")})
+	require.NoError(t, err)
+}
+
+func TestService_AddNote_ValidatesBody(t *testing.T) {
+	svc, repo, _ := newReviewService(t)
+
+	_, err := svc.AddNote(context.Background(), "form-1", "sub-1", "user-1", "   ")
+	require.ErrorIs(t, err, review.ErrInvalidNote)
+
+	repo.EXPECT().GetSubmission(gomock.Any(), "form-1", "sub-1").Return(newSubmission(), nil)
+	repo.EXPECT().CreateNote(gomock.Any(), gomock.Any()).Return(nil)
+
+	note, err := svc.AddNote(context.Background(), "form-1", "sub-1", "user-1", " Called back ")
+	require.NoError(t, err)
+	assert.Equal(t, "Called back", note.Body)
+	assert.Equal(t, "user-1", note.AuthorID)
+}
+
+func TestService_DeleteNote_OnlyAuthorOfNoteOnSubmission(t *testing.T) {
+	svc, repo, _ := newReviewService(t)
+
+	repo.EXPECT().GetSubmission(gomock.Any(), "form-1", "sub-1").Return(newSubmission(), nil).Times(3)
+	repo.EXPECT().GetNote(gomock.Any(), "note-1").Return(&review.Note{ID: "note-1", SubmissionID: "sub-1", AuthorID: "user-1"}, nil).Times(2)
+	repo.EXPECT().GetNote(gomock.Any(), "note-2").Return(&review.Note{ID: "note-2", SubmissionID: "sub-9", AuthorID: "user-1"}, nil)
+	repo.EXPECT().DeleteNote(gomock.Any(), "note-1").Return(nil)
+
+	require.ErrorIs(t, svc.DeleteNote(context.Background(), "form-1", "sub-1", "note-1", "user-2"), review.ErrNotNoteAuthor)
+	require.ErrorIs(t, svc.DeleteNote(context.Background(), "form-1", "sub-1", "note-2", "user-1"), review.ErrNoteNotFound)
+	require.NoError(t, svc.DeleteNote(context.Background(), "form-1", "sub-1", "note-1", "user-1"))
+}
+
+func TestService_ListNotes_SubmissionOfAnotherForm(t *testing.T) {
+	svc, repo, _ := newReviewService(t)
+
+	repo.EXPECT().GetSubmission(gomock.Any(), "form-1", "sub-1").Return(nil, review.ErrSubmissionNotFound)
+
+	_, err := svc.ListNotes(context.Background(), "form-1", "sub-1")
+	require.ErrorIs(t, err, review.ErrSubmissionNotFound)
+}
@@ -0,0 +1,29 @@
+//go:generate mockgen -typed -source=repository.go -destination=../../../test/mocks/workspace/mock_repository.go -package=workspace
+
+package workspace
+
+import (
+	"context"
+)
+
+// Repository defines storage for workspaces and their members.
+// Lookups of missing records return errors wrapping common.ErrNotFound.
+type Repository interface {
+	// CreateWorkspace persists a workspace together with its initial owner membership
+	CreateWorkspace(ctx context.Context, workspace *Workspace, owner *Member) error
+	// GetWorkspace returns a workspace by ID
+	GetWorkspace(ctx context.Context, id string) (*Workspace, error)
+	// ListWorkspacesByUser returns all workspaces the user is a member of
+	ListWorkspacesByUser(ctx context.Context, userID string) ([]*Workspace, error)
+	// GetMember returns a user's membership in a workspace
+	GetMember(ctx context.Context, workspaceID, userID string) (*Member, error)
+	// ListMembers returns all members of a workspace
+	ListMembers(ctx context.Context, workspaceID string) ([]*Member, error)
+	// SaveMember creates or updates a membership. Demoting the last owner fails with ErrLastOwner;
+	// the check and the write are atomic, so concurrent changes cannot remove every owner.
+	SaveMember(ctx context.Context, member *Member) error
+	// DeleteMember removes a membership. Removing the last owner fails with ErrLastOwner, atomically.
+	DeleteMember(ctx context.Context, workspaceID, userID string) error
+	// UpdatePlanTier records the plan tier the workspace's usage is charged to
+	UpdatePlanTier(ctx context.Context, workspaceID, planTier string) error
+}
@@ -0,0 +1,229 @@
+//go:generate mockgen -typed -source=service.go -destination=../../../test/mocks/workspace/mock_service.go -package=workspace -mock_names=Service=MockService
+
+package workspace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/goformx/goforms/internal/domain/common/plans"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+// Service defines workspace management and authorization
+type Service interface {
+	// CreateWorkspace creates a workspace owned by ownerID, charged to the owner's plan tier
+	CreateWorkspace(ctx context.Context, ownerID, name, planTier string) (*Workspace, error)
+	// GetWorkspace returns a workspace by ID
+	GetWorkspace(ctx context.Context, workspaceID string) (*Workspace, error)
+	// ListWorkspaces returns the workspaces a user belongs to
+	ListWorkspaces(ctx context.Context, userID string) ([]*Workspace, error)
+	// ListMembers returns the members of a workspace
+	ListMembers(ctx context.Context, workspaceID string) ([]*Member, error)
+	// SetMemberRole adds a member or changes an existing member's role
+	SetMemberRole(ctx context.Context, workspaceID, userID string, role Role) (*Member, error)
+	// RemoveMember removes a member from a workspace
+	RemoveMember(ctx context.Context, workspaceID, userID string) error
+	// Authorize returns the user's membership if their role grants the permission.
+	// It returns ErrNotMember or ErrPermissionDenied otherwise.
+	Authorize(ctx context.Context, workspaceID, userID string, permission Permission) (*Member, error)
+	// PlanTier returns the plan tier the workspace's usage is charged to: its owners' plan.
+	// Plans are asserted per request, so a request by an owner records their current tier first.
+	PlanTier(ctx context.Context, actor *Member, actorPlanTier string) (string, error)
+}
+
+// service implements Service
+type service struct {
+	repository Repository
+	logger     logging.Logger
+}
+
+// NewService creates a new workspace service
+func NewService(repository Repository, logger logging.Logger) Service {
+	return &service{
+		repository: repository,
+		logger:     logger,
+	}
+}
+
+// CreateWorkspace creates a workspace owned by ownerID, charged to the owner's plan tier
+func (s *service) CreateWorkspace(ctx context.Context, ownerID, name, planTier string) (*Workspace, error) {
+	if ownerID == "" {
+		return nil, errors.New("create workspace: owner ID is required")
+	}
+
+	if planTier == "" {
+		planTier = plans.TierFree
+	}
+
+	// Assign the ID up front so the owner membership can reference it
+	ws := &Workspace{
+		ID:        uuid.New().String(),
+		Name:      strings.TrimSpace(name),
+		CreatedBy: ownerID,
+		PlanTier:  planTier,
+	}
+
+	if err := ws.Validate(); err != nil {
+		return nil, fmt.Errorf("create workspace: %w", err)
+	}
+
+	owner := &Member{WorkspaceID: ws.ID, UserID: ownerID, Role: RoleOwner}
+
+	if err := s.repository.CreateWorkspace(ctx, ws, owner); err != nil {
+		return nil, fmt.Errorf("create workspace: %w", err)
+	}
+
+	return ws, nil
+}
+
+// GetWorkspace returns a workspace by ID
+func (s *service) GetWorkspace(ctx context.Context, workspaceID string) (*Workspace, error) {
+	ws, err := s.repository.GetWorkspace(ctx, workspaceID)
+	if err != nil {
+		if errors.Is(err, common.ErrNotFound) {
+			return nil, ErrWorkspaceNotFound
+		}
+
+		return nil, fmt.Errorf("get workspace: %w", err)
+	}
+
+	return ws, nil
+}
+
+// ListWorkspaces returns the workspaces a user belongs to
+func (s *service) ListWorkspaces(ctx context.Context, userID string) ([]*Workspace, error) {
+	workspaces, err := s.repository.ListWorkspacesByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("list workspaces: %w", err)
+	}
+
+	return workspaces, nil
+}
+
+// ListMembers returns the members of a workspace
+func (s *service) ListMembers(ctx context.Context, workspaceID string) ([]*Member, error) {
+	members, err := s.repository.ListMembers(ctx, workspaceID)
+	if err != nil {
+		return nil, fmt.Errorf("list workspace members: %w", err)
+	}
+
+	return members, nil
+}
+
+// SetMemberRole adds a member or changes an existing member's role
+func (s *service) SetMemberRole(ctx context.Context, workspaceID, userID string, role Role) (*Member, error) {
+	if !role.IsValid() {
+		return nil, ErrInvalidRole
+	}
+
+	if userID == "" {
+		return nil, errors.New("set member role: user ID is required")
+	}
+
+	existing, err := s.getMember(ctx, workspaceID, userID)
+	if err != nil && !errors.Is(err, ErrNotMember) {
+		return nil, err
+	}
+
+	member := &Member{WorkspaceID: workspaceID, UserID: userID, Role: role}
+	if existing != nil {
+		member.CreatedAt = existing.CreatedAt
+	}
+
+	// The repository refuses to demote the last owner
+	if saveErr := s.repository.SaveMember(ctx, member); saveErr != nil {
+		return nil, fmt.Errorf("save workspace member: %w", saveErr)
+	}
+
+	s.logger.Info("workspace member role set",
+		"workspace_id", workspaceID,
+		"user_id", s.logger.SanitizeField("user_id", userID),
+		"role", role)
+
+	return member, nil
+}
+
+// RemoveMember removes a member from a workspace
+func (s *service) RemoveMember(ctx context.Context, workspaceID, userID string) error {
+	if _, err := s.getMember(ctx, workspaceID, userID); err != nil {
+		return err
+	}
+
+	// The repository refuses to remove the last owner
+	if deleteErr := s.repository.DeleteMember(ctx, workspaceID, userID); deleteErr != nil {
+		return fmt.Errorf("delete workspace member: %w", deleteErr)
+	}
+
+	return nil
+}
+
+// Authorize returns the user's membership if their role grants the permission
+func (s *service) Authorize(
+	ctx context.Context,
+	workspaceID, userID string,
+	permission Permission,
+) (*Member, error) {
+	member, err := s.getMember(ctx, workspaceID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !member.Can(permission) {
+		s.logger.Warn("workspace permission denied",
+			"workspace_id", workspaceID,
+			"user_id", s.logger.SanitizeField("user_id", userID),
+			"role", member.Role,
+			"permission", permission)
+
+		return nil, ErrPermissionDenied
+	}
+
+	return member, nil
+}
+
+// PlanTier returns the plan tier the workspace's usage is charged to, recording the tier of an acting owner
+func (s *service) PlanTier(ctx context.Context, actor *Member, actorPlanTier string) (string, error) {
+	ws, err := s.GetWorkspace(ctx, actor.WorkspaceID)
+	if err != nil {
+		return "", err
+	}
+
+	if actor.Role != RoleOwner || actorPlanTier == "" || actorPlanTier == ws.PlanTier {
+		return ws.PlanTier, nil
+	}
+
+	if updateErr := s.repository.UpdatePlanTier(ctx, ws.ID, actorPlanTier); updateErr != nil {
+		return "", fmt.Errorf("update workspace plan tier: %w", updateErr)
+	}
+
+	s.logger.Info("workspace plan tier updated",
+		"workspace_id", ws.ID,
+		"from", ws.PlanTier,
+		"to", actorPlanTier)
+
+	return actorPlanTier, nil
+}
+
+// getMember loads a membership, translating missing rows to ErrNotMember
+func (s *service) getMember(ctx context.Context, workspaceID, userID string) (*Member, error) {
+	if workspaceID == "" || userID == "" {
+		return nil, ErrNotMember
+	}
+
+	member, err := s.repository.GetMember(ctx, workspaceID, userID)
+	if err != nil {
+		if errors.Is(err, common.ErrNotFound) {
+			return nil, ErrNotMember
+		}
+
+		return nil, fmt.Errorf("get workspace member: %w", err)
+	}
+
+	return member, nil
+}
@@ -0,0 +1,159 @@
+package workspace_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goformx/goforms/internal/domain/workspace"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+	mocklogging "github.com/goformx/goforms/test/mocks/logging"
+	mockworkspace "github.com/goformx/goforms/test/mocks/workspace"
+)
+
+func TestRole_Can(t *testing.T) {
+	tests := []struct {
+		role       workspace.Role
+		permission workspace.Permission
+		want       bool
+	}{
+		{workspace.RoleOwner, workspace.PermissionManageMembers, true},
+		{workspace.RoleEditor, workspace.PermissionManageMembers, false},
+		{workspace.RoleEditor, workspace.PermissionEditForm, true},
+		{workspace.RoleViewer, workspace.PermissionViewForm, true},
+		{workspace.RoleViewer, workspace.PermissionEditForm, false},
+		{workspace.RoleViewer, workspace.PermissionViewSubmissions, false},
+		{workspace.RoleSubmissionsOnly, workspace.PermissionViewSubmissions, true},
+		{workspace.RoleSubmissionsOnly, workspace.PermissionViewForm, false},
+		{workspace.RoleSubmissionsOnly, workspace.PermissionReviewSubmissions, true},
+		{workspace.RoleSubmissionsOnly, workspace.PermissionManageSubmissions, false},
+		{workspace.RoleViewer, workspace.PermissionReviewSubmissions, false},
+		{workspace.Role("admin"), workspace.PermissionViewForm, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(string(tt.role)+"/"+string(tt.permission), func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.role.Can(tt.permission))
+		})
+	}
+}
+
+func TestService_CreateWorkspace_AddsOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mockworkspace.NewMockRepository(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+
+	repo.EXPECT().CreateWorkspace(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, ws *workspace.Workspace, owner *workspace.Member) error {
+			assert.Equal(t, "Agency", ws.Name)
+			assert.Equal(t, "pro", ws.PlanTier)
+			assert.Equal(t, ws.ID, owner.WorkspaceID)
+			assert.Equal(t, "user123", owner.UserID)
+			assert.Equal(t, workspace.RoleOwner, owner.Role)
+
+			return nil
+		})
+
+	ws, err := workspace.NewService(repo, logger).CreateWorkspace(context.Background(), "user123", "  Agency ", "pro")
+	require.NoError(t, err)
+	assert.NotEmpty(t, ws.ID)
+}
+
+func TestService_CreateWorkspace_RequiresName(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mockworkspace.NewMockRepository(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+
+	_, err := workspace.NewService(repo, logger).CreateWorkspace(context.Background(), "user123", " ", "")
+	require.ErrorIs(t, err, workspace.ErrNameRequired)
+}
+
+func TestService_Authorize(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mockworkspace.NewMockRepository(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	logger.EXPECT().SanitizeField(gomock.Any(), gomock.Any()).Return("[redacted]").AnyTimes()
+	logger.EXPECT().Warn(gomock.Any(), gomock.Any()).AnyTimes()
+
+	viewer := &workspace.Member{WorkspaceID: "ws1", UserID: "viewer", Role: workspace.RoleViewer}
+	repo.EXPECT().GetMember(gomock.Any(), "ws1", "viewer").Return(viewer, nil).Times(2)
+	repo.EXPECT().GetMember(gomock.Any(), "ws1", "stranger").
+		Return(nil, common.NewNotFoundError("get", "workspace_member", "ws1"))
+
+	svc := workspace.NewService(repo, logger)
+
+	member, err := svc.Authorize(context.Background(), "ws1", "viewer", workspace.PermissionViewForm)
+	require.NoError(t, err)
+	assert.Equal(t, viewer, member)
+
+	_, err = svc.Authorize(context.Background(), "ws1", "viewer", workspace.PermissionDeleteForm)
+	require.ErrorIs(t, err, workspace.ErrPermissionDenied)
+
+	_, err = svc.Authorize(context.Background(), "ws1", "stranger", workspace.PermissionViewForm)
+	require.ErrorIs(t, err, workspace.ErrNotMember)
+}
+
+func TestService_SetMemberRole_RejectsDemotingLastOwner(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mockworkspace.NewMockRepository(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+
+	owner := &workspace.Member{WorkspaceID: "ws1", UserID: "owner", Role: workspace.RoleOwner}
+	repo.EXPECT().GetMember(gomock.Any(), "ws1", "owner").Return(owner, nil)
+	repo.EXPECT().SaveMember(gomock.Any(), gomock.Any()).Return(workspace.ErrLastOwner)
+
+	_, err := workspace.NewService(repo, logger).
+		SetMemberRole(context.Background(), "ws1", "owner", workspace.RoleEditor)
+	require.ErrorIs(t, err, workspace.ErrLastOwner)
+}
+
+func TestService_SetMemberRole_RejectsUnknownRole(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mockworkspace.NewMockRepository(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+
+	_, err := workspace.NewService(repo, logger).
+		SetMemberRole(context.Background(), "ws1", "user", workspace.Role("superuser"))
+	require.ErrorIs(t, err, workspace.ErrInvalidRole)
+}
+
+func TestService_RemoveMember_AllowsOwnerWhenAnotherExists(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mockworkspace.NewMockRepository(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+
+	owner := &workspace.Member{WorkspaceID: "ws1", UserID: "owner", Role: workspace.RoleOwner}
+	repo.EXPECT().GetMember(gomock.Any(), "ws1", "owner").Return(owner, nil)
+	repo.EXPECT().DeleteMember(gomock.Any(), "ws1", "owner").Return(nil)
+
+	require.NoError(t, workspace.NewService(repo, logger).RemoveMember(context.Background(), "ws1", "owner"))
+}
+
+func TestService_PlanTier_RecordsTheTierOfOwners(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	repo := mockworkspace.NewMockRepository(ctrl)
+	logger := mocklogging.NewMockLogger(ctrl)
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	repo.EXPECT().GetWorkspace(gomock.Any(), "ws1").Return(&workspace.Workspace{ID: "ws1", PlanTier: "business"}, nil).Times(3)
+	repo.EXPECT().UpdatePlanTier(gomock.Any(), "ws1", "enterprise").Return(nil)
+
+	svc := workspace.NewService(repo, logger)
+
+	// An editor on a free plan creates forms against the owners' plan
+	tier, err := svc.PlanTier(context.Background(), &workspace.Member{WorkspaceID: "ws1", Role: workspace.RoleEditor}, "free")
+	require.NoError(t, err)
+	assert.Equal(t, "business", tier)
+
+	tier, err = svc.PlanTier(context.Background(), &workspace.Member{WorkspaceID: "ws1", Role: workspace.RoleOwner}, "business")
+	require.NoError(t, err)
+	assert.Equal(t, "business", tier)
+
+	// An owner who upgraded carries the workspace with them
+	tier, err = svc.PlanTier(context.Background(), &workspace.Member{WorkspaceID: "ws1", Role: workspace.RoleOwner}, "enterprise")
+	require.NoError(t, err)
+	assert.Equal(t, "enterprise", tier)
+}
@@ -0,0 +1,156 @@
+// Package workspace provides team workspaces that own forms and grant
+// members role-based access to them.
+package workspace
+
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+)
+
+var (
+	// ErrWorkspaceNotFound is returned when a workspace does not exist
+	ErrWorkspaceNotFound = errors.New("workspace not found")
+	// ErrNotMember is returned when a user is not a member of a workspace
+	ErrNotMember = errors.New("user is not a member of the workspace")
+	// ErrPermissionDenied is returned when a member's role does not grant a permission
+	ErrPermissionDenied = errors.New("permission denied")
+	// ErrInvalidRole is returned for unknown roles
+	ErrInvalidRole = errors.New("invalid workspace role")
+	// ErrLastOwner is returned when an operation would leave a workspace without an owner
+	ErrLastOwner = errors.New("workspace must keep at least one owner")
+	// ErrNameRequired is returned when a workspace is created without a name
+	ErrNameRequired = errors.New("workspace name is required")
+)
+
+// Role is a member's role within a workspace
+type Role string
+
+const (
+	// RoleOwner has full control including member management
+	RoleOwner Role = "owner"
+	// RoleEditor can create, edit and delete forms and read submissions
+	RoleEditor Role = "editor"
+	// RoleViewer can view forms
+	RoleViewer Role = "viewer"
+	// RoleSubmissionsOnly can read submissions but not the form definitions
+	RoleSubmissionsOnly Role = "submissions_only"
+)
+
+// Permission is a single capability checked before an operation
+type Permission string
+
+const (
+	// PermissionViewWorkspace allows reading the workspace and its member list
+	PermissionViewWorkspace Permission = "workspace:view"
+	// PermissionViewForm allows reading form definitions
+	PermissionViewForm Permission = "form:view"
+	// PermissionCreateForm allows creating forms in the workspace
+	PermissionCreateForm Permission = "form:create"
+	// PermissionEditForm allows updating forms
+	PermissionEditForm Permission = "form:edit"
+	// PermissionDeleteForm allows deleting forms
+	PermissionDeleteForm Permission = "form:delete"
+	// PermissionViewSubmissions allows reading submissions
+	PermissionViewSubmissions Permission = "submissions:view"
+	// PermissionManageSubmissions allows deleting submissions and changing their status in bulk
+	PermissionManageSubmissions Permission = "submissions:manage"
+	// PermissionReviewSubmissions allows triaging submissions: review status, assignment, tags and notes
+	PermissionReviewSubmissions Permission = "submissions:review"
+	// PermissionViewAudit allows reading the audit log
+	PermissionViewAudit Permission = "audit:view"
+	// PermissionManageMembers allows adding, removing and changing member roles
+	PermissionManageMembers Permission = "members:manage"
+	// PermissionManageAPIKeys allows creating, listing and revoking API keys
+	PermissionManageAPIKeys Permission = "api_keys:manage"
+)
+
+// rolePermissions maps each role to the permissions it grants
+var rolePermissions = map[Role][]Permission{
+	RoleOwner: {
+		PermissionViewWorkspace, PermissionViewForm, PermissionCreateForm, PermissionEditForm, PermissionDeleteForm,
+		PermissionViewSubmissions, PermissionManageSubmissions, PermissionReviewSubmissions, PermissionViewAudit,
+		PermissionManageMembers, PermissionManageAPIKeys,
+	},
+	RoleEditor: {
+		PermissionViewWorkspace, PermissionViewForm, PermissionCreateForm, PermissionEditForm, PermissionDeleteForm,
+		PermissionViewSubmissions, PermissionManageSubmissions, PermissionReviewSubmissions, PermissionViewAudit,
+		PermissionManageAPIKeys,
+	},
+	RoleViewer:          {PermissionViewWorkspace, PermissionViewForm},
+	RoleSubmissionsOnly: {PermissionViewWorkspace, PermissionViewSubmissions, PermissionReviewSubmissions},
+}
+
+// IsValid reports whether the role is known
+func (r Role) IsValid() bool {
+	_, ok := rolePermissions[r]
+
+	return ok
+}
+
+// Can reports whether the role grants the permission
+func (r Role) Can(permission Permission) bool {
+	for _, p := range rolePermissions[r] {
+		if p == permission {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Workspace is a team that owns forms
+type Workspace struct {
+	ID        string         `gorm:"column:uuid;primaryKey;type:uuid" json:"id"`
+	Name      string         `gorm:"not null;size:100"                json:"name"`
+	CreatedBy string         `gorm:"not null;size:255"                json:"created_by"`
+	PlanTier  string         `gorm:"not null;size:20;default:free"    json:"plan_tier"`
+	CreatedAt time.Time      `gorm:"not null;autoCreateTime"          json:"created_at"`
+	UpdatedAt time.Time      `gorm:"not null;autoUpdateTime"          json:"updated_at"`
+	DeletedAt gorm.DeletedAt `gorm:"index"                            json:"-"`
+}
+
+// TableName specifies the table name for the Workspace model
+func (Workspace) TableName() string {
+	return "workspaces"
+}
+
+// BeforeCreate is a GORM hook that generates a UUID before inserting a new workspace
+func (w *Workspace) BeforeCreate(_ *gorm.DB) error {
+	if w.ID == "" {
+		w.ID = uuid.New().String()
+	}
+
+	return nil
+}
+
+// Validate validates the workspace
+func (w *Workspace) Validate() error {
+	if strings.TrimSpace(w.Name) == "" {
+		return ErrNameRequired
+	}
+
+	return nil
+}
+
+// Member is a user's membership in a workspace
+type Member struct {
+	WorkspaceID string    `gorm:"primaryKey;size:36"      json:"workspace_id"`
+	UserID      string    `gorm:"primaryKey;size:255"     json:"user_id"`
+	Role        Role      `gorm:"not null;size:32"        json:"role"`
+	CreatedAt   time.Time `gorm:"not null;autoCreateTime" json:"created_at"`
+	UpdatedAt   time.Time `gorm:"not null;autoUpdateTime" json:"updated_at"`
+}
+
+// TableName specifies the table name for the Member model
+func (Member) TableName() string {
+	return "workspace_members"
+}
+
+// Can reports whether the member's role grants the permission
+func (m *Member) Can(permission Permission) bool {
+	return m != nil && m.Role.Can(permission)
+}
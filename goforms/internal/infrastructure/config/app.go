@@ -24,6 +24,30 @@ type AppConfig struct {
 	WriteTimeout   time.Duration `json:"write_timeout"`
 	IdleTimeout    time.Duration `json:"idle_timeout"`
 	RequestTimeout time.Duration `json:"request_timeout"`
+
+	Idempotency IdempotencyConfig `json:"idempotency"`
+	Stream      StreamConfig      `json:"stream"`
+
+	// OpenAPIValidation checks requests and responses against the OpenAPI document; development only
+	OpenAPIValidation bool `json:"openapi_validation"`
+}
+
+// IdempotencyConfig configures how Idempotency-Key responses are stored for replay
+type IdempotencyConfig struct {
+	Store string        `json:"store"` // database, memory
+	TTL   time.Duration `json:"ttl"`   // How long a stored response can be replayed
+}
+
+// StreamConfig configures the live submission streams served as server-sent events
+type StreamConfig struct {
+	// MaxConnectionsPerUser caps the streams one user has open at once, across forms
+	MaxConnectionsPerUser int `json:"max_connections_per_user"`
+	// ReplaySize is how many recent events of each form are kept for clients resuming with Last-Event-ID
+	ReplaySize int `json:"replay_size"`
+	// Heartbeat is how often an idle stream sends a comment so proxies keep it open
+	Heartbeat time.Duration `json:"heartbeat"`
+	// AnalyticsInterval is how often analytics counts collected since the last tick are sent
+	AnalyticsInterval time.Duration `json:"analytics_interval"`
 }
 
 // IsDevelopment returns true if the application is running in development mode
@@ -65,6 +89,20 @@ func (c *AppConfig) Validate() error {
 		errs = append(errs, "idle timeout must be positive")
 	}
 
+	switch c.Idempotency.Store {
+	case "", "database", "memory":
+	default:
+		errs = append(errs, fmt.Sprintf("unsupported idempotency store %q", c.Idempotency.Store))
+	}
+
+	if c.Idempotency.TTL < 0 {
+		errs = append(errs, "idempotency TTL must not be negative")
+	}
+
+	if c.Stream.MaxConnectionsPerUser < 0 || c.Stream.ReplaySize < 0 || c.Stream.Heartbeat < 0 || c.Stream.AnalyticsInterval < 0 {
+		errs = append(errs, "stream limits and intervals must not be negative")
+	}
+
 	if len(errs) > 0 {
 		return fmt.Errorf("app config validation errors: %s", strings.Join(errs, "; "))
 	}
@@ -2,6 +2,7 @@ package config_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -60,6 +61,30 @@ func TestAppConfig_Validate(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "unsupported idempotency store",
+			appConfig: config.AppConfig{
+				Name:         "Test App",
+				Port:         8080,
+				ReadTimeout:  5,
+				WriteTimeout: 5,
+				IdleTimeout:  5,
+				Idempotency:  config.IdempotencyConfig{Store: "redis"},
+			},
+			expectError: true,
+		},
+		{
+			name: "negative stream limit",
+			appConfig: config.AppConfig{
+				Name:         "Test App",
+				Port:         8080,
+				ReadTimeout:  5,
+				WriteTimeout: 5,
+				IdleTimeout:  5,
+				Stream:       config.StreamConfig{MaxConnectionsPerUser: -1},
+			},
+			expectError: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -142,3 +167,27 @@ func TestAppConfig_IsDevelopment(t *testing.T) {
 		})
 	}
 }
+
+func TestLoad_StreamSettings(t *testing.T) {
+	cfg, err := config.NewViperConfig().LoadUnvalidated()
+	require.NoError(t, err)
+
+	assert.Equal(t, config.StreamConfig{
+		MaxConnectionsPerUser: config.DefaultStreamMaxConnectionsPerUser,
+		ReplaySize:            config.DefaultStreamReplaySize,
+		Heartbeat:             config.DefaultStreamHeartbeat,
+		AnalyticsInterval:     config.DefaultStreamAnalyticsInterval,
+	}, cfg.App.Stream)
+
+	t.Setenv("GOFORMS_STREAM_MAX_CONNECTIONS_PER_USER", "2")
+	t.Setenv("GOFORMS_STREAM_REPLAY_SIZE", "64")
+	t.Setenv("GOFORMS_STREAM_HEARTBEAT", "30s")
+	t.Setenv("GOFORMS_STREAM_ANALYTICS_INTERVAL", "1m")
+
+	cfg, err = config.NewViperConfig().LoadUnvalidated()
+	require.NoError(t, err)
+
+	assert.Equal(t, config.StreamConfig{
+		MaxConnectionsPerUser: 2, ReplaySize: 64, Heartbeat: 30 * time.Second, AnalyticsInterval: time.Minute,
+	}, cfg.App.Stream)
+}
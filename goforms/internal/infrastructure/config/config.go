@@ -14,6 +14,7 @@ type Config struct {
 	Database DatabaseConfig `json:"database"`
 	Security SecurityConfig `json:"security"`
 	Session  SessionConfig  `json:"session"`
+	Events   EventsConfig   `json:"events"`
 }
 
 // validateConfig validates the configuration
@@ -65,7 +66,11 @@ func (c *Config) validateCoreConfig() error {
 
 // validateConditionalConfig validates configuration sections that depend on other settings
 func (c *Config) validateConditionalConfig() error {
-	return c.validateSessionConfig()
+	if err := c.validateSessionConfig(); err != nil {
+		return err
+	}
+
+	return c.validateEventsConfig()
 }
 
 // validateSessionConfig validates session configuration
@@ -74,9 +79,42 @@ func (c *Config) validateSessionConfig() error {
 		return errors.New("session secret is required when session type is not 'none'")
 	}
 
+	if c.Session.Store == SessionStoreRedis && c.Session.Redis.Addr == "" {
+		return errors.New("session redis address is required for the redis session store")
+	}
+
 	return nil
 }
 
+// validateEventsConfig validates the event bus configuration; an unset backend is the memory bus
+func (c *Config) validateEventsConfig() error {
+	switch c.Events.Backend {
+	case "", EventBackendMemory:
+		switch c.Events.Memory.Backpressure {
+		case "", EventsBackpressureBlock, EventsBackpressureDropOldest, EventsBackpressureReject:
+		default:
+			return fmt.Errorf("unsupported event bus backpressure %q", c.Events.Memory.Backpressure)
+		}
+	case EventBackendNATS:
+		if c.Events.NATS.URL == "" {
+			return errors.New("events nats url is required for the nats event bus")
+		}
+	case EventBackendRedis:
+		if c.Events.Redis.Addr == "" {
+			return errors.New("events redis address is required for the redis event bus")
+		}
+	default:
+		return fmt.Errorf("unsupported event bus backend %q", c.Events.Backend)
+	}
+
+	switch c.Events.Encoding {
+	case "", EventsEncodingJSON, EventsEncodingProtobuf:
+		return nil
+	default:
+		return fmt.Errorf("unsupported event encoding %q", c.Events.Encoding)
+	}
+}
+
 // GetConfigSummary returns a summary of the current configuration
 func (c *Config) GetConfigSummary() map[string]any {
 	return map[string]any{
@@ -99,11 +137,17 @@ func (c *Config) GetConfigSummary() map[string]any {
 			"csp_enabled":        c.Security.CSP.Enabled,
 		},
 		"services": map[string]any{
-			"session_type": c.Session.Type,
+			"session_type":   c.Session.Type,
+			"events_backend": c.Events.Backend,
 		},
 	}
 }
 
+// Validate returns the validation errors that prevent the application from starting
+func (c *Config) Validate() error {
+	return c.validateConfig()
+}
+
 // IsValid checks if the configuration is valid
 func (c *Config) IsValid() bool {
 	return c.validateConfig() == nil
@@ -141,6 +141,48 @@ func TestConfig_Validate(t *testing.T) {
 			}(),
 			expectError: true,
 		},
+		{
+			name: "rotating keys without legacy secret",
+			config: func() *config.Config {
+				cfg := createValidConfig()
+				cfg.Security.Assertion.Secret = ""
+				cfg.Security.Assertion.Keys = []config.AssertionKey{
+					{ID: "2026-10", Secret: "rotating-assertion-secret-long-enough-123456"},
+				}
+				return cfg
+			}(),
+			expectError: false,
+		},
+		{
+			name: "duplicate assertion key id",
+			config: func() *config.Config {
+				cfg := createValidConfig()
+				cfg.Security.Assertion.Keys = []config.AssertionKey{
+					{ID: "k1", Secret: "rotating-assertion-secret-long-enough-123456"},
+					{ID: "k1", Secret: "another-assertion-secret-long-enough-1234567"},
+				}
+				return cfg
+			}(),
+			expectError: true,
+		},
+		{
+			name: "too short assertion key secret",
+			config: func() *config.Config {
+				cfg := createValidConfig()
+				cfg.Security.Assertion.Keys = []config.AssertionKey{{ID: "k1", Secret: "short"}}
+				return cfg
+			}(),
+			expectError: true,
+		},
+		{
+			name: "redis nonce store without address",
+			config: func() *config.Config {
+				cfg := createValidConfig()
+				cfg.Security.Assertion.Nonce.Store = "redis"
+				return cfg
+			}(),
+			expectError: true,
+		},
 		{
 			name: "session config without secret",
 			config: &config.Config{
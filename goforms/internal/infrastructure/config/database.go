@@ -7,6 +7,14 @@ import (
 	"time"
 )
 
+// Supported database drivers
+const (
+	DriverPostgres = "postgres"
+	DriverMariaDB  = "mariadb"
+	// DriverSQLite stores the database in a local file, for development and tests
+	DriverSQLite = "sqlite"
+)
+
 // DatabaseConfig holds all database-related configuration
 type DatabaseConfig struct {
 	// Common database settings
@@ -27,6 +35,9 @@ type DatabaseConfig struct {
 	// MariaDB specific settings
 	RootPassword string `json:"root_password"`
 
+	// SQLite specific settings: Path is the database file, created if it does not exist
+	Path string `json:"path"`
+
 	// Logging configuration
 	Logging DatabaseLoggingConfig `json:"logging"`
 }
@@ -48,9 +59,11 @@ type DatabaseLoggingConfig struct {
 func (c *DatabaseConfig) Validate() error {
 	var errs []string
 
-	// Validate common fields
-	if err := c.validateCommonFields(); err != nil {
-		errs = append(errs, err.Error())
+	// SQLite needs no server, so the connection fields do not apply to it
+	if c.Driver != DriverSQLite {
+		if err := c.validateCommonFields(); err != nil {
+			errs = append(errs, err.Error())
+		}
 	}
 
 	// Validate driver-specific fields
@@ -99,10 +112,12 @@ func (c *DatabaseConfig) validateCommonFields() error {
 // validateDriverSpecificFields validates driver-specific configuration fields
 func (c *DatabaseConfig) validateDriverSpecificFields() error {
 	switch c.Driver {
-	case "postgres":
+	case DriverPostgres:
 		return c.validatePostgresFields()
-	case "mariadb":
+	case DriverMariaDB:
 		return c.validateMariaDBFields()
+	case DriverSQLite:
+		return c.validateSQLiteFields()
 	default:
 		return errors.New("unsupported database driver type")
 	}
@@ -125,3 +140,17 @@ func (c *DatabaseConfig) validateMariaDBFields() error {
 
 	return nil
 }
+
+// validateSQLiteFields validates SQLite-specific fields
+func (c *DatabaseConfig) validateSQLiteFields() error {
+	if c.Path == "" {
+		return errors.New("SQLite database path is required")
+	}
+
+	return nil
+}
+
+// IsSQLite reports whether the database is a local SQLite file
+func (c *DatabaseConfig) IsSQLite() bool {
+	return c.Driver == DriverSQLite
+}
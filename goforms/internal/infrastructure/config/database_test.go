@@ -78,6 +78,21 @@ func TestDatabaseConfig_Validate(t *testing.T) {
 			},
 			expectError: true,
 		},
+		{
+			name: "sqlite needs only a path",
+			dbConfig: config.DatabaseConfig{
+				Driver: config.DriverSQLite,
+				Path:   "goforms.db",
+			},
+			expectError: false,
+		},
+		{
+			name: "sqlite without a path",
+			dbConfig: config.DatabaseConfig{
+				Driver: config.DriverSQLite,
+			},
+			expectError: true,
+		},
 		{
 			name: "empty database name",
 			dbConfig: config.DatabaseConfig{
@@ -22,6 +22,15 @@ const (
 	DefaultSessionMaxAge  = 24 * time.Hour
 	DefaultAuthTimeout    = 30 * time.Minute
 	DefaultLockoutTime    = 15 * time.Minute
+	DefaultIdempotencyTTL = 24 * time.Hour
+)
+
+// Default live submission stream settings
+const (
+	DefaultStreamMaxConnectionsPerUser = 5
+	DefaultStreamReplaySize            = 256
+	DefaultStreamHeartbeat             = 15 * time.Second
+	DefaultStreamAnalyticsInterval     = 5 * time.Second
 )
 
 // Default connection pool settings
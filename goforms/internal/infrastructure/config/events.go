@@ -0,0 +1,134 @@
+package config
+
+import (
+	"time"
+)
+
+// Event buses selected with events.backend
+const (
+	// EventBackendMemory delivers events to subscribers in the same process; they are lost on restart
+	EventBackendMemory = "memory"
+	// EventBackendNATS publishes events to a NATS JetStream stream
+	EventBackendNATS = "nats"
+	// EventBackendRedis publishes events to Redis streams
+	EventBackendRedis = "redis"
+)
+
+// Backpressure policies of the memory event bus, applied when a queue is full
+const (
+	// EventsBackpressureBlock makes publishers wait for room in the queue
+	EventsBackpressureBlock = "block"
+	// EventsBackpressureDropOldest discards the oldest queued event to make room
+	EventsBackpressureDropOldest = "drop_oldest"
+	// EventsBackpressureReject fails the publish
+	EventsBackpressureReject = "reject"
+)
+
+// Event formats selected with events.encoding; both follow CloudEvents 1.0
+const (
+	// EventsEncodingJSON publishes events in the CloudEvents JSON format
+	EventsEncodingJSON = "json"
+	// EventsEncodingProtobuf publishes events in the CloudEvents protobuf format
+	EventsEncodingProtobuf = "protobuf"
+)
+
+// Default event bus settings
+const (
+	DefaultEventsTopicPrefix = "goforms.events."
+	DefaultEventsGroup       = "goforms"
+	DefaultEventsAckWait     = 30 * time.Second
+	DefaultEventsMaxDeliver  = 5
+	DefaultEventsNATSStream  = "GOFORMS_EVENTS"
+	DefaultEventsRedisMaxLen = 100000
+
+	DefaultEventsMemoryWorkers      = 4
+	DefaultEventsMemoryQueueSize    = 1024
+	DefaultEventsMemoryBlockTimeout = 5 * time.Second
+)
+
+// EventsConfig selects the event bus and how events map onto broker topics
+type EventsConfig struct {
+	Backend string `json:"backend"` // memory, nats or redis
+
+	// Source identifies this service in the envelope of the events it publishes
+	Source string `json:"source"`
+	// Encoding is the format of published events, json or protobuf; consumers read either
+	Encoding string `json:"encoding"`
+	// Group is the durable consumer group; replicas sharing a group each receive an event once
+	Group string `json:"group"`
+	// TopicPrefix is prepended to event types without an entry in Topics
+	TopicPrefix string `json:"topic_prefix"`
+	// Topics maps event types, such as form.submitted, to a NATS subject or Redis stream
+	Topics map[string]string `json:"topics"`
+	// AckWait is how long a handler has before its event is delivered again
+	AckWait time.Duration `json:"ack_wait"`
+	// MaxDeliver caps deliveries of an event whose handlers keep failing
+	MaxDeliver int `json:"max_deliver"`
+
+	Memory EventsMemoryConfig `json:"memory"`
+	NATS   EventsNATSConfig   `json:"nats"`
+	Redis  EventsRedisConfig  `json:"redis"`
+}
+
+// EventsMemoryConfig sizes the queues and workers of the memory event bus. Each event type has
+// its own queue, so a slow handler only holds up events of its type.
+type EventsMemoryConfig struct {
+	// Workers is how many events of one type are handled at once
+	Workers int `json:"workers"`
+	// QueueSize is how many events of one type wait for a worker
+	QueueSize int `json:"queue_size"`
+	// Backpressure is block, drop_oldest or reject
+	Backpressure string `json:"backpressure"`
+	// BlockTimeout is the longest the block policy waits for room before failing the publish
+	BlockTimeout time.Duration `json:"block_timeout"`
+	// Queues overrides Workers and QueueSize for individual event types
+	Queues map[string]EventsQueueConfig `json:"queues"`
+}
+
+// EventsQueueConfig sizes the queue of one event type; zero values take the bus-wide setting
+type EventsQueueConfig struct {
+	Workers   int `json:"workers"`
+	QueueSize int `json:"queue_size"`
+}
+
+// Queue returns the worker count and queue size for eventType
+func (c *EventsMemoryConfig) Queue(eventType string) EventsQueueConfig {
+	queue := c.Queues[eventType]
+
+	if queue.Workers <= 0 {
+		queue.Workers = c.Workers
+	}
+
+	if queue.QueueSize <= 0 {
+		queue.QueueSize = c.QueueSize
+	}
+
+	return queue
+}
+
+// EventsNATSConfig locates the NATS server and the JetStream stream holding events
+type EventsNATSConfig struct {
+	URL      string `json:"url"`
+	Token    string `json:"token"`
+	User     string `json:"user"`
+	Password string `json:"password"`
+	Stream   string `json:"stream"`
+}
+
+// EventsRedisConfig locates the Redis server holding event streams
+type EventsRedisConfig struct {
+	Addr     string `json:"addr"`
+	Password string `json:"password"`
+	DB       int    `json:"db"`
+	// MaxLen approximately caps each stream; zero keeps every event
+	MaxLen int64 `json:"max_len"`
+}
+
+// Topic returns the topic events of eventType are published to
+func (c *EventsConfig) Topic(eventType string) string {
+	if topic, ok := c.Topics[eventType]; ok && topic != "" {
+		return topic
+	}
+
+	return c.TopicPrefix + eventType
+}
@@ -0,0 +1,141 @@
+package config_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goformx/goforms/internal/infrastructure/config"
+)
+
+func TestLoad_EventsDefaults(t *testing.T) {
+	cfg, err := config.NewViperConfig().LoadUnvalidated()
+	require.NoError(t, err)
+
+	assert.Equal(t, config.EventBackendMemory, cfg.Events.Backend)
+	assert.Equal(t, config.DefaultEventsGroup, cfg.Events.Group)
+	assert.Equal(t, config.EventsEncodingJSON, cfg.Events.Encoding)
+	assert.Equal(t, config.DefaultEventsAckWait, cfg.Events.AckWait)
+	assert.Equal(t, config.DefaultEventsMaxDeliver, cfg.Events.MaxDeliver)
+	assert.Equal(t, "goforms.events.form.submitted", cfg.Events.Topic("form.submitted"))
+	assert.Equal(t, config.EventsBackpressureBlock, cfg.Events.Memory.Backpressure)
+	assert.Equal(t, config.EventsQueueConfig{
+		Workers: config.DefaultEventsMemoryWorkers, QueueSize: config.DefaultEventsMemoryQueueSize,
+	}, cfg.Events.Memory.Queue("form.submitted"))
+}
+
+func TestLoad_EventsMemoryQueues(t *testing.T) {
+	t.Setenv("GOFORMS_EVENTS_MEMORY_WORKERS", "2")
+	t.Setenv("GOFORMS_EVENTS_MEMORY_BACKPRESSURE", "drop_oldest")
+	t.Setenv("GOFORMS_EVENTS_MEMORY_QUEUES", "form.submitted=8:4096,form.deleted=:16")
+
+	cfg, err := config.NewViperConfig().LoadUnvalidated()
+	require.NoError(t, err)
+
+	memory := cfg.Events.Memory
+	assert.Equal(t, config.EventsBackpressureDropOldest, memory.Backpressure)
+	assert.Equal(t, config.EventsQueueConfig{Workers: 8, QueueSize: 4096}, memory.Queue("form.submitted"))
+	assert.Equal(t, config.EventsQueueConfig{Workers: 2, QueueSize: 16}, memory.Queue("form.deleted"))
+	assert.Equal(t, config.EventsQueueConfig{Workers: 2, QueueSize: config.DefaultEventsMemoryQueueSize}, memory.Queue("form.created"))
+
+	t.Setenv("GOFORMS_EVENTS_MEMORY_QUEUES", "form.submitted=many")
+
+	_, err = config.NewViperConfig().LoadUnvalidated()
+	require.ErrorContains(t, err, "want workers:queue_size")
+}
+
+func TestLoad_EventsFromEnvironment(t *testing.T) {
+	t.Setenv("GOFORMS_EVENTS_BACKEND", "nats")
+	t.Setenv("GOFORMS_EVENTS_NATS_URL", "nats://nats:4222")
+	t.Setenv("GOFORMS_EVENTS_GROUP", "webhooks")
+	t.Setenv("GOFORMS_EVENTS_ENCODING", "protobuf")
+	t.Setenv("GOFORMS_EVENTS_ACK_WAIT", "10s")
+	t.Setenv("GOFORMS_EVENTS_MAX_DELIVER", "8")
+	t.Setenv("GOFORMS_EVENTS_TOPICS", "form.submitted=submissions, form.deleted = forms.deleted")
+	t.Setenv("GOFORMS_EVENTS_NATS_TOKEN_FILE", writeSecretFile(t, "nats_token", "nats-token-from-file"))
+
+	cfg, err := config.NewViperConfig().LoadUnvalidated()
+	require.NoError(t, err)
+
+	assert.Equal(t, config.EventBackendNATS, cfg.Events.Backend)
+	assert.Equal(t, "nats://nats:4222", cfg.Events.NATS.URL)
+	assert.Equal(t, "nats-token-from-file", cfg.Events.NATS.Token)
+	assert.Equal(t, "webhooks", cfg.Events.Group)
+	assert.Equal(t, config.EventsEncodingProtobuf, cfg.Events.Encoding)
+	assert.Equal(t, 10*time.Second, cfg.Events.AckWait)
+	assert.Equal(t, 8, cfg.Events.MaxDeliver)
+	assert.Equal(t, "submissions", cfg.Events.Topic("form.submitted"))
+	assert.Equal(t, "forms.deleted", cfg.Events.Topic("form.deleted"))
+	assert.Equal(t, "goforms.events.form.updated", cfg.Events.Topic("form.updated"))
+}
+
+func TestValidateConfig_Events(t *testing.T) {
+	tests := []struct {
+		name   string
+		events config.EventsConfig
+		field  string
+	}{
+		{name: "memory needs nothing", events: config.EventsConfig{Backend: config.EventBackendMemory}},
+		{
+			name:   "nats needs a url",
+			events: config.EventsConfig{Backend: config.EventBackendNATS, AckWait: time.Second, MaxDeliver: 1},
+			field:  "events.nats.url",
+		},
+		{
+			name:   "redis needs an address",
+			events: config.EventsConfig{Backend: config.EventBackendRedis, AckWait: time.Second, MaxDeliver: 1},
+			field:  "events.redis.addr",
+		},
+		{
+			name: "redelivery needs an ack wait",
+			events: config.EventsConfig{
+				Backend: config.EventBackendRedis, Redis: config.EventsRedisConfig{Addr: "redis:6379"}, MaxDeliver: 1,
+			},
+			field: "events.ack_wait",
+		},
+		{name: "unknown backend", events: config.EventsConfig{Backend: "kafka"}, field: "events.backend"},
+		{
+			name: "unknown encoding",
+			events: config.EventsConfig{
+				Backend: config.EventBackendNATS, NATS: config.EventsNATSConfig{URL: "nats://nats:4222"},
+				Encoding: "avro", AckWait: time.Second, MaxDeliver: 1,
+			},
+			field: "events.encoding",
+		},
+		{
+			name:   "unknown backpressure",
+			events: config.EventsConfig{Memory: config.EventsMemoryConfig{Backpressure: "drop_newest"}},
+			field:  "events.memory.backpressure",
+		},
+		{
+			name:   "negative block timeout",
+			events: config.EventsConfig{Memory: config.EventsMemoryConfig{BlockTimeout: -time.Second}},
+			field:  "events.memory.block_timeout",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := config.ValidateConfig(&config.Config{Events: tt.events})
+
+			// Other sections of the empty config are invalid too; only the events errors matter here
+			var fields []string
+			for _, validationErr := range result.Errors {
+				if strings.HasPrefix(validationErr.Field, "events.") {
+					fields = append(fields, validationErr.Field)
+				}
+			}
+
+			if tt.field == "" {
+				assert.Empty(t, fields)
+
+				return
+			}
+
+			assert.Equal(t, []string{tt.field}, fields)
+		})
+	}
+}
@@ -13,6 +13,7 @@ var Module = fx.Module("config",
 	fx.Provide(NewDatabaseConfig),
 	fx.Provide(NewSecurityConfig),
 	fx.Provide(NewSessionConfig),
+	fx.Provide(NewSecurityPolicy),
 )
 
 // Individual config providers for fine-grained dependency injection
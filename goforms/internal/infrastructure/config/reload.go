@@ -0,0 +1,210 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"go.uber.org/fx"
+
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+)
+
+// reloadDebounce lets editors and config map updates finish writing before the file is read
+const reloadDebounce = 250 * time.Millisecond
+
+// Reload triggers recorded in logs
+const (
+	reloadTriggerFile   = "file"
+	reloadTriggerSignal = "sighup"
+)
+
+// ReloadModule watches the config file and SIGHUP while the server runs, reloading the security
+// policy on either. The CLI leaves it out so commands never install the watchers.
+var ReloadModule = fx.Module("config-reload",
+	fx.Provide(NewReloader),
+	fx.Invoke(func(lc fx.Lifecycle, r *Reloader) {
+		lc.Append(fx.Hook{
+			OnStart: func(_ context.Context) error { return r.Start() },
+			OnStop:  func(_ context.Context) error { return r.Stop() },
+		})
+	}),
+)
+
+// Reloader re-reads the configuration and swaps the reloadable security sections into the policy.
+// A configuration that fails validation is rejected and the policy keeps its current value.
+type Reloader struct {
+	source *ViperConfig
+	policy *SecurityPolicy
+	logger logging.Logger
+
+	mu      sync.Mutex
+	watcher *fsnotify.Watcher
+	signals chan os.Signal
+	done    chan struct{}
+	stopped sync.WaitGroup
+}
+
+// NewReloader creates a reloader reading from the source the configuration was loaded from
+func NewReloader(source *ViperConfig, policy *SecurityPolicy, logger logging.Logger) *Reloader {
+	return &Reloader{
+		source: source,
+		policy: policy,
+		logger: logger,
+	}
+}
+
+// Reload re-reads and validates the configuration and applies its reloadable security sections.
+// trigger names what caused the reload in logs.
+func (r *Reloader) Reload(trigger string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	next, err := r.source.LoadUnvalidated()
+	if err != nil {
+		r.logger.Error("configuration reload rejected", "trigger", trigger, "error", err)
+
+		return fmt.Errorf("reload configuration: %w", err)
+	}
+
+	current := r.policy.Current()
+
+	var applied, ignored []string
+
+	for _, change := range DiffSecurity(current, &next.Security) {
+		if isReloadableSetting(strings.TrimPrefix(change.Key, "security.")) {
+			applied = append(applied, change.String())
+		} else {
+			ignored = append(ignored, change.String())
+		}
+	}
+
+	if err = next.validateConfig(); err != nil {
+		r.logger.Error("configuration reload rejected",
+			"trigger", trigger, "error", err, "changes", applied, "restart_required", ignored)
+
+		return fmt.Errorf("reload configuration: %w", err)
+	}
+
+	if len(ignored) > 0 {
+		r.logger.Warn("configuration changes need a restart to take effect", "trigger", trigger, "changes", ignored)
+	}
+
+	if len(applied) == 0 {
+		r.logger.Info("configuration reloaded without security policy changes", "trigger", trigger)
+
+		return nil
+	}
+
+	r.policy.store(current.withReloadable(&next.Security))
+	r.logger.Info("security policy reloaded", "trigger", trigger, "changes", applied)
+
+	return nil
+}
+
+// Start reloads on SIGHUP and, when the configuration came from a file, whenever the file changes
+func (r *Reloader) Start() error {
+	r.done = make(chan struct{})
+	r.signals = make(chan os.Signal, 1)
+	signal.Notify(r.signals, syscall.SIGHUP)
+
+	var (
+		events <-chan fsnotify.Event
+		errs   <-chan error
+	)
+
+	if path := r.source.GetConfigFilePath(); path != "" {
+		watcher, err := fsnotify.NewWatcher()
+		if err != nil {
+			signal.Stop(r.signals)
+
+			return fmt.Errorf("watch config file: %w", err)
+		}
+
+		// Watch the directory rather than the file, so replacing the file by rename is seen too
+		if err = watcher.Add(filepath.Dir(path)); err != nil {
+			signal.Stop(r.signals)
+			_ = watcher.Close()
+
+			return fmt.Errorf("watch config file: %w", err)
+		}
+
+		r.watcher = watcher
+		events, errs = watcher.Events, watcher.Errors
+	}
+
+	r.stopped.Add(1)
+
+	go r.run(events, errs)
+
+	r.logger.Info("configuration reload enabled",
+		"config_file", r.source.GetConfigFilePath(), "reloadable", ReloadableSecuritySections)
+
+	return nil
+}
+
+// Stop stops watching for reload triggers
+func (r *Reloader) Stop() error {
+	if r.done == nil {
+		return nil
+	}
+
+	signal.Stop(r.signals)
+	close(r.done)
+	r.stopped.Wait()
+
+	if r.watcher != nil {
+		if err := r.watcher.Close(); err != nil {
+			return fmt.Errorf("stop watching config file: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// run reloads on each trigger until stopped. File events are debounced, since saving a file
+// usually produces several.
+func (r *Reloader) run(events <-chan fsnotify.Event, errs <-chan error) {
+	defer r.stopped.Done()
+
+	debounce := time.NewTimer(reloadDebounce)
+	debounce.Stop()
+
+	for {
+		select {
+		case <-r.done:
+			debounce.Stop()
+
+			return
+		case <-r.signals:
+			_ = r.Reload(reloadTriggerSignal)
+		case event := <-events:
+			if r.isConfigFileEvent(event) {
+				debounce.Reset(reloadDebounce)
+			}
+		case err := <-errs:
+			r.logger.Warn("config file watch error", "error", err)
+		case <-debounce.C:
+			_ = r.Reload(reloadTriggerFile)
+		}
+	}
+}
+
+// isConfigFileEvent reports whether a change in the watched directory may have changed the config
+// file. Kubernetes updates mounted config maps by swapping the ..data symlink.
+func (r *Reloader) isConfigFileEvent(event fsnotify.Event) bool {
+	if !event.Has(fsnotify.Write | fsnotify.Create | fsnotify.Rename) {
+		return false
+	}
+
+	name := filepath.Base(event.Name)
+
+	return name == filepath.Base(r.source.GetConfigFilePath()) || name == "..data"
+}
@@ -0,0 +1,205 @@
+package config_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"go.uber.org/zap/zaptest/observer"
+
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+	"github.com/goformx/goforms/internal/infrastructure/sanitization"
+)
+
+const reloadTestConfig = `
+app:
+  environment: test
+database:
+  password: database-password
+security:
+  csrf:
+    secret: csrf-secret-that-is-at-least-32-characters
+  assertion:
+    secret: %ASSERTION%
+  cors:
+    allowed_origins: [%ORIGINS%]
+  rate_limit:
+    burst: 20
+session:
+  secret: session-secret-that-is-at-least-32-characters
+`
+
+// writeReloadConfig writes a config file with the given assertion secret and CORS origins
+func writeReloadConfig(t *testing.T, path, assertionSecret, origins string) {
+	t.Helper()
+
+	doc := strings.NewReplacer("%ASSERTION%", assertionSecret, "%ORIGINS%", origins).Replace(reloadTestConfig)
+	require.NoError(t, os.WriteFile(path, []byte(doc), 0o600))
+}
+
+// newReloader loads the config file and returns its security policy, a reloader and the reloader's logs
+func newReloader(t *testing.T, path string) (*config.SecurityPolicy, *config.Reloader, *observer.ObservedLogs) {
+	t.Helper()
+
+	source := config.NewViperConfig()
+	source.SetConfigFile(path)
+
+	cfg, err := source.Load()
+	require.NoError(t, err)
+
+	core, logs := observer.New(zap.DebugLevel)
+
+	factory, err := logging.NewFactory(&logging.FactoryConfig{AppName: "goforms-test", LogLevel: "debug"},
+		sanitization.NewService())
+	require.NoError(t, err)
+
+	logger, err := factory.WithTestCore(core).CreateLogger()
+	require.NoError(t, err)
+
+	policy := config.NewSecurityPolicy(cfg)
+
+	return policy, config.NewReloader(source, policy, logger), logs
+}
+
+func TestReloader_AppliesReloadableSections(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeReloadConfig(t, path, "first-assertion-secret-of-32-characters!", "https://one.example")
+
+	policy, reloader, logs := newReloader(t, path)
+	before := policy.Current()
+
+	writeReloadConfig(t, path, "second-assertion-secret-of-32-characters", "https://one.example, https://two.example")
+	require.NoError(t, reloader.Reload("test"))
+
+	after := policy.Current()
+	assert.Equal(t, []string{"https://one.example", "https://two.example"}, after.CORS.AllowedOrigins)
+	assert.Equal(t, "second-assertion-secret-of-32-characters", after.Assertion.Secret)
+	assert.Equal(t, []string{"https://one.example"}, before.CORS.AllowedOrigins, "the previous policy is left intact")
+
+	entries := logs.FilterMessage("security policy reloaded").All()
+	require.Len(t, entries, 1)
+
+	changes := fmt.Sprint(entries[0].ContextMap()["changes"])
+	assert.Contains(t, changes, "security.assertion.secret: [REDACTED] -> [REDACTED]")
+	assert.Contains(t, changes,
+		"security.cors.allowed_origins: [https://one.example] -> [https://one.example https://two.example]")
+}
+
+func TestReloader_RejectsInvalidConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeReloadConfig(t, path, "first-assertion-secret-of-32-characters!", "https://one.example")
+
+	policy, reloader, logs := newReloader(t, path)
+	before := policy.Current()
+
+	// A wildcard origin cannot be combined with credentials, which are allowed by default
+	writeReloadConfig(t, path, "second-assertion-secret-of-32-characters", `"*"`)
+	require.Error(t, reloader.Reload("test"))
+
+	assert.Same(t, before, policy.Current())
+
+	entries := logs.FilterMessage("configuration reload rejected").All()
+	require.Len(t, entries, 1)
+	assert.Contains(t, fmt.Sprint(entries[0].ContextMap()["changes"]), "security.cors.allowed_origins")
+	assert.NotContains(t, fmt.Sprint(entries[0].ContextMap()), "second-assertion-secret")
+}
+
+func TestReloader_KeepsSectionsThatNeedRestart(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeReloadConfig(t, path, "first-assertion-secret-of-32-characters!", "https://one.example")
+
+	policy, reloader, logs := newReloader(t, path)
+
+	doc, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	updated := strings.Replace(string(doc),
+		"csrf-secret-that-is-at-least-32-characters", "another-csrf-secret-of-at-least-32-characters", 1)
+	require.NoError(t, os.WriteFile(path, []byte(updated), 0o600))
+	require.NoError(t, reloader.Reload("test"))
+
+	assert.Equal(t, "csrf-secret-that-is-at-least-32-characters", policy.Current().CSRF.Secret)
+	assert.Equal(t, 1, logs.FilterMessage("configuration changes need a restart to take effect").Len())
+}
+
+func TestReloader_WatchesConfigFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	writeReloadConfig(t, path, "first-assertion-secret-of-32-characters!", "https://one.example")
+
+	policy, reloader, _ := newReloader(t, path)
+	require.NoError(t, reloader.Start())
+	t.Cleanup(func() { require.NoError(t, reloader.Stop()) })
+
+	writeReloadConfig(t, path, "first-assertion-secret-of-32-characters!", "https://two.example")
+
+	assert.Eventually(t, func() bool {
+		return slices.Equal(policy.Current().CORS.AllowedOrigins, []string{"https://two.example"})
+	}, 5*time.Second, 20*time.Millisecond)
+}
+
+func TestSecurityConfig_Redacted(t *testing.T) {
+	cfg := config.SecurityConfig{
+		CSRF:       config.CSRFConfig{Secret: "csrf-secret"},
+		Encryption: config.EncryptionConfig{Key: "encryption-key"},
+		Assertion: config.AssertionConfig{
+			Secret: "assertion-secret",
+			Keys:   []config.AssertionKey{{ID: "k1", Secret: "rotating-secret"}},
+			Nonce:  config.AssertionNonceConfig{RedisPassword: ""},
+		},
+		APIKey: config.APIKeyConfig{Keys: []string{"api-key-1"}, HeaderName: "X-API-Key"},
+	}
+
+	redacted := cfg.Redacted()
+
+	assert.Equal(t, "[REDACTED]", redacted.CSRF.Secret)
+	assert.Equal(t, "[REDACTED]", redacted.Encryption.Key)
+	assert.Equal(t, "[REDACTED]", redacted.Assertion.Secret)
+	assert.Equal(t, "k1", redacted.Assertion.Keys[0].ID)
+	assert.Equal(t, "[REDACTED]", redacted.Assertion.Keys[0].Secret)
+	assert.Empty(t, redacted.Assertion.Nonce.RedisPassword, "unset secrets stay visibly unset")
+	assert.Equal(t, []string{"[REDACTED]"}, redacted.APIKey.Keys)
+	assert.Equal(t, "X-API-Key", redacted.APIKey.HeaderName)
+
+	assert.Equal(t, "rotating-secret", cfg.Assertion.Keys[0].Secret, "redacting leaves the original untouched")
+	assert.Equal(t, "api-key-1", cfg.APIKey.Keys[0])
+}
+
+func TestDiffSecurity(t *testing.T) {
+	from := config.SecurityConfig{
+		RateLimit: config.RateLimitConfig{Burst: 10, Window: time.Minute},
+		APIKey:    config.APIKeyConfig{Keys: []string{"old-key"}},
+	}
+
+	to := from
+	to.RateLimit.Window = 2 * time.Minute
+	to.APIKey.Keys = []string{"new-key"}
+
+	assert.Equal(t, []config.ConfigChange{
+		{Key: "security.api_key.keys", From: "[REDACTED]", To: "[REDACTED]"},
+		{Key: "security.rate_limit.window", From: "1m0s", To: "2m0s"},
+	}, config.DiffSecurity(&from, &to))
+
+	assert.Empty(t, config.DiffSecurity(&from, &from))
+
+	// Entries removed from a map are reported as well as added ones
+	from.RateLimit.EndpointLimits = map[string]config.EndpointLimit{"/api/forms": {RPS: 5}}
+	to = from
+	to.RateLimit.EndpointLimits = map[string]config.EndpointLimit{"/forms": {RPS: 5}}
+
+	assert.Equal(t, []config.ConfigChange{
+		{Key: "security.rate_limit.endpoint_limits./api/forms.burst", From: "0"},
+		{Key: "security.rate_limit.endpoint_limits./api/forms.rps", From: "5"},
+		{Key: "security.rate_limit.endpoint_limits./api/forms.window", From: "0s"},
+		{Key: "security.rate_limit.endpoint_limits./forms.burst", To: "0"},
+		{Key: "security.rate_limit.endpoint_limits./forms.rps", To: "5"},
+		{Key: "security.rate_limit.endpoint_limits./forms.window", To: "0s"},
+	}, config.DiffSecurity(&from, &to))
+}
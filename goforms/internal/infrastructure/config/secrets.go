@@ -0,0 +1,290 @@
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// secretLookupTimeout bounds how long a provider may take to resolve one secret
+const secretLookupTimeout = 10 * time.Second
+
+// fileEnvSuffix marks an environment variable naming a file that holds a secret, the convention
+// used by Docker and Kubernetes secrets, as in DB_PASSWORD_FILE=/run/secrets/db_password
+const fileEnvSuffix = "_FILE"
+
+// SecretProvider resolves secrets from a store outside the configuration, such as a vault.
+// Keys are dotted config keys like database.password. Providers registered with the
+// secret_providers Fx group are consulted every time the configuration is loaded.
+type SecretProvider interface {
+	// Name identifies the provider in logs
+	Name() string
+	// Secret returns the value stored for key, or found false when the store has none
+	Secret(ctx context.Context, key string) (value string, found bool, err error)
+}
+
+// secretSetting is a setting that holds a secret, the environment variables it is read from,
+// and how a resolved value is applied to the configuration
+type secretSetting struct {
+	key   string
+	envs  []string
+	apply func(cfg *Config, value string) error
+}
+
+// secretSettings lists every secret setting resolved through files and providers
+var secretSettings = []secretSetting{
+	{"database.password", []string{"DB_PASSWORD", "DATABASE_PASSWORD"}, func(cfg *Config, v string) error {
+		cfg.Database.Password = v
+
+		return nil
+	}},
+	{"database.root_password", []string{"DB_ROOT_PASSWORD"}, func(cfg *Config, v string) error {
+		cfg.Database.RootPassword = v
+
+		return nil
+	}},
+	{"session.secret", []string{"SESSION_SECRET"}, func(cfg *Config, v string) error {
+		cfg.Session.Secret = v
+
+		return nil
+	}},
+	{"session.redis.password", []string{"SESSION_REDIS_PASSWORD"}, func(cfg *Config, v string) error {
+		cfg.Session.Redis.Password = v
+
+		return nil
+	}},
+	{"security.csrf.secret", []string{"SECURITY_CSRF_SECRET"}, func(cfg *Config, v string) error {
+		cfg.Security.CSRF.Secret = v
+
+		return nil
+	}},
+	{"security.encryption.key", []string{"SECURITY_ENCRYPTION_KEY"}, func(cfg *Config, v string) error {
+		cfg.Security.Encryption.Key = v
+
+		return nil
+	}},
+	{"security.assertion.secret", []string{"GOFORMS_SHARED_SECRET", "SECURITY_ASSERTION_SECRET"}, func(cfg *Config, v string) error {
+		cfg.Security.Assertion.Secret = v
+
+		return nil
+	}},
+	{"security.assertion.keys", []string{"GOFORMS_ASSERTION_KEYS"}, func(cfg *Config, v string) error {
+		keys, err := parseAssertionKeys([]byte(v))
+		if err != nil {
+			return err
+		}
+
+		cfg.Security.Assertion.Keys = keys
+
+		return nil
+	}},
+	{"security.assertion.nonce.redis_password", []string{"GOFORMS_ASSERTION_NONCE_REDIS_PASSWORD"}, func(cfg *Config, v string) error {
+		cfg.Security.Assertion.Nonce.RedisPassword = v
+
+		return nil
+	}},
+	{"events.nats.token", []string{"GOFORMS_EVENTS_NATS_TOKEN"}, func(cfg *Config, v string) error {
+		cfg.Events.NATS.Token = v
+
+		return nil
+	}},
+	{"events.nats.password", []string{"GOFORMS_EVENTS_NATS_PASSWORD"}, func(cfg *Config, v string) error {
+		cfg.Events.NATS.Password = v
+
+		return nil
+	}},
+	{"events.redis.password", []string{"GOFORMS_EVENTS_REDIS_PASSWORD"}, func(cfg *Config, v string) error {
+		cfg.Events.Redis.Password = v
+
+		return nil
+	}},
+	{"security.api_key.keys", []string{"API_KEYS"}, func(cfg *Config, v string) error {
+		cfg.Security.APIKey.Keys = splitAPIKeys(v)
+
+		return nil
+	}},
+}
+
+// SecretKeys returns the dotted config keys of every setting that may be resolved as a secret
+func SecretKeys() []string {
+	keys := make([]string, len(secretSettings))
+	for i, setting := range secretSettings {
+		keys[i] = setting.key
+	}
+
+	return keys
+}
+
+// IsSecretKey reports whether key is a setting that may be resolved as a secret
+func IsSecretKey(key string) bool {
+	return slices.Contains(SecretKeys(), key)
+}
+
+// AddSecretProvider consults p for secrets on every load. Providers added first take precedence.
+func (vc *ViperConfig) AddSecretProvider(p SecretProvider) {
+	vc.secretProviders = append(vc.secretProviders, p)
+}
+
+// SecretSources names where each secret resolved outside the environment and config files came
+// from, keyed by config key. It never contains secret values.
+func (vc *ViperConfig) SecretSources() map[string]string {
+	return vc.secretSources
+}
+
+// resolveSecrets replaces secret settings with values from *_FILE variables, the providers and the
+// encrypted local secrets file, in that order of precedence. Settings none of them hold keep the
+// value from the environment or config files. Errors name the setting but never the value.
+func (vc *ViperConfig) resolveSecrets(cfg *Config) error {
+	providers := slices.Clone(vc.secretProviders)
+
+	path, masterKey, err := LocalSecretsFromEnv()
+	if err != nil {
+		return err
+	}
+
+	if path != "" {
+		if masterKey == "" {
+			return fmt.Errorf("%s is set but %s is not", EnvSecretsFile, EnvMasterKey)
+		}
+
+		local, openErr := OpenLocalSecretsFile(path, masterKey)
+		if openErr != nil {
+			return openErr
+		}
+
+		providers = append(providers, local)
+	}
+
+	sources := map[string]string{}
+
+	for _, setting := range secretSettings {
+		value, source, found, resolveErr := resolveSecret(setting, providers)
+		if resolveErr != nil {
+			return resolveErr
+		}
+
+		if !found {
+			continue
+		}
+
+		if applyErr := setting.apply(cfg, value); applyErr != nil {
+			return fmt.Errorf("secret %s from %s: %w", setting.key, source, applyErr)
+		}
+
+		sources[setting.key] = source
+	}
+
+	vc.secretSources = sources
+
+	return nil
+}
+
+// resolveSecret looks setting up in its *_FILE variables and then in each provider
+func resolveSecret(setting secretSetting, providers []SecretProvider) (value, source string, found bool, err error) {
+	for _, env := range setting.envs {
+		value, found, err = readSecretFileEnv(env)
+		if err != nil || found {
+			return value, env + fileEnvSuffix, found, err
+		}
+	}
+
+	for _, provider := range providers {
+		ctx, cancel := context.WithTimeout(context.Background(), secretLookupTimeout)
+		value, found, err = provider.Secret(ctx, setting.key)
+
+		cancel()
+
+		if err != nil {
+			return "", "", false, fmt.Errorf("resolve secret %s from %s: %w", setting.key, provider.Name(), err)
+		}
+
+		if found {
+			return value, provider.Name(), true, nil
+		}
+	}
+
+	return "", "", false, nil
+}
+
+// readSecretFileEnv reads the secret in the file named by env's *_FILE variable. Setting both
+// the variable and its *_FILE form is an error, since it is unclear which one is meant.
+func readSecretFileEnv(env string) (string, bool, error) {
+	path := os.Getenv(env + fileEnvSuffix)
+	if path == "" {
+		return "", false, nil
+	}
+
+	if os.Getenv(env) != "" {
+		return "", false, fmt.Errorf("both %s and %s%s are set; use one", env, env, fileEnvSuffix)
+	}
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return "", false, fmt.Errorf("read %s%s: %w", env, fileEnvSuffix, err)
+	}
+
+	// Secret files usually end with a newline that is not part of the secret
+	return strings.TrimRight(string(contents), "\r\n"), true, nil
+}
+
+// lookupSecretEnv returns the secret in env or in the file named by its *_FILE variable
+func lookupSecretEnv(env string) (string, error) {
+	value, found, err := readSecretFileEnv(env)
+	if err != nil || found {
+		return value, err
+	}
+
+	return os.Getenv(env), nil
+}
+
+// parseAssertionKeys decodes a JSON array of rotating assertion keys
+func parseAssertionKeys(raw []byte) ([]AssertionKey, error) {
+	var keys []AssertionKey
+	if err := json.Unmarshal(raw, &keys); err != nil {
+		// Report the shape of the problem only; the payload contains secrets
+		return nil, errors.New("assertion keys must be a list of {id, secret, not_before, not_after} objects")
+	}
+
+	return keys, nil
+}
+
+// splitAPIKeys splits a comma-separated list of API keys, dropping surrounding whitespace
+func splitAPIKeys(value string) []string {
+	keys := strings.Split(value, ",")
+	for i, key := range keys {
+		keys[i] = strings.TrimSpace(key)
+	}
+
+	return keys
+}
+
+// StaticSecretProvider is a SecretProvider serving secrets from memory, for tests and local
+// development in place of an external vault
+type StaticSecretProvider struct {
+	name    string
+	secrets map[string]string
+}
+
+var _ SecretProvider = (*StaticSecretProvider)(nil)
+
+// NewStaticSecretProvider creates a provider serving the given secrets keyed by config key
+func NewStaticSecretProvider(name string, secrets map[string]string) *StaticSecretProvider {
+	return &StaticSecretProvider{name: name, secrets: secrets}
+}
+
+// Name identifies the provider in logs
+func (p *StaticSecretProvider) Name() string {
+	return p.name
+}
+
+// Secret returns the secret stored for key
+func (p *StaticSecretProvider) Secret(_ context.Context, key string) (string, bool, error) {
+	value, found := p.secrets[key]
+
+	return value, found, nil
+}
@@ -0,0 +1,239 @@
+package config
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+)
+
+// Environment variables locating the encrypted local secrets file. The master key may also be
+// read from a file with GOFORMS_MASTER_KEY_FILE.
+const (
+	EnvSecretsFile = "GOFORMS_SECRETS_FILE"
+	EnvMasterKey   = "GOFORMS_MASTER_KEY"
+)
+
+// Encrypted secrets file format
+const (
+	secretsFileVersion = 1
+	secretsFileCipher  = "AES-256-GCM"
+	masterKeySize      = 32
+	secretsFilePerm    = 0o600
+)
+
+// secretsFileAAD binds the ciphertext to this file format
+var secretsFileAAD = []byte("goforms-secrets-v1")
+
+// ErrInvalidMasterKey is returned when the master key is malformed or does not unlock the secrets file
+var ErrInvalidMasterKey = errors.New("invalid master key")
+
+// encryptedSecretsFile is the on-disk form of the encrypted local secrets file. The plaintext is
+// a JSON object of secrets keyed by config key.
+type encryptedSecretsFile struct {
+	Version    int    `json:"version"`
+	Cipher     string `json:"cipher"`
+	Nonce      string `json:"nonce"`
+	Ciphertext string `json:"ciphertext"`
+}
+
+// LocalSecretsFile is a SecretProvider reading an encrypted secrets file unlocked by a master key.
+// It keeps secrets in local development and single-host deployments out of config files.
+type LocalSecretsFile struct {
+	path    string
+	secrets map[string]string
+}
+
+var _ SecretProvider = (*LocalSecretsFile)(nil)
+
+// GenerateMasterKey returns a new random master key, base64 encoded
+func GenerateMasterKey() (string, error) {
+	key := make([]byte, masterKeySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", fmt.Errorf("generate master key: %w", err)
+	}
+
+	return base64.StdEncoding.EncodeToString(key), nil
+}
+
+// OpenLocalSecretsFile decrypts the secrets file at path. A missing file holds no secrets.
+func OpenLocalSecretsFile(path, masterKey string) (*LocalSecretsFile, error) {
+	file := &LocalSecretsFile{path: path, secrets: map[string]string{}}
+
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return file, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("read secrets file: %w", err)
+	}
+
+	var stored encryptedSecretsFile
+	if err = json.Unmarshal(raw, &stored); err != nil {
+		return nil, fmt.Errorf("decode secrets file %s: %w", path, err)
+	}
+
+	if stored.Version != secretsFileVersion || stored.Cipher != secretsFileCipher {
+		return nil, fmt.Errorf("secrets file %s: unsupported version %d (%s)", path, stored.Version, stored.Cipher)
+	}
+
+	aead, err := newSecretsCipher(masterKey)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce, nonceErr := base64.StdEncoding.DecodeString(stored.Nonce)
+	ciphertext, cipherErr := base64.StdEncoding.DecodeString(stored.Ciphertext)
+
+	if nonceErr != nil || cipherErr != nil || len(nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("secrets file %s is corrupt", path)
+	}
+
+	plaintext, err := aead.Open(nil, nonce, ciphertext, secretsFileAAD)
+	if err != nil {
+		return nil, fmt.Errorf("unlock secrets file %s: %w", path, ErrInvalidMasterKey)
+	}
+
+	if err = json.Unmarshal(plaintext, &file.secrets); err != nil {
+		return nil, fmt.Errorf("secrets file %s is corrupt", path)
+	}
+
+	return file, nil
+}
+
+// Name identifies the provider in logs
+func (f *LocalSecretsFile) Name() string {
+	return "secrets file " + f.path
+}
+
+// Secret returns the secret stored for key
+func (f *LocalSecretsFile) Secret(_ context.Context, key string) (string, bool, error) {
+	value, found := f.secrets[key]
+
+	return value, found, nil
+}
+
+// Keys returns the config keys the file holds secrets for, sorted
+func (f *LocalSecretsFile) Keys() []string {
+	keys := make([]string, 0, len(f.secrets))
+	for key := range f.secrets {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+// Set stores a secret for key, which must be one of SecretKeys. Call Save to write the file.
+func (f *LocalSecretsFile) Set(key, value string) error {
+	if !IsSecretKey(key) {
+		return fmt.Errorf("%s is not a secret setting", key)
+	}
+
+	f.secrets[key] = value
+
+	return nil
+}
+
+// Delete removes the secret stored for key. Call Save to write the file.
+func (f *LocalSecretsFile) Delete(key string) {
+	delete(f.secrets, key)
+}
+
+// Save encrypts the secrets with masterKey and replaces the file, readable by its owner only
+func (f *LocalSecretsFile) Save(masterKey string) error {
+	aead, err := newSecretsCipher(masterKey)
+	if err != nil {
+		return err
+	}
+
+	plaintext, err := json.Marshal(f.secrets)
+	if err != nil {
+		return fmt.Errorf("encode secrets: %w", err)
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return fmt.Errorf("generate nonce: %w", err)
+	}
+
+	encoded, err := json.MarshalIndent(encryptedSecretsFile{
+		Version:    secretsFileVersion,
+		Cipher:     secretsFileCipher,
+		Nonce:      base64.StdEncoding.EncodeToString(nonce),
+		Ciphertext: base64.StdEncoding.EncodeToString(aead.Seal(nil, nonce, plaintext, secretsFileAAD)),
+	}, "", "  ")
+	if err != nil {
+		return fmt.Errorf("encode secrets file: %w", err)
+	}
+
+	// Write beside the file and rename so a failed write never leaves a truncated file
+	tmp, err := os.CreateTemp(filepath.Dir(f.path), filepath.Base(f.path)+".*")
+	if err != nil {
+		return fmt.Errorf("write secrets file: %w", err)
+	}
+
+	defer func() { _ = os.Remove(tmp.Name()) }()
+
+	if _, err = tmp.Write(append(encoded, '\n')); err != nil {
+		_ = tmp.Close()
+
+		return fmt.Errorf("write secrets file: %w", err)
+	}
+
+	if err = tmp.Chmod(secretsFilePerm); err != nil {
+		_ = tmp.Close()
+
+		return fmt.Errorf("write secrets file: %w", err)
+	}
+
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf("write secrets file: %w", err)
+	}
+
+	if err = os.Rename(tmp.Name(), f.path); err != nil {
+		return fmt.Errorf("write secrets file: %w", err)
+	}
+
+	return nil
+}
+
+// newSecretsCipher creates the AEAD for a base64 encoded master key
+func newSecretsCipher(masterKey string) (cipher.AEAD, error) {
+	key, err := base64.StdEncoding.DecodeString(masterKey)
+	if err != nil || len(key) != masterKeySize {
+		return nil, fmt.Errorf("%w: must be %d bytes, base64 encoded", ErrInvalidMasterKey, masterKeySize)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("create cipher: %w", err)
+	}
+
+	return aead, nil
+}
+
+// LocalSecretsFromEnv returns the encrypted secrets file named by GOFORMS_SECRETS_FILE and the master
+// key in GOFORMS_MASTER_KEY or the file named by GOFORMS_MASTER_KEY_FILE. Either may be empty.
+func LocalSecretsFromEnv() (path, masterKey string, err error) {
+	masterKey, err = lookupSecretEnv(EnvMasterKey)
+	if err != nil {
+		return "", "", err
+	}
+
+	return os.Getenv(EnvSecretsFile), masterKey, nil
+}
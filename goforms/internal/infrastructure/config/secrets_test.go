@@ -0,0 +1,172 @@
+package config_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goformx/goforms/internal/infrastructure/config"
+)
+
+// writeSecretFile writes a mounted secret the way Docker and Kubernetes do, with a trailing newline
+func writeSecretFile(t *testing.T, name, value string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), name)
+	require.NoError(t, os.WriteFile(path, []byte(value+"\n"), 0o600))
+
+	return path
+}
+
+func TestLoad_ReadsSecretsFromFileVariables(t *testing.T) {
+	t.Setenv("DB_PASSWORD_FILE", writeSecretFile(t, "db_password", "database-password-from-file"))
+	t.Setenv("API_KEYS_FILE", writeSecretFile(t, "api_keys", "key-one, key-two"))
+
+	vc := config.NewViperConfig()
+
+	cfg, err := vc.LoadUnvalidated()
+	require.NoError(t, err)
+
+	assert.Equal(t, "database-password-from-file", cfg.Database.Password)
+	assert.Equal(t, []string{"key-one", "key-two"}, cfg.Security.APIKey.Keys)
+	assert.Equal(t, map[string]string{
+		"database.password":     "DB_PASSWORD_FILE",
+		"security.api_key.keys": "API_KEYS_FILE",
+	}, vc.SecretSources())
+}
+
+func TestLoad_RejectsSecretSetTwice(t *testing.T) {
+	t.Setenv("SESSION_SECRET", "session-secret-from-the-environment")
+	t.Setenv("SESSION_SECRET_FILE", writeSecretFile(t, "session_secret", "session-secret-from-a-file"))
+
+	_, err := config.NewViperConfig().LoadUnvalidated()
+	require.Error(t, err)
+
+	assert.Contains(t, err.Error(), "both SESSION_SECRET and SESSION_SECRET_FILE are set")
+	assert.NotContains(t, err.Error(), "session-secret-from")
+}
+
+func TestLoad_SecretPrecedence(t *testing.T) {
+	masterKey, err := config.GenerateMasterKey()
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "secrets.json")
+
+	local, err := config.OpenLocalSecretsFile(path, masterKey)
+	require.NoError(t, err)
+	require.NoError(t, local.Set("session.secret", "session-secret-from-the-secrets-file"))
+	require.NoError(t, local.Set("security.csrf.secret", "csrf-secret-from-the-secrets-file"))
+	require.NoError(t, local.Save(masterKey))
+
+	t.Setenv(config.EnvSecretsFile, path)
+	t.Setenv(config.EnvMasterKey+"_FILE", writeSecretFile(t, "master_key", masterKey))
+	t.Setenv("SESSION_SECRET", "session-secret-from-the-environment")
+	t.Setenv("SECURITY_ENCRYPTION_KEY_FILE", writeSecretFile(t, "encryption_key", "encryption-key-from-a-file"))
+
+	vc := config.NewViperConfig()
+	vc.AddSecretProvider(config.NewStaticSecretProvider("vault", map[string]string{
+		"session.secret":          "session-secret-from-the-vault",
+		"security.encryption.key": "encryption-key-from-the-vault",
+	}))
+
+	cfg, err := vc.LoadUnvalidated()
+	require.NoError(t, err)
+
+	// *_FILE variables win over providers, which win over the secrets file and the environment
+	assert.Equal(t, "encryption-key-from-a-file", cfg.Security.Encryption.Key)
+	assert.Equal(t, "session-secret-from-the-vault", cfg.Session.Secret)
+	assert.Equal(t, "csrf-secret-from-the-secrets-file", cfg.Security.CSRF.Secret)
+
+	assert.Equal(t, map[string]string{
+		"security.encryption.key": "SECURITY_ENCRYPTION_KEY_FILE",
+		"session.secret":          "vault",
+		"security.csrf.secret":    "secrets file " + path,
+	}, vc.SecretSources())
+}
+
+func TestLoad_SecretsFileNeedsMasterKey(t *testing.T) {
+	t.Setenv(config.EnvSecretsFile, filepath.Join(t.TempDir(), "secrets.json"))
+	t.Setenv(config.EnvMasterKey, "")
+
+	_, err := config.NewViperConfig().LoadUnvalidated()
+	require.ErrorContains(t, err, "GOFORMS_SECRETS_FILE is set but GOFORMS_MASTER_KEY is not")
+}
+
+func TestLocalSecretsFile_EncryptsSecrets(t *testing.T) {
+	masterKey, err := config.GenerateMasterKey()
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "secrets.json")
+
+	file, err := config.OpenLocalSecretsFile(path, masterKey)
+	require.NoError(t, err)
+	assert.Empty(t, file.Keys(), "a missing file holds no secrets")
+
+	require.NoError(t, file.Set("database.password", "database-password-in-the-file"))
+	require.NoError(t, file.Save(masterKey))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	raw, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(raw), "database-password-in-the-file")
+
+	reopened, err := config.OpenLocalSecretsFile(path, masterKey)
+	require.NoError(t, err)
+
+	value, found, err := reopened.Secret(t.Context(), "database.password")
+	require.NoError(t, err)
+	assert.True(t, found)
+	assert.Equal(t, "database-password-in-the-file", value)
+
+	otherKey, err := config.GenerateMasterKey()
+	require.NoError(t, err)
+
+	_, err = config.OpenLocalSecretsFile(path, otherKey)
+	require.ErrorIs(t, err, config.ErrInvalidMasterKey)
+
+	_, err = config.OpenLocalSecretsFile(path, "not-a-master-key")
+	require.ErrorIs(t, err, config.ErrInvalidMasterKey)
+}
+
+func TestLocalSecretsFile_RejectsSettingsThatAreNotSecrets(t *testing.T) {
+	masterKey, err := config.GenerateMasterKey()
+	require.NoError(t, err)
+
+	file, err := config.OpenLocalSecretsFile(filepath.Join(t.TempDir(), "secrets.json"), masterKey)
+	require.NoError(t, err)
+
+	require.Error(t, file.Set("database.host", "db.internal"))
+}
+
+func TestConfig_Redacted(t *testing.T) {
+	cfg := createValidConfig()
+	cfg.Database.RootPassword = "root-password"
+	cfg.Security.CSRF.Secret = "csrf-secret"
+	cfg.Security.Assertion.Keys = []config.AssertionKey{{ID: "k1", Secret: "key-secret"}}
+	cfg.Security.APIKey.Keys = []string{"api-key"}
+	cfg.Events.NATS.Token = "nats-token"
+	cfg.Events.NATS.Password = "nats-password"
+	cfg.Events.Redis.Password = "redis-password"
+
+	redacted := cfg.Redacted()
+
+	assert.Equal(t, "[REDACTED]", redacted.Database.Password)
+	assert.Equal(t, "[REDACTED]", redacted.Database.RootPassword)
+	assert.Equal(t, "[REDACTED]", redacted.Session.Secret)
+	assert.Equal(t, "[REDACTED]", redacted.Security.CSRF.Secret)
+	assert.Equal(t, "[REDACTED]", redacted.Security.Assertion.Secret)
+	assert.Equal(t, "[REDACTED]", redacted.Events.NATS.Token)
+	assert.Equal(t, "[REDACTED]", redacted.Events.NATS.Password)
+	assert.Equal(t, "[REDACTED]", redacted.Events.Redis.Password)
+	assert.Equal(t, []string{"[REDACTED]"}, redacted.Security.APIKey.Keys)
+	assert.Equal(t, []config.AssertionKey{{ID: "k1", Secret: "[REDACTED]"}}, redacted.Security.Assertion.Keys)
+	assert.Equal(t, "testuser", redacted.Database.Username)
+	assert.Equal(t, "testpass", cfg.Database.Password, "redacting leaves the original untouched")
+	assert.Equal(t, "key-secret", cfg.Security.Assertion.Keys[0].Secret, "redacting leaves the original untouched")
+}
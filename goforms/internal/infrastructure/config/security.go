@@ -160,8 +160,36 @@ type EncryptionConfig struct {
 
 // AssertionConfig represents Laravel signed assertion verification configuration
 type AssertionConfig struct {
-	Secret               string `json:"secret"`
-	TimestampSkewSeconds int    `json:"timestamp_skew_seconds"`
+	Secret               string               `json:"secret"` // Used when the request carries no X-Key-Id
+	TimestampSkewSeconds int                  `json:"timestamp_skew_seconds"`
+	Keys                 []AssertionKey       `json:"keys"` // Rotating secrets selected by X-Key-Id
+	Nonce                AssertionNonceConfig `json:"nonce"`
+}
+
+// AssertionKey is one of several signing secrets active during rotation.
+// A zero NotBefore or NotAfter leaves that side of the validity window open.
+type AssertionKey struct {
+	ID        string    `json:"id"`
+	Secret    string    `json:"secret"`
+	NotBefore time.Time `json:"not_before"`
+	NotAfter  time.Time `json:"not_after"`
+}
+
+// redacted returns the key with its secret hidden and its ID and validity left visible
+func (k AssertionKey) redacted() any {
+	k.Secret = redact(k.Secret)
+
+	return k
+}
+
+// AssertionNonceConfig configures replay protection for signed assertions
+type AssertionNonceConfig struct {
+	Required      bool   `json:"required"` // Reject requests without X-Nonce
+	Store         string `json:"store"`    // memory, redis
+	RedisAddr     string `json:"redis_addr"`
+	RedisPassword string `json:"redis_password"`
+	RedisDB       int    `json:"redis_db"`
+	KeyPrefix     string `json:"key_prefix"`
 }
 
 // APIKeyConfig represents API key authentication configuration
@@ -330,14 +358,45 @@ func (s *SecurityConfig) validateAPIKey() error {
 
 // validateAssertion validates assertion auth configuration
 func (s *SecurityConfig) validateAssertion() error {
-	if s.Assertion.Secret == "" {
+	if s.Assertion.Secret == "" && len(s.Assertion.Keys) == 0 {
 		return errors.New("assertion secret is required")
 	}
 
-	if len(s.Assertion.Secret) < MinSecretLength {
+	if s.Assertion.Secret != "" && len(s.Assertion.Secret) < MinSecretLength {
 		return fmt.Errorf("assertion secret must be at least %d characters", MinSecretLength)
 	}
 
+	seen := make(map[string]bool, len(s.Assertion.Keys))
+	for _, key := range s.Assertion.Keys {
+		if key.ID == "" {
+			return errors.New("assertion key ID is required")
+		}
+
+		if seen[key.ID] {
+			return fmt.Errorf("duplicate assertion key ID %q", key.ID)
+		}
+
+		seen[key.ID] = true
+
+		if len(key.Secret) < MinSecretLength {
+			return fmt.Errorf("assertion key %q secret must be at least %d characters", key.ID, MinSecretLength)
+		}
+
+		if !key.NotBefore.IsZero() && !key.NotAfter.IsZero() && !key.NotAfter.After(key.NotBefore) {
+			return fmt.Errorf("assertion key %q not_after must be after not_before", key.ID)
+		}
+	}
+
+	switch s.Assertion.Nonce.Store {
+	case "", "memory":
+	case "redis":
+		if s.Assertion.Nonce.RedisAddr == "" {
+			return errors.New("assertion nonce redis address is required")
+		}
+	default:
+		return fmt.Errorf("unsupported assertion nonce store %q", s.Assertion.Nonce.Store)
+	}
+
 	return nil
 }
 
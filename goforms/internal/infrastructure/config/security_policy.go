@@ -0,0 +1,250 @@
+package config
+
+import (
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// redactedValue replaces secrets in redacted configuration and diffs
+const redactedValue = "[REDACTED]"
+
+// ReloadableSecuritySections are the security sections a reload applies without a restart. Changes
+// to any other section are logged and take effect on the next start.
+var ReloadableSecuritySections = []string{
+	"cors",
+	"rate_limit",
+	"api_key",
+	"csp",
+	"security_headers",
+	"assertion.secret",
+	"assertion.keys",
+}
+
+// SecurityPolicy holds the security configuration in effect. A reload replaces it atomically, so
+// a request sees either the old or the new configuration and never a mix of both.
+type SecurityPolicy struct {
+	current atomic.Pointer[securityVersion]
+}
+
+// securityVersion is one generation of the security configuration
+type securityVersion struct {
+	config   *SecurityConfig
+	loadedAt time.Time
+}
+
+// NewSecurityPolicy creates a security policy starting from the loaded configuration
+func NewSecurityPolicy(cfg *Config) *SecurityPolicy {
+	security := cfg.Security
+
+	p := &SecurityPolicy{}
+	p.store(&security)
+
+	return p
+}
+
+// Current returns the security configuration in effect. Callers must not modify it.
+func (p *SecurityPolicy) Current() *SecurityConfig {
+	return p.current.Load().config
+}
+
+// Snapshot returns the security configuration in effect together with when it was loaded
+func (p *SecurityPolicy) Snapshot() (*SecurityConfig, time.Time) {
+	version := p.current.Load()
+
+	return version.config, version.loadedAt
+}
+
+// store makes cfg the security configuration in effect
+func (p *SecurityPolicy) store(cfg *SecurityConfig) {
+	p.current.Store(&securityVersion{config: cfg, loadedAt: time.Now().UTC()})
+}
+
+// Redacted returns a copy of the configuration with every secret replaced, for display
+func (s SecurityConfig) Redacted() SecurityConfig {
+	redacted := s
+	redactSecrets("security", reflect.ValueOf(&redacted).Elem())
+
+	return redacted
+}
+
+// Redacted returns a copy of the configuration with every secret replaced, for config dumps
+func (c Config) Redacted() Config {
+	redacted := c
+	redactSecrets("", reflect.ValueOf(&redacted).Elem())
+
+	return redacted
+}
+
+// redactSecrets replaces every setting of v that secretSettings lists as a secret. Settings are
+// named by their dotted key like flattenSettings names them, so a secret added to the registry
+// is redacted without further changes.
+func redactSecrets(key string, v reflect.Value) {
+	if IsSecretKey(key) {
+		redactValue(v)
+
+		return
+	}
+
+	if v.Kind() != reflect.Struct {
+		return
+	}
+
+	for i := range v.NumField() {
+		redactSecrets(settingKey(key, v.Type().Field(i)), v.Field(i))
+	}
+}
+
+// redactable is implemented by structured secrets that keep their other fields visible
+type redactable interface {
+	redacted() any
+}
+
+// redactValue hides a secret setting. Slices are copied so the original configuration keeps its values.
+func redactValue(v reflect.Value) {
+	if r, ok := v.Interface().(redactable); ok {
+		v.Set(reflect.ValueOf(r.redacted()))
+
+		return
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(redact(v.String()))
+	case reflect.Slice:
+		if v.IsNil() {
+			return
+		}
+
+		copied := reflect.MakeSlice(v.Type(), v.Len(), v.Len())
+		reflect.Copy(copied, v)
+
+		for i := range copied.Len() {
+			redactValue(copied.Index(i))
+		}
+
+		v.Set(copied)
+	default:
+	}
+}
+
+// redact hides a secret, leaving an unset secret visibly unset
+func redact(secret string) string {
+	if secret == "" {
+		return ""
+	}
+
+	return redactedValue
+}
+
+// withReloadable returns a copy of s with the reloadable sections taken from next
+func (s SecurityConfig) withReloadable(next *SecurityConfig) *SecurityConfig {
+	merged := s
+	merged.CORS = next.CORS
+	merged.RateLimit = next.RateLimit
+	merged.APIKey = next.APIKey
+	merged.CSP = next.CSP
+	merged.SecurityHeaders = next.SecurityHeaders
+	merged.Assertion.Secret = next.Assertion.Secret
+	merged.Assertion.Keys = next.Assertion.Keys
+
+	return &merged
+}
+
+// ConfigChange is a setting that differs between two configurations. Secret values are redacted.
+type ConfigChange struct {
+	Key  string `json:"key"`
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// String formats the change for logs
+func (c ConfigChange) String() string {
+	return fmt.Sprintf("%s: %s -> %s", c.Key, c.From, c.To)
+}
+
+// DiffSecurity lists the settings that differ between two security configurations, ordered by key.
+// Keys are the dotted config keys such as security.cors.allowed_origins.
+func DiffSecurity(from, to *SecurityConfig) []ConfigChange {
+	before, after := map[string]string{}, map[string]string{}
+	flattenSettings("", reflect.ValueOf(*from), before)
+	flattenSettings("", reflect.ValueOf(*to), after)
+
+	var changes []ConfigChange
+
+	keys := maps.Clone(after)
+	maps.Copy(keys, before)
+
+	for key := range keys {
+		from, had := before[key]
+		to, has := after[key]
+
+		if had == has && from == to {
+			continue
+		}
+
+		change := ConfigChange{Key: "security." + key, From: from, To: to}
+		if isSecretSetting(key) {
+			change.From, change.To = redact(change.From), redact(change.To)
+		}
+
+		changes = append(changes, change)
+	}
+
+	slices.SortFunc(changes, func(a, b ConfigChange) int { return strings.Compare(a.Key, b.Key) })
+
+	return changes
+}
+
+// flattenSettings records the formatted value of every leaf setting of v under its dotted key,
+// naming fields by their JSON tags and map entries by their keys, so an entry added to or removed
+// from a map is a setting of its own
+func flattenSettings(prefix string, v reflect.Value, settings map[string]string) {
+	if v.Kind() == reflect.Map {
+		for iter := v.MapRange(); iter.Next(); {
+			flattenSettings(prefix+"."+fmt.Sprint(iter.Key().Interface()), iter.Value(), settings)
+		}
+
+		return
+	}
+
+	if v.Kind() != reflect.Struct || v.Type() == reflect.TypeFor[time.Time]() {
+		settings[prefix] = fmt.Sprint(v.Interface())
+
+		return
+	}
+
+	for i := range v.NumField() {
+		flattenSettings(settingKey(prefix, v.Type().Field(i)), v.Field(i), settings)
+	}
+}
+
+// settingKey returns the dotted key of a field under prefix, naming it by its JSON tag
+func settingKey(prefix string, field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name == "" || name == "-" {
+		name = field.Name
+	}
+
+	if prefix == "" {
+		return name
+	}
+
+	return prefix + "." + name
+}
+
+// isSecretSetting reports whether a flattened security setting holds a secret
+func isSecretSetting(key string) bool {
+	return IsSecretKey("security." + key)
+}
+
+// isReloadableSetting reports whether a reload applies a flattened security setting
+func isReloadableSetting(key string) bool {
+	return slices.ContainsFunc(ReloadableSecuritySections, func(section string) bool {
+		return strings.HasPrefix(key, section+".") || key == section
+	})
+}
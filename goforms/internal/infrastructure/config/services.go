@@ -4,6 +4,16 @@ import (
 	"time"
 )
 
+// Session stores selected with session.store
+const (
+	// SessionStoreMemory keeps sessions in the process; they are lost on restart and not shared
+	SessionStoreMemory = "memory"
+	// SessionStoreDatabase keeps sessions in the sessions table, shared by all replicas
+	SessionStoreDatabase = "database"
+	// SessionStoreRedis keeps sessions in Redis, shared by all replicas
+	SessionStoreRedis = "redis"
+)
+
 // SessionConfig holds session-related configuration
 type SessionConfig struct {
 	Type       string        `json:"type"`
@@ -14,7 +24,16 @@ type SessionConfig struct {
 	Secure     bool          `json:"secure"`
 	HTTPOnly   bool          `json:"http_only"`
 	SameSite   string        `json:"same_site"`
-	Store      string        `json:"store"`
-	StoreFile  string        `json:"store_file"`
+	Store      string        `json:"store"` // memory, database or redis
 	CookieName string        `json:"cookie_name"`
+
+	Redis SessionRedisConfig `json:"redis"`
+}
+
+// SessionRedisConfig locates the Redis server of the redis session store
+type SessionRedisConfig struct {
+	Addr      string `json:"addr"`
+	Password  string `json:"password"`
+	DB        int    `json:"db"`
+	KeyPrefix string `json:"key_prefix"`
 }
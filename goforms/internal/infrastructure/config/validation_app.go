@@ -13,6 +13,7 @@ func validateAppConfig(cfg AppConfig, result *ValidationResult) {
 	validateAppConfigTimeouts(cfg, result)
 	validateAppConfigURL(cfg, result)
 	validateAppConfigEnvironment(cfg, result)
+	validateAppConfigStream(cfg.Stream, result)
 }
 
 func validateAppConfigName(cfg AppConfig, result *ValidationResult) {
@@ -69,3 +70,21 @@ func validateAppConfigEnvironment(cfg AppConfig, result *ValidationResult) {
 		)
 	}
 }
+
+func validateAppConfigStream(cfg StreamConfig, result *ValidationResult) {
+	if cfg.MaxConnectionsPerUser < 0 {
+		result.AddError("app.stream.max_connections_per_user", "max connections per user must not be negative", cfg.MaxConnectionsPerUser)
+	}
+
+	if cfg.ReplaySize < 0 {
+		result.AddError("app.stream.replay_size", "replay size must not be negative", cfg.ReplaySize)
+	}
+
+	if cfg.Heartbeat < 0 {
+		result.AddError("app.stream.heartbeat", "heartbeat must not be negative", cfg.Heartbeat)
+	}
+
+	if cfg.AnalyticsInterval < 0 {
+		result.AddError("app.stream.analytics_interval", "analytics interval must not be negative", cfg.AnalyticsInterval)
+	}
+}
@@ -9,10 +9,16 @@ import (
 func validateDatabaseConfig(cfg DatabaseConfig, result *ValidationResult) {
 	validateDatabaseConfigDriverPresence(cfg, result)
 	validateDatabaseConfigDriver(cfg, result)
-	validateDatabaseConfigHost(cfg, result)
-	validateDatabaseConfigPort(cfg, result)
-	validateDatabaseConfigName(cfg, result)
-	validateDatabaseConfigUsername(cfg, result)
+
+	if cfg.IsSQLite() {
+		validateDatabaseConfigPath(cfg, result)
+	} else {
+		validateDatabaseConfigHost(cfg, result)
+		validateDatabaseConfigPort(cfg, result)
+		validateDatabaseConfigName(cfg, result)
+		validateDatabaseConfigUsername(cfg, result)
+	}
+
 	validateDatabaseConfigPool(cfg, result)
 }
 
@@ -23,7 +29,7 @@ func validateDatabaseConfigDriverPresence(cfg DatabaseConfig, result *Validation
 }
 
 func validateDatabaseConfigDriver(cfg DatabaseConfig, result *ValidationResult) {
-	supportedDrivers := []string{"postgres", "mysql", "mariadb"}
+	supportedDrivers := []string{DriverPostgres, "mysql", DriverMariaDB, DriverSQLite}
 	driverValid := false
 
 	for _, driver := range supportedDrivers {
@@ -63,6 +69,12 @@ func validateDatabaseConfigUsername(cfg DatabaseConfig, result *ValidationResult
 	}
 }
 
+func validateDatabaseConfigPath(cfg DatabaseConfig, result *ValidationResult) {
+	if cfg.Path == "" {
+		result.AddError("database.path", "SQLite database path is required", cfg.Path)
+	}
+}
+
 func validateDatabaseConfigPool(cfg DatabaseConfig, result *ValidationResult) {
 	if cfg.MaxOpenConns <= 0 {
 		result.AddError("database.max_open_conns", "max open connections must be positive", cfg.MaxOpenConns)
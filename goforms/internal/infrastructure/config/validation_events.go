@@ -0,0 +1,71 @@
+package config
+
+// validateEventsConfig validates event bus configuration
+func validateEventsConfig(cfg EventsConfig, result *ValidationResult) {
+	switch cfg.Backend {
+	case "", EventBackendMemory:
+		validateEventsMemoryConfig(cfg.Memory, result)
+
+		return
+	case EventBackendNATS:
+		validateEventsEncoding(cfg.Encoding, result)
+
+		if cfg.NATS.URL == "" {
+			result.AddError("events.nats.url", "nats url is required for the nats event bus", cfg.NATS.URL)
+		}
+	case EventBackendRedis:
+		validateEventsEncoding(cfg.Encoding, result)
+
+		if cfg.Redis.Addr == "" {
+			result.AddError("events.redis.addr", "redis address is required for the redis event bus", cfg.Redis.Addr)
+		}
+	default:
+		result.AddError("events.backend", "unsupported event bus backend", cfg.Backend)
+
+		return
+	}
+
+	if cfg.AckWait <= 0 {
+		result.AddError("events.ack_wait", "ack wait must be positive", cfg.AckWait)
+	}
+
+	if cfg.MaxDeliver < 1 {
+		result.AddError("events.max_deliver", "max deliver must be at least 1", cfg.MaxDeliver)
+	}
+}
+
+// validateEventsEncoding validates the format broker-backed buses publish events in
+func validateEventsEncoding(encoding string, result *ValidationResult) {
+	switch encoding {
+	case "", EventsEncodingJSON, EventsEncodingProtobuf:
+	default:
+		result.AddError("events.encoding", "encoding must be json or protobuf", encoding)
+	}
+}
+
+// validateEventsMemoryConfig validates the queues of the memory event bus
+func validateEventsMemoryConfig(cfg EventsMemoryConfig, result *ValidationResult) {
+	switch cfg.Backpressure {
+	case "", EventsBackpressureBlock, EventsBackpressureDropOldest, EventsBackpressureReject:
+	default:
+		result.AddError("events.memory.backpressure", "backpressure must be block, drop_oldest or reject", cfg.Backpressure)
+	}
+
+	if cfg.Workers < 0 {
+		result.AddError("events.memory.workers", "workers must not be negative", cfg.Workers)
+	}
+
+	if cfg.QueueSize < 0 {
+		result.AddError("events.memory.queue_size", "queue size must not be negative", cfg.QueueSize)
+	}
+
+	if cfg.BlockTimeout < 0 {
+		result.AddError("events.memory.block_timeout", "block timeout must not be negative", cfg.BlockTimeout)
+	}
+
+	for eventType, queue := range cfg.Queues {
+		if queue.Workers < 0 || queue.QueueSize < 0 {
+			result.AddError("events.memory.queues."+eventType, "workers and queue size must not be negative", queue)
+		}
+	}
+}
@@ -8,11 +8,6 @@ import (
 	"time"
 )
 
-// File permission constants
-const (
-	permUserWrite = 0o200
-)
-
 // ValidationError represents a configuration validation error
 type ValidationError struct {
 	Field   string
@@ -49,6 +44,7 @@ func ValidateConfig(cfg *Config) ValidationResult {
 	validateDatabaseConfig(cfg.Database, &result)
 	validateSecurityConfig(cfg.Security, &result)
 	validateSessionConfig(cfg.Session, &result)
+	validateEventsConfig(cfg.Events, &result)
 
 	// Validate cross-section dependencies
 	validateCrossSectionDependencies(cfg, &result)
@@ -75,16 +71,6 @@ func fileExists(path string) bool {
 	return err == nil
 }
 
-// isWritableDirectory checks if a directory is writable
-func isWritableDirectory(path string) bool {
-	info, err := os.Stat(path)
-	if err != nil {
-		return false
-	}
-
-	return info.IsDir() && (info.Mode()&permUserWrite) != 0
-}
-
 // ValidateEnvironmentVariables validates that required environment variables are set
 func ValidateEnvironmentVariables() ValidationResult {
 	result := ValidationResult{IsValid: true}
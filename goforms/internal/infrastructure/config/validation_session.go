@@ -2,7 +2,6 @@
 package config
 
 import (
-	"path/filepath"
 	"strings"
 )
 
@@ -11,7 +10,7 @@ func validateSessionConfig(cfg SessionConfig, result *ValidationResult) {
 	validateSessionType(cfg, result)
 	validateSessionSecret(cfg, result)
 	validateSessionDuration(cfg, result)
-	validateSessionFile(cfg, result)
+	validateSessionStore(cfg, result)
 }
 
 func validateSessionType(cfg SessionConfig, result *ValidationResult) {
@@ -53,21 +52,14 @@ func validateSessionDuration(cfg SessionConfig, result *ValidationResult) {
 	}
 }
 
-func validateSessionFile(cfg SessionConfig, result *ValidationResult) {
-	if !strings.EqualFold(cfg.Type, "file") {
-		return
-	}
-
-	if cfg.StoreFile == "" {
-		result.AddError("session.store_file",
-			"session store file is required for file sessions", cfg.StoreFile)
-
-		return
-	}
-	// Ensure session directory is writable
-	sessionDir := filepath.Dir(cfg.StoreFile)
-	if !isWritableDirectory(sessionDir) {
-		result.AddError("session.store_file",
-			"session directory must be writable", sessionDir)
+func validateSessionStore(cfg SessionConfig, result *ValidationResult) {
+	switch cfg.Store {
+	case SessionStoreMemory, SessionStoreDatabase:
+	case SessionStoreRedis:
+		if cfg.Redis.Addr == "" {
+			result.AddError("session.redis.addr", "redis address is required for the redis session store", cfg.Redis.Addr)
+		}
+	default:
+		result.AddError("session.store", "unsupported session store", cfg.Store)
 	}
 }
@@ -3,9 +3,11 @@
 package config
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"github.com/spf13/viper"
@@ -16,6 +18,9 @@ import (
 type ViperConfig struct {
 	viper          *viper.Viper
 	configFilePath string // Path to loaded config file, available after Load()
+
+	secretProviders []SecretProvider
+	secretSources   map[string]string // Where resolved secrets came from, available after Load()
 }
 
 // GetConfigFilePath returns the path to the loaded config file
@@ -23,6 +28,11 @@ func (vc *ViperConfig) GetConfigFilePath() string {
 	return vc.configFilePath
 }
 
+// SetConfigFile reads the configuration from path instead of searching the config paths
+func (vc *ViperConfig) SetConfigFile(path string) {
+	vc.viper.SetConfigFile(path)
+}
+
 // NewViperConfig creates a new Viper configuration instance
 func NewViperConfig() *ViperConfig {
 	v := viper.New()
@@ -46,6 +56,13 @@ func NewViperConfig() *ViperConfig {
 	_ = v.BindEnv("app.write_timeout", "APP_WRITE_TIMEOUT")
 	_ = v.BindEnv("app.idle_timeout", "APP_IDLE_TIMEOUT")
 	_ = v.BindEnv("app.request_timeout", "APP_REQUEST_TIMEOUT")
+	_ = v.BindEnv("app.idempotency.store", "GOFORMS_IDEMPOTENCY_STORE")
+	_ = v.BindEnv("app.idempotency.ttl", "GOFORMS_IDEMPOTENCY_TTL")
+	_ = v.BindEnv("app.stream.max_connections_per_user", "GOFORMS_STREAM_MAX_CONNECTIONS_PER_USER")
+	_ = v.BindEnv("app.stream.replay_size", "GOFORMS_STREAM_REPLAY_SIZE")
+	_ = v.BindEnv("app.stream.heartbeat", "GOFORMS_STREAM_HEARTBEAT")
+	_ = v.BindEnv("app.stream.analytics_interval", "GOFORMS_STREAM_ANALYTICS_INTERVAL")
+	_ = v.BindEnv("app.openapi_validation", "GOFORMS_OPENAPI_VALIDATION")
 
 	// Bind DB_* environment variables to database.* config keys
 	// This allows users to use the common DB_ prefix convention
@@ -56,6 +73,7 @@ func NewViperConfig() *ViperConfig {
 	_ = v.BindEnv("database.password", "DB_PASSWORD")
 	_ = v.BindEnv("database.driver", "DB_CONNECTION", "DB_DRIVER")
 	_ = v.BindEnv("database.ssl_mode", "DB_SSL_MODE")
+	_ = v.BindEnv("database.path", "DB_PATH")
 
 	// Bind CORS_* environment variables for convenience
 	_ = v.BindEnv("security.cors.allowed_origins", "CORS_ALLOWED_ORIGINS", "CORS_ORIGINS")
@@ -66,11 +84,42 @@ func NewViperConfig() *ViperConfig {
 
 	// Bind session and security env vars
 	_ = v.BindEnv("session.secret", "SESSION_SECRET")
+	_ = v.BindEnv("session.store", "SESSION_STORE")
+	_ = v.BindEnv("session.redis.addr", "SESSION_REDIS_ADDR")
+	_ = v.BindEnv("session.redis.password", "SESSION_REDIS_PASSWORD")
+	_ = v.BindEnv("session.redis.db", "SESSION_REDIS_DB")
 	_ = v.BindEnv("security.csrf.secret", "SECURITY_CSRF_SECRET")
 	_ = v.BindEnv("security.secure_cookie", "SECURITY_SECURE_COOKIE")
 
 	// Bind GOFORMS_SHARED_SECRET for Laravel-Go assertion verification
 	_ = v.BindEnv("security.assertion.secret", "GOFORMS_SHARED_SECRET")
+	_ = v.BindEnv("security.assertion.nonce.required", "GOFORMS_ASSERTION_NONCE_REQUIRED")
+	_ = v.BindEnv("security.assertion.nonce.store", "GOFORMS_ASSERTION_NONCE_STORE")
+	_ = v.BindEnv("security.assertion.nonce.redis_addr", "GOFORMS_ASSERTION_NONCE_REDIS_ADDR")
+	_ = v.BindEnv("security.assertion.nonce.redis_password", "GOFORMS_ASSERTION_NONCE_REDIS_PASSWORD")
+	_ = v.BindEnv("security.assertion.nonce.redis_db", "GOFORMS_ASSERTION_NONCE_REDIS_DB")
+
+	// Bind GOFORMS_EVENTS_* environment variables to the event bus
+	_ = v.BindEnv("events.backend", "GOFORMS_EVENTS_BACKEND")
+	_ = v.BindEnv("events.source", "GOFORMS_EVENTS_SOURCE")
+	_ = v.BindEnv("events.encoding", "GOFORMS_EVENTS_ENCODING")
+	_ = v.BindEnv("events.group", "GOFORMS_EVENTS_GROUP")
+	_ = v.BindEnv("events.topic_prefix", "GOFORMS_EVENTS_TOPIC_PREFIX")
+	_ = v.BindEnv("events.ack_wait", "GOFORMS_EVENTS_ACK_WAIT")
+	_ = v.BindEnv("events.max_deliver", "GOFORMS_EVENTS_MAX_DELIVER")
+	_ = v.BindEnv("events.memory.workers", "GOFORMS_EVENTS_MEMORY_WORKERS")
+	_ = v.BindEnv("events.memory.queue_size", "GOFORMS_EVENTS_MEMORY_QUEUE_SIZE")
+	_ = v.BindEnv("events.memory.backpressure", "GOFORMS_EVENTS_MEMORY_BACKPRESSURE")
+	_ = v.BindEnv("events.memory.block_timeout", "GOFORMS_EVENTS_MEMORY_BLOCK_TIMEOUT")
+	_ = v.BindEnv("events.nats.url", "GOFORMS_EVENTS_NATS_URL")
+	_ = v.BindEnv("events.nats.token", "GOFORMS_EVENTS_NATS_TOKEN")
+	_ = v.BindEnv("events.nats.user", "GOFORMS_EVENTS_NATS_USER")
+	_ = v.BindEnv("events.nats.password", "GOFORMS_EVENTS_NATS_PASSWORD")
+	_ = v.BindEnv("events.nats.stream", "GOFORMS_EVENTS_NATS_STREAM")
+	_ = v.BindEnv("events.redis.addr", "GOFORMS_EVENTS_REDIS_ADDR")
+	_ = v.BindEnv("events.redis.password", "GOFORMS_EVENTS_REDIS_PASSWORD")
+	_ = v.BindEnv("events.redis.db", "GOFORMS_EVENTS_REDIS_DB")
+	_ = v.BindEnv("events.redis.max_len", "GOFORMS_EVENTS_REDIS_MAX_LEN")
 
 	// Set config file search paths (order matters - first found wins)
 	v.AddConfigPath(".")
@@ -87,6 +136,22 @@ func NewViperConfig() *ViperConfig {
 
 // Load loads configuration using Viper with improved error handling
 func (vc *ViperConfig) Load() (*Config, error) {
+	config, err := vc.LoadUnvalidated()
+	if err != nil {
+		return nil, err
+	}
+
+	// Validate configuration with detailed error reporting
+	if validateErr := config.validateConfig(); validateErr != nil {
+		return nil, fmt.Errorf("configuration validation failed: %w", validateErr)
+	}
+
+	return config, nil
+}
+
+// LoadUnvalidated loads configuration without validating it, so tooling can report
+// every problem instead of failing on the first
+func (vc *ViperConfig) LoadUnvalidated() (*Config, error) {
 	if err := vc.loadConfigFiles(); err != nil {
 		return nil, fmt.Errorf("failed to load configuration files: %w", err)
 	}
@@ -97,9 +162,8 @@ func (vc *ViperConfig) Load() (*Config, error) {
 		return nil, fmt.Errorf("failed to load configuration sections: %w", err)
 	}
 
-	// Validate configuration with detailed error reporting
-	if err := config.validateConfig(); err != nil {
-		return nil, fmt.Errorf("configuration validation failed: %w", err)
+	if err := vc.resolveSecrets(config); err != nil {
+		return nil, fmt.Errorf("failed to resolve secrets: %w", err)
 	}
 
 	return config, nil
@@ -143,6 +207,7 @@ func (vc *ViperConfig) loadAllConfigSections(config *Config) error {
 		vc.loadDatabaseConfig,
 		vc.loadSecurityConfig,
 		vc.loadSessionConfig,
+		vc.loadEventsConfig,
 	}
 
 	for _, loader := range loaders {
@@ -170,6 +235,17 @@ func (vc *ViperConfig) loadAppConfig(config *Config) error {
 		WriteTimeout:   vc.viper.GetDuration("app.write_timeout"),
 		IdleTimeout:    vc.viper.GetDuration("app.idle_timeout"),
 		RequestTimeout: vc.viper.GetDuration("app.request_timeout"),
+		Idempotency: IdempotencyConfig{
+			Store: vc.viper.GetString("app.idempotency.store"),
+			TTL:   vc.viper.GetDuration("app.idempotency.ttl"),
+		},
+		Stream: StreamConfig{
+			MaxConnectionsPerUser: vc.viper.GetInt("app.stream.max_connections_per_user"),
+			ReplaySize:            vc.viper.GetInt("app.stream.replay_size"),
+			Heartbeat:             vc.viper.GetDuration("app.stream.heartbeat"),
+			AnalyticsInterval:     vc.viper.GetDuration("app.stream.analytics_interval"),
+		},
+		OpenAPIValidation: vc.viper.GetBool("app.openapi_validation"),
 	}
 
 	return nil
@@ -185,6 +261,7 @@ func (vc *ViperConfig) loadDatabaseConfig(config *Config) error {
 		Username:        vc.viper.GetString("database.username"),
 		Password:        vc.viper.GetString("database.password"),
 		SSLMode:         vc.viper.GetString("database.ssl_mode"),
+		Path:            vc.viper.GetString("database.path"),
 		MaxOpenConns:    vc.viper.GetInt("database.max_open_conns"),
 		MaxIdleConns:    vc.viper.GetInt("database.max_idle_conns"),
 		ConnMaxLifetime: vc.viper.GetDuration("database.conn_max_lifetime"),
@@ -231,20 +308,46 @@ func (vc *ViperConfig) loadAssertionConfig() AssertionConfig {
 	return AssertionConfig{
 		Secret:               vc.viper.GetString("security.assertion.secret"),
 		TimestampSkewSeconds: vc.viper.GetInt("security.assertion.timestamp_skew_seconds"),
+		Nonce: AssertionNonceConfig{
+			Required:      vc.viper.GetBool("security.assertion.nonce.required"),
+			Store:         vc.viper.GetString("security.assertion.nonce.store"),
+			RedisAddr:     vc.viper.GetString("security.assertion.nonce.redis_addr"),
+			RedisPassword: vc.viper.GetString("security.assertion.nonce.redis_password"),
+			RedisDB:       vc.viper.GetInt("security.assertion.nonce.redis_db"),
+			KeyPrefix:     vc.viper.GetString("security.assertion.nonce.key_prefix"),
+		},
 	}
 }
 
+// loadAssertionKeys loads rotating assertion secrets. GOFORMS_ASSERTION_KEYS takes a JSON array
+// of {"id","secret","not_before","not_after"} objects; otherwise security.assertion.keys is used.
+func (vc *ViperConfig) loadAssertionKeys() ([]AssertionKey, error) {
+	var raw []byte
+
+	if keysEnv := os.Getenv("GOFORMS_ASSERTION_KEYS"); keysEnv != "" {
+		raw = []byte(keysEnv)
+	} else if configured := vc.viper.Get("security.assertion.keys"); configured != nil {
+		// Round-trip through JSON so RFC 3339 strings and YAML timestamps both decode into time.Time
+		encoded, err := json.Marshal(configured)
+		if err != nil {
+			return nil, fmt.Errorf("encode assertion keys: %w", err)
+		}
+
+		raw = encoded
+	} else {
+		return nil, nil
+	}
+
+	return parseAssertionKeys(raw)
+}
+
 // loadAPIKeyConfig loads API key configuration from viper
 func (vc *ViperConfig) loadAPIKeyConfig() APIKeyConfig {
 	// Support environment variable with comma-separated keys
 	keysEnv := os.Getenv("API_KEYS")
 	var keys []string
 	if keysEnv != "" {
-		keys = strings.Split(keysEnv, ",")
-		// Trim whitespace from each key
-		for i, key := range keys {
-			keys[i] = strings.TrimSpace(key)
-		}
+		keys = splitAPIKeys(keysEnv)
 	} else {
 		keys = vc.viper.GetStringSlice("security.api_key.keys")
 	}
@@ -288,17 +391,22 @@ func (vc *ViperConfig) loadRateLimitConfig() RateLimitConfig {
 // loadCSPConfig loads CSP configuration from viper
 func (vc *ViperConfig) loadCSPConfig() CSPConfig {
 	return CSPConfig{
-		Enabled:    vc.viper.GetBool("security.csp.enabled"),
-		DefaultSrc: vc.viper.GetString("security.csp.default_src"),
-		ScriptSrc:  vc.viper.GetString("security.csp.script_src"),
-		StyleSrc:   vc.viper.GetString("security.csp.style_src"),
-		ImgSrc:     vc.viper.GetString("security.csp.img_src"),
-		ConnectSrc: vc.viper.GetString("security.csp.connect_src"),
-		FontSrc:    vc.viper.GetString("security.csp.font_src"),
-		ObjectSrc:  vc.viper.GetString("security.csp.object_src"),
-		MediaSrc:   vc.viper.GetString("security.csp.media_src"),
-		FrameSrc:   vc.viper.GetString("security.csp.frame_src"),
-		ReportURI:  vc.viper.GetString("security.csp.report_uri"),
+		Enabled:     vc.viper.GetBool("security.csp.enabled"),
+		DefaultSrc:  vc.viper.GetString("security.csp.default_src"),
+		ScriptSrc:   vc.viper.GetString("security.csp.script_src"),
+		StyleSrc:    vc.viper.GetString("security.csp.style_src"),
+		ImgSrc:      vc.viper.GetString("security.csp.img_src"),
+		ConnectSrc:  vc.viper.GetString("security.csp.connect_src"),
+		FontSrc:     vc.viper.GetString("security.csp.font_src"),
+		ObjectSrc:   vc.viper.GetString("security.csp.object_src"),
+		MediaSrc:    vc.viper.GetString("security.csp.media_src"),
+		FrameSrc:    vc.viper.GetString("security.csp.frame_src"),
+		FormAction:  vc.viper.GetString("security.csp.form_action"),
+		BaseURI:     vc.viper.GetString("security.csp.base_uri"),
+		ManifestSrc: vc.viper.GetString("security.csp.manifest_src"),
+		WorkerSrc:   vc.viper.GetString("security.csp.worker_src"),
+		ReportURI:   vc.viper.GetString("security.csp.report_uri"),
+		ReportOnly:  vc.viper.GetBool("security.csp.report_only"),
 	}
 }
 
@@ -349,6 +457,13 @@ func (vc *ViperConfig) loadSecurityConfig(config *Config) error {
 		Debug:        vc.viper.GetBool("security.debug"),
 	}
 
+	keys, err := vc.loadAssertionKeys()
+	if err != nil {
+		return err
+	}
+
+	config.Security.Assertion.Keys = keys
+
 	return nil
 }
 
@@ -364,13 +479,135 @@ func (vc *ViperConfig) loadSessionConfig(config *Config) error {
 		HTTPOnly:   vc.viper.GetBool("session.http_only"),
 		SameSite:   vc.viper.GetString("session.same_site"),
 		Store:      vc.viper.GetString("session.store"),
-		StoreFile:  vc.viper.GetString("session.store_file"),
 		CookieName: vc.viper.GetString("session.cookie_name"),
+		Redis: SessionRedisConfig{
+			Addr:      vc.viper.GetString("session.redis.addr"),
+			Password:  vc.viper.GetString("session.redis.password"),
+			DB:        vc.viper.GetInt("session.redis.db"),
+			KeyPrefix: vc.viper.GetString("session.redis.key_prefix"),
+		},
+	}
+
+	return nil
+}
+
+// loadEventsConfig loads event bus configuration. GOFORMS_EVENTS_TOPICS and
+// GOFORMS_EVENTS_MEMORY_QUEUES take comma-separated type=value pairs; otherwise the events.topics
+// and events.memory.queues maps are used.
+func (vc *ViperConfig) loadEventsConfig(config *Config) error {
+	topics := vc.viper.GetStringMapString("events.topics")
+
+	if topicsEnv := os.Getenv("GOFORMS_EVENTS_TOPICS"); topicsEnv != "" {
+		parsed, err := parseEventMap(topicsEnv)
+		if err != nil {
+			return err
+		}
+
+		topics = parsed
+	}
+
+	queues := vc.viper.GetStringMapString("events.memory.queues")
+	if queuesEnv := os.Getenv("GOFORMS_EVENTS_MEMORY_QUEUES"); queuesEnv != "" {
+		parsed, err := parseEventMap(queuesEnv)
+		if err != nil {
+			return err
+		}
+
+		queues = parsed
+	}
+
+	memoryQueues, err := parseEventQueues(queues)
+	if err != nil {
+		return err
+	}
+
+	config.Events = EventsConfig{
+		Backend:     vc.viper.GetString("events.backend"),
+		Source:      vc.viper.GetString("events.source"),
+		Encoding:    vc.viper.GetString("events.encoding"),
+		Group:       vc.viper.GetString("events.group"),
+		TopicPrefix: vc.viper.GetString("events.topic_prefix"),
+		Topics:      topics,
+		AckWait:     vc.viper.GetDuration("events.ack_wait"),
+		MaxDeliver:  vc.viper.GetInt("events.max_deliver"),
+		Memory: EventsMemoryConfig{
+			Workers:      vc.viper.GetInt("events.memory.workers"),
+			QueueSize:    vc.viper.GetInt("events.memory.queue_size"),
+			Backpressure: vc.viper.GetString("events.memory.backpressure"),
+			BlockTimeout: vc.viper.GetDuration("events.memory.block_timeout"),
+			Queues:       memoryQueues,
+		},
+		NATS: EventsNATSConfig{
+			URL:      vc.viper.GetString("events.nats.url"),
+			Token:    vc.viper.GetString("events.nats.token"),
+			User:     vc.viper.GetString("events.nats.user"),
+			Password: vc.viper.GetString("events.nats.password"),
+			Stream:   vc.viper.GetString("events.nats.stream"),
+		},
+		Redis: EventsRedisConfig{
+			Addr:     vc.viper.GetString("events.redis.addr"),
+			Password: vc.viper.GetString("events.redis.password"),
+			DB:       vc.viper.GetInt("events.redis.db"),
+			MaxLen:   vc.viper.GetInt64("events.redis.max_len"),
+		},
 	}
 
 	return nil
 }
 
+// parseEventMap parses comma-separated type=value pairs, such as form.submitted=submissions
+func parseEventMap(raw string) (map[string]string, error) {
+	topics := map[string]string{}
+
+	for pair := range strings.SplitSeq(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+
+		eventType, topic, ok := strings.Cut(pair, "=")
+		if !ok || strings.TrimSpace(eventType) == "" || strings.TrimSpace(topic) == "" {
+			return nil, fmt.Errorf("invalid event setting %q: want type=value", pair)
+		}
+
+		topics[strings.TrimSpace(eventType)] = strings.TrimSpace(topic)
+	}
+
+	return topics, nil
+}
+
+// parseEventQueues parses per event type queue sizing written as workers:queue_size, such as
+// form.submitted=8:4096; either number may be left out to keep the bus-wide setting
+func parseEventQueues(raw map[string]string) (map[string]EventsQueueConfig, error) {
+	queues := make(map[string]EventsQueueConfig, len(raw))
+
+	for eventType, sizing := range raw {
+		workers, queueSize, _ := strings.Cut(sizing, ":")
+
+		var queue EventsQueueConfig
+
+		for _, field := range []struct {
+			value  string
+			target *int
+		}{{workers, &queue.Workers}, {queueSize, &queue.QueueSize}} {
+			if strings.TrimSpace(field.value) == "" {
+				continue
+			}
+
+			n, err := strconv.Atoi(strings.TrimSpace(field.value))
+			if err != nil || n < 0 {
+				return nil, fmt.Errorf("invalid event queue %s=%s: want workers:queue_size", eventType, sizing)
+			}
+
+			*field.target = n
+		}
+
+		queues[eventType] = queue
+	}
+
+	return queues, nil
+}
+
 // LoadForEnvironment loads configuration for a specific environment
 func (vc *ViperConfig) LoadForEnvironment(env string) (*Config, error) {
 	// Set environment-specific config file
@@ -404,6 +641,7 @@ func setDefaults(v *viper.Viper) {
 	setDatabaseDefaults(v)
 	setSecurityDefaults(v)
 	setSessionDefaults(v)
+	setEventsDefaults(v)
 }
 
 // setAppDefaults sets application default values
@@ -421,11 +659,18 @@ func setAppDefaults(v *viper.Viper) {
 	v.SetDefault("app.write_timeout", DefaultWriteTimeout)
 	v.SetDefault("app.idle_timeout", DefaultIdleTimeout)
 	v.SetDefault("app.request_timeout", DefaultRequestTimeout)
+	v.SetDefault("app.idempotency.store", "database")
+	v.SetDefault("app.idempotency.ttl", DefaultIdempotencyTTL)
+	v.SetDefault("app.stream.max_connections_per_user", DefaultStreamMaxConnectionsPerUser)
+	v.SetDefault("app.stream.replay_size", DefaultStreamReplaySize)
+	v.SetDefault("app.stream.heartbeat", DefaultStreamHeartbeat)
+	v.SetDefault("app.stream.analytics_interval", DefaultStreamAnalyticsInterval)
+	v.SetDefault("app.openapi_validation", false)
 }
 
 // setDatabaseDefaults sets database default values
 func setDatabaseDefaults(v *viper.Viper) {
-	v.SetDefault("database.driver", "postgres")
+	v.SetDefault("database.driver", DriverPostgres)
 	v.SetDefault("database.host", "localhost")
 	v.SetDefault("database.port", DefaultDBPort)
 	v.SetDefault("database.name", "goforms")
@@ -460,7 +705,7 @@ func setCORSDefaults(v *viper.Viper) {
 	v.SetDefault("security.cors.enabled", true)
 	v.SetDefault("security.cors.allowed_origins", []string{})
 	v.SetDefault("security.cors.allowed_methods", []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"})
-	allowedHeaders := []string{"Content-Type", "Authorization", "X-Csrf-Token", "X-Requested-With", "X-API-Key"}
+	allowedHeaders := []string{"Content-Type", "Authorization", "X-Csrf-Token", "X-Requested-With", "X-API-Key", "Idempotency-Key"}
 	v.SetDefault("security.cors.allowed_headers", allowedHeaders)
 	v.SetDefault("security.cors.exposed_headers", []string{})
 	v.SetDefault("security.cors.allow_credentials", true)
@@ -473,6 +718,9 @@ const defaultAssertionTimestampSkewSeconds = 60
 func setAssertionDefaults(v *viper.Viper) {
 	v.SetDefault("security.assertion.secret", "")
 	v.SetDefault("security.assertion.timestamp_skew_seconds", defaultAssertionTimestampSkewSeconds)
+	v.SetDefault("security.assertion.nonce.required", false)
+	v.SetDefault("security.assertion.nonce.store", "memory")
+	v.SetDefault("security.assertion.nonce.key_prefix", "goforms:assertion:nonce:")
 }
 
 // setAPIKeyDefaults sets API key default values
@@ -546,16 +794,69 @@ func setSessionDefaults(v *viper.Viper) {
 	v.SetDefault("session.secure", false)
 	v.SetDefault("session.http_only", true)
 	v.SetDefault("session.same_site", "lax")
-	v.SetDefault("session.store", "memory")
-	v.SetDefault("session.store_file", "storage/sessions/sessions.json")
+	v.SetDefault("session.store", "database")
+	v.SetDefault("session.redis.key_prefix", "goforms:session:")
 	v.SetDefault("session.cookie_name", "session")
 }
 
-// NewViperConfigProvider creates an Fx provider for Viper configuration
+// setEventsDefaults sets event bus default values
+func setEventsDefaults(v *viper.Viper) {
+	v.SetDefault("events.backend", EventBackendMemory)
+	v.SetDefault("events.source", "goforms")
+	v.SetDefault("events.encoding", EventsEncodingJSON)
+	v.SetDefault("events.group", DefaultEventsGroup)
+	v.SetDefault("events.topic_prefix", DefaultEventsTopicPrefix)
+	v.SetDefault("events.ack_wait", DefaultEventsAckWait)
+	v.SetDefault("events.max_deliver", DefaultEventsMaxDeliver)
+	v.SetDefault("events.memory.workers", DefaultEventsMemoryWorkers)
+	v.SetDefault("events.memory.queue_size", DefaultEventsMemoryQueueSize)
+	v.SetDefault("events.memory.backpressure", EventsBackpressureBlock)
+	v.SetDefault("events.memory.block_timeout", DefaultEventsMemoryBlockTimeout)
+	v.SetDefault("events.nats.stream", DefaultEventsNATSStream)
+	v.SetDefault("events.redis.max_len", DefaultEventsRedisMaxLen)
+}
+
+// Overrides are configuration values that take precedence over config files and the
+// environment, such as the settings implied by a command-line flag. Keys are dotted config
+// keys like database.driver.
+type Overrides map[string]any
+
+// Override sets configuration values that take precedence over every other source
+func (vc *ViperConfig) Override(overrides Overrides) {
+	for key, value := range overrides {
+		vc.viper.Set(key, value)
+	}
+}
+
+// viperConfigParams are the optional inputs of the configuration provider
+type viperConfigParams struct {
+	fx.In
+
+	Overrides Overrides `optional:"true"`
+
+	// SecretProviders are external secret stores, such as a vault, supplied to the
+	// secret_providers group
+	SecretProviders []SecretProvider `group:"secret_providers"`
+}
+
+// NewViperConfigProvider creates an Fx provider for Viper configuration. Overrides supplied
+// to the application are applied before the configuration is validated. The loader is provided
+// alongside the configuration so it can be reloaded from the same sources, including the
+// secret providers.
 func NewViperConfigProvider() fx.Option {
-	return fx.Provide(func() (*Config, error) {
+	return fx.Provide(func(p viperConfigParams) (*Config, *ViperConfig, error) {
 		vc := NewViperConfig()
+		vc.Override(p.Overrides)
+
+		for _, provider := range p.SecretProviders {
+			vc.AddSecretProvider(provider)
+		}
+
+		cfg, err := vc.Load()
+		if err != nil {
+			return nil, nil, err
+		}
 
-		return vc.Load()
+		return cfg, vc, nil
 	})
 }
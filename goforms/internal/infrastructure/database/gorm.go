@@ -8,6 +8,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/glebarez/sqlite"
 	"gorm.io/driver/mysql"
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
@@ -30,6 +31,8 @@ const (
 	ConnectionPoolWarningThreshold = 0.8
 	// ConnectionPoolPercentageMultiplier is used to convert ratio to percentage
 	ConnectionPoolPercentageMultiplier = 100
+	// sqliteBusyTimeoutMillis is how long SQLite waits for another connection's write lock
+	sqliteBusyTimeoutMillis = 5000
 )
 
 // GormDB wraps the GORM database connection
@@ -122,12 +125,15 @@ func createDatabaseConnection(cfg *config.Config, gormConfig *gorm.Config) (*gor
 
 	// Create database connection based on the selected driver
 	switch cfg.Database.Driver {
-	case "postgres":
+	case config.DriverPostgres:
 		dsn := buildPostgresDSN(cfg)
 		db, err = gorm.Open(postgres.Open(dsn), gormConfig)
-	case "mariadb":
+	case config.DriverMariaDB:
 		dsn := buildMariaDBDSN(cfg)
 		db, err = gorm.Open(mysql.Open(dsn), gormConfig)
+	case config.DriverSQLite:
+		dsn := buildSQLiteDSN(cfg)
+		db, err = gorm.Open(sqlite.Open(dsn), gormConfig)
 	default:
 		return nil, fmt.Errorf("unsupported database connection type: %s", cfg.Database.Driver)
 	}
@@ -162,6 +168,18 @@ func buildMariaDBDSN(cfg *config.Config) string {
 	)
 }
 
+// buildSQLiteDSN builds the SQLite connection string for the pure Go driver, which keeps
+// CGO_ENABLED=0 builds working. Foreign keys are off by default in SQLite and must be
+// enabled per connection; WAL lets readers run alongside the single writer, and immediate
+// transactions take the write lock up front instead of failing with SQLITE_BUSY when two
+// transactions try to upgrade a read lock at once.
+func buildSQLiteDSN(cfg *config.Config) string {
+	return fmt.Sprintf("file:%s?_pragma=foreign_keys(1)&_pragma=journal_mode(WAL)&_pragma=busy_timeout(%d)&_txlock=immediate",
+		cfg.Database.Path,
+		sqliteBusyTimeoutMillis,
+	)
+}
+
 // configureConnectionPool configures the database connection pool
 func configureConnectionPool(db *gorm.DB, cfg *config.Config) error {
 	sqlDB, err := db.DB()
@@ -0,0 +1,231 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/goformx/goforms/internal/domain/common/events"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+)
+
+// consumerRetryDelay is how long a consumer waits before retrying after the broker failed
+const consumerRetryDelay = time.Second
+
+// brokerBus holds what the broker-backed buses share: subscribers, one consumer goroutine per
+// subscribed event type, encoding published events, and validating and dispatching delivered
+// envelopes to handlers. The bus embedding it supplies consume, which reads one event type from
+// the broker until its context ends. A broadcast bus consumes without a durable group, so every
+// process gets every event published while it subscribes.
+type brokerBus struct {
+	cfg       config.EventsConfig
+	logger    logging.Logger
+	schemas   *events.SchemaRegistry
+	consume   func(ctx context.Context, eventName string)
+	broadcast bool
+
+	mu        sync.Mutex
+	handlers  map[string][]func(context.Context, events.Event) error
+	consumers map[string]context.CancelFunc
+	running   bool
+	wg        sync.WaitGroup
+}
+
+func newBrokerBus(cfg config.EventsConfig, logger logging.Logger, schemas *events.SchemaRegistry) *brokerBus {
+	return &brokerBus{
+		cfg:       cfg,
+		logger:    logger,
+		schemas:   schemas,
+		handlers:  make(map[string][]func(context.Context, events.Event) error),
+		consumers: make(map[string]context.CancelFunc),
+	}
+}
+
+// Subscribe registers handler for events named eventName. Handlers of this process share the
+// durable consumer of its group, so each event reaches one replica; handlers that return an
+// error have the event delivered again, to every handler of the type. Every replica subscribed
+// to a broadcast bus receives each event.
+func (b *brokerBus) Subscribe(
+	_ context.Context,
+	eventName string,
+	handler func(context.Context, events.Event) error,
+) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.handlers[eventName] = append(b.handlers[eventName], handler)
+
+	if b.running {
+		b.startConsumerLocked(eventName)
+	}
+
+	return nil
+}
+
+// Unsubscribe removes the handlers of eventName and stops consuming it. The durable consumer is
+// kept, so events published meanwhile are delivered when the type is subscribed again; a
+// broadcast bus misses them.
+func (b *brokerBus) Unsubscribe(_ context.Context, eventName string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.handlers, eventName)
+
+	if cancel, ok := b.consumers[eventName]; ok {
+		cancel()
+		delete(b.consumers, eventName)
+	}
+
+	return nil
+}
+
+// Start begins consuming every subscribed event type
+func (b *brokerBus) Start(_ context.Context) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.running = true
+
+	for eventName := range b.handlers {
+		b.startConsumerLocked(eventName)
+	}
+
+	return nil
+}
+
+// stopConsumers cancels the consumers and waits for them, or for ctx to end
+func (b *brokerBus) stopConsumers(ctx context.Context) error {
+	b.mu.Lock()
+	b.running = false
+
+	for eventName, cancel := range b.consumers {
+		cancel()
+		delete(b.consumers, eventName)
+	}
+	b.mu.Unlock()
+
+	done := make(chan struct{})
+
+	go func() {
+		b.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return fmt.Errorf("stop event consumers: %w", ctx.Err())
+	}
+}
+
+// startConsumerLocked starts the consumer of eventName unless it runs; callers must hold the lock
+func (b *brokerBus) startConsumerLocked(eventName string) {
+	if _, ok := b.consumers[eventName]; ok {
+		return
+	}
+
+	// Consumers outlive the context of the Start or Subscribe call that began them
+	ctx, cancel := context.WithCancel(context.Background())
+	b.consumers[eventName] = cancel
+
+	b.wg.Add(1)
+
+	go func() {
+		defer b.wg.Done()
+
+		b.consume(ctx, eventName)
+	}()
+}
+
+// encode wraps event in an envelope and serializes it in the configured format
+func (b *brokerBus) encode(event events.Event) (*Envelope, []byte, error) {
+	envelope, err := NewEnvelope(event, b.cfg.Source, b.schemas)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data, err := EncodeEnvelope(envelope, b.cfg.Encoding)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return envelope, data, nil
+}
+
+// deliver decodes a message and runs the handlers of eventName on it. A nil result acknowledges
+// the message: unreadable messages, payloads that break the schema version they name and other
+// event types sharing the topic are acknowledged without running handlers, since delivering them
+// again would not help. Payloads of schema versions this build does not know are delivered.
+func (b *brokerBus) deliver(ctx context.Context, eventName string, data []byte) error {
+	envelope, err := DecodeEnvelope(data)
+	if err != nil {
+		b.logger.Error("dropping unreadable event", "event", eventName, "error", err)
+
+		return nil
+	}
+
+	if envelope.Type != eventName {
+		return nil
+	}
+
+	if b.schemas != nil && envelope.DataVersion > 0 {
+		err = b.schemas.Validate(envelope.Type, envelope.DataVersion, envelope.Data)
+		if err != nil && !errors.Is(err, events.ErrSchemaNotFound) {
+			b.logger.Error("dropping invalid event", "event", eventName, "event_id", envelope.ID, "error", err)
+
+			return nil
+		}
+	}
+
+	b.mu.Lock()
+	handlers := slices.Clone(b.handlers[eventName])
+	b.mu.Unlock()
+
+	handlerCtx, cancel := context.WithTimeout(ctx, b.cfg.AckWait)
+	defer cancel()
+
+	event := NewRemoteEvent(envelope)
+
+	var errs []error
+
+	for _, handler := range handlers {
+		if handleErr := handler(handlerCtx, event); handleErr != nil {
+			errs = append(errs, handleErr)
+		}
+	}
+
+	if len(errs) > 0 {
+		b.logger.Error("failed to handle event", "event", eventName, "event_id", envelope.ID, "error", errors.Join(errs...))
+
+		return errors.Join(errs...)
+	}
+
+	return nil
+}
+
+// consumerGroup is the durable consumer of eventName: the configured group and the event type,
+// restricted to characters NATS and Redis both accept in names
+func (b *brokerBus) consumerGroup(eventName string) string {
+	return consumerNameReplacer.Replace(b.cfg.Group + "_" + eventName)
+}
+
+var consumerNameReplacer = strings.NewReplacer(".", "_", "*", "_", ">", "_", " ", "_", "/", "_", "\\", "_")
+
+// sleepCtx waits for d or until ctx ends, reporting whether the full wait elapsed
+func sleepCtx(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
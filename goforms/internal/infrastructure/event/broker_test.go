@@ -0,0 +1,601 @@
+package event_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/nats-io/nats-server/v2/server"
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/goformx/goforms/internal/domain/common/events"
+	formevents "github.com/goformx/goforms/internal/domain/form/events"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/event"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+	"github.com/goformx/goforms/internal/infrastructure/sanitization"
+)
+
+const (
+	submittedTopic   = "goforms.submissions"
+	submittedDurable = "test_form_submitted"
+	natsStream       = "TEST_EVENTS"
+	probeID          = "probe"
+	natsToken        = "s3cret"
+	// redisAddrEnv names a disposable Redis server to test against instead of an in-process one;
+	// its database is flushed
+	redisAddrEnv = "GOFORMS_TEST_REDIS_ADDR"
+)
+
+// broker runs event buses of one backend against a server
+type broker struct {
+	name string
+	// newBus creates a bus in group, stopped when the test ends
+	newBus func(t *testing.T, group string) events.EventBus
+	// newBroadcastBus creates a broadcast bus in group, stopped when the test ends
+	newBroadcastBus func(t *testing.T, group string) events.EventBus
+	// durableGroups counts the consumer groups kept for form.submitted events
+	durableGroups func() int
+	// pending counts form.submitted events delivered to the test group and not acknowledged
+	pending func() int
+	// unreachable creates a bus that cannot reach its broker
+	unreachable func(t *testing.T) events.EventBus
+}
+
+func newLogger(t *testing.T) logging.Logger {
+	t.Helper()
+
+	factory, err := logging.NewFactory(&logging.FactoryConfig{AppName: "goforms-test", LogLevel: "error"},
+		sanitization.NewService())
+	require.NoError(t, err)
+
+	logger, err := factory.WithTestCore(zapcore.NewNopCore()).CreateLogger()
+	require.NoError(t, err)
+
+	return logger
+}
+
+func newSchemaRegistry(t *testing.T) *events.SchemaRegistry {
+	t.Helper()
+
+	schemas, err := formevents.NewSchemaRegistry()
+	require.NoError(t, err)
+
+	return schemas
+}
+
+// eventsConfig maps form.submitted to its own topic and redelivers quickly
+func eventsConfig(group string) config.EventsConfig {
+	return config.EventsConfig{
+		Source:      "goforms-test",
+		Encoding:    config.EventsEncodingJSON,
+		Group:       group,
+		TopicPrefix: config.DefaultEventsTopicPrefix,
+		Topics:      map[string]string{string(formevents.FormSubmittedEventType): submittedTopic},
+		AckWait:     100 * time.Millisecond,
+		MaxDeliver:  3,
+		NATS:        config.EventsNATSConfig{Stream: natsStream},
+	}
+}
+
+func startBus(t *testing.T, bus events.EventBus) events.EventBus {
+	t.Helper()
+
+	t.Cleanup(func() { _ = bus.Stop(context.Background()) })
+	require.NoError(t, bus.Start(t.Context()))
+
+	return bus
+}
+
+func startBroadcastBus(t *testing.T, cfg config.EventsConfig, logger logging.Logger, schemas *events.SchemaRegistry) events.EventBus {
+	t.Helper()
+
+	bus, owned, err := event.NewBroadcastBus(cfg, logger, schemas, nil)
+	require.NoError(t, err)
+	require.True(t, owned)
+
+	return startBus(t, bus)
+}
+
+// redisServer returns the address of the Redis server named by redisAddrEnv, or of an
+// in-process one, and a client for inspecting it
+func redisServer(t *testing.T) (string, *redis.Client) {
+	t.Helper()
+
+	addr := os.Getenv(redisAddrEnv)
+	if addr == "" {
+		addr = miniredis.RunT(t).Addr()
+	}
+
+	client := redis.NewClient(&redis.Options{Addr: addr})
+	t.Cleanup(func() { _ = client.Close() })
+	require.NoError(t, client.FlushDB(t.Context()).Err())
+
+	return addr, client
+}
+
+// natsServer runs a JetStream enabled NATS server requiring natsToken and returns a client for inspecting it
+func natsServer(t *testing.T) (*server.Server, jetstream.JetStream) {
+	t.Helper()
+
+	srv, err := server.NewServer(&server.Options{
+		Host:          "127.0.0.1",
+		Port:          server.RANDOM_PORT,
+		JetStream:     true,
+		StoreDir:      t.TempDir(),
+		Authorization: natsToken,
+		NoSigs:        true,
+	})
+	require.NoError(t, err)
+
+	srv.Start()
+	t.Cleanup(srv.Shutdown)
+	require.True(t, srv.ReadyForConnections(5*time.Second), "nats server did not start")
+
+	conn, err := nats.Connect(srv.ClientURL(), nats.Token(natsToken))
+	require.NoError(t, err)
+	t.Cleanup(conn.Close)
+
+	js, err := jetstream.New(conn)
+	require.NoError(t, err)
+
+	return srv, js
+}
+
+// brokers returns each backend publishing in each encoding
+func brokers(t *testing.T) []broker {
+	t.Helper()
+
+	logger := newLogger(t)
+	schemas := newSchemaRegistry(t)
+
+	redisAddr, redisClient := redisServer(t)
+	natsSrv, js := natsServer(t)
+
+	var all []broker
+
+	for _, encoding := range []string{config.EventsEncodingJSON, config.EventsEncodingProtobuf} {
+		all = append(all,
+			broker{
+				name: "redis/" + encoding,
+				newBus: func(t *testing.T, group string) events.EventBus {
+					t.Helper()
+
+					cfg := eventsConfig(group)
+					cfg.Encoding = encoding
+					cfg.Redis = config.EventsRedisConfig{Addr: redisAddr, MaxLen: 1000}
+
+					return startBus(t, event.NewRedisStreamBus(cfg, logger, schemas))
+				},
+				newBroadcastBus: func(t *testing.T, group string) events.EventBus {
+					t.Helper()
+
+					cfg := eventsConfig(group)
+					cfg.Backend, cfg.Encoding = config.EventBackendRedis, encoding
+					cfg.Redis = config.EventsRedisConfig{Addr: redisAddr, MaxLen: 1000}
+
+					return startBroadcastBus(t, cfg, logger, schemas)
+				},
+				durableGroups: func() int {
+					groups, err := redisClient.XInfoGroups(t.Context(), submittedTopic).Result()
+					if err != nil {
+						return -1
+					}
+
+					return len(groups)
+				},
+				pending: func() int {
+					summary, err := redisClient.XPending(t.Context(), submittedTopic, submittedDurable).Result()
+					if err != nil {
+						return -1
+					}
+
+					return int(summary.Count)
+				},
+				unreachable: func(t *testing.T) events.EventBus {
+					t.Helper()
+
+					cfg := eventsConfig("test")
+					cfg.Redis.Addr = "127.0.0.1:1"
+
+					return event.NewRedisStreamBus(cfg, logger, schemas)
+				},
+			},
+			broker{
+				name: "nats/" + encoding,
+				newBus: func(t *testing.T, group string) events.EventBus {
+					t.Helper()
+
+					cfg := eventsConfig(group)
+					cfg.Encoding = encoding
+					cfg.NATS.URL, cfg.NATS.Token = natsSrv.ClientURL(), natsToken
+
+					return startBus(t, event.NewNATSJetStreamBus(cfg, logger, schemas))
+				},
+				newBroadcastBus: func(t *testing.T, group string) events.EventBus {
+					t.Helper()
+
+					cfg := eventsConfig(group)
+					cfg.Backend, cfg.Encoding = config.EventBackendNATS, encoding
+					cfg.NATS.URL, cfg.NATS.Token = natsSrv.ClientURL(), natsToken
+
+					return startBroadcastBus(t, cfg, logger, schemas)
+				},
+				durableGroups: func() int {
+					stream, err := js.Stream(t.Context(), natsStream)
+					if err != nil {
+						return -1
+					}
+
+					durable := 0
+
+					for info := range stream.ListConsumers(t.Context()).Info() {
+						if info.Config.Durable != "" {
+							durable++
+						}
+					}
+
+					return durable
+				},
+				pending: func() int {
+					consumer, err := js.Consumer(t.Context(), natsStream, submittedDurable)
+					if err != nil {
+						return -1
+					}
+
+					info, err := consumer.Info(t.Context())
+					if err != nil {
+						return -1
+					}
+
+					return info.NumAckPending
+				},
+				unreachable: func(t *testing.T) events.EventBus {
+					t.Helper()
+
+					cfg := eventsConfig("test")
+					cfg.NATS.URL, cfg.NATS.Token = natsSrv.ClientURL(), "wrong"
+
+					return event.NewNATSJetStreamBus(cfg, logger, schemas)
+				},
+			},
+		)
+	}
+
+	return all
+}
+
+// recorder collects the submissions delivered to a handler, noting probes separately
+type recorder struct {
+	mu     sync.Mutex
+	ids    []string
+	probed bool
+}
+
+func (r *recorder) handle(_ context.Context, e events.Event) error {
+	remote, ok := e.(*event.RemoteEvent)
+	if !ok {
+		return errors.New("not a remote event")
+	}
+
+	var submission model.FormSubmission
+	if err := remote.Decode(&submission); err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if submission.ID == probeID {
+		r.probed = true
+	} else {
+		r.ids = append(r.ids, submission.ID)
+	}
+
+	return nil
+}
+
+func (r *recorder) subscribed() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.probed
+}
+
+func (r *recorder) received() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return append([]string(nil), r.ids...)
+}
+
+func submitted(id string) events.Event {
+	return formevents.NewFormSubmittedEvent(&model.FormSubmission{
+		ID:          id,
+		FormID:      "form-1",
+		Data:        model.JSON{"email": "ada@example.com"},
+		Status:      model.SubmissionStatusPending,
+		SubmittedAt: time.Now(),
+	})
+}
+
+// waitForSubscription publishes probes until subscribed reports they arrive, so the durable
+// consumers exist; events published before that would not reach them
+func waitForSubscription(t *testing.T, publisher events.EventBus, subscribed func() bool) {
+	t.Helper()
+
+	require.Eventually(t, func() bool {
+		require.NoError(t, publisher.Publish(t.Context(), submitted(probeID)))
+
+		return subscribed()
+	}, 5*time.Second, 50*time.Millisecond)
+}
+
+func TestBrokerBus_DeliversEachEventOncePerGroup(t *testing.T) {
+	for _, b := range brokers(t) {
+		t.Run(b.name, func(t *testing.T) {
+			var replicaA, replicaB, audit recorder
+
+			busA, busB, auditBus := b.newBus(t, "test"), b.newBus(t, "test"), b.newBus(t, "audit")
+			require.NoError(t, busA.Subscribe(t.Context(), string(formevents.FormSubmittedEventType), replicaA.handle))
+			require.NoError(t, busB.Subscribe(t.Context(), string(formevents.FormSubmittedEventType), replicaB.handle))
+			require.NoError(t, auditBus.Subscribe(t.Context(), string(formevents.FormSubmittedEventType), audit.handle))
+
+			waitForSubscription(t, busA, func() bool {
+				return audit.subscribed() && (replicaA.subscribed() || replicaB.subscribed())
+			})
+
+			require.NoError(t, busA.PublishBatch(t.Context(), []events.Event{submitted("s1"), submitted("s2")}))
+			require.NoError(t, busB.Publish(t.Context(), formevents.NewFormDeletedEvent("form-1")))
+			require.NoError(t, busB.Publish(t.Context(), submitted("s3")))
+
+			require.Eventually(t, func() bool {
+				return len(replicaA.received())+len(replicaB.received()) == 3 && len(audit.received()) == 3
+			}, 5*time.Second, 10*time.Millisecond)
+
+			// Each replica of a group gets an event once between them; every group gets all of them
+			assert.ElementsMatch(t, []string{"s1", "s2", "s3"}, append(replicaA.received(), replicaB.received()...))
+			assert.ElementsMatch(t, []string{"s1", "s2", "s3"}, audit.received())
+			assert.Eventually(t, func() bool { return b.pending() == 0 }, 5*time.Second, 10*time.Millisecond)
+		})
+	}
+}
+
+func TestBrokerBus_EnvelopeIsACloudEvent(t *testing.T) {
+	for _, b := range brokers(t) {
+		t.Run(b.name, func(t *testing.T) {
+			received := make(chan *event.RemoteEvent, 16)
+
+			bus := b.newBus(t, "test")
+			require.NoError(t, bus.Subscribe(t.Context(), string(formevents.FormDeletedEventType), func(_ context.Context, e events.Event) error {
+				remote, _ := e.(*event.RemoteEvent)
+				received <- remote
+
+				return nil
+			}))
+
+			var remote *event.RemoteEvent
+
+			require.Eventually(t, func() bool {
+				require.NoError(t, bus.Publish(t.Context(), formevents.NewFormDeletedEvent("form-9")))
+
+				select {
+				case remote = <-received:
+					return true
+				case <-time.After(50 * time.Millisecond):
+					return false
+				}
+			}, 5*time.Second, time.Millisecond)
+
+			assert.Equal(t, string(formevents.FormDeletedEventType), remote.Name())
+			assert.Equal(t, "goforms-test", remote.Source())
+			assert.NotEmpty(t, remote.ID())
+			assert.WithinDuration(t, time.Now(), remote.Timestamp(), time.Minute)
+			assert.Equal(t, 1, remote.Version())
+			assert.Equal(t, "urn:goformx:event-schema:form.deleted:1", remote.DataSchema())
+
+			var payload formevents.FormDeletedPayload
+			require.NoError(t, remote.Decode(&payload))
+			assert.Equal(t, "form-9", payload.FormID)
+		})
+	}
+}
+
+func TestBrokerBus_RedeliversFailedEventsUpToMaxDeliver(t *testing.T) {
+	for _, b := range brokers(t) {
+		t.Run(b.name, func(t *testing.T) {
+			var (
+				mu       sync.Mutex
+				attempts = map[string]int{}
+				probe    recorder
+			)
+
+			bus := b.newBus(t, "test")
+			require.NoError(t, bus.Subscribe(t.Context(), string(formevents.FormSubmittedEventType), func(ctx context.Context, e events.Event) error {
+				var submission model.FormSubmission
+				if err := e.(*event.RemoteEvent).Decode(&submission); err != nil {
+					return err
+				}
+
+				if submission.ID == probeID {
+					return probe.handle(ctx, e)
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+
+				attempts[submission.ID]++
+
+				// "flaky" succeeds on its second delivery; "broken" never does
+				if submission.ID == "flaky" && attempts[submission.ID] == 2 {
+					return nil
+				}
+
+				return errors.New("handler failed")
+			}))
+
+			waitForSubscription(t, bus, probe.subscribed)
+
+			require.NoError(t, bus.Publish(t.Context(), submitted("flaky")))
+			require.NoError(t, bus.Publish(t.Context(), submitted("broken")))
+
+			count := func(id string) int {
+				mu.Lock()
+				defer mu.Unlock()
+
+				return attempts[id]
+			}
+
+			require.Eventually(t, func() bool {
+				return count("flaky") == 2 && count("broken") == 3 && b.pending() == 0
+			}, 10*time.Second, 10*time.Millisecond)
+
+			// Neither is delivered again: one was handled, the other reached MaxDeliver
+			time.Sleep(400 * time.Millisecond)
+			assert.Equal(t, 2, count("flaky"))
+			assert.Equal(t, 3, count("broken"))
+		})
+	}
+}
+
+func TestBrokerBus_KeepsEventsWhileGroupIsDown(t *testing.T) {
+	for _, b := range brokers(t) {
+		t.Run(b.name, func(t *testing.T) {
+			var first, second recorder
+
+			publisher := b.newBus(t, "publisher")
+
+			subscriber := b.newBus(t, "test")
+			require.NoError(t, subscriber.Subscribe(t.Context(), string(formevents.FormSubmittedEventType), first.handle))
+			waitForSubscription(t, publisher, first.subscribed)
+			require.NoError(t, subscriber.Stop(t.Context()))
+
+			// Published while no replica of the group runs
+			require.NoError(t, publisher.Publish(t.Context(), submitted("while-down")))
+
+			restarted := b.newBus(t, "test")
+			require.NoError(t, restarted.Subscribe(t.Context(), string(formevents.FormSubmittedEventType), second.handle))
+
+			require.Eventually(t, func() bool {
+				return assert.ObjectsAreEqual([]string{"while-down"}, second.received())
+			}, 5*time.Second, 10*time.Millisecond)
+			assert.Empty(t, first.received())
+		})
+	}
+}
+
+func TestBrokerBus_BroadcastsEveryEventToEveryReplica(t *testing.T) {
+	for _, b := range brokers(t) {
+		t.Run(b.name, func(t *testing.T) {
+			var replicaA, replicaB, late recorder
+
+			publisher := b.newBus(t, "publisher")
+
+			busA, busB := b.newBroadcastBus(t, "test"), b.newBroadcastBus(t, "test")
+			require.NoError(t, busA.Subscribe(t.Context(), string(formevents.FormSubmittedEventType), replicaA.handle))
+			require.NoError(t, busB.Subscribe(t.Context(), string(formevents.FormSubmittedEventType), replicaB.handle))
+			waitForSubscription(t, publisher, func() bool { return replicaA.subscribed() && replicaB.subscribed() })
+
+			require.NoError(t, publisher.PublishBatch(t.Context(), []events.Event{submitted("s1"), submitted("s2")}))
+
+			require.Eventually(t, func() bool {
+				return len(replicaA.received()) == 2 && len(replicaB.received()) == 2
+			}, 5*time.Second, 10*time.Millisecond)
+			assert.Equal(t, []string{"s1", "s2"}, replicaA.received())
+			assert.Equal(t, []string{"s1", "s2"}, replicaB.received())
+
+			// A replica starting later gets the events published from then on, not the earlier ones
+			lateBus := b.newBroadcastBus(t, "test")
+			require.NoError(t, lateBus.Subscribe(t.Context(), string(formevents.FormSubmittedEventType), late.handle))
+			waitForSubscription(t, publisher, late.subscribed)
+			require.NoError(t, publisher.Publish(t.Context(), submitted("s3")))
+
+			require.Eventually(t, func() bool {
+				return assert.ObjectsAreEqual([]string{"s3"}, late.received())
+			}, 5*time.Second, 10*time.Millisecond)
+
+			// Nothing durable is left on the broker for the group
+			assert.Equal(t, 0, b.durableGroups())
+		})
+	}
+}
+
+func TestBrokerBus_Health(t *testing.T) {
+	for _, b := range brokers(t) {
+		t.Run(b.name, func(t *testing.T) {
+			bus := b.newBus(t, "test")
+			require.NoError(t, bus.Publish(t.Context(), submitted("s1")))
+			require.NoError(t, bus.Health(t.Context()))
+
+			unreachable := b.unreachable(t)
+			t.Cleanup(func() { _ = unreachable.Stop(context.Background()) })
+			require.Error(t, unreachable.Health(t.Context()))
+			require.Error(t, unreachable.Publish(t.Context(), submitted("s2")))
+		})
+	}
+}
+
+func TestBrokerBus_RejectsPayloadsBreakingTheirSchema(t *testing.T) {
+	for _, b := range brokers(t) {
+		t.Run(b.name, func(t *testing.T) {
+			bus := b.newBus(t, "test")
+
+			// A submission without data or status does not match form.submitted version 1
+			err := bus.Publish(t.Context(), formevents.NewFormSubmittedEvent(&model.FormSubmission{ID: "s1", FormID: "form-1"}))
+
+			var schemaErr *events.SchemaValidationError
+			require.ErrorAs(t, err, &schemaErr)
+			assert.Equal(t, 1, schemaErr.Version)
+
+			// Event types without a schema are published unchecked
+			require.NoError(t, bus.Publish(t.Context(), formevents.NewEvent("custom.event", map[string]int{"n": 1})))
+		})
+	}
+}
+
+func TestNewEventBus(t *testing.T) {
+	logger := newLogger(t)
+
+	bus, err := event.NewEventBus(config.EventsConfig{}, logger, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &event.MemoryEventBus{}, bus)
+
+	bus, err = event.NewEventBus(config.EventsConfig{Backend: config.EventBackendRedis}, logger, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &event.RedisStreamBus{}, bus)
+
+	bus, err = event.NewEventBus(config.EventsConfig{Backend: config.EventBackendNATS}, logger, nil)
+	require.NoError(t, err)
+	assert.IsType(t, &event.NATSJetStreamBus{}, bus)
+
+	_, err = event.NewEventBus(config.EventsConfig{Backend: "kafka"}, logger, nil)
+	require.Error(t, err)
+}
+
+func TestNewBroadcastBus(t *testing.T) {
+	logger := newLogger(t)
+	shared := event.NewMemoryEventBus(logger)
+
+	bus, owned, err := event.NewBroadcastBus(config.EventsConfig{}, logger, nil, shared)
+	require.NoError(t, err)
+	assert.False(t, owned)
+	assert.Same(t, shared, bus.EventBus)
+
+	bus, owned, err = event.NewBroadcastBus(config.EventsConfig{Backend: config.EventBackendRedis}, logger, nil, shared)
+	require.NoError(t, err)
+	assert.True(t, owned)
+	assert.IsType(t, &event.RedisStreamBus{}, bus.EventBus)
+
+	_, _, err = event.NewBroadcastBus(config.EventsConfig{Backend: "kafka"}, logger, nil, shared)
+	require.Error(t, err)
+}
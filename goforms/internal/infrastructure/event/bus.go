@@ -0,0 +1,60 @@
+package event
+
+import (
+	"fmt"
+
+	"github.com/goformx/goforms/internal/domain/common/events"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+)
+
+// NewEventBus creates the event bus selected by cfg.Backend; an unset backend is the memory bus.
+// Broker-backed buses check the payloads they publish and receive against schemas; a nil
+// registry disables the checks. The memory bus passes payloads by value and checks nothing.
+func NewEventBus(cfg config.EventsConfig, logger logging.Logger, schemas *events.SchemaRegistry) (events.EventBus, error) {
+	switch cfg.Backend {
+	case "", config.EventBackendMemory:
+		return NewMemoryEventBusWithOptions(logger, MemoryOptions{EventsMemoryConfig: cfg.Memory}), nil
+	case config.EventBackendNATS:
+		return NewNATSJetStreamBus(cfg, logger, schemas), nil
+	case config.EventBackendRedis:
+		return NewRedisStreamBus(cfg, logger, schemas), nil
+	default:
+		return nil, fmt.Errorf("unsupported event bus backend %q", cfg.Backend)
+	}
+}
+
+// BroadcastBus is the event bus of subscribers that keep state per process, such as open event
+// streams, and so need every event in every replica rather than in one replica of their group
+type BroadcastBus struct {
+	events.EventBus
+}
+
+// NewBroadcastBus creates the broadcast bus for the bus selected by cfg.Backend. The memory bus
+// already delivers each event to all of its subscribers, so shared is reused and owned is false.
+// A broker backend gets a bus of its own reading every event published from the moment it
+// subscribes, without a durable consumer group, so nothing is left on the broker when the process
+// ends and a restarted process does not replay old events; the caller starts and stops it.
+func NewBroadcastBus(
+	cfg config.EventsConfig,
+	logger logging.Logger,
+	schemas *events.SchemaRegistry,
+	shared events.EventBus,
+) (bus BroadcastBus, owned bool, err error) {
+	switch cfg.Backend {
+	case "", config.EventBackendMemory:
+		return BroadcastBus{EventBus: shared}, false, nil
+	case config.EventBackendNATS:
+		broadcast := NewNATSJetStreamBus(cfg, logger, schemas)
+		broadcast.broadcast = true
+
+		return BroadcastBus{EventBus: broadcast}, true, nil
+	case config.EventBackendRedis:
+		broadcast := NewRedisStreamBus(cfg, logger, schemas)
+		broadcast.broadcast = true
+
+		return BroadcastBus{EventBus: broadcast}, true, nil
+	default:
+		return BroadcastBus{}, false, fmt.Errorf("unsupported event bus backend %q", cfg.Backend)
+	}
+}
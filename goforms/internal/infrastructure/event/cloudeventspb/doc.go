@@ -0,0 +1,9 @@
+// Package cloudeventspb holds the Go bindings of the CloudEvents protobuf format
+// (io.cloudevents.v1.CloudEvent). cloudevents.proto is the file published with the
+// CloudEvents 1.0.2 specification, unchanged; cloudevents.pb.go was generated from it by
+// protoc-gen-go v1.36.1, matching the protobuf runtime in go.mod:
+//
+//	protoc --go_out=. --go_opt=paths=source_relative \
+//		--go_opt=Mcloudevents.proto="github.com/goformx/goforms/internal/infrastructure/event/cloudeventspb;cloudeventspb" \
+//		cloudevents.proto
+package cloudeventspb
@@ -0,0 +1,345 @@
+package event
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"github.com/goformx/goforms/internal/domain/common/events"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+)
+
+// CloudEvents attributes written by this build
+const (
+	// SpecVersion is the CloudEvents version of published envelopes; others are rejected
+	SpecVersion = "1.0"
+	// DataContentType is the content type of event data, the JSON of the payload
+	DataContentType = "application/json"
+	// DataVersionExtension names the extension attribute carrying the payload schema version
+	DataVersionExtension = "dataversion"
+)
+
+// Content types of the CloudEvents formats envelopes are encoded in
+const (
+	ContentTypeJSON     = "application/cloudevents+json"
+	ContentTypeProtobuf = "application/cloudevents+protobuf"
+)
+
+// ErrUnsupportedEnvelope is returned for messages that are not envelopes this build can read
+var ErrUnsupportedEnvelope = errors.New("unsupported event envelope")
+
+// Envelope is an event as published to a broker, a CloudEvents 1.0 event whose data is the JSON
+// of the payload. Publishers name the payload schema in DataSchema and its version in
+// DataVersion, so consumers can validate the payload and tell versions apart.
+type Envelope struct {
+	ID              string
+	Source          string
+	SpecVersion     string
+	Type            string
+	Time            time.Time
+	DataContentType string
+	DataSchema      string
+	Subject         string
+	// DataVersion is the payload schema version, 0 for event types without a registered schema
+	DataVersion int
+	// Extensions holds the other extension attributes, including the publisher's metadata
+	Extensions map[string]string
+	Data       json.RawMessage
+}
+
+// NewEnvelope serializes event as published by source. When schemas has a schema for the event
+// type, the payload must match its latest version, which the envelope then names.
+func NewEnvelope(event events.Event, source string, schemas *events.SchemaRegistry) (*Envelope, error) {
+	data, err := json.Marshal(event.Payload())
+	if err != nil {
+		return nil, fmt.Errorf("encode %s payload: %w", event.Name(), err)
+	}
+
+	envelope := &Envelope{
+		ID:              uuid.New().String(),
+		Source:          source,
+		SpecVersion:     SpecVersion,
+		Type:            event.Name(),
+		Time:            event.Timestamp().UTC(),
+		DataContentType: DataContentType,
+		Extensions:      metadataExtensions(event.Metadata()),
+		Data:            data,
+	}
+
+	if schemas == nil {
+		return envelope, nil
+	}
+
+	if schema, ok := schemas.Latest(event.Name()); ok {
+		if err = schemas.Validate(schema.Type, schema.Version, data); err != nil {
+			return nil, fmt.Errorf("publish %s: %w", event.Name(), err)
+		}
+
+		envelope.DataSchema = schema.URI()
+		envelope.DataVersion = schema.Version
+	}
+
+	return envelope, nil
+}
+
+// EncodeEnvelope serializes envelope in the CloudEvents format selected by encoding
+func EncodeEnvelope(envelope *Envelope, encoding string) ([]byte, error) {
+	switch encoding {
+	case "", config.EventsEncodingJSON:
+		return envelope.MarshalJSON()
+	case config.EventsEncodingProtobuf:
+		return envelope.MarshalProtobuf()
+	default:
+		return nil, fmt.Errorf("unsupported event encoding %q", encoding)
+	}
+}
+
+// DecodeEnvelope parses an envelope in either CloudEvents format. JSON envelopes are objects,
+// while a protobuf envelope starts with the tag of one of its fields.
+func DecodeEnvelope(data []byte) (*Envelope, error) {
+	var (
+		envelope Envelope
+		err      error
+	)
+
+	if trimmed := bytes.TrimLeft(data, " \t\r\n"); len(trimmed) > 0 && trimmed[0] == '{' {
+		err = envelope.UnmarshalJSON(data)
+	} else {
+		err = envelope.UnmarshalProtobuf(data)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnsupportedEnvelope, err)
+	}
+
+	if envelope.SpecVersion != SpecVersion || envelope.ID == "" || envelope.Source == "" || envelope.Type == "" {
+		return nil, fmt.Errorf("%w: type %q specversion %q", ErrUnsupportedEnvelope, envelope.Type, envelope.SpecVersion)
+	}
+
+	return &envelope, nil
+}
+
+// jsonAttributes are the attributes of the JSON format other than the extensions
+var jsonAttributes = map[string]bool{
+	"id": true, "source": true, "specversion": true, "type": true, "time": true, "datacontenttype": true,
+	"dataschema": true, "subject": true, "data": true, "data_base64": true, DataVersionExtension: true,
+}
+
+// MarshalJSON encodes the envelope in the CloudEvents JSON format, with the data inlined
+func (e *Envelope) MarshalJSON() ([]byte, error) {
+	attributes := make(map[string]any, len(e.Extensions)+10)
+	for name, value := range e.Extensions {
+		attributes[name] = value
+	}
+
+	attributes["id"] = e.ID
+	attributes["source"] = e.Source
+	attributes["specversion"] = e.SpecVersion
+	attributes["type"] = e.Type
+
+	if !e.Time.IsZero() {
+		attributes["time"] = e.Time.Format(time.RFC3339Nano)
+	}
+
+	setIfNotEmpty(attributes, "datacontenttype", e.DataContentType)
+	setIfNotEmpty(attributes, "dataschema", e.DataSchema)
+	setIfNotEmpty(attributes, "subject", e.Subject)
+
+	if e.DataVersion > 0 {
+		attributes[DataVersionExtension] = e.DataVersion
+	}
+
+	if len(e.Data) > 0 {
+		attributes["data"] = e.Data
+	}
+
+	data, err := json.Marshal(attributes)
+	if err != nil {
+		return nil, fmt.Errorf("encode %s envelope: %w", e.Type, err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalJSON decodes an envelope in the CloudEvents JSON format. Extension attributes that
+// are not strings keep their JSON text.
+func (e *Envelope) UnmarshalJSON(data []byte) error {
+	var attributes map[string]json.RawMessage
+	if err := json.Unmarshal(data, &attributes); err != nil {
+		return fmt.Errorf("decode envelope: %w", err)
+	}
+
+	var decoded Envelope
+
+	for name, target := range map[string]*string{
+		"id": &decoded.ID, "source": &decoded.Source, "specversion": &decoded.SpecVersion, "type": &decoded.Type,
+		"datacontenttype": &decoded.DataContentType, "dataschema": &decoded.DataSchema, "subject": &decoded.Subject,
+	} {
+		if raw, ok := attributes[name]; ok {
+			if err := json.Unmarshal(raw, target); err != nil {
+				return fmt.Errorf("decode envelope attribute %s: %w", name, err)
+			}
+		}
+	}
+
+	if raw, ok := attributes["time"]; ok {
+		if err := json.Unmarshal(raw, &decoded.Time); err != nil {
+			return fmt.Errorf("decode envelope attribute time: %w", err)
+		}
+	}
+
+	if raw, ok := attributes[DataVersionExtension]; ok {
+		version, err := strconv.Atoi(strings.Trim(string(raw), `"`))
+		if err != nil {
+			return fmt.Errorf("decode envelope attribute %s: %w", DataVersionExtension, err)
+		}
+
+		decoded.DataVersion = version
+	}
+
+	if raw, ok := attributes["data"]; ok {
+		decoded.Data = append(json.RawMessage(nil), raw...)
+	} else if raw, ok = attributes["data_base64"]; ok {
+		var binary []byte
+		if err := json.Unmarshal(raw, &binary); err != nil {
+			return fmt.Errorf("decode envelope attribute data_base64: %w", err)
+		}
+
+		decoded.Data = binary
+	}
+
+	for name, raw := range attributes {
+		if jsonAttributes[name] {
+			continue
+		}
+
+		value := string(raw)
+		if err := json.Unmarshal(raw, &value); err != nil {
+			value = string(raw)
+		}
+
+		decoded.setExtension(name, value)
+	}
+
+	*e = decoded
+
+	return nil
+}
+
+func (e *Envelope) setExtension(name, value string) {
+	if e.Extensions == nil {
+		e.Extensions = make(map[string]string)
+	}
+
+	e.Extensions[name] = value
+}
+
+func setIfNotEmpty(attributes map[string]any, name, value string) {
+	if value != "" {
+		attributes[name] = value
+	}
+}
+
+// metadataExtensions turns event metadata into extension attributes. CloudEvents attribute names
+// are lowercase letters and digits, so other characters are dropped from the keys, and keys
+// naming a context attribute are left out.
+func metadataExtensions(metadata map[string]any) map[string]string {
+	if len(metadata) == 0 {
+		return nil
+	}
+
+	extensions := make(map[string]string, len(metadata))
+
+	for key, value := range metadata {
+		name := strings.Map(func(r rune) rune {
+			switch {
+			case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+				return r
+			case r >= 'A' && r <= 'Z':
+				return r + 'a' - 'A'
+			default:
+				return -1
+			}
+		}, key)
+
+		if name == "" || jsonAttributes[name] {
+			continue
+		}
+
+		extensions[name] = fmt.Sprint(value)
+	}
+
+	return extensions
+}
+
+// RemoteEvent is an event received from a broker. Its payload is the JSON the publisher encoded;
+// handlers decode it into the type they expect with Decode.
+type RemoteEvent struct {
+	envelope *Envelope
+}
+
+// NewRemoteEvent wraps a decoded envelope as an event
+func NewRemoteEvent(envelope *Envelope) *RemoteEvent {
+	return &RemoteEvent{envelope: envelope}
+}
+
+// Name returns the event type
+func (e *RemoteEvent) Name() string {
+	return e.envelope.Type
+}
+
+// Timestamp returns when the event occurred
+func (e *RemoteEvent) Timestamp() time.Time {
+	return e.envelope.Time
+}
+
+// Payload returns the JSON-encoded payload as a json.RawMessage
+func (e *RemoteEvent) Payload() any {
+	return e.envelope.Data
+}
+
+// Metadata returns the extension attributes of the envelope
+func (e *RemoteEvent) Metadata() map[string]any {
+	metadata := make(map[string]any, len(e.envelope.Extensions))
+	for name, value := range e.envelope.Extensions {
+		metadata[name] = value
+	}
+
+	return metadata
+}
+
+// ID returns the envelope ID, stable across redeliveries of the event
+func (e *RemoteEvent) ID() string {
+	return e.envelope.ID
+}
+
+// Source returns the service that published the event
+func (e *RemoteEvent) Source() string {
+	return e.envelope.Source
+}
+
+// Version returns the payload schema version the event was published with, 0 when its type had
+// no registered schema
+func (e *RemoteEvent) Version() int {
+	return e.envelope.DataVersion
+}
+
+// DataSchema returns the URI of the payload schema, empty when its type had no registered schema
+func (e *RemoteEvent) DataSchema() string {
+	return e.envelope.DataSchema
+}
+
+// Decode unmarshals the payload into v
+func (e *RemoteEvent) Decode(v any) error {
+	if err := json.Unmarshal(e.envelope.Data, v); err != nil {
+		return fmt.Errorf("decode %s payload: %w", e.envelope.Type, err)
+	}
+
+	return nil
+}
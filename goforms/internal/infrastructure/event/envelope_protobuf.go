@@ -0,0 +1,179 @@
+package event
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/goformx/goforms/internal/infrastructure/event/cloudeventspb"
+)
+
+// errProtoData is returned for protobuf envelopes carrying their data as a protobuf message,
+// which this build cannot turn into the JSON payload handlers read
+var errProtoData = errors.New("protobuf data is not supported")
+
+// MarshalProtobuf encodes the envelope in the CloudEvents protobuf format. The JSON data is
+// carried as text_data; optional attributes go in the attributes map with their CloudEvents types.
+func (e *Envelope) MarshalProtobuf() ([]byte, error) {
+	msg := &cloudeventspb.CloudEvent{
+		Id:          e.ID,
+		Source:      e.Source,
+		SpecVersion: e.SpecVersion,
+		Type:        e.Type,
+		Attributes:  make(map[string]*cloudeventspb.CloudEvent_CloudEventAttributeValue),
+	}
+
+	if !e.Time.IsZero() {
+		msg.Attributes["time"] = &cloudeventspb.CloudEvent_CloudEventAttributeValue{
+			Attr: &cloudeventspb.CloudEvent_CloudEventAttributeValue_CeTimestamp{CeTimestamp: timestamppb.New(e.Time)},
+		}
+	}
+
+	if e.DataContentType != "" {
+		msg.Attributes["datacontenttype"] = protoString(e.DataContentType)
+	}
+
+	if e.DataSchema != "" {
+		msg.Attributes["dataschema"] = &cloudeventspb.CloudEvent_CloudEventAttributeValue{
+			Attr: &cloudeventspb.CloudEvent_CloudEventAttributeValue_CeUri{CeUri: e.DataSchema},
+		}
+	}
+
+	if e.Subject != "" {
+		msg.Attributes["subject"] = protoString(e.Subject)
+	}
+
+	if e.DataVersion > 0 {
+		if e.DataVersion > math.MaxInt32 {
+			return nil, fmt.Errorf("encode protobuf envelope: data version %d exceeds an integer attribute", e.DataVersion)
+		}
+
+		msg.Attributes[DataVersionExtension] = &cloudeventspb.CloudEvent_CloudEventAttributeValue{
+			Attr: &cloudeventspb.CloudEvent_CloudEventAttributeValue_CeInteger{CeInteger: int32(e.DataVersion)}, //nolint:gosec // bounded above
+		}
+	}
+
+	for name, value := range e.Extensions {
+		msg.Attributes[name] = protoString(value)
+	}
+
+	if len(e.Data) > 0 {
+		msg.Data = &cloudeventspb.CloudEvent_TextData{TextData: string(e.Data)}
+	}
+
+	data, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg)
+	if err != nil {
+		return nil, fmt.Errorf("encode protobuf envelope: %w", err)
+	}
+
+	return data, nil
+}
+
+// UnmarshalProtobuf decodes an envelope in the CloudEvents protobuf format
+func (e *Envelope) UnmarshalProtobuf(data []byte) error {
+	var msg cloudeventspb.CloudEvent
+	if err := proto.Unmarshal(data, &msg); err != nil {
+		return fmt.Errorf("decode protobuf envelope: %w", err)
+	}
+
+	decoded := Envelope{
+		ID:          msg.GetId(),
+		Source:      msg.GetSource(),
+		SpecVersion: msg.GetSpecVersion(),
+		Type:        msg.GetType(),
+	}
+
+	switch payload := msg.GetData().(type) {
+	case *cloudeventspb.CloudEvent_TextData:
+		decoded.Data = []byte(payload.TextData)
+	case *cloudeventspb.CloudEvent_BinaryData:
+		decoded.Data = payload.BinaryData
+	case *cloudeventspb.CloudEvent_ProtoData:
+		return errProtoData
+	}
+
+	for name, value := range msg.GetAttributes() {
+		if err := decoded.setProtoAttribute(name, value); err != nil {
+			return fmt.Errorf("decode protobuf envelope: attribute %s: %w", name, err)
+		}
+	}
+
+	*e = decoded
+
+	return nil
+}
+
+// setProtoAttribute sets the envelope field of one entry of the attributes map
+func (e *Envelope) setProtoAttribute(name string, value *cloudeventspb.CloudEvent_CloudEventAttributeValue) error {
+	if name == "time" {
+		if timestamp := value.GetCeTimestamp(); timestamp != nil {
+			if err := timestamp.CheckValid(); err != nil {
+				return fmt.Errorf("invalid timestamp: %w", err)
+			}
+
+			e.Time = timestamp.AsTime()
+
+			return nil
+		}
+	}
+
+	text, err := protoAttributeString(value)
+	if err != nil {
+		return err
+	}
+
+	switch name {
+	case "time":
+		e.Time, err = time.Parse(time.RFC3339Nano, text)
+	case DataVersionExtension:
+		e.DataVersion, err = strconv.Atoi(text)
+	case "datacontenttype":
+		e.DataContentType = text
+	case "dataschema":
+		e.DataSchema = text
+	case "subject":
+		e.Subject = text
+	default:
+		e.setExtension(name, text)
+	}
+
+	if err != nil {
+		return fmt.Errorf("invalid value: %w", err)
+	}
+
+	return nil
+}
+
+// protoAttributeString returns an attribute value in its CloudEvents string form
+func protoAttributeString(value *cloudeventspb.CloudEvent_CloudEventAttributeValue) (string, error) {
+	switch attr := value.GetAttr().(type) {
+	case *cloudeventspb.CloudEvent_CloudEventAttributeValue_CeString:
+		return attr.CeString, nil
+	case *cloudeventspb.CloudEvent_CloudEventAttributeValue_CeUri:
+		return attr.CeUri, nil
+	case *cloudeventspb.CloudEvent_CloudEventAttributeValue_CeUriRef:
+		return attr.CeUriRef, nil
+	case *cloudeventspb.CloudEvent_CloudEventAttributeValue_CeBytes:
+		return string(attr.CeBytes), nil
+	case *cloudeventspb.CloudEvent_CloudEventAttributeValue_CeBoolean:
+		return strconv.FormatBool(attr.CeBoolean), nil
+	case *cloudeventspb.CloudEvent_CloudEventAttributeValue_CeInteger:
+		return strconv.FormatInt(int64(attr.CeInteger), 10), nil
+	case *cloudeventspb.CloudEvent_CloudEventAttributeValue_CeTimestamp:
+		return attr.CeTimestamp.AsTime().Format(time.RFC3339Nano), nil
+	default:
+		return "", errors.New("attribute has no value")
+	}
+}
+
+// protoString is a string attribute value
+func protoString(text string) *cloudeventspb.CloudEvent_CloudEventAttributeValue {
+	return &cloudeventspb.CloudEvent_CloudEventAttributeValue{
+		Attr: &cloudeventspb.CloudEvent_CloudEventAttributeValue_CeString{CeString: text},
+	}
+}
@@ -0,0 +1,181 @@
+package event_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/goformx/goforms/internal/domain/common/events"
+	formevents "github.com/goformx/goforms/internal/domain/form/events"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/event"
+	"github.com/goformx/goforms/internal/infrastructure/event/cloudeventspb"
+)
+
+func TestEnvelope_RoundTripsInBothFormats(t *testing.T) {
+	published := submitted("s1")
+	published.Metadata()["Request-ID"] = "req-1"
+
+	envelope, err := event.NewEnvelope(published, "goforms-test", newSchemaRegistry(t))
+	require.NoError(t, err)
+
+	assert.Equal(t, event.SpecVersion, envelope.SpecVersion)
+	assert.Equal(t, event.DataContentType, envelope.DataContentType)
+	assert.Equal(t, "urn:goformx:event-schema:form.submitted:1", envelope.DataSchema)
+	assert.Equal(t, 1, envelope.DataVersion)
+	assert.Equal(t, map[string]string{"requestid": "req-1"}, envelope.Extensions)
+
+	for _, encoding := range []string{config.EventsEncodingJSON, config.EventsEncodingProtobuf} {
+		t.Run(encoding, func(t *testing.T) {
+			data, encodeErr := event.EncodeEnvelope(envelope, encoding)
+			require.NoError(t, encodeErr)
+
+			decoded, decodeErr := event.DecodeEnvelope(data)
+			require.NoError(t, decodeErr)
+
+			assert.Equal(t, envelope.ID, decoded.ID)
+			assert.Equal(t, envelope.Source, decoded.Source)
+			assert.Equal(t, envelope.Type, decoded.Type)
+			assert.True(t, envelope.Time.Equal(decoded.Time))
+			assert.Equal(t, envelope.DataContentType, decoded.DataContentType)
+			assert.Equal(t, envelope.DataSchema, decoded.DataSchema)
+			assert.Equal(t, envelope.DataVersion, decoded.DataVersion)
+			assert.Equal(t, envelope.Extensions, decoded.Extensions)
+			assert.JSONEq(t, string(envelope.Data), string(decoded.Data))
+		})
+	}
+}
+
+func TestEnvelope_JSONFormatFollowsCloudEvents(t *testing.T) {
+	envelope, err := event.NewEnvelope(formevents.NewFormDeletedEvent("form-1"), "goforms-test", newSchemaRegistry(t))
+	require.NoError(t, err)
+
+	data, err := event.EncodeEnvelope(envelope, config.EventsEncodingJSON)
+	require.NoError(t, err)
+
+	var attributes map[string]any
+	require.NoError(t, json.Unmarshal(data, &attributes))
+
+	assert.Equal(t, "1.0", attributes["specversion"])
+	assert.Equal(t, "form.deleted", attributes["type"])
+	assert.Equal(t, "goforms-test", attributes["source"])
+	assert.Equal(t, "application/json", attributes["datacontenttype"])
+	assert.Equal(t, "urn:goformx:event-schema:form.deleted:1", attributes["dataschema"])
+	assert.InDelta(t, 1, attributes["dataversion"], 0)
+	assert.Equal(t, map[string]any{"form_id": "form-1"}, attributes["data"])
+	assert.NotEmpty(t, attributes["id"])
+	assert.NotEmpty(t, attributes["time"])
+
+	// Events from other CloudEvents producers are read too, with data_base64 and extensions
+	decoded, err := event.DecodeEnvelope([]byte(`{
+		"specversion": "1.0", "id": "e-1", "source": "/billing", "type": "invoice.paid",
+		"data_base64": "eyJ0b3RhbCI6NDJ9", "region": "eu", "attempt": 2
+	}`))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"total":42}`, string(decoded.Data))
+	assert.Equal(t, map[string]string{"region": "eu", "attempt": "2"}, decoded.Extensions)
+	assert.Zero(t, decoded.DataVersion)
+}
+
+// goldenCloudEvent reads a CloudEvent message from its protobuf JSON form in testdata
+func goldenCloudEvent(t *testing.T, name string) *cloudeventspb.CloudEvent {
+	t.Helper()
+
+	data, err := os.ReadFile(filepath.Join("testdata", name))
+	require.NoError(t, err)
+
+	var golden cloudeventspb.CloudEvent
+	require.NoError(t, protojson.Unmarshal(data, &golden))
+
+	return &golden
+}
+
+func TestEnvelope_ProtobufFormatMatchesTheCloudEventsProto(t *testing.T) {
+	envelope := &event.Envelope{
+		ID:              "e-1",
+		Source:          "goforms-test",
+		SpecVersion:     event.SpecVersion,
+		Type:            "form.deleted",
+		Time:            time.Date(2026, 1, 2, 3, 4, 5, 6, time.UTC),
+		DataContentType: event.DataContentType,
+		DataSchema:      "urn:goformx:event-schema:form.deleted:1",
+		DataVersion:     1,
+		Extensions:      map[string]string{"requestid": "req-1"},
+		Data:            json.RawMessage(`{"form_id":"form-1"}`),
+	}
+
+	data, err := event.EncodeEnvelope(envelope, config.EventsEncodingProtobuf)
+	require.NoError(t, err)
+
+	// Decoded with the bindings of the official cloudevents.proto
+	var decoded cloudeventspb.CloudEvent
+	require.NoError(t, proto.Unmarshal(data, &decoded))
+
+	golden := goldenCloudEvent(t, "form_deleted.cloudevent.json")
+	assert.True(t, proto.Equal(golden, &decoded), "got %s", protojson.Format(&decoded))
+}
+
+func TestDecodeEnvelope_ReadsProtobufFromOtherProducers(t *testing.T) {
+	data, err := proto.Marshal(goldenCloudEvent(t, "foreign.cloudevent.json"))
+	require.NoError(t, err)
+
+	decoded, err := event.DecodeEnvelope(data)
+	require.NoError(t, err)
+
+	assert.Equal(t, "e-2", decoded.ID)
+	assert.Equal(t, "/billing", decoded.Source)
+	assert.Equal(t, "invoice.paid", decoded.Type)
+	assert.True(t, time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC).Equal(decoded.Time))
+	assert.Equal(t, 3, decoded.DataVersion)
+	assert.Equal(t, "invoice-7", decoded.Subject)
+	assert.Equal(t, map[string]string{"urgent": "true", "attempt": "2", "origin": "/eu"}, decoded.Extensions)
+	assert.JSONEq(t, `{"total":42}`, string(decoded.Data))
+
+	// Data carried as a protobuf message cannot be handed to handlers as JSON
+	withProtoData := goldenCloudEvent(t, "foreign.cloudevent.json")
+	withProtoData.Data = &cloudeventspb.CloudEvent_ProtoData{}
+	data, err = proto.Marshal(withProtoData)
+	require.NoError(t, err)
+
+	_, err = event.DecodeEnvelope(data)
+	require.ErrorIs(t, err, event.ErrUnsupportedEnvelope)
+}
+
+func TestDecodeEnvelope_RejectsOtherMessages(t *testing.T) {
+	for name, data := range map[string]string{
+		"empty":             "",
+		"not an envelope":   "hello",
+		"other specversion": `{"specversion":"0.3","id":"e-1","source":"/s","type":"t"}`,
+		"missing id":        `{"specversion":"1.0","source":"/s","type":"t"}`,
+		"bad dataversion":   `{"specversion":"1.0","id":"e-1","source":"/s","type":"t","dataversion":"x"}`,
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := event.DecodeEnvelope([]byte(data))
+			require.ErrorIs(t, err, event.ErrUnsupportedEnvelope)
+		})
+	}
+}
+
+func TestNewEnvelope_ValidatesPayloadAgainstLatestSchema(t *testing.T) {
+	schemas := events.NewSchemaRegistry()
+	require.NoError(t, schemas.Register("form.deleted", 1, []byte(`{"type":"object","required":["form_id"]}`)))
+	require.NoError(t, schemas.Register("form.deleted", 2, []byte(`{"type":"object","required":["form_id","deleted_by"]}`)))
+
+	_, err := event.NewEnvelope(formevents.NewFormDeletedEvent("form-1"), "goforms-test", schemas)
+
+	var schemaErr *events.SchemaValidationError
+	require.ErrorAs(t, err, &schemaErr)
+	assert.Equal(t, 2, schemaErr.Version)
+
+	envelope, err := event.NewEnvelope(formevents.NewFormDeletedEvent("form-1"), "goforms-test", nil)
+	require.NoError(t, err)
+	assert.Empty(t, envelope.DataSchema)
+	assert.Zero(t, envelope.DataVersion)
+}
@@ -1,54 +1,199 @@
-// Package event provides in-memory event bus and publisher implementations.
-// It implements the domain event interfaces for local event handling.
+// Package event provides the event buses: in process, on NATS JetStream and on Redis Streams.
+// Broker-backed buses publish events as CloudEvents whose payloads follow registered schemas.
 package event
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"runtime/debug"
 	"sync"
+	"time"
 
 	"github.com/goformx/goforms/internal/domain/common/events"
+	"github.com/goformx/goforms/internal/infrastructure/config"
 	"github.com/goformx/goforms/internal/infrastructure/logging"
 )
 
-// MemoryEventBus implements events.EventBus using in-memory storage
+var (
+	// ErrEventQueueFull is returned by Publish when the reject policy turns an event away
+	ErrEventQueueFull = errors.New("event queue is full")
+	// ErrEventBusStopped is returned by Publish once the bus is stopping
+	ErrEventBusStopped = errors.New("event bus is stopped")
+	// ErrHandlerPanicked wraps the value a panicking handler recovered with
+	ErrHandlerPanicked = errors.New("event handler panicked")
+)
+
+// MemoryOptions configures the memory event bus. Zero values take the defaults.
+type MemoryOptions struct {
+	config.EventsMemoryConfig
+
+	// RetryCount is how many times a failing handler is called for one event
+	RetryCount int
+	// RetryDelay is the wait before the first retry; later retries wait proportionally longer
+	RetryDelay time.Duration
+}
+
+// MemoryEventBus implements events.EventBus in process. Publish queues the event and returns;
+// each event type has a bounded queue drained by its own workers, started on the first publish.
+// Handlers of an event run one after another on a worker, each retried on error or panic, so a
+// failing handler neither reaches the publisher nor stops the others. Events of one type may be
+// handled out of order when the type has several workers.
 type MemoryEventBus struct {
-	logger     logging.Logger
+	logger logging.Logger
+	opts   MemoryOptions
+
 	handlers   map[string][]func(context.Context, events.Event) error
 	handlersMu sync.RWMutex
+
+	// stateMu guards stopped; publishers register in publishing under it, so once Stop set stopped
+	// it can wait for them and then close the queues safely
+	stateMu    sync.RWMutex
+	stopped    bool
+	stopping   chan struct{}
+	publishing sync.WaitGroup
+	queuesMu   sync.Mutex
+	queues     map[string]*eventQueue
+	workers    sync.WaitGroup
+
+	// abort cancels in-flight handlers when Stop runs out of time
+	abort       context.Context
+	cancelAbort context.CancelFunc
+}
+
+// queuedEvent is an event waiting for a worker with the handlers subscribed when it was published
+type queuedEvent struct {
+	ctx      context.Context
+	event    events.Event
+	handlers []func(context.Context, events.Event) error
+}
+
+// eventQueue is the bounded queue of one event type
+type eventQueue struct {
+	events chan queuedEvent
 }
 
-// NewMemoryEventBus creates a new memory-based event bus
+// NewMemoryEventBus creates a memory event bus with the default queues and retries
 func NewMemoryEventBus(logger logging.Logger) events.EventBus {
+	return NewMemoryEventBusWithOptions(logger, MemoryOptions{})
+}
+
+// NewMemoryEventBusWithOptions creates a memory event bus sized by opts
+func NewMemoryEventBusWithOptions(logger logging.Logger, opts MemoryOptions) *MemoryEventBus {
+	if opts.Workers <= 0 {
+		opts.Workers = config.DefaultEventsMemoryWorkers
+	}
+
+	if opts.QueueSize <= 0 {
+		opts.QueueSize = config.DefaultEventsMemoryQueueSize
+	}
+
+	if opts.Backpressure == "" {
+		opts.Backpressure = config.EventsBackpressureBlock
+	}
+
+	if opts.BlockTimeout <= 0 {
+		opts.BlockTimeout = config.DefaultEventsMemoryBlockTimeout
+	}
+
+	if opts.RetryCount <= 0 {
+		opts.RetryCount = events.DefaultRetryCount
+	}
+
+	if opts.RetryDelay <= 0 {
+		opts.RetryDelay = events.DefaultRetryDelay
+	}
+
+	abort, cancelAbort := context.WithCancel(context.Background())
+
 	return &MemoryEventBus{
-		logger:   logger,
-		handlers: make(map[string][]func(context.Context, events.Event) error),
+		logger:      logger,
+		opts:        opts,
+		handlers:    make(map[string][]func(context.Context, events.Event) error),
+		stopping:    make(chan struct{}),
+		queues:      make(map[string]*eventQueue),
+		abort:       abort,
+		cancelAbort: cancelAbort,
 	}
 }
 
-// Publish publishes an event to all subscribers
+// Publish queues an event for its subscribers. When the queue of its type is full, the
+// backpressure policy decides: block waits for room until ctx ends, BlockTimeout passes or the
+// bus stops, drop_oldest discards the oldest queued event and reject returns ErrEventQueueFull.
+// Handlers receive ctx without its cancellation, so they outlive the request that published the event.
 func (b *MemoryEventBus) Publish(ctx context.Context, event events.Event) error {
 	b.handlersMu.RLock()
-	handlers := b.handlers[event.Name()]
+	handlers := append([]func(context.Context, events.Event) error(nil), b.handlers[event.Name()]...)
 	b.handlersMu.RUnlock()
 
-	for _, handler := range handlers {
-		if err := handler(ctx, event); err != nil {
-			b.logger.Error("failed to handle event",
-				"event", event.Name(),
-				"error", err,
-			)
+	if len(handlers) == 0 {
+		return nil
+	}
+
+	queue, err := b.beginPublish(event.Name())
+	if err != nil {
+		return err
+	}
+	defer b.publishing.Done()
+
+	item := queuedEvent{ctx: context.WithoutCancel(ctx), event: event, handlers: handlers}
+
+	switch b.opts.Backpressure {
+	case config.EventsBackpressureReject:
+		select {
+		case queue.events <- item:
+			return nil
+		default:
+			return fmt.Errorf("publish %s: %w", event.Name(), ErrEventQueueFull)
+		}
+	case config.EventsBackpressureDropOldest:
+		for {
+			select {
+			case queue.events <- item:
+				return nil
+			default:
+			}
+
+			select {
+			case dropped := <-queue.events:
+				b.logger.Warn("event queue is full, dropping oldest event", "event", dropped.event.Name())
+			default:
+			}
+		}
+	default:
+		timer := time.NewTimer(b.opts.BlockTimeout)
+		defer timer.Stop()
+
+		select {
+		case queue.events <- item:
+			return nil
+		case <-ctx.Done():
+			return fmt.Errorf("publish %s: %w", event.Name(), ctx.Err())
+		case <-b.stopping:
+			return fmt.Errorf("publish %s: %w", event.Name(), ErrEventBusStopped)
+		case <-timer.C:
+			return fmt.Errorf("publish %s: %w after %s", event.Name(), ErrEventQueueFull, b.opts.BlockTimeout)
 		}
 	}
+}
 
-	return nil
+// beginPublish registers a publisher and returns the queue of eventName, unless the bus is stopped.
+// The lock is not held while the publisher waits for room, so Stop is never blocked by a full queue.
+func (b *MemoryEventBus) beginPublish(eventName string) (*eventQueue, error) {
+	b.stateMu.RLock()
+	defer b.stateMu.RUnlock()
+
+	if b.stopped {
+		return nil, ErrEventBusStopped
+	}
+
+	b.publishing.Add(1)
+
+	return b.queue(eventName), nil
 }
 
-// PublishBatch publishes multiple events
+// PublishBatch queues the events in order, stopping at the first failure
 func (b *MemoryEventBus) PublishBatch(ctx context.Context, eventList []events.Event) error {
-	b.handlersMu.Lock()
-	defer b.handlersMu.Unlock()
-
 	for _, event := range eventList {
 		if err := b.Publish(ctx, event); err != nil {
 			return fmt.Errorf("failed to publish event: %w", err)
@@ -67,16 +212,12 @@ func (b *MemoryEventBus) Subscribe(
 	b.handlersMu.Lock()
 	defer b.handlersMu.Unlock()
 
-	if _, exists := b.handlers[eventName]; !exists {
-		b.handlers[eventName] = make([]func(context.Context, events.Event) error, 0)
-	}
-
 	b.handlers[eventName] = append(b.handlers[eventName], handler)
 
 	return nil
 }
 
-// Unsubscribe unsubscribes from an event
+// Unsubscribe unsubscribes from an event. Events already queued still reach its handlers.
 func (b *MemoryEventBus) Unsubscribe(_ context.Context, eventName string) error {
 	b.handlersMu.Lock()
 	defer b.handlersMu.Unlock()
@@ -86,17 +227,136 @@ func (b *MemoryEventBus) Unsubscribe(_ context.Context, eventName string) error
 	return nil
 }
 
-// Start starts the event bus
+// Start starts the event bus; workers start with the first event of each type
 func (b *MemoryEventBus) Start(_ context.Context) error {
 	return nil
 }
 
-// Stop stops the event bus
-func (b *MemoryEventBus) Stop(_ context.Context) error {
-	return nil
+// Stop stops accepting events and waits until the queued and in-flight events are handled.
+// Publishers still waiting for room fail with ErrEventBusStopped. When ctx ends first, running
+// handlers are cancelled, retries are abandoned and ctx's error is returned.
+func (b *MemoryEventBus) Stop(ctx context.Context) error {
+	b.stateMu.Lock()
+	first := !b.stopped
+	b.stopped = true
+	b.stateMu.Unlock()
+
+	drained := make(chan struct{})
+
+	go func() {
+		if first {
+			close(b.stopping)
+
+			// No publisher registers once stopped is set; after the last one leaves, nothing sends anymore
+			b.publishing.Wait()
+
+			b.queuesMu.Lock()
+			for _, queue := range b.queues {
+				close(queue.events)
+			}
+			b.queuesMu.Unlock()
+		}
+
+		b.workers.Wait()
+		close(drained)
+	}()
+
+	select {
+	case <-drained:
+		b.cancelAbort()
+
+		return nil
+	case <-ctx.Done():
+		b.cancelAbort()
+
+		return fmt.Errorf("drain event queues: %w", ctx.Err())
+	}
 }
 
-// Health returns the health status of the event bus
+// Health reports an error once the bus is stopped
 func (b *MemoryEventBus) Health(_ context.Context) error {
+	b.stateMu.RLock()
+	defer b.stateMu.RUnlock()
+
+	if b.stopped {
+		return ErrEventBusStopped
+	}
+
 	return nil
 }
+
+// queue returns the queue of eventName, starting its workers on first use; callers must hold
+// stateMu, for reading is enough, and the bus must not be stopped
+func (b *MemoryEventBus) queue(eventName string) *eventQueue {
+	b.queuesMu.Lock()
+	defer b.queuesMu.Unlock()
+
+	if queue, ok := b.queues[eventName]; ok {
+		return queue
+	}
+
+	sizing := b.opts.Queue(eventName)
+	queue := &eventQueue{events: make(chan queuedEvent, sizing.QueueSize)}
+	b.queues[eventName] = queue
+
+	b.workers.Add(sizing.Workers)
+
+	for range sizing.Workers {
+		go b.work(queue)
+	}
+
+	return queue
+}
+
+// work handles queued events until the queue is closed and empty
+func (b *MemoryEventBus) work(queue *eventQueue) {
+	defer b.workers.Done()
+
+	for item := range queue.events {
+		for _, handler := range item.handlers {
+			b.handle(item, handler)
+		}
+	}
+}
+
+// handle calls handler with the event until it succeeds or RetryCount attempts failed
+func (b *MemoryEventBus) handle(item queuedEvent, handler func(context.Context, events.Event) error) {
+	ctx, cancel := context.WithCancel(item.ctx)
+	defer cancel()
+
+	stopAbort := context.AfterFunc(b.abort, cancel)
+	defer stopAbort()
+
+	var err error
+
+	for attempt := 1; attempt <= b.opts.RetryCount; attempt++ {
+		if err = callHandler(ctx, handler, item.event); err == nil {
+			return
+		}
+
+		if errors.Is(err, ErrHandlerPanicked) {
+			b.logger.Error("event handler panicked", "event", item.event.Name(), "attempt", attempt, "error", err)
+		}
+
+		if attempt == b.opts.RetryCount {
+			break
+		}
+
+		if !sleepCtx(ctx, time.Duration(attempt)*b.opts.RetryDelay) {
+			break
+		}
+	}
+
+	b.logger.Error("failed to handle event", "event", item.event.Name(), "attempts", b.opts.RetryCount, "error", err)
+}
+
+// callHandler runs handler, turning a panic into an error carrying its stack
+func callHandler(ctx context.Context, handler func(context.Context, events.Event) error, event events.Event) (err error) {
+	defer func() {
+		if recovered := recover(); recovered != nil {
+			err = fmt.Errorf("%w: %v\n%s", ErrHandlerPanicked, recovered, debug.Stack())
+		}
+	}()
+
+	return handler(ctx, event)
+}
@@ -0,0 +1,298 @@
+package event_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goformx/goforms/internal/domain/common/events"
+	formevents "github.com/goformx/goforms/internal/domain/form/events"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/event"
+)
+
+var submittedType = string(formevents.FormSubmittedEventType)
+
+func newMemoryBus(t *testing.T, memory config.EventsMemoryConfig) *event.MemoryEventBus {
+	t.Helper()
+
+	bus := event.NewMemoryEventBusWithOptions(newLogger(t), event.MemoryOptions{
+		EventsMemoryConfig: memory,
+		RetryDelay:         time.Millisecond,
+	})
+	t.Cleanup(func() { _ = bus.Stop(context.Background()) })
+
+	return bus
+}
+
+func submissionID(e events.Event) string {
+	if submission, ok := e.Payload().(*model.FormSubmission); ok {
+		return submission.ID
+	}
+
+	return ""
+}
+
+// gate is a handler that records submissions and blocks each call until released
+type gate struct {
+	started chan string
+	release chan struct{}
+
+	mu  sync.Mutex
+	ids []string
+}
+
+func newGate() *gate {
+	return &gate{started: make(chan string, 16), release: make(chan struct{})}
+}
+
+func (g *gate) handle(ctx context.Context, e events.Event) error {
+	g.started <- submissionID(e)
+
+	select {
+	case <-g.release:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	g.ids = append(g.ids, submissionID(e))
+
+	return nil
+}
+
+func (g *gate) handled() []string {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	return append([]string(nil), g.ids...)
+}
+
+func TestMemoryEventBus_PublishDoesNotWaitForHandlers(t *testing.T) {
+	bus := newMemoryBus(t, config.EventsMemoryConfig{})
+	handler := newGate()
+	require.NoError(t, bus.Subscribe(t.Context(), submittedType, handler.handle))
+
+	// The publisher's context ends with its request; handlers keep running
+	ctx, cancel := context.WithCancel(t.Context())
+	require.NoError(t, bus.Publish(ctx, submitted("s1")))
+	cancel()
+
+	assert.Equal(t, "s1", <-handler.started)
+	assert.Empty(t, handler.handled())
+
+	close(handler.release)
+	require.NoError(t, bus.Stop(t.Context()))
+	assert.Equal(t, []string{"s1"}, handler.handled())
+}
+
+func TestMemoryEventBus_RetriesFailingHandlersAndIsolatesPanics(t *testing.T) {
+	bus := newMemoryBus(t, config.EventsMemoryConfig{})
+
+	var flakyCalls, panicCalls, steadyCalls atomic.Int32
+
+	require.NoError(t, bus.Subscribe(t.Context(), submittedType, func(context.Context, events.Event) error {
+		if flakyCalls.Add(1) < events.DefaultRetryCount {
+			return errors.New("temporary failure")
+		}
+
+		return nil
+	}))
+	require.NoError(t, bus.Subscribe(t.Context(), submittedType, func(context.Context, events.Event) error {
+		panicCalls.Add(1)
+		panic("handler bug")
+	}))
+	require.NoError(t, bus.Subscribe(t.Context(), submittedType, func(context.Context, events.Event) error {
+		steadyCalls.Add(1)
+
+		return nil
+	}))
+
+	require.NoError(t, bus.Publish(t.Context(), submitted("s1")))
+	require.NoError(t, bus.Stop(t.Context()))
+
+	assert.Equal(t, int32(events.DefaultRetryCount), flakyCalls.Load())
+	assert.Equal(t, int32(events.DefaultRetryCount), panicCalls.Load())
+	assert.Equal(t, int32(1), steadyCalls.Load())
+}
+
+func TestMemoryEventBus_Backpressure(t *testing.T) {
+	// One worker busy with s1 and one queued event fill the queue
+	fill := func(t *testing.T, backpressure string, blockTimeout time.Duration) (*event.MemoryEventBus, *gate) {
+		t.Helper()
+
+		bus := newMemoryBus(t, config.EventsMemoryConfig{
+			Workers: 1, QueueSize: 1, Backpressure: backpressure, BlockTimeout: blockTimeout,
+		})
+		handler := newGate()
+		require.NoError(t, bus.Subscribe(t.Context(), submittedType, handler.handle))
+
+		require.NoError(t, bus.Publish(t.Context(), submitted("s1")))
+		assert.Equal(t, "s1", <-handler.started)
+		require.NoError(t, bus.Publish(t.Context(), submitted("s2")))
+
+		return bus, handler
+	}
+
+	t.Run("block waits for room", func(t *testing.T) {
+		bus, handler := fill(t, config.EventsBackpressureBlock, 0)
+
+		ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+		defer cancel()
+
+		require.ErrorIs(t, bus.Publish(ctx, submitted("s3")), context.DeadlineExceeded)
+
+		published := make(chan error, 1)
+
+		go func() { published <- bus.Publish(t.Context(), submitted("s4")) }()
+
+		close(handler.release)
+		require.NoError(t, <-published)
+		require.NoError(t, bus.Stop(t.Context()))
+		assert.Equal(t, []string{"s1", "s2", "s4"}, handler.handled())
+	})
+
+	t.Run("block gives up after BlockTimeout", func(t *testing.T) {
+		bus, handler := fill(t, config.EventsBackpressureBlock, 20*time.Millisecond)
+
+		require.ErrorIs(t, bus.Publish(t.Context(), submitted("s3")), event.ErrEventQueueFull)
+
+		close(handler.release)
+		require.NoError(t, bus.Stop(t.Context()))
+		assert.Equal(t, []string{"s1", "s2"}, handler.handled())
+	})
+
+	t.Run("Stop releases blocked publishers", func(t *testing.T) {
+		bus, handler := fill(t, config.EventsBackpressureBlock, time.Minute)
+
+		published := make(chan error, 1)
+
+		go func() { published <- bus.Publish(t.Context(), submitted("s3")) }()
+
+		// Stop must not wait for the blocked publisher, which gives up once the bus is stopping
+		stopped := make(chan error, 1)
+
+		go func() { stopped <- bus.Stop(t.Context()) }()
+
+		select {
+		case err := <-published:
+			require.ErrorIs(t, err, event.ErrEventBusStopped)
+		case <-time.After(5 * time.Second):
+			t.Fatal("publish still blocked after Stop")
+		}
+
+		close(handler.release)
+		require.NoError(t, <-stopped)
+		assert.Equal(t, []string{"s1", "s2"}, handler.handled())
+	})
+
+	t.Run("drop_oldest discards the oldest queued event", func(t *testing.T) {
+		bus, handler := fill(t, config.EventsBackpressureDropOldest, 0)
+
+		require.NoError(t, bus.Publish(t.Context(), submitted("s3")))
+
+		close(handler.release)
+		require.NoError(t, bus.Stop(t.Context()))
+		assert.Equal(t, []string{"s1", "s3"}, handler.handled())
+	})
+
+	t.Run("reject fails the publish", func(t *testing.T) {
+		bus, handler := fill(t, config.EventsBackpressureReject, 0)
+
+		require.ErrorIs(t, bus.Publish(t.Context(), submitted("s3")), event.ErrEventQueueFull)
+
+		close(handler.release)
+		require.NoError(t, bus.Stop(t.Context()))
+		assert.Equal(t, []string{"s1", "s2"}, handler.handled())
+	})
+}
+
+func TestMemoryEventBus_QueuesPerEventType(t *testing.T) {
+	bus := newMemoryBus(t, config.EventsMemoryConfig{
+		Workers: 1, QueueSize: 1, Backpressure: config.EventsBackpressureReject,
+		Queues: map[string]config.EventsQueueConfig{submittedType: {QueueSize: 4}},
+	})
+
+	submissions := newGate()
+	require.NoError(t, bus.Subscribe(t.Context(), submittedType, submissions.handle))
+
+	deleted := make(chan string, 1)
+	require.NoError(t, bus.Subscribe(t.Context(), string(formevents.FormDeletedEventType), func(context.Context, events.Event) error {
+		deleted <- "form-1"
+
+		return nil
+	}))
+
+	require.NoError(t, bus.Publish(t.Context(), submitted("s1")))
+	<-submissions.started
+
+	// form.submitted has room for four waiting events, and a stuck handler does not hold up other types
+	for _, id := range []string{"s2", "s3", "s4", "s5"} {
+		require.NoError(t, bus.Publish(t.Context(), submitted(id)))
+	}
+
+	require.ErrorIs(t, bus.Publish(t.Context(), submitted("s6")), event.ErrEventQueueFull)
+	require.NoError(t, bus.Publish(t.Context(), formevents.NewFormDeletedEvent("form-1")))
+	assert.Equal(t, "form-1", <-deleted)
+
+	close(submissions.release)
+}
+
+func TestMemoryEventBus_StopDrainsQueuedEvents(t *testing.T) {
+	bus := newMemoryBus(t, config.EventsMemoryConfig{Workers: 2})
+
+	var handled atomic.Int32
+
+	require.NoError(t, bus.Subscribe(t.Context(), submittedType, func(context.Context, events.Event) error {
+		time.Sleep(time.Millisecond)
+		handled.Add(1)
+
+		return nil
+	}))
+
+	batch := make([]events.Event, 0, 20)
+	for range 20 {
+		batch = append(batch, submitted("s"))
+	}
+
+	require.NoError(t, bus.PublishBatch(t.Context(), batch))
+	require.NoError(t, bus.Stop(t.Context()))
+	assert.Equal(t, int32(20), handled.Load())
+
+	require.ErrorIs(t, bus.Publish(t.Context(), submitted("late")), event.ErrEventBusStopped)
+	require.ErrorIs(t, bus.Health(t.Context()), event.ErrEventBusStopped)
+}
+
+func TestMemoryEventBus_StopCancelsHandlersWhenContextEnds(t *testing.T) {
+	bus := newMemoryBus(t, config.EventsMemoryConfig{})
+	handler := newGate()
+	require.NoError(t, bus.Subscribe(t.Context(), submittedType, handler.handle))
+
+	require.NoError(t, bus.Publish(t.Context(), submitted("s1")))
+	<-handler.started
+
+	ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+	defer cancel()
+
+	require.ErrorIs(t, bus.Stop(ctx), context.DeadlineExceeded)
+
+	// The cancelled handler is retried no further once the bus gave up
+	require.Never(t, func() bool {
+		select {
+		case <-handler.started:
+			return true
+		default:
+			return false
+		}
+	}, 50*time.Millisecond, 5*time.Millisecond)
+	assert.Empty(t, handler.handled())
+}
@@ -0,0 +1,322 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/nats-io/nats.go"
+	"github.com/nats-io/nats.go/jetstream"
+
+	"github.com/goformx/goforms/internal/domain/common/events"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+)
+
+const (
+	// natsFetchWait is how long a pull request stays open for messages
+	natsFetchWait = time.Second
+	// natsFetchBatch is the most messages a consumer pulls at once
+	natsFetchBatch = 16
+	// natsRequestTimeout bounds JetStream API requests and publish acknowledgements
+	natsRequestTimeout = 5 * time.Second
+	// natsBroadcastInactiveThreshold is how long the server keeps the consumer of a broadcast bus
+	// after it stops pulling, as when its process ended
+	natsBroadcastInactiveThreshold = time.Minute
+)
+
+// NATSJetStreamBus implements events.EventBus on a NATS JetStream stream. Each event type is
+// published to its topic's subject, all captured by one stream; subscribers pull from a durable
+// consumer named after the configured group and the event type, so events survive restarts and
+// each reaches one replica of the group. Messages a handler fails are delivered again after
+// AckWait, up to MaxDeliver times. As a broadcast bus it pulls from an ephemeral consumer of its
+// own, which the server deletes once it has been inactive for a while.
+type NATSJetStreamBus struct {
+	*brokerBus
+
+	url         string
+	options     []nats.Option
+	stream      string
+	streamReady atomic.Bool
+
+	mu   sync.Mutex
+	conn *nats.Conn
+	js   jetstream.JetStream
+}
+
+// NewNATSJetStreamBus creates a bus publishing to the NATS server in cfg.NATS; payloads of event types
+// with a schema in schemas must match it. The connection is opened on first use.
+func NewNATSJetStreamBus(cfg config.EventsConfig, logger logging.Logger, schemas *events.SchemaRegistry) *NATSJetStreamBus {
+	// Once connected, the client reconnects for as long as the bus runs
+	options := []nats.Option{nats.Name(cfg.Source), nats.MaxReconnects(-1)}
+	if cfg.NATS.Token != "" {
+		options = append(options, nats.Token(cfg.NATS.Token))
+	}
+
+	if cfg.NATS.User != "" {
+		options = append(options, nats.UserInfo(cfg.NATS.User, cfg.NATS.Password))
+	}
+
+	b := &NATSJetStreamBus{
+		brokerBus: newBrokerBus(cfg, logger, schemas),
+		url:       cfg.NATS.URL,
+		options:   options,
+		stream:    cfg.NATS.Stream,
+	}
+	b.brokerBus.consume = b.consume
+
+	return b
+}
+
+// jetStream returns the JetStream API of the connection, connecting first if needed
+func (b *NATSJetStreamBus) jetStream() (*nats.Conn, jetstream.JetStream, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.js != nil {
+		return b.conn, b.js, nil
+	}
+
+	conn, err := nats.Connect(b.url, b.options...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("connect to nats: %w", err)
+	}
+
+	js, err := jetstream.New(conn)
+	if err != nil {
+		conn.Close()
+
+		return nil, nil, fmt.Errorf("open jetstream: %w", err)
+	}
+
+	b.conn, b.js = conn, js
+
+	return conn, js, nil
+}
+
+// Publish publishes event to the subject of its topic and waits until the stream stored it
+func (b *NATSJetStreamBus) Publish(ctx context.Context, event events.Event) error {
+	_, data, err := b.encode(event)
+	if err != nil {
+		return err
+	}
+
+	if err = b.ensureStream(ctx); err != nil {
+		return err
+	}
+
+	_, js, err := b.jetStream()
+	if err != nil {
+		return err
+	}
+
+	pubCtx, cancel := context.WithTimeout(ctx, natsRequestTimeout)
+	defer cancel()
+
+	if _, err = js.Publish(pubCtx, b.cfg.Topic(event.Name()), data); err != nil {
+		return fmt.Errorf("publish %s to nats: %w", event.Name(), err)
+	}
+
+	return nil
+}
+
+// PublishBatch publishes the events in order, stopping at the first failure
+func (b *NATSJetStreamBus) PublishBatch(ctx context.Context, eventList []events.Event) error {
+	for _, event := range eventList {
+		if err := b.Publish(ctx, event); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Start creates the stream and begins consuming every subscribed event type. An unreachable
+// server does not prevent starting; publishing and consumers retry until it is reachable.
+func (b *NATSJetStreamBus) Start(ctx context.Context) error {
+	if err := b.ensureStream(ctx); err != nil {
+		b.logger.Warn("nats event stream is not ready", "stream", b.stream, "error", err)
+	}
+
+	return b.brokerBus.Start(ctx)
+}
+
+// Stop stops consuming and closes the connection
+func (b *NATSJetStreamBus) Stop(ctx context.Context) error {
+	stopErr := b.stopConsumers(ctx)
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.conn != nil {
+		b.conn.Close()
+		b.conn, b.js = nil, nil
+	}
+
+	return stopErr
+}
+
+// Health reports whether the server answers and the stream exists
+func (b *NATSJetStreamBus) Health(ctx context.Context) error {
+	conn, js, err := b.jetStream()
+	if err != nil {
+		return fmt.Errorf("nats event bus: %w", err)
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, natsRequestTimeout)
+	defer cancel()
+
+	if err = conn.FlushWithContext(reqCtx); err != nil {
+		return fmt.Errorf("nats event bus: %w", err)
+	}
+
+	if _, err = js.Stream(reqCtx, b.stream); err != nil {
+		return fmt.Errorf("nats event stream %s: %w", b.stream, err)
+	}
+
+	return nil
+}
+
+// ensureStream creates the stream capturing every topic, once
+func (b *NATSJetStreamBus) ensureStream(ctx context.Context) error {
+	if b.streamReady.Load() {
+		return nil
+	}
+
+	_, js, err := b.jetStream()
+	if err != nil {
+		return err
+	}
+
+	// Streams reject overlapping subjects, so mapped topics under the prefix are not listed
+	subjects := []string{b.cfg.TopicPrefix + ">"}
+	for _, topic := range b.cfg.Topics {
+		if !strings.HasPrefix(topic, b.cfg.TopicPrefix) && !slices.Contains(subjects, topic) {
+			subjects = append(subjects, topic)
+		}
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, natsRequestTimeout)
+	defer cancel()
+
+	if _, err = js.CreateOrUpdateStream(reqCtx, jetstream.StreamConfig{
+		Name:     b.stream,
+		Subjects: subjects,
+		Storage:  jetstream.FileStorage,
+	}); err != nil {
+		return fmt.Errorf("create nats stream %s: %w", b.stream, err)
+	}
+
+	b.streamReady.Store(true)
+
+	return nil
+}
+
+// consume pulls eventName from its consumer until ctx ends. Failures are logged and retried.
+func (b *NATSJetStreamBus) consume(ctx context.Context, eventName string) {
+	subject, durable := b.cfg.Topic(eventName), b.consumerGroup(eventName)
+
+	var consumer jetstream.Consumer
+
+	for ctx.Err() == nil {
+		if consumer == nil {
+			var err error
+			if consumer, err = b.ensureConsumer(ctx, subject, durable); err != nil {
+				b.logger.Error("failed to create nats consumer", "stream", b.stream, "consumer", durable, "error", err)
+				sleepCtx(ctx, consumerRetryDelay)
+
+				continue
+			}
+		}
+
+		if err := b.fetch(ctx, eventName, consumer); err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			b.logger.Error("failed to fetch nats events", "stream", b.stream, "consumer", durable, "error", err)
+
+			// The consumer or stream may have been deleted; create them again
+			consumer = nil
+			b.streamReady.Store(false)
+
+			sleepCtx(ctx, consumerRetryDelay)
+		}
+	}
+}
+
+// fetch pulls one batch of messages and handles them
+func (b *NATSJetStreamBus) fetch(ctx context.Context, eventName string, consumer jetstream.Consumer) error {
+	batch, err := consumer.Fetch(natsFetchBatch, jetstream.FetchMaxWait(natsFetchWait))
+	if err != nil {
+		return fmt.Errorf("fetch: %w", err)
+	}
+
+	for msg := range batch.Messages() {
+		b.handle(ctx, eventName, msg)
+	}
+
+	if err = batch.Error(); err != nil && !errors.Is(err, nats.ErrTimeout) {
+		return fmt.Errorf("fetch: %w", err)
+	}
+
+	return nil
+}
+
+// handle delivers one message, acknowledging it once handled. A failed message is left for the
+// server to deliver again after AckWait; it stops after MaxDeliver deliveries.
+func (b *NATSJetStreamBus) handle(ctx context.Context, eventName string, msg jetstream.Msg) {
+	if err := b.deliver(ctx, eventName, msg.Data()); err != nil {
+		if metadata, metaErr := msg.Metadata(); metaErr == nil && metadata.NumDelivered >= uint64(b.cfg.MaxDeliver) {
+			b.logger.Error("dropping event after repeated delivery failures",
+				"event", eventName, "stream", b.stream, "deliveries", metadata.NumDelivered)
+		}
+
+		return
+	}
+
+	if err := msg.Ack(); err != nil {
+		b.logger.Error("failed to acknowledge nats event", "event", eventName, "error", err)
+	}
+}
+
+// ensureConsumer creates the stream unless it exists, then the durable consumer, or an ephemeral
+// one for a broadcast bus
+func (b *NATSJetStreamBus) ensureConsumer(ctx context.Context, subject, durable string) (jetstream.Consumer, error) {
+	if err := b.ensureStream(ctx); err != nil {
+		return nil, err
+	}
+
+	_, js, err := b.jetStream()
+	if err != nil {
+		return nil, err
+	}
+
+	reqCtx, cancel := context.WithTimeout(ctx, natsRequestTimeout)
+	defer cancel()
+
+	consumerConfig := jetstream.ConsumerConfig{
+		Durable:       durable,
+		FilterSubject: subject,
+		DeliverPolicy: jetstream.DeliverNewPolicy,
+		AckPolicy:     jetstream.AckExplicitPolicy,
+		AckWait:       b.cfg.AckWait,
+		MaxDeliver:    b.cfg.MaxDeliver,
+	}
+	if b.broadcast {
+		consumerConfig.Durable = ""
+		consumerConfig.InactiveThreshold = natsBroadcastInactiveThreshold
+	}
+
+	consumer, err := js.CreateOrUpdateConsumer(reqCtx, b.stream, consumerConfig)
+	if err != nil {
+		return nil, fmt.Errorf("create nats consumer %s: %w", durable, err)
+	}
+
+	return consumer, nil
+}
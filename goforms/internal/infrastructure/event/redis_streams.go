@@ -0,0 +1,384 @@
+package event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"github.com/goformx/goforms/internal/domain/common/events"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+)
+
+const (
+	// redisReadBlock is how long a consumer waits for new entries per read, bounding how long Stop
+	// waits for it
+	redisReadBlock = time.Second
+	// redisReadCount is the most entries a consumer reads or reclaims at once
+	redisReadCount = 16
+	// redisCommandTimeout bounds commands other than blocking reads
+	redisCommandTimeout = 5 * time.Second
+)
+
+// Stream entry fields written by the Redis bus
+const (
+	redisFieldType     = "type"
+	redisFieldEnvelope = "envelope"
+)
+
+// RedisStreamBus implements events.EventBus on Redis streams. Each event type is appended to its
+// topic's stream; subscribers read it through a consumer group named after the configured group
+// and the event type, so events survive restarts and each reaches one replica of the group.
+// Entries left unacknowledged for AckWait, because a handler failed or a replica died, are
+// claimed again until they have been delivered MaxDeliver times. As a broadcast bus it reads the
+// streams directly, from the entries appended once it subscribed, without a group or
+// acknowledgements.
+type RedisStreamBus struct {
+	*brokerBus
+
+	client   *redis.Client
+	consumer string
+}
+
+// NewRedisStreamBus creates a bus publishing to the Redis server in cfg.Redis, validating payloads
+// against schemas. Connections are opened on first use.
+func NewRedisStreamBus(cfg config.EventsConfig, logger logging.Logger, schemas *events.SchemaRegistry) *RedisStreamBus {
+	b := &RedisStreamBus{
+		brokerBus: newBrokerBus(cfg, logger, schemas),
+		client:    redis.NewClient(&redis.Options{Addr: cfg.Redis.Addr, Password: cfg.Redis.Password, DB: cfg.Redis.DB}),
+		consumer:  consumerName(),
+	}
+	b.brokerBus.consume = b.consume
+
+	return b
+}
+
+// Publish appends event to the stream of its topic
+func (b *RedisStreamBus) Publish(ctx context.Context, event events.Event) error {
+	args, err := b.xaddArgs(event)
+	if err != nil {
+		return err
+	}
+
+	if err = b.client.XAdd(ctx, args).Err(); err != nil {
+		return fmt.Errorf("publish %s to redis: %w", event.Name(), err)
+	}
+
+	return nil
+}
+
+// PublishBatch appends the events in one transaction, so either all of them are published or none
+func (b *RedisStreamBus) PublishBatch(ctx context.Context, eventList []events.Event) error {
+	if len(eventList) == 0 {
+		return nil
+	}
+
+	batch := make([]*redis.XAddArgs, 0, len(eventList))
+
+	for _, event := range eventList {
+		args, err := b.xaddArgs(event)
+		if err != nil {
+			return err
+		}
+
+		batch = append(batch, args)
+	}
+
+	if _, err := b.client.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, args := range batch {
+			pipe.XAdd(ctx, args)
+		}
+
+		return nil
+	}); err != nil {
+		return fmt.Errorf("publish event batch to redis: %w", err)
+	}
+
+	return nil
+}
+
+// Stop stops consuming and closes the connections
+func (b *RedisStreamBus) Stop(ctx context.Context) error {
+	stopErr := b.stopConsumers(ctx)
+
+	if err := b.client.Close(); err != nil {
+		return errors.Join(stopErr, fmt.Errorf("close redis event bus: %w", err))
+	}
+
+	return stopErr
+}
+
+// Health reports whether Redis answers
+func (b *RedisStreamBus) Health(ctx context.Context) error {
+	if err := b.client.Ping(ctx).Err(); err != nil {
+		return fmt.Errorf("redis event bus: %w", err)
+	}
+
+	return nil
+}
+
+// xaddArgs builds the XADD command publishing event
+func (b *RedisStreamBus) xaddArgs(event events.Event) (*redis.XAddArgs, error) {
+	envelope, data, err := b.encode(event)
+	if err != nil {
+		return nil, err
+	}
+
+	return &redis.XAddArgs{
+		Stream: b.cfg.Topic(event.Name()),
+		MaxLen: b.cfg.Redis.MaxLen,
+		Approx: true,
+		Values: []string{redisFieldType, envelope.Type, redisFieldEnvelope, string(data)},
+	}, nil
+}
+
+// consume reads eventName from its stream until ctx ends. Failures are logged and retried.
+func (b *RedisStreamBus) consume(ctx context.Context, eventName string) {
+	if b.broadcast {
+		b.consumeBroadcast(ctx, eventName)
+
+		return
+	}
+
+	stream, group := b.cfg.Topic(eventName), b.consumerGroup(eventName)
+	groupReady := false
+
+	var lastReclaim time.Time
+
+	for ctx.Err() == nil {
+		if !groupReady {
+			if err := b.createGroup(ctx, stream, group); err != nil {
+				b.logger.Error("failed to create redis consumer group", "stream", stream, "group", group, "error", err)
+				sleepCtx(ctx, consumerRetryDelay)
+
+				continue
+			}
+
+			groupReady = true
+		}
+
+		if time.Since(lastReclaim) >= b.cfg.AckWait {
+			if err := b.reclaim(ctx, eventName, stream, group); err != nil && ctx.Err() == nil {
+				b.logger.Error("failed to reclaim redis events", "stream", stream, "error", err)
+			}
+
+			lastReclaim = time.Now()
+		}
+
+		if err := b.readNew(ctx, eventName, stream, group); err != nil && ctx.Err() == nil {
+			b.logger.Error("failed to read redis events", "stream", stream, "error", err)
+
+			// The group is gone if the stream was deleted; create it again
+			groupReady = !strings.Contains(err.Error(), "NOGROUP")
+
+			sleepCtx(ctx, consumerRetryDelay)
+		}
+	}
+}
+
+// consumeBroadcast reads the entries of eventName appended to its stream from the moment it
+// starts, until ctx ends. Failures are logged and retried; a handler failing an entry misses it.
+func (b *RedisStreamBus) consumeBroadcast(ctx context.Context, eventName string) {
+	stream := b.cfg.Topic(eventName)
+
+	var lastID string
+
+	for ctx.Err() == nil {
+		if lastID == "" {
+			var err error
+			if lastID, err = b.lastEntryID(ctx, stream); err != nil {
+				b.logger.Error("failed to read redis stream position", "stream", stream, "error", err)
+				sleepCtx(ctx, consumerRetryDelay)
+
+				continue
+			}
+		}
+
+		next, err := b.readAfter(ctx, eventName, stream, lastID)
+		if err != nil && ctx.Err() == nil {
+			b.logger.Error("failed to read redis events", "stream", stream, "error", err)
+			sleepCtx(ctx, consumerRetryDelay)
+		}
+
+		lastID = next
+	}
+}
+
+// lastEntryID returns the ID of the newest entry of stream, or 0-0 if it has none. Reading after
+// it rather than after $ keeps entries appended between two reads.
+func (b *RedisStreamBus) lastEntryID(ctx context.Context, stream string) (string, error) {
+	cmdCtx, cancel := context.WithTimeout(ctx, redisCommandTimeout)
+	defer cancel()
+
+	entries, err := b.client.XRevRangeN(cmdCtx, stream, "+", "-", 1).Result()
+	if err != nil {
+		return "", fmt.Errorf("read newest entry: %w", err)
+	}
+
+	if len(entries) == 0 {
+		return "0-0", nil
+	}
+
+	return entries[0].ID, nil
+}
+
+// readAfter waits for entries appended after lastID and handles them, returning the ID of the
+// last entry read
+func (b *RedisStreamBus) readAfter(ctx context.Context, eventName, stream, lastID string) (string, error) {
+	cmdCtx, cancel := context.WithTimeout(ctx, redisReadBlock+redisCommandTimeout)
+	defer cancel()
+
+	streams, err := b.client.XRead(cmdCtx, &redis.XReadArgs{
+		Streams: []string{stream, lastID},
+		Count:   redisReadCount,
+		Block:   redisReadBlock,
+	}).Result()
+	if errors.Is(err, redis.Nil) {
+		return lastID, nil
+	}
+
+	if err != nil {
+		return lastID, fmt.Errorf("read stream: %w", err)
+	}
+
+	for _, s := range streams {
+		for _, entry := range s.Messages {
+			envelope, _ := entry.Values[redisFieldEnvelope].(string)
+			_ = b.deliver(ctx, eventName, []byte(envelope))
+			lastID = entry.ID
+		}
+	}
+
+	return lastID, nil
+}
+
+// createGroup creates the consumer group, and the stream with it, unless it exists. A new group
+// receives events published from then on.
+func (b *RedisStreamBus) createGroup(ctx context.Context, stream, group string) error {
+	cmdCtx, cancel := context.WithTimeout(ctx, redisCommandTimeout)
+	defer cancel()
+
+	err := b.client.XGroupCreateMkStream(cmdCtx, stream, group, "$").Err()
+	if err != nil && !strings.HasPrefix(err.Error(), "BUSYGROUP") {
+		return fmt.Errorf("create consumer group: %w", err)
+	}
+
+	return nil
+}
+
+// readNew waits for entries not yet delivered to the group and handles them
+func (b *RedisStreamBus) readNew(ctx context.Context, eventName, stream, group string) error {
+	// Reads block no longer than AckWait so reclaiming keeps pace with it. The command deadline
+	// leaves room for the server to answer after the block time.
+	block := max(min(b.cfg.AckWait, redisReadBlock), time.Millisecond)
+
+	cmdCtx, cancel := context.WithTimeout(ctx, block+redisCommandTimeout)
+	defer cancel()
+
+	streams, err := b.client.XReadGroup(cmdCtx, &redis.XReadGroupArgs{
+		Group:    group,
+		Consumer: b.consumer,
+		Streams:  []string{stream, ">"},
+		Count:    redisReadCount,
+		Block:    block,
+	}).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("read stream: %w", err)
+	}
+
+	for _, s := range streams {
+		if err = b.handleEntries(ctx, eventName, stream, group, s.Messages); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// reclaim acknowledges entries delivered MaxDeliver times without success, then claims the
+// entries left unacknowledged for AckWait and handles them again
+func (b *RedisStreamBus) reclaim(ctx context.Context, eventName, stream, group string) error {
+	cmdCtx, cancel := context.WithTimeout(ctx, redisCommandTimeout)
+	defer cancel()
+
+	pending, err := b.client.XPendingExt(cmdCtx, &redis.XPendingExtArgs{
+		Stream: stream,
+		Group:  group,
+		Idle:   b.cfg.AckWait,
+		Start:  "-",
+		End:    "+",
+		Count:  redisReadCount,
+	}).Result()
+	if err != nil {
+		return fmt.Errorf("list pending entries: %w", err)
+	}
+
+	for _, entry := range pending {
+		if entry.RetryCount < int64(b.cfg.MaxDeliver) {
+			continue
+		}
+
+		b.logger.Error("dropping event after repeated delivery failures",
+			"event", eventName, "stream", stream, "entry_id", entry.ID, "deliveries", entry.RetryCount)
+
+		if err = b.client.XAck(cmdCtx, stream, group, entry.ID).Err(); err != nil {
+			return fmt.Errorf("acknowledge dropped entry: %w", err)
+		}
+	}
+
+	claimed, _, err := b.client.XAutoClaim(cmdCtx, &redis.XAutoClaimArgs{
+		Stream:   stream,
+		Group:    group,
+		Consumer: b.consumer,
+		MinIdle:  b.cfg.AckWait,
+		Start:    "0-0",
+		Count:    redisReadCount,
+	}).Result()
+	if err != nil {
+		return fmt.Errorf("claim idle entries: %w", err)
+	}
+
+	return b.handleEntries(ctx, eventName, stream, group, claimed)
+}
+
+// handleEntries delivers each entry, acknowledging those handled
+func (b *RedisStreamBus) handleEntries(ctx context.Context, eventName, stream, group string, entries []redis.XMessage) error {
+	for _, entry := range entries {
+		// An entry trimmed from the stream has no fields left; acknowledge it
+		envelope, _ := entry.Values[redisFieldEnvelope].(string)
+		if b.deliver(ctx, eventName, []byte(envelope)) != nil {
+			continue
+		}
+
+		cmdCtx, cancel := context.WithTimeout(ctx, redisCommandTimeout)
+		err := b.client.XAck(cmdCtx, stream, group, entry.ID).Err()
+
+		cancel()
+
+		if err != nil {
+			return fmt.Errorf("acknowledge entry: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// consumerName identifies this process within consumer groups
+func consumerName() string {
+	host, err := os.Hostname()
+	if err != nil || host == "" {
+		host = "goforms"
+	}
+
+	return host + "-" + uuid.New().String()[:8]
+}
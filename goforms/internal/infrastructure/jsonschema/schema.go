@@ -0,0 +1,58 @@
+// Package jsonschema implements the subset of JSON Schema 2020-12 the service uses to describe
+// its API in the OpenAPI document: types, enums, required and closed objects, arrays, string
+// lengths, patterns, the date-time format and numeric bounds. Schemas are built in code from
+// these keywords only, so none is ever ignored, and request values decoded from JSON are
+// validated against them. Event payload schemas, which are JSON documents, are compiled by a
+// complete JSON Schema implementation instead.
+package jsonschema
+
+import (
+	"encoding/json"
+)
+
+// Types is the JSON Schema type keyword; it marshals as a string when it holds one type
+type Types []string
+
+// MarshalJSON writes a single type as a string and several as an array
+func (t Types) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+
+	return json.Marshal([]string(t))
+}
+
+// Has reports whether the type list allows name
+func (t Types) Has(name string) bool {
+	for _, typ := range t {
+		if typ == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Schema is a JSON Schema written with the supported keywords
+type Schema struct {
+	Ref         string `json:"$ref,omitempty"`
+	Type        Types  `json:"type,omitempty"`
+	Format      string `json:"format,omitempty"`
+	Description string `json:"description,omitempty"`
+	Enum        []any  `json:"enum,omitempty"`
+
+	Properties map[string]*Schema `json:"properties,omitempty"`
+	Required   []string           `json:"required,omitempty"`
+	// AdditionalProperties is false to close an object, or a schema for the values of a map
+	AdditionalProperties any `json:"additionalProperties,omitempty"`
+
+	Items    *Schema `json:"items,omitempty"`
+	MinItems *int    `json:"minItems,omitempty"`
+	MaxItems *int    `json:"maxItems,omitempty"`
+
+	MinLength *int     `json:"minLength,omitempty"`
+	MaxLength *int     `json:"maxLength,omitempty"`
+	Pattern   string   `json:"pattern,omitempty"`
+	Minimum   *float64 `json:"minimum,omitempty"`
+	Maximum   *float64 `json:"maximum,omitempty"`
+}
@@ -0,0 +1,212 @@
+package jsonschema
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// ValidationError is one way a value breaks its schema
+type ValidationError struct {
+	// Path locates the value, such as body.tags[1] or query.limit
+	Path    string
+	Message string
+	// Rule is the schema keyword that failed
+	Rule string
+}
+
+// Error implements the error interface
+func (e ValidationError) Error() string {
+	if e.Path == "" {
+		return e.Message
+	}
+
+	return e.Path + ": " + e.Message
+}
+
+// Resolver follows a $ref to the schema it names; it returns schemas without a $ref as they are
+type Resolver func(*Schema) *Schema
+
+// Validate checks a value decoded from JSON against a schema and returns every violation. resolve
+// follows $refs; it may be nil when the schema has none.
+func Validate(schema *Schema, value any, path string, resolve Resolver) []ValidationError {
+	if resolve == nil {
+		resolve = func(s *Schema) *Schema { return s }
+	}
+
+	v := &validator{resolve: resolve}
+	v.validate(schema, value, path)
+
+	return v.errs
+}
+
+// validator collects the violations of one value
+type validator struct {
+	resolve Resolver
+	errs    []ValidationError
+}
+
+func (v *validator) validate(schema *Schema, value any, path string) {
+	schema = v.resolve(schema)
+	if schema == nil {
+		return
+	}
+
+	fail := func(rule, format string, args ...any) {
+		v.errs = append(v.errs, ValidationError{Path: path, Message: fmt.Sprintf(format, args...), Rule: rule})
+	}
+
+	if len(schema.Type) > 0 && !matchesType(schema.Type, value) {
+		fail("type", "must be %s", strings.Join(schema.Type, " or "))
+
+		return
+	}
+
+	if len(schema.Enum) > 0 && !slices.Contains(schema.Enum, value) {
+		fail("enum", "must be one of %s", enumList(schema.Enum))
+
+		return
+	}
+
+	switch typed := value.(type) {
+	case string:
+		validateString(schema, typed, fail)
+	case float64:
+		if schema.Minimum != nil && typed < *schema.Minimum {
+			fail("minimum", "must be at least %v", *schema.Minimum)
+		}
+
+		if schema.Maximum != nil && typed > *schema.Maximum {
+			fail("maximum", "must be at most %v", *schema.Maximum)
+		}
+	case []any:
+		if schema.MinItems != nil && len(typed) < *schema.MinItems {
+			fail("minItems", "must have at least %d items", *schema.MinItems)
+		}
+
+		if schema.MaxItems != nil && len(typed) > *schema.MaxItems {
+			fail("maxItems", "must have at most %d items", *schema.MaxItems)
+		}
+
+		for i, item := range typed {
+			v.validate(schema.Items, item, path+"["+strconv.Itoa(i)+"]")
+		}
+	case map[string]any:
+		v.validateObject(schema, typed, path)
+	}
+}
+
+func validateString(schema *Schema, value string, fail func(rule, format string, args ...any)) {
+	length := utf8.RuneCountInString(value)
+
+	if schema.MinLength != nil && length < *schema.MinLength {
+		if *schema.MinLength == 1 {
+			fail("minLength", "must not be empty")
+		} else {
+			fail("minLength", "must be at least %d characters", *schema.MinLength)
+		}
+	}
+
+	if schema.MaxLength != nil && length > *schema.MaxLength {
+		fail("maxLength", "must be at most %d characters", *schema.MaxLength)
+	}
+
+	if schema.Pattern != "" {
+		if re, err := regexp.Compile(schema.Pattern); err == nil && !re.MatchString(value) {
+			fail("pattern", "must match %s", schema.Pattern)
+		}
+	}
+
+	if schema.Format == "date-time" {
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			fail("format", "must be an RFC 3339 date-time")
+		}
+	}
+}
+
+func (v *validator) validateObject(schema *Schema, value map[string]any, path string) {
+	for _, name := range schema.Required {
+		if _, ok := value[name]; !ok {
+			v.errs = append(v.errs, ValidationError{Path: joinPath(path, name), Message: "is required", Rule: "required"})
+		}
+	}
+
+	// Sorted so the same value always reports its errors in the same order
+	names := make([]string, 0, len(value))
+	for name := range value {
+		names = append(names, name)
+	}
+
+	slices.Sort(names)
+
+	for _, name := range names {
+		if property, ok := schema.Properties[name]; ok {
+			v.validate(property, value[name], joinPath(path, name))
+
+			continue
+		}
+
+		switch additional := schema.AdditionalProperties.(type) {
+		case bool:
+			if !additional {
+				v.errs = append(v.errs, ValidationError{Path: joinPath(path, name), Message: "is not allowed", Rule: "additionalProperties"})
+			}
+		case *Schema:
+			v.validate(additional, value[name], joinPath(path, name))
+		}
+	}
+}
+
+// matchesType reports whether a decoded JSON value has one of the types; whole numbers are integers
+func matchesType(types Types, value any) bool {
+	switch v := value.(type) {
+	case nil:
+		return types.Has("null")
+	case string:
+		return types.Has("string")
+	case bool:
+		return types.Has("boolean")
+	case float64:
+		return types.Has("number") || (types.Has("integer") && v == math.Trunc(v))
+	case []any:
+		return types.Has("array")
+	case map[string]any:
+		return types.Has("object")
+	}
+
+	return false
+}
+
+func enumList(values []any) string {
+	names := make([]string, 0, len(values))
+
+	for _, value := range values {
+		switch v := value.(type) {
+		case nil:
+			names = append(names, "null")
+		case string:
+			if v == "" {
+				names = append(names, `""`)
+			} else {
+				names = append(names, v)
+			}
+		default:
+			names = append(names, fmt.Sprint(v))
+		}
+	}
+
+	return strings.Join(names, ", ")
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+
+	return path + "." + name
+}
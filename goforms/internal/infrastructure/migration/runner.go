@@ -0,0 +1,341 @@
+package migration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+)
+
+// DefaultLockTimeout bounds how long a command waits for another runner to release the lock
+const DefaultLockTimeout = time.Minute
+
+var (
+	// ErrDirty is returned when an earlier migration failed part way. The schema has to be
+	// repaired by hand and the version recorded with Force before migrating again.
+	ErrDirty = errors.New("database is dirty after a failed migration")
+	// ErrUnknownVersion is returned when the database is at a version this binary does not ship
+	ErrUnknownVersion = errors.New("database version has no matching migration")
+	// ErrIrreversible is returned when rolling back a migration without a down script
+	ErrIrreversible = errors.New("migration has no down script")
+	// ErrNothingApplied is returned by Redo when no migration has been applied
+	ErrNothingApplied = errors.New("no migration has been applied")
+	// ErrLockTimeout is returned when the migration lock is not acquired in time
+	ErrLockTimeout = errors.New("timed out waiting for the migration lock")
+)
+
+// Target is the database migrations are applied to. Implementations hold a single
+// connection so the lock, the version table and the scripts share one session.
+type Target interface {
+	// Lock takes the migration lock, waiting until ctx is done
+	Lock(ctx context.Context) error
+	// Unlock releases the migration lock
+	Unlock(ctx context.Context) error
+	// Version returns the applied version, 0 when none, and whether it is dirty
+	Version(ctx context.Context) (int64, bool, error)
+	// SetVersion records the applied version
+	SetVersion(ctx context.Context, version int64, dirty bool) error
+	// Exec runs a migration script
+	Exec(ctx context.Context, script string) error
+	// Close releases the connection
+	Close() error
+}
+
+// Connector opens a Target for one runner operation
+type Connector func(ctx context.Context) (Target, error)
+
+// MigrationStatus reports whether one migration has been applied
+type MigrationStatus struct {
+	Version int64
+	Name    string
+	Applied bool
+}
+
+// Status is the migration state of a database
+type Status struct {
+	// Version is the last applied migration, 0 when none
+	Version    int64
+	Dirty      bool
+	Migrations []MigrationStatus
+}
+
+// Pending returns the number of migrations that have not been applied
+func (s Status) Pending() int {
+	pending := 0
+
+	for _, m := range s.Migrations {
+		if !m.Applied {
+			pending++
+		}
+	}
+
+	return pending
+}
+
+// Runner applies and rolls back migrations. Every change runs under a database lock so
+// replicas starting together, or an operator racing a deploy, never apply a migration twice.
+type Runner struct {
+	migrations  []Migration
+	connect     Connector
+	logger      logging.Logger
+	lockTimeout time.Duration
+}
+
+// New creates a runner for migrations ordered by version
+func New(migrations []Migration, connect Connector, logger logging.Logger) *Runner {
+	return &Runner{
+		migrations:  migrations,
+		connect:     connect,
+		logger:      logger,
+		lockTimeout: DefaultLockTimeout,
+	}
+}
+
+// SetLockTimeout changes how long operations wait for the migration lock
+func (r *Runner) SetLockTimeout(timeout time.Duration) {
+	if timeout > 0 {
+		r.lockTimeout = timeout
+	}
+}
+
+// Status reports the applied version and which migrations are pending
+func (r *Runner) Status(ctx context.Context) (Status, error) {
+	target, err := r.connect(ctx)
+	if err != nil {
+		return Status{}, err
+	}
+	defer r.close(target)
+
+	version, dirty, err := target.Version(ctx)
+	if err != nil {
+		return Status{}, fmt.Errorf("read migration version: %w", err)
+	}
+
+	status := Status{Version: version, Dirty: dirty, Migrations: make([]MigrationStatus, 0, len(r.migrations))}
+	for _, m := range r.migrations {
+		status.Migrations = append(status.Migrations, MigrationStatus{
+			Version: m.Version,
+			Name:    m.Name,
+			Applied: m.Version <= version,
+		})
+	}
+
+	return status, nil
+}
+
+// Up applies up to steps pending migrations, or all of them when steps is not positive,
+// and returns the migrations it applied
+func (r *Runner) Up(ctx context.Context, steps int) ([]Migration, error) {
+	var applied []Migration
+
+	err := r.locked(ctx, func(target Target) error {
+		var err error
+
+		applied, err = r.up(ctx, target, steps)
+
+		return err
+	})
+
+	return applied, err
+}
+
+// Down rolls back up to steps applied migrations, or all of them when steps is not positive,
+// and returns the migrations it rolled back, newest first
+func (r *Runner) Down(ctx context.Context, steps int) ([]Migration, error) {
+	var reverted []Migration
+
+	err := r.locked(ctx, func(target Target) error {
+		var err error
+
+		reverted, err = r.down(ctx, target, steps)
+
+		return err
+	})
+
+	return reverted, err
+}
+
+// Redo rolls back the last applied migration and applies it again
+func (r *Runner) Redo(ctx context.Context) (Migration, error) {
+	var redone Migration
+
+	err := r.locked(ctx, func(target Target) error {
+		reverted, err := r.down(ctx, target, 1)
+		if err != nil {
+			return err
+		}
+
+		if len(reverted) == 0 {
+			return ErrNothingApplied
+		}
+
+		redone = reverted[0]
+
+		_, err = r.up(ctx, target, 1)
+
+		return err
+	})
+
+	return redone, err
+}
+
+// Force records version as applied and clean without running any script. It is the way
+// out of a dirty state once the schema has been repaired by hand.
+func (r *Runner) Force(ctx context.Context, version int64) error {
+	if _, err := r.position(version); err != nil {
+		return err
+	}
+
+	return r.locked(ctx, func(target Target) error {
+		if err := target.SetVersion(ctx, version, false); err != nil {
+			return fmt.Errorf("force migration version: %w", err)
+		}
+
+		r.logger.Info("forced migration version", "version", version)
+
+		return nil
+	})
+}
+
+// up applies pending migrations on a locked target
+func (r *Runner) up(ctx context.Context, target Target, steps int) ([]Migration, error) {
+	pos, err := r.current(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := r.migrations[pos:]
+	if steps > 0 && steps < len(pending) {
+		pending = pending[:steps]
+	}
+
+	for i, m := range pending {
+		r.logger.Info("applying migration", "version", m.Version, "name", m.Name)
+
+		if err = r.run(ctx, target, m.Version, m.Up); err != nil {
+			return pending[:i], fmt.Errorf("apply migration %d_%s: %w", m.Version, m.Name, err)
+		}
+	}
+
+	return pending, nil
+}
+
+// down rolls back applied migrations on a locked target
+func (r *Runner) down(ctx context.Context, target Target, steps int) ([]Migration, error) {
+	pos, err := r.current(ctx, target)
+	if err != nil {
+		return nil, err
+	}
+
+	count := pos
+	if steps > 0 && steps < count {
+		count = steps
+	}
+
+	reverted := make([]Migration, 0, count)
+
+	for i := pos - 1; i >= pos-count; i-- {
+		m := r.migrations[i]
+		if m.Down == "" {
+			return reverted, fmt.Errorf("%w: %d_%s", ErrIrreversible, m.Version, m.Name)
+		}
+
+		previous := int64(0)
+		if i > 0 {
+			previous = r.migrations[i-1].Version
+		}
+
+		r.logger.Info("rolling back migration", "version", m.Version, "name", m.Name)
+
+		if err = r.run(ctx, target, previous, m.Down); err != nil {
+			return reverted, fmt.Errorf("roll back migration %d_%s: %w", m.Version, m.Name, err)
+		}
+
+		reverted = append(reverted, m)
+	}
+
+	return reverted, nil
+}
+
+// run executes a script, leaving the target dirty at version if the script fails
+func (r *Runner) run(ctx context.Context, target Target, version int64, script string) error {
+	if err := target.SetVersion(ctx, version, true); err != nil {
+		return fmt.Errorf("mark migration version: %w", err)
+	}
+
+	if err := target.Exec(ctx, script); err != nil {
+		return err
+	}
+
+	if err := target.SetVersion(ctx, version, false); err != nil {
+		return fmt.Errorf("record migration version: %w", err)
+	}
+
+	return nil
+}
+
+// current returns how many migrations are applied, refusing to continue from a dirty state
+func (r *Runner) current(ctx context.Context, target Target) (int, error) {
+	version, dirty, err := target.Version(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("read migration version: %w", err)
+	}
+
+	if dirty {
+		return 0, fmt.Errorf("%w at version %d", ErrDirty, version)
+	}
+
+	return r.position(version)
+}
+
+// position returns how many migrations are applied when version is the current one
+func (r *Runner) position(version int64) (int, error) {
+	if version == 0 {
+		return 0, nil
+	}
+
+	for i, m := range r.migrations {
+		if m.Version == version {
+			return i + 1, nil
+		}
+	}
+
+	return 0, fmt.Errorf("%w: %d", ErrUnknownVersion, version)
+}
+
+// locked runs fn on a target holding the migration lock
+func (r *Runner) locked(ctx context.Context, fn func(Target) error) error {
+	target, err := r.connect(ctx)
+	if err != nil {
+		return err
+	}
+	defer r.close(target)
+
+	lockCtx, cancel := context.WithTimeout(ctx, r.lockTimeout)
+	defer cancel()
+
+	if err = target.Lock(lockCtx); err != nil {
+		if errors.Is(err, ErrLockTimeout) || lockCtx.Err() != nil {
+			return ErrLockTimeout
+		}
+
+		return fmt.Errorf("acquire migration lock: %w", err)
+	}
+
+	defer func() {
+		if unlockErr := target.Unlock(context.WithoutCancel(ctx)); unlockErr != nil {
+			r.logger.Warn("failed to release migration lock", "error", unlockErr)
+		}
+	}()
+
+	return fn(target)
+}
+
+// close releases a target's connection
+func (r *Runner) close(target Target) {
+	if err := target.Close(); err != nil {
+		r.logger.Warn("failed to close migration connection", "error", err)
+	}
+}
@@ -0,0 +1,176 @@
+package migration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/goformx/goforms/internal/infrastructure/migration"
+	mocklogging "github.com/goformx/goforms/test/mocks/logging"
+)
+
+// fakeTarget records the scripts it runs and the version it holds
+type fakeTarget struct {
+	version int64
+	dirty   bool
+	locked  bool
+	failOn  string
+	scripts []string
+}
+
+func (t *fakeTarget) Lock(context.Context) error {
+	if t.locked {
+		return errors.New("already locked")
+	}
+
+	t.locked = true
+
+	return nil
+}
+
+func (t *fakeTarget) Unlock(context.Context) error {
+	t.locked = false
+
+	return nil
+}
+
+func (t *fakeTarget) Version(context.Context) (int64, bool, error) {
+	return t.version, t.dirty, nil
+}
+
+func (t *fakeTarget) SetVersion(_ context.Context, version int64, dirty bool) error {
+	t.version, t.dirty = version, dirty
+
+	return nil
+}
+
+func (t *fakeTarget) Exec(_ context.Context, script string) error {
+	if script == t.failOn {
+		return errors.New("syntax error")
+	}
+
+	t.scripts = append(t.scripts, script)
+
+	return nil
+}
+
+func (t *fakeTarget) Close() error {
+	return nil
+}
+
+func newRunner(t *testing.T, target *fakeTarget) *migration.Runner {
+	t.Helper()
+
+	logger := mocklogging.NewMockLogger(gomock.NewController(t))
+	logger.EXPECT().Info(gomock.Any(), gomock.Any()).AnyTimes()
+
+	migrations := []migration.Migration{
+		{Version: 1, Name: "create_users", Up: "up 1", Down: "down 1"},
+		{Version: 2, Name: "create_forms", Up: "up 2", Down: "down 2"},
+		{Version: 3, Name: "add_tags", Up: "up 3", Down: "down 3"},
+	}
+
+	return migration.New(migrations, func(context.Context) (migration.Target, error) {
+		return target, nil
+	}, logger)
+}
+
+func TestRunner_UpAppliesPendingInOrder(t *testing.T) {
+	target := &fakeTarget{version: 1}
+	runner := newRunner(t, target)
+
+	applied, err := runner.Up(context.Background(), 0)
+	require.NoError(t, err)
+	require.Len(t, applied, 2)
+	assert.Equal(t, []string{"up 2", "up 3"}, target.scripts)
+	assert.Equal(t, int64(3), target.version)
+	assert.False(t, target.dirty)
+	assert.False(t, target.locked)
+
+	applied, err = runner.Up(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Empty(t, applied)
+}
+
+func TestRunner_UpStopsAfterSteps(t *testing.T) {
+	target := &fakeTarget{}
+
+	_, err := newRunner(t, target).Up(context.Background(), 1)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), target.version)
+}
+
+func TestRunner_FailedMigrationLeavesDirtyVersion(t *testing.T) {
+	target := &fakeTarget{failOn: "up 2"}
+	runner := newRunner(t, target)
+
+	applied, err := runner.Up(context.Background(), 0)
+	require.Error(t, err)
+	assert.Len(t, applied, 1)
+	assert.Equal(t, int64(2), target.version)
+	assert.True(t, target.dirty)
+
+	_, err = runner.Up(context.Background(), 0)
+	require.ErrorIs(t, err, migration.ErrDirty)
+
+	target.failOn = ""
+	require.NoError(t, runner.Force(context.Background(), 1))
+
+	_, err = runner.Up(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), target.version)
+}
+
+func TestRunner_DownRollsBackNewestFirst(t *testing.T) {
+	target := &fakeTarget{version: 3}
+
+	reverted, err := newRunner(t, target).Down(context.Background(), 2)
+	require.NoError(t, err)
+	require.Len(t, reverted, 2)
+	assert.Equal(t, int64(3), reverted[0].Version)
+	assert.Equal(t, []string{"down 3", "down 2"}, target.scripts)
+	assert.Equal(t, int64(1), target.version)
+}
+
+func TestRunner_DownAllEmptiesVersion(t *testing.T) {
+	target := &fakeTarget{version: 3}
+
+	reverted, err := newRunner(t, target).Down(context.Background(), 0)
+	require.NoError(t, err)
+	assert.Len(t, reverted, 3)
+	assert.Equal(t, int64(0), target.version)
+}
+
+func TestRunner_Redo(t *testing.T) {
+	target := &fakeTarget{version: 2}
+
+	redone, err := newRunner(t, target).Redo(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), redone.Version)
+	assert.Equal(t, []string{"down 2", "up 2"}, target.scripts)
+	assert.Equal(t, int64(2), target.version)
+
+	_, err = newRunner(t, &fakeTarget{}).Redo(context.Background())
+	require.ErrorIs(t, err, migration.ErrNothingApplied)
+}
+
+func TestRunner_RejectsUnknownVersion(t *testing.T) {
+	runner := newRunner(t, &fakeTarget{version: 99})
+
+	_, err := runner.Up(context.Background(), 0)
+	require.ErrorIs(t, err, migration.ErrUnknownVersion)
+	require.ErrorIs(t, runner.Force(context.Background(), 42), migration.ErrUnknownVersion)
+}
+
+func TestRunner_Status(t *testing.T) {
+	status, err := newRunner(t, &fakeTarget{version: 2}).Status(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), status.Version)
+	assert.Equal(t, 1, status.Pending())
+	assert.True(t, status.Migrations[1].Applied)
+	assert.False(t, status.Migrations[2].Applied)
+}
@@ -0,0 +1,172 @@
+// Package migration applies the embedded SQL migrations. It keeps its state in the
+// schema_migrations table used by golang-migrate, so databases migrated with the
+// migrate CLI can switch to the built-in runner without losing track of their version.
+package migration
+
+import (
+	"fmt"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// migrationFile matches names such as 2026101807_create_idempotency_keys.up.sql
+var migrationFile = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+// Migration is one versioned schema change
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Load reads the migrations in the root of fsys, ordered by version. Files that are not
+// named like migrations are ignored; a version may lack a down script but not an up script.
+func Load(fsys fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(fsys, ".")
+	if err != nil {
+		return nil, fmt.Errorf("read migrations: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+
+	for _, entry := range entries {
+		match := migrationFile.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+
+		version, parseErr := strconv.ParseInt(match[1], 10, 64)
+		if parseErr != nil || version <= 0 {
+			return nil, fmt.Errorf("migration %s: invalid version", entry.Name())
+		}
+
+		content, readErr := fs.ReadFile(fsys, entry.Name())
+		if readErr != nil {
+			return nil, fmt.Errorf("read migration %s: %w", entry.Name(), readErr)
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		} else if m.Name != match[2] {
+			return nil, fmt.Errorf("migration version %d is used by %q and %q", version, m.Name, match[2])
+		}
+
+		if match[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up script", m.Version, m.Name)
+		}
+
+		migrations = append(migrations, *m)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+// SplitStatements splits a script into its statements for drivers that execute one statement
+// at a time. Semicolons inside quotes and comments do not end a statement.
+func SplitStatements(script string) []string {
+	var (
+		statements []string
+		start      int
+	)
+
+	for i := 0; i < len(script); i++ {
+		switch script[i] {
+		case '\'', '"', '`':
+			i = skipQuoted(script, i)
+		case '-':
+			if i+1 < len(script) && script[i+1] == '-' {
+				i = skipUntil(script, i, "\n")
+			}
+		case '/':
+			if i+1 < len(script) && script[i+1] == '*' {
+				i = skipUntil(script, i+2, "*/") + 1
+			}
+		case ';':
+			statements = appendStatement(statements, script[start:i])
+			start = i + 1
+		}
+	}
+
+	return appendStatement(statements, script[start:])
+}
+
+// skipQuoted returns the index of the quote closing the string opened at i. A doubled
+// quote or a backslash escapes the quote character.
+func skipQuoted(script string, i int) int {
+	quote := script[i]
+
+	for j := i + 1; j < len(script); j++ {
+		switch script[j] {
+		case '\\':
+			j++
+		case quote:
+			if j+1 < len(script) && script[j+1] == quote {
+				j++
+
+				continue
+			}
+
+			return j
+		}
+	}
+
+	return len(script)
+}
+
+// skipUntil returns the index of the first byte of end at or after i, or the end of the script
+func skipUntil(script string, i int, end string) int {
+	for j := i; j+len(end) <= len(script); j++ {
+		if script[j:j+len(end)] == end {
+			return j
+		}
+	}
+
+	return len(script)
+}
+
+// appendStatement appends statement unless it holds nothing but whitespace and comments
+func appendStatement(statements []string, statement string) []string {
+	for i := 0; i < len(statement); i++ {
+		switch statement[i] {
+		case ' ', '\t', '\r', '\n':
+		case '-':
+			if i+1 < len(statement) && statement[i+1] == '-' {
+				i = skipUntil(statement, i, "\n")
+
+				continue
+			}
+
+			return append(statements, strings.TrimSpace(statement))
+		case '/':
+			if i+1 < len(statement) && statement[i+1] == '*' {
+				i = skipUntil(statement, i+2, "*/") + 1
+
+				continue
+			}
+
+			return append(statements, strings.TrimSpace(statement))
+		default:
+			return append(statements, strings.TrimSpace(statement))
+		}
+	}
+
+	return statements
+}
@@ -0,0 +1,69 @@
+package migration_test
+
+import (
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goformx/goforms/internal/infrastructure/migration"
+	"github.com/goformx/goforms/migrations"
+)
+
+func TestLoad_PairsScriptsByVersion(t *testing.T) {
+	fsys := fstest.MapFS{
+		"0002_add_tags.up.sql":       {Data: []byte("ALTER TABLE forms ADD tags TEXT;")},
+		"0001_create_forms.up.sql":   {Data: []byte("CREATE TABLE forms (id INT);")},
+		"0001_create_forms.down.sql": {Data: []byte("DROP TABLE forms;")},
+		"README.md":                  {Data: []byte("not a migration")},
+	}
+
+	loaded, err := migration.Load(fsys)
+	require.NoError(t, err)
+	require.Len(t, loaded, 2)
+	assert.Equal(t, int64(1), loaded[0].Version)
+	assert.Equal(t, "create_forms", loaded[0].Name)
+	assert.Equal(t, "DROP TABLE forms;", loaded[0].Down)
+	assert.Empty(t, loaded[1].Down)
+}
+
+func TestLoad_RejectsMissingUpScript(t *testing.T) {
+	_, err := migration.Load(fstest.MapFS{"0001_create_forms.down.sql": {Data: []byte("DROP TABLE forms;")}})
+	require.Error(t, err)
+}
+
+func TestLoad_EmbeddedMigrations(t *testing.T) {
+	for _, driver := range []string{"postgres", "mariadb", "sqlite"} {
+		t.Run(driver, func(t *testing.T) {
+			fsys, err := migrations.ForDriver(driver)
+			require.NoError(t, err)
+
+			loaded, err := migration.Load(fsys)
+			require.NoError(t, err)
+			require.NotEmpty(t, loaded)
+
+			for _, m := range loaded {
+				assert.NotEmpty(t, m.Down, "migration %d_%s", m.Version, m.Name)
+			}
+		})
+	}
+
+	_, err := migrations.ForDriver("oracle")
+	require.Error(t, err)
+}
+
+func TestSplitStatements(t *testing.T) {
+	script := `-- create the table; with a comment
+CREATE TABLE notes (body TEXT DEFAULT 'a;b', note VARCHAR(10) DEFAULT 'it''s');
+/* block; comment */
+INSERT INTO notes (body) VALUES ("x;y");
+
+-- trailing comment only
+`
+
+	assert.Equal(t, []string{
+		"-- create the table; with a comment\nCREATE TABLE notes (body TEXT DEFAULT 'a;b', note VARCHAR(10) DEFAULT 'it''s')",
+		"/* block; comment */\nINSERT INTO notes (body) VALUES (\"x;y\")",
+	}, migration.SplitStatements(script))
+}
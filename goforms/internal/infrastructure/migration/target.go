@@ -0,0 +1,232 @@
+package migration
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/database"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+	"github.com/goformx/goforms/migrations"
+)
+
+const (
+	// versionTable is shared with golang-migrate
+	versionTable = "schema_migrations"
+	// advisoryLockID identifies the PostgreSQL advisory lock ("goforms" in ASCII)
+	advisoryLockID int64 = 0x676f666f726d73
+	// namedLock identifies the MariaDB named lock
+	namedLock = "goforms_schema_migrations"
+)
+
+// NewDatabaseRunner creates a runner applying the embedded migrations of the configured
+// driver to the application database
+func NewDatabaseRunner(cfg *config.Config, db database.DB, logger logging.Logger) (*Runner, error) {
+	driver := cfg.Database.Driver
+
+	fsys, err := migrations.ForDriver(driver)
+	if err != nil {
+		return nil, fmt.Errorf("load migrations: %w", err)
+	}
+
+	loaded, err := Load(fsys)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := db.GetDB().DB()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get database instance: %w", err)
+	}
+
+	return New(loaded, func(ctx context.Context) (Target, error) {
+		return NewSQLTarget(ctx, sqlDB, driver)
+	}, logger.WithComponent("migration")), nil
+}
+
+// SQLTarget is a Target on one connection of a PostgreSQL, MariaDB or SQLite database
+type SQLTarget struct {
+	conn   *sql.Conn
+	driver string
+}
+
+// NewSQLTarget reserves a connection from db for a migration operation
+func NewSQLTarget(ctx context.Context, db *sql.DB, driver string) (*SQLTarget, error) {
+	switch driver {
+	case config.DriverPostgres, config.DriverMariaDB, config.DriverSQLite:
+	default:
+		return nil, fmt.Errorf("unsupported database driver for migrations: %s", driver)
+	}
+
+	conn, err := db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("open migration connection: %w", err)
+	}
+
+	return &SQLTarget{conn: conn, driver: driver}, nil
+}
+
+// Lock takes a session-level lock that is released with the connection if the process dies.
+// SQLite has no session locks and needs none: its databases are local files migrated by
+// the one process that owns them.
+func (t *SQLTarget) Lock(ctx context.Context) error {
+	switch t.driver {
+	case config.DriverSQLite:
+		return nil
+	case config.DriverPostgres:
+		if _, err := t.conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", advisoryLockID); err != nil {
+			return fmt.Errorf("pg_advisory_lock: %w", err)
+		}
+
+		return nil
+	}
+
+	// GET_LOCK waits in whole seconds; a negative timeout waits forever
+	timeout := -1
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout = max(int(time.Until(deadline).Seconds()), 0)
+	}
+
+	var acquired sql.NullInt64
+	if err := t.conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", namedLock, timeout).Scan(&acquired); err != nil {
+		return fmt.Errorf("GET_LOCK: %w", err)
+	}
+
+	if !acquired.Valid || acquired.Int64 != 1 {
+		return ErrLockTimeout
+	}
+
+	return nil
+}
+
+// Unlock releases the lock taken by Lock
+func (t *SQLTarget) Unlock(ctx context.Context) error {
+	query, args := "SELECT RELEASE_LOCK(?)", []any{namedLock}
+
+	switch t.driver {
+	case config.DriverSQLite:
+		return nil
+	case config.DriverPostgres:
+		query, args = "SELECT pg_advisory_unlock($1)", []any{advisoryLockID}
+	}
+
+	if _, err := t.conn.ExecContext(ctx, query, args...); err != nil {
+		return fmt.Errorf("release migration lock: %w", err)
+	}
+
+	return nil
+}
+
+// Version reads the version table, creating it on first use
+func (t *SQLTarget) Version(ctx context.Context) (int64, bool, error) {
+	if _, err := t.conn.ExecContext(ctx,
+		"CREATE TABLE IF NOT EXISTS "+versionTable+" (version BIGINT NOT NULL PRIMARY KEY, dirty BOOLEAN NOT NULL)",
+	); err != nil {
+		return 0, false, fmt.Errorf("create %s: %w", versionTable, err)
+	}
+
+	var (
+		version int64
+		dirty   bool
+	)
+
+	err := t.conn.QueryRowContext(ctx, "SELECT version, dirty FROM "+versionTable+" LIMIT 1").Scan(&version, &dirty)
+	if errors.Is(err, sql.ErrNoRows) {
+		return 0, false, nil
+	}
+
+	if err != nil {
+		return 0, false, fmt.Errorf("read %s: %w", versionTable, err)
+	}
+
+	return version, dirty, nil
+}
+
+// SetVersion replaces the single row of the version table; a clean version 0 leaves it empty
+func (t *SQLTarget) SetVersion(ctx context.Context, version int64, dirty bool) error {
+	tx, err := t.conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin: %w", err)
+	}
+
+	if _, err = tx.ExecContext(ctx, "DELETE FROM "+versionTable); err != nil {
+		return rollback(tx, err)
+	}
+
+	if version != 0 || dirty {
+		if _, err = tx.ExecContext(ctx, t.bind("INSERT INTO "+versionTable+" (version, dirty) VALUES (?, ?)"),
+			version, dirty); err != nil {
+			return rollback(tx, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("commit: %w", err)
+	}
+
+	return nil
+}
+
+// Exec runs a script. PostgreSQL and SQLite accept a whole script at once; MariaDB
+// connections do not allow multiple statements, so its scripts are run statement by statement.
+func (t *SQLTarget) Exec(ctx context.Context, script string) error {
+	statements := []string{script}
+	if t.driver == config.DriverMariaDB {
+		statements = SplitStatements(script)
+	}
+
+	for _, statement := range statements {
+		if _, err := t.conn.ExecContext(ctx, statement); err != nil {
+			return fmt.Errorf("execute migration: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Close returns the connection to the pool
+func (t *SQLTarget) Close() error {
+	if err := t.conn.Close(); err != nil {
+		return fmt.Errorf("close migration connection: %w", err)
+	}
+
+	return nil
+}
+
+// bind rewrites ? placeholders for drivers using numbered ones
+func (t *SQLTarget) bind(query string) string {
+	if t.driver != config.DriverPostgres {
+		return query
+	}
+
+	var b strings.Builder
+
+	n := 0
+
+	for _, r := range query {
+		if r == '?' {
+			n++
+			b.WriteString("$" + strconv.Itoa(n))
+
+			continue
+		}
+
+		b.WriteRune(r)
+	}
+
+	return b.String()
+}
+
+// rollback aborts tx after err
+func rollback(tx *sql.Tx, err error) error {
+	if rbErr := tx.Rollback(); rbErr != nil {
+		return fmt.Errorf("%w (rollback: %w)", err, rbErr)
+	}
+
+	return fmt.Errorf("update %s: %w", versionTable, err)
+}
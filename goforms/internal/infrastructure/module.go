@@ -12,13 +12,16 @@ import (
 	"go.uber.org/fx"
 
 	"github.com/goformx/goforms/internal/application/handlers/web"
+	"github.com/goformx/goforms/internal/application/response"
+	"github.com/goformx/goforms/internal/domain/common/events"
 	"github.com/goformx/goforms/internal/domain/form"
-	formevent "github.com/goformx/goforms/internal/domain/form/event"
+	formevents "github.com/goformx/goforms/internal/domain/form/events"
 	"github.com/goformx/goforms/internal/domain/user"
 	"github.com/goformx/goforms/internal/infrastructure/config"
 	"github.com/goformx/goforms/internal/infrastructure/database"
 	"github.com/goformx/goforms/internal/infrastructure/event"
 	"github.com/goformx/goforms/internal/infrastructure/logging"
+	"github.com/goformx/goforms/internal/infrastructure/migration"
 	"github.com/goformx/goforms/internal/infrastructure/sanitization"
 	"github.com/goformx/goforms/internal/infrastructure/server"
 	"github.com/goformx/goforms/internal/infrastructure/version"
@@ -97,13 +100,6 @@ func (p ServiceParams) Validate() error {
 	return nil
 }
 
-// EventPublisherParams contains dependencies for creating an event publisher.
-// The event publisher is responsible for distributing domain events throughout the application.
-type EventPublisherParams struct {
-	fx.In
-	Logger logging.Logger `validate:"required"`
-}
-
 // LoggerFactoryParams contains dependencies for creating a logger factory
 type LoggerFactoryParams struct {
 	fx.In
@@ -111,19 +107,6 @@ type LoggerFactoryParams struct {
 	Sanitizer sanitization.ServiceInterface `validate:"required"`
 }
 
-// NewEventPublisher creates a new event publisher with proper dependency validation.
-// It returns an error if required dependencies are missing or invalid.
-func NewEventPublisher(p EventPublisherParams) (formevent.Publisher, error) {
-	if p.Logger == nil {
-		return nil, fmt.Errorf("event publisher creation failed: %w", ErrMissingLogger)
-	}
-
-	publisher := event.NewMemoryPublisher(p.Logger)
-	p.Logger.Info("Event publisher initialized successfully")
-
-	return publisher, nil
-}
-
 // NewLoggerFactory creates a new logger factory with proper configuration and error handling.
 func NewLoggerFactory(p LoggerFactoryParams) (*logging.Factory, error) {
 	if p.Config == nil {
@@ -225,6 +208,9 @@ func ProvideEcho() *echo.Echo {
 	e.HideBanner = true
 	e.HidePort = true
 
+	// Errors returned by handlers and middleware are rendered as RFC 7807 problems
+	e.HTTPErrorHandler = response.HTTPErrorHandler
+
 	return e
 }
 
@@ -260,6 +246,67 @@ func ProvideDatabase(lc fx.Lifecycle, cfg *config.Config, logger logging.Logger)
 	return db, nil
 }
 
+// ProvideEventBus creates the event bus selected by the events configuration. It is started with
+// the application, so broker-backed buses begin consuming subscribed events, and stopped with
+// it, which waits for running handlers and closes broker connections.
+func ProvideEventBus(
+	lc fx.Lifecycle,
+	cfg *config.Config,
+	logger logging.Logger,
+	schemas *events.SchemaRegistry,
+) (events.EventBus, error) {
+	if cfg == nil {
+		return nil, ErrMissingConfig
+	}
+
+	if logger == nil {
+		return nil, ErrMissingLogger
+	}
+
+	bus, err := event.NewEventBus(cfg.Events, logger, schemas)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event bus: %w", err)
+	}
+
+	lc.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			logger.Info("Event bus started", "backend", cfg.Events.Backend)
+
+			return bus.Start(ctx)
+		},
+		OnStop: func(ctx context.Context) error {
+			return bus.Stop(ctx)
+		},
+	})
+
+	return bus, nil
+}
+
+// ProvideBroadcastEventBus creates the bus for subscribers that need every event in every
+// replica, such as live submission streams. With a broker backend it is a second bus, started
+// and stopped with the application.
+func ProvideBroadcastEventBus(
+	lc fx.Lifecycle,
+	cfg *config.Config,
+	logger logging.Logger,
+	schemas *events.SchemaRegistry,
+	shared events.EventBus,
+) (event.BroadcastBus, error) {
+	bus, owned, err := event.NewBroadcastBus(cfg.Events, logger, schemas, shared)
+	if err != nil {
+		return event.BroadcastBus{}, fmt.Errorf("failed to create broadcast event bus: %w", err)
+	}
+
+	if owned {
+		lc.Append(fx.Hook{
+			OnStart: bus.Start,
+			OnStop:  bus.Stop,
+		})
+	}
+
+	return bus, nil
+}
+
 // ProvideSanitizationService creates a new sanitization service with proper annotations.
 func ProvideSanitizationService() sanitization.ServiceInterface {
 	return sanitization.NewService()
@@ -276,6 +323,9 @@ var Module = fx.Module("infrastructure",
 		// Database with lifecycle management
 		ProvideDatabase,
 
+		// Embedded schema migrations
+		migration.NewDatabaseRunner,
+
 		// HTTP server
 		server.New,
 
@@ -290,8 +340,9 @@ var Module = fx.Module("infrastructure",
 		NewLogger,
 
 		// Event system
-		NewEventPublisher,
-		event.NewMemoryEventBus,
+		formevents.NewSchemaRegistry,
+		ProvideEventBus,
+		ProvideBroadcastEventBus,
 	),
 
 	// Lifecycle management
@@ -0,0 +1,187 @@
+/*
+ * GoFormX embed SDK (protocol v1).
+ *
+ * Usage:
+ *   <script src="https://forms.example.com/assets/embed/v1/embed.js"></script>
+ *   <div data-goformx-form="FORM_ID"></div>
+ *
+ * or programmatically:
+ *   GoFormX.embed({
+ *     formId: 'FORM_ID',
+ *     container: '#signup',
+ *     prefill: { email: 'ada@example.com' },
+ *     theme: { '--goformx-primary': '#4f46e5' },
+ *     onLoad: function () {},
+ *     onPageChange: function (page) {},
+ *     onValidationError: function (errors) {},
+ *     onSubmit: function (submission) {}
+ *   });
+ *
+ * Messages are only accepted from the GoFormX origin this script was loaded from and from the
+ * embedded frame itself; the server only talks back to host origins listed in the form's
+ * CORS origins.
+ */
+(function (window, document) {
+  'use strict';
+
+  var PROTOCOL_VERSION = 1;
+  var MESSAGE_SOURCE = 'goformx';
+  var THEME_VARIABLE = /^--[A-Za-z0-9-]+$/;
+
+  var currentScript = document.currentScript;
+  var defaultBaseUrl = currentScript ? new URL(currentScript.src, window.location.href).origin : '';
+
+  function resolveContainer(container) {
+    if (typeof container === 'string') {
+      return document.querySelector(container);
+    }
+    return container || null;
+  }
+
+  function sanitizeTheme(theme) {
+    var clean = {};
+    Object.keys(theme || {}).forEach(function (name) {
+      if (THEME_VARIABLE.test(name) && typeof theme[name] === 'string') {
+        clean[name] = theme[name];
+      }
+    });
+    return clean;
+  }
+
+  function call(callback, arg) {
+    if (typeof callback === 'function') {
+      try {
+        callback(arg);
+      } catch (err) {
+        console.error('GoFormX callback error:', err);
+      }
+    }
+  }
+
+  function Embed(options) {
+    if (!options || !options.formId) {
+      throw new Error('GoFormX.embed: formId is required');
+    }
+
+    this.options = options;
+    this.baseUrl = (options.baseUrl || defaultBaseUrl).replace(/\/+$/, '');
+    this.origin = new URL(this.baseUrl, window.location.href).origin;
+    this.container = resolveContainer(options.container);
+    if (!this.container) {
+      throw new Error('GoFormX.embed: container not found');
+    }
+
+    this.iframe = document.createElement('iframe');
+    this.iframe.src = this.baseUrl + '/forms/' + encodeURIComponent(options.formId) +
+      '/embed?origin=' + encodeURIComponent(window.location.origin);
+    this.iframe.title = options.title || 'Form';
+    this.iframe.setAttribute('scrolling', 'no');
+    this.iframe.style.width = '100%';
+    this.iframe.style.border = '0';
+    this.iframe.style.overflow = 'hidden';
+    this.iframe.style.height = (options.minHeight || 150) + 'px';
+
+    this.handleMessage = this.handleMessage.bind(this);
+    window.addEventListener('message', this.handleMessage);
+    this.container.appendChild(this.iframe);
+  }
+
+  Embed.prototype.post = function (type, payload) {
+    if (!this.iframe.contentWindow) {
+      return;
+    }
+    this.iframe.contentWindow.postMessage({
+      source: MESSAGE_SOURCE,
+      version: PROTOCOL_VERSION,
+      type: type,
+      payload: payload || {}
+    }, this.origin);
+  };
+
+  Embed.prototype.handleMessage = function (event) {
+    var message = event.data;
+    if (event.origin !== this.origin || event.source !== this.iframe.contentWindow) {
+      return;
+    }
+    if (!message || message.source !== MESSAGE_SOURCE || message.version !== PROTOCOL_VERSION) {
+      return;
+    }
+
+    var payload = message.payload || {};
+    var options = this.options;
+
+    switch (message.type) {
+      case 'ready':
+        this.post('init', {
+          prefill: options.prefill || {},
+          theme: sanitizeTheme(options.theme)
+        });
+        break;
+      case 'loaded':
+        call(options.onLoad, payload);
+        break;
+      case 'resize':
+        if (options.autoResize !== false && typeof payload.height === 'number' && payload.height > 0) {
+          this.iframe.style.height = Math.max(Math.ceil(payload.height), options.minHeight || 0) + 'px';
+        }
+        break;
+      case 'page':
+        call(options.onPageChange, payload);
+        break;
+      case 'validation_error':
+        call(options.onValidationError, payload.errors || []);
+        break;
+      case 'submitted':
+        call(options.onSubmit, payload.submission);
+        break;
+      default:
+        break;
+    }
+  };
+
+  // destroy removes the iframe and stops listening for its messages
+  Embed.prototype.destroy = function () {
+    window.removeEventListener('message', this.handleMessage);
+    if (this.iframe.parentNode) {
+      this.iframe.parentNode.removeChild(this.iframe);
+    }
+  };
+
+  function parseJSONAttribute(element, name) {
+    var value = element.getAttribute(name);
+    if (!value) {
+      return undefined;
+    }
+    try {
+      return JSON.parse(value);
+    } catch (err) {
+      console.error('GoFormX: invalid JSON in ' + name, err);
+      return undefined;
+    }
+  }
+
+  function autoInit() {
+    var elements = document.querySelectorAll('[data-goformx-form]:not([data-goformx-initialized])');
+    Array.prototype.forEach.call(elements, function (element) {
+      element.setAttribute('data-goformx-initialized', 'true');
+      new Embed({
+        formId: element.getAttribute('data-goformx-form'),
+        container: element,
+        prefill: parseJSONAttribute(element, 'data-goformx-prefill'),
+        theme: parseJSONAttribute(element, 'data-goformx-theme')
+      });
+    });
+  }
+
+  window.GoFormX = window.GoFormX || {};
+  window.GoFormX.version = PROTOCOL_VERSION;
+  window.GoFormX.embed = function (options) {
+    return new Embed(options);
+  };
+
+  if (document.readyState === 'loading') {
+    document.addEventListener('DOMContentLoaded', autoInit);
+  } else {
+    autoInit();
+  }
+})(window, document);
@@ -0,0 +1,131 @@
+/*
+ * GoFormX embed bootstrap (runs inside the iframe, protocol v1).
+ * Reads its configuration from data attributes so the embed page needs no inline script.
+ * It only talks to the host origin the server verified against the form's CORS origins.
+ */
+(function () {
+  'use strict';
+
+  var PROTOCOL_VERSION = 1;
+  var MESSAGE_SOURCE = 'goformx';
+  var THEME_VARIABLE = /^--[A-Za-z0-9-]+$/;
+
+  var script = document.currentScript;
+  var config = (script && script.dataset) || {};
+  var container = document.getElementById('formio');
+  // The verified host origin; "'none'" when the host is not allowed, in which case nothing is posted
+  var targetOrigin = document.documentElement.dataset.corsOrigin;
+  var canPost = window.parent !== window && targetOrigin && targetOrigin !== "'none'";
+  var formInstance = null;
+  var pendingInit = null;
+
+  function post(type, payload) {
+    if (!canPost) {
+      return;
+    }
+    window.parent.postMessage({
+      source: MESSAGE_SOURCE,
+      version: PROTOCOL_VERSION,
+      type: type,
+      formId: config.formId,
+      payload: payload || {}
+    }, targetOrigin);
+  }
+
+  function showError(err) {
+    var message = document.createElement('p');
+    message.className = 'goformx-embed-error';
+    message.textContent = 'Failed to load form. Please try again.';
+    container.replaceChildren(message);
+    console.error('Form.io load error:', err);
+  }
+
+  function applyTheme(theme) {
+    Object.keys(theme || {}).forEach(function (name) {
+      if (THEME_VARIABLE.test(name) && typeof theme[name] === 'string') {
+        document.documentElement.style.setProperty(name, theme[name]);
+      }
+    });
+  }
+
+  function applyInit(init) {
+    applyTheme(init.theme);
+    if (init.prefill && typeof init.prefill === 'object') {
+      formInstance.submission = { data: init.prefill };
+    }
+  }
+
+  function reportHeight() {
+    post('resize', { height: document.documentElement.scrollHeight });
+  }
+
+  window.addEventListener('message', function (event) {
+    var message = event.data;
+    if (!canPost || event.origin !== targetOrigin || event.source !== window.parent) {
+      return;
+    }
+    if (!message || message.source !== MESSAGE_SOURCE || message.version !== PROTOCOL_VERSION) {
+      return;
+    }
+    if (message.type === 'init') {
+      if (formInstance) {
+        applyInit(message.payload || {});
+      } else {
+        pendingInit = message.payload || {};
+      }
+    }
+  });
+
+  if (typeof ResizeObserver !== 'undefined') {
+    new ResizeObserver(reportHeight).observe(document.body);
+  }
+
+  post('ready');
+
+  if (typeof Formio === 'undefined') {
+    showError(new Error('renderer not loaded'));
+    return;
+  }
+
+  Formio.createForm(container, config.schemaUrl, {
+    submit: config.submitUrl,
+    noSubmit: false
+  }).then(function (form) {
+    formInstance = form;
+    if (pendingInit) {
+      applyInit(pendingInit);
+      pendingInit = null;
+    }
+
+    form.on('wizardPageSelected', function (page, index) {
+      post('page', { index: index, key: page && page.key });
+    });
+    form.on('nextPage', function (event) {
+      post('page', { index: event && event.page });
+    });
+    form.on('prevPage', function (event) {
+      post('page', { index: event && event.page });
+    });
+    form.on('error', function (errors) {
+      var list = (Array.isArray(errors) ? errors : [errors]).map(function (error) {
+        return {
+          key: error && error.component ? error.component.key : undefined,
+          message: error && error.message
+        };
+      });
+      post('validation_error', { errors: list });
+    });
+    form.on('submit', function (submission) {
+      if (submission && submission.submission) {
+        post('submitted', { submission: submission.submission });
+        // Pre-SDK listeners watch for this message shape
+        if (canPost) {
+          window.parent.postMessage({ type: 'goformx:submitted', submission: submission.submission }, targetOrigin);
+        }
+      }
+    });
+
+    post('loaded', {});
+    reportHeight();
+  }).catch(showError);
+})();
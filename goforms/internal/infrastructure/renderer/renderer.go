@@ -0,0 +1,215 @@
+// Package renderer bundles the assets served to embedded forms.
+//
+// The GoFormX embed SDK, the in-frame bootstrap script and its stylesheet are always
+// compiled in. The Form.io renderer itself is vendored into assets/formio by
+// scripts/vendor-renderer.sh so air-gapped deployments never reach a CDN. The script
+// pins the release and the digests of its files next to them, and loading fails when
+// the embedded files do not match. Only development builds may run without it, falling
+// back to the public Form.io CDN.
+//
+// Every asset carries a Subresource Integrity hash, and the bundle version is
+// derived from the asset contents so asset URLs can be cached indefinitely.
+package renderer
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"embed"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"mime"
+	"path"
+	"sort"
+	"strings"
+)
+
+// Asset names within the bundle
+const (
+	// LoaderScript is the host-page SDK that injects and talks to the embed iframe
+	LoaderScript    = "embed.js"
+	BootstrapScript = "goformx-embed.js"
+	BootstrapStyle  = "goformx-embed.css"
+	// FormStyle styles the server-rendered form page served to clients without JavaScript
+	FormStyle    = "goformx-form.css"
+	FormioScript = "formio/formio.full.min.js"
+	FormioStyle  = "formio/formio.full.min.css"
+
+	// formioVersionFile is written by the vendoring script next to the Form.io assets
+	formioVersionFile = "formio/VERSION"
+	// formioChecksumFile pins the sha384 Subresource Integrity value of each Form.io asset,
+	// one "sha384-... name" line per file, as written by the vendoring script
+	formioChecksumFile = "formio/SHA384SUMS"
+
+	// LoaderChannel is the stable path segment host pages use for the loader of embed protocol v1.
+	// Content-addressed URLs change with every release; this one is served with a short cache.
+	LoaderChannel = "v1"
+
+	// CDNOrigin serves the renderer when it has not been vendored into the build
+	CDNOrigin = "https://cdn.form.io"
+)
+
+//go:embed assets
+var assetFS embed.FS
+
+// Asset is a single embedded file ready to be served
+type Asset struct {
+	Name        string
+	ContentType string
+	Content     []byte
+	// Integrity is the Subresource Integrity value (sha384-...)
+	Integrity string
+	// ETag is a strong validator derived from the content
+	ETag string
+}
+
+// Bundle is the set of renderer assets compiled into the binary
+type Bundle struct {
+	version       string
+	formioVersion string
+	assets        map[string]*Asset
+}
+
+// Load reads and hashes the embedded assets
+func Load() (*Bundle, error) {
+	sub, err := fs.Sub(assetFS, "assets")
+	if err != nil {
+		return nil, fmt.Errorf("open renderer assets: %w", err)
+	}
+
+	return loadFS(sub)
+}
+
+// loadFS builds a bundle from any file system laid out like the assets directory
+func loadFS(fsys fs.FS) (*Bundle, error) {
+	bundle := &Bundle{assets: make(map[string]*Asset)}
+	versionHash := sha256.New()
+
+	var names []string
+
+	walkErr := fs.WalkDir(fsys, ".", func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !d.IsDir() {
+			names = append(names, name)
+		}
+
+		return nil
+	})
+	if walkErr != nil {
+		return nil, fmt.Errorf("walk renderer assets: %w", walkErr)
+	}
+
+	// Sorted so the bundle version is stable across builds
+	sort.Strings(names)
+
+	var checksums []byte
+
+	for _, name := range names {
+		content, readErr := fs.ReadFile(fsys, name)
+		if readErr != nil {
+			return nil, fmt.Errorf("read renderer asset %s: %w", name, readErr)
+		}
+
+		switch name {
+		case formioVersionFile:
+			bundle.formioVersion = strings.TrimSpace(string(content))
+
+			continue
+		case formioChecksumFile:
+			checksums = content
+
+			continue
+		}
+
+		contentType := mime.TypeByExtension(path.Ext(name))
+		if contentType == "" {
+			// Only serve files whose type we can declare; nosniff would block anything else
+			continue
+		}
+
+		sum384 := sha512.Sum384(content)
+		sum256 := sha256.Sum256(content)
+
+		bundle.assets[name] = &Asset{
+			Name:        name,
+			ContentType: contentType,
+			Content:     content,
+			Integrity:   "sha384-" + base64.StdEncoding.EncodeToString(sum384[:]),
+			ETag:        `"` + hex.EncodeToString(sum256[:16]) + `"`,
+		}
+
+		versionHash.Write([]byte(name))
+		versionHash.Write(sum256[:])
+	}
+
+	if err := bundle.verifyPinned(checksums); err != nil {
+		return nil, err
+	}
+
+	bundle.version = hex.EncodeToString(versionHash.Sum(nil))[:12]
+
+	return bundle, nil
+}
+
+// verifyPinned checks the Form.io assets against the digests pinned by the vendoring script.
+// Form.io assets without pinned digests are refused, so a partial or hand-copied renderer never
+// ships.
+func (b *Bundle) verifyPinned(checksums []byte) error {
+	pinned := make(map[string]string)
+
+	for line := range strings.Lines(string(checksums)) {
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			continue
+		}
+
+		pinned[path.Join(path.Dir(formioChecksumFile), fields[1])] = fields[0]
+	}
+
+	for _, name := range []string{FormioScript, FormioStyle} {
+		asset, ok := b.assets[name]
+		if !ok {
+			continue
+		}
+
+		want, ok := pinned[name]
+		if !ok {
+			return fmt.Errorf("renderer asset %s has no pinned digest in %s", name, formioChecksumFile)
+		}
+
+		if asset.Integrity != want {
+			return fmt.Errorf("renderer asset %s does not match its pinned digest %s", name, want)
+		}
+	}
+
+	return nil
+}
+
+// Version identifies the bundle contents; it changes whenever any asset changes
+func (b *Bundle) Version() string {
+	return b.version
+}
+
+// FormioVersion is the vendored Form.io release, or empty when the CDN is used
+func (b *Bundle) FormioVersion() string {
+	return b.formioVersion
+}
+
+// Vendored reports whether the Form.io renderer is compiled into the binary
+func (b *Bundle) Vendored() bool {
+	_, hasScript := b.assets[FormioScript]
+	_, hasStyle := b.assets[FormioStyle]
+
+	return hasScript && hasStyle
+}
+
+// Get returns the named asset
+func (b *Bundle) Get(name string) (*Asset, bool) {
+	asset, ok := b.assets[name]
+
+	return asset, ok
+}
@@ -0,0 +1,83 @@
+package renderer //nolint:testpackage // internal test for the unexported file system loader
+
+import (
+	"crypto/sha512"
+	"encoding/base64"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad_EmbedsBootstrapAssets(t *testing.T) {
+	bundle, err := Load()
+	require.NoError(t, err)
+
+	for _, name := range []string{BootstrapScript, BootstrapStyle, FormStyle} {
+		asset, ok := bundle.Get(name)
+		require.True(t, ok, name)
+		assert.NotEmpty(t, asset.Content)
+	}
+
+	assert.Len(t, bundle.Version(), 12)
+}
+
+// integrity is the Subresource Integrity value of content
+func integrity(content string) string {
+	sum := sha512.Sum384([]byte(content))
+
+	return "sha384-" + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func TestLoadFS_IntegrityAndVendoring(t *testing.T) {
+	fsys := fstest.MapFS{
+		"goformx-embed.js":           {Data: []byte("console.log('hi')")},
+		"formio/formio.full.min.js":  {Data: []byte("var Formio = {};")},
+		"formio/formio.full.min.css": {Data: []byte(".formio {}")},
+		"formio/VERSION":             {Data: []byte("4.14.13\n")},
+		"formio/SHA384SUMS": {Data: []byte(integrity("var Formio = {};") + "  formio.full.min.js\n" +
+			integrity(".formio {}") + "  formio.full.min.css\n")},
+		"formio/LICENSE": {Data: []byte("license")},
+	}
+
+	bundle, err := loadFS(fsys)
+	require.NoError(t, err)
+
+	asset, ok := bundle.Get("goformx-embed.js")
+	require.True(t, ok)
+
+	assert.Equal(t, integrity("console.log('hi')"), asset.Integrity)
+
+	assert.True(t, bundle.Vendored())
+	assert.Equal(t, "4.14.13", bundle.FormioVersion())
+
+	_, served := bundle.Get("formio/LICENSE")
+	assert.False(t, served, "files without a known content type are not served")
+
+	delete(fsys, "formio/formio.full.min.css")
+	fsys["goformx-embed.js"] = &fstest.MapFile{Data: []byte("console.log('changed')")}
+
+	changed, err := loadFS(fsys)
+	require.NoError(t, err)
+	assert.False(t, changed.Vendored())
+	assert.NotEqual(t, bundle.Version(), changed.Version(), "version follows content")
+}
+
+func TestLoadFS_RefusesRendererNotMatchingItsPins(t *testing.T) {
+	fsys := fstest.MapFS{
+		"formio/formio.full.min.js":  {Data: []byte("var Formio = {tampered: true};")},
+		"formio/formio.full.min.css": {Data: []byte(".formio {}")},
+		"formio/SHA384SUMS": {Data: []byte(integrity("var Formio = {};") + "  formio.full.min.js\n" +
+			integrity(".formio {}") + "  formio.full.min.css\n")},
+	}
+
+	_, err := loadFS(fsys)
+	require.ErrorContains(t, err, "does not match its pinned digest")
+
+	// Copied in by hand, without the pins the vendoring script writes
+	delete(fsys, "formio/SHA384SUMS")
+
+	_, err = loadFS(fsys)
+	require.ErrorContains(t, err, "has no pinned digest")
+}
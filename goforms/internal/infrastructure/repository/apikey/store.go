@@ -0,0 +1,114 @@
+// Package repository provides the API key repository implementation
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/goformx/goforms/internal/domain/apikey"
+	"github.com/goformx/goforms/internal/infrastructure/database"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+// Store implements apikey.Repository interface
+type Store struct {
+	db     database.DB
+	logger logging.Logger
+}
+
+// NewStore creates a new API key store
+func NewStore(db database.DB, logger logging.Logger) apikey.Repository {
+	return &Store{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create persists a new key
+func (s *Store) Create(ctx context.Context, key *apikey.Key) error {
+	if err := s.db.GetDB().WithContext(ctx).Create(key).Error; err != nil {
+		s.logger.Error("failed to create api key", "key_id", key.ID, "error", err)
+
+		return fmt.Errorf("create api key: %w", common.NewDatabaseError("create", "api_key", key.ID, err))
+	}
+
+	return nil
+}
+
+// GetByID returns a key by ID
+func (s *Store) GetByID(ctx context.Context, id string) (*apikey.Key, error) {
+	return s.first(ctx, "get", id, "uuid = ?", id)
+}
+
+// GetByHash returns the key whose plaintext hashes to hash
+func (s *Store) GetByHash(ctx context.Context, hash string) (*apikey.Key, error) {
+	return s.first(ctx, "get_by_hash", "", "hash = ?", hash)
+}
+
+// first loads a single key matching the condition
+func (s *Store) first(ctx context.Context, op, id, query string, arg any) (*apikey.Key, error) {
+	var key apikey.Key
+	if err := s.db.GetDB().WithContext(ctx).Where(query, arg).First(&key).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("get api key: %w", common.NewNotFoundError(op, "api_key", id))
+		}
+
+		return nil, fmt.Errorf("get api key: %w", common.NewDatabaseError(op, "api_key", id, err))
+	}
+
+	return &key, nil
+}
+
+// List returns keys matching the filter, newest first
+func (s *Store) List(ctx context.Context, filter apikey.Filter) ([]*apikey.Key, error) {
+	query := s.db.GetDB().WithContext(ctx)
+
+	if filter.FormID != "" {
+		query = query.Where("form_id = ?", filter.FormID)
+	}
+
+	if filter.WorkspaceID != "" {
+		query = query.Where("workspace_id = ?", filter.WorkspaceID)
+	}
+
+	var keys []*apikey.Key
+	if err := query.Order("created_at DESC").Find(&keys).Error; err != nil {
+		return nil, fmt.Errorf("list api keys: %w", common.NewDatabaseError("list", "api_key", "", err))
+	}
+
+	return keys, nil
+}
+
+// Revoke marks a key revoked at the given time
+func (s *Store) Revoke(ctx context.Context, id string, at time.Time) error {
+	result := s.db.GetDB().WithContext(ctx).
+		Model(&apikey.Key{}).
+		Where("uuid = ? AND revoked_at IS NULL", id).
+		Update("revoked_at", at)
+	if result.Error != nil {
+		return fmt.Errorf("revoke api key: %w", common.NewDatabaseError("revoke", "api_key", id, result.Error))
+	}
+
+	if result.RowsAffected == 0 {
+		return fmt.Errorf("revoke api key: %w", common.NewNotFoundError("revoke", "api_key", id))
+	}
+
+	return nil
+}
+
+// TouchLastUsed records when a key was last used
+func (s *Store) TouchLastUsed(ctx context.Context, id string, at time.Time) error {
+	if err := s.db.GetDB().WithContext(ctx).
+		Model(&apikey.Key{}).
+		Where("uuid = ?", id).
+		UpdateColumn("last_used_at", at).Error; err != nil {
+		return fmt.Errorf("touch api key: %w", common.NewDatabaseError("touch", "api_key", id, err))
+	}
+
+	return nil
+}
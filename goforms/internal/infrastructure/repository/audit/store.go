@@ -0,0 +1,136 @@
+// Package repository provides the audit log repository implementation
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/goformx/goforms/internal/domain/audit"
+	"github.com/goformx/goforms/internal/infrastructure/database"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+// Store implements audit.Repository interface
+type Store struct {
+	db     database.DB
+	logger logging.Logger
+}
+
+// NewStore creates a new audit store
+func NewStore(db database.DB, logger logging.Logger) audit.Repository {
+	return &Store{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Append persists a fully chained entry. Another replica appending the same sequence first
+// violates the unique index on sequence, reported as audit.ErrSequenceTaken.
+func (s *Store) Append(ctx context.Context, entry *audit.Entry) error {
+	db := s.db.GetDB()
+	if err := db.WithContext(ctx).Create(entry).Error; err != nil {
+		if common.IsDuplicateKey(db, err) {
+			return fmt.Errorf("%w: sequence %d", audit.ErrSequenceTaken, entry.Sequence)
+		}
+
+		s.logger.Error("failed to append audit entry",
+			"sequence", entry.Sequence,
+			"action", entry.Action,
+			"error", err,
+		)
+
+		return fmt.Errorf("append audit entry: %w", common.NewDatabaseError("create", "audit_entry", entry.ID, err))
+	}
+
+	return nil
+}
+
+// Last returns the entry with the highest sequence, or audit.ErrEmptyLog when there is none
+func (s *Store) Last(ctx context.Context) (*audit.Entry, error) {
+	var entry audit.Entry
+	if err := s.db.GetDB().WithContext(ctx).Order("sequence DESC").First(&entry).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, audit.ErrEmptyLog
+		}
+
+		return nil, fmt.Errorf("get last audit entry: %w", common.NewDatabaseError("get", "audit_entry", "", err))
+	}
+
+	return &entry, nil
+}
+
+// List returns entries matching the filter, newest first
+func (s *Store) List(ctx context.Context, filter audit.Filter) ([]*audit.Entry, error) {
+	var entries []*audit.Entry
+	if err := s.applyFilter(s.db.GetDB().WithContext(ctx), filter).
+		Order("sequence DESC").
+		Offset(filter.Offset).
+		Limit(filter.Limit).
+		Find(&entries).Error; err != nil {
+		return nil, fmt.Errorf("list audit entries: %w", common.NewDatabaseError("list", "audit_entry", "", err))
+	}
+
+	return entries, nil
+}
+
+// Count returns the number of entries matching the filter
+func (s *Store) Count(ctx context.Context, filter audit.Filter) (int64, error) {
+	var count int64
+	if err := s.applyFilter(s.db.GetDB().WithContext(ctx).Model(&audit.Entry{}), filter).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("count audit entries: %w", common.NewDatabaseError("count", "audit_entry", "", err))
+	}
+
+	return count, nil
+}
+
+// Range returns up to limit entries with sequence greater than afterSequence, oldest first
+func (s *Store) Range(ctx context.Context, afterSequence int64, limit int) ([]*audit.Entry, error) {
+	var entries []*audit.Entry
+	if err := s.db.GetDB().WithContext(ctx).
+		Where("sequence > ?", afterSequence).
+		Order("sequence ASC").
+		Limit(limit).
+		Find(&entries).Error; err != nil {
+		return nil, fmt.Errorf("range audit entries: %w", common.NewDatabaseError("list", "audit_entry", "", err))
+	}
+
+	return entries, nil
+}
+
+// applyFilter adds WHERE clauses for every non-empty filter field
+func (s *Store) applyFilter(query *gorm.DB, filter audit.Filter) *gorm.DB {
+	if filter.OwnerID != "" {
+		query = query.Where("owner_id = ?", filter.OwnerID)
+	}
+
+	if filter.ActorID != "" {
+		query = query.Where("actor_id = ?", filter.ActorID)
+	}
+
+	if filter.Action != "" {
+		query = query.Where("action = ?", filter.Action)
+	}
+
+	if filter.ResourceType != "" {
+		query = query.Where("resource_type = ?", filter.ResourceType)
+	}
+
+	if filter.ResourceID != "" {
+		query = query.Where("resource_id = ?", filter.ResourceID)
+	}
+
+	if !filter.From.IsZero() {
+		query = query.Where("occurred_at >= ?", filter.From)
+	}
+
+	if !filter.To.IsZero() {
+		query = query.Where("occurred_at <= ?", filter.To)
+	}
+
+	return query
+}
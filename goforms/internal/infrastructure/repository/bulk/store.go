@@ -0,0 +1,232 @@
+// Package repository provides the bulk job repository implementation
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/goformx/goforms/internal/domain/bulk"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/infrastructure/database"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+// jobOutput is one committed chunk of an export job's file
+type jobOutput struct {
+	JobID string `gorm:"column:job_id;primaryKey"`
+	// Position is the job's processed count after the chunk, which orders the chunks
+	Position  int64     `gorm:"column:position;primaryKey"`
+	Data      string    `gorm:"column:data"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName specifies the table name for export chunks
+func (jobOutput) TableName() string {
+	return "bulk_job_outputs"
+}
+
+// Store implements bulk.Repository interface
+type Store struct {
+	db     database.DB
+	logger logging.Logger
+}
+
+// NewStore creates a new bulk job store
+func NewStore(db database.DB, logger logging.Logger) bulk.Repository {
+	return &Store{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// CreateJob persists a new job
+func (s *Store) CreateJob(ctx context.Context, job *bulk.Job) error {
+	if err := s.db.GetDB().WithContext(ctx).Create(job).Error; err != nil {
+		return fmt.Errorf("create bulk job: %w", common.NewDatabaseError("create", "bulk_job", job.ID, err))
+	}
+
+	return nil
+}
+
+// GetJob returns a job by ID
+func (s *Store) GetJob(ctx context.Context, id string) (*bulk.Job, error) {
+	var job bulk.Job
+	if err := s.db.GetDB().WithContext(ctx).Where("uuid = ?", id).First(&job).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, bulk.ErrJobNotFound
+		}
+
+		return nil, fmt.Errorf("get bulk job: %w", common.NewDatabaseError("get", "bulk_job", id, err))
+	}
+
+	return &job, nil
+}
+
+// ListJobs returns a form's most recent jobs, newest first
+func (s *Store) ListJobs(ctx context.Context, formID string, limit int) ([]*bulk.Job, error) {
+	var jobs []*bulk.Job
+	if err := s.db.GetDB().WithContext(ctx).
+		Where("form_id = ?", formID).
+		Order("created_at DESC, uuid DESC").
+		Limit(limit).
+		Find(&jobs).Error; err != nil {
+		return nil, fmt.Errorf("list bulk jobs: %w", common.NewDatabaseError("list", "bulk_job", formID, err))
+	}
+
+	return jobs, nil
+}
+
+// UpdateJob saves a job's status, progress and timestamps
+func (s *Store) UpdateJob(ctx context.Context, job *bulk.Job) error {
+	if err := s.db.GetDB().WithContext(ctx).Save(job).Error; err != nil {
+		s.logger.Error("failed to update bulk job", "job_id", job.ID, "error", err)
+
+		return fmt.Errorf("update bulk job: %w", common.NewDatabaseError("update", "bulk_job", job.ID, err))
+	}
+
+	return nil
+}
+
+// TransitionJob saves a job's status, error and timestamps only while its stored status is from
+func (s *Store) TransitionJob(ctx context.Context, job *bulk.Job, from bulk.Status) (bool, error) {
+	result := s.db.GetDB().WithContext(ctx).Model(job).
+		Where("status = ?", from).
+		Select("status", "error", "started_at", "completed_at", "updated_at").
+		Updates(job)
+	if result.Error != nil {
+		s.logger.Error("failed to transition bulk job", "job_id", job.ID, "error", result.Error)
+
+		return false, fmt.Errorf("transition bulk job: %w", common.NewDatabaseError("update", "bulk_job", job.ID, result.Error))
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// ListStaleJobs returns the queued and running jobs last updated before the time
+func (s *Store) ListStaleJobs(ctx context.Context, before time.Time) ([]*bulk.Job, error) {
+	var jobs []*bulk.Job
+	if err := s.db.GetDB().WithContext(ctx).
+		Where("status IN ? AND updated_at < ?", []bulk.Status{bulk.StatusQueued, bulk.StatusRunning}, before).
+		Order("updated_at ASC").
+		Find(&jobs).Error; err != nil {
+		return nil, fmt.Errorf("list stale bulk jobs: %w", common.NewDatabaseError("list", "bulk_job", "", err))
+	}
+
+	return jobs, nil
+}
+
+// CountSelection returns the number of a form's submissions matching the selection
+func (s *Store) CountSelection(ctx context.Context, formID string, selection bulk.Selection) (int64, error) {
+	var count int64
+	if err := applySelection(s.db.GetDB().WithContext(ctx).Model(&model.FormSubmission{}), formID, selection).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("count bulk selection: %w", common.NewDatabaseError("count", "form_submission", formID, err))
+	}
+
+	return count, nil
+}
+
+// NextChunk returns up to limit matching submissions with IDs greater than afterID, ordered by ID
+func (s *Store) NextChunk(
+	ctx context.Context,
+	formID string,
+	selection bulk.Selection,
+	afterID string,
+	limit int,
+) ([]*model.FormSubmission, error) {
+	query := applySelection(s.db.GetDB().WithContext(ctx), formID, selection)
+	if afterID != "" {
+		query = query.Where("uuid > ?", afterID)
+	}
+
+	var submissions []*model.FormSubmission
+	if err := query.Order("uuid ASC").Limit(limit).Find(&submissions).Error; err != nil {
+		return nil, fmt.Errorf("load bulk chunk: %w", common.NewDatabaseError("list", "form_submission", formID, err))
+	}
+
+	return submissions, nil
+}
+
+// CommitChunk applies the change to the submissions, appends any export output and saves
+// the job's progress in a single transaction
+func (s *Store) CommitChunk(ctx context.Context, job *bulk.Job, submissionIDs []string, change bulk.Change) error {
+	err := s.db.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		submissions := tx.Model(&model.FormSubmission{}).Where("form_id = ? AND uuid IN ?", job.FormID, submissionIDs)
+
+		switch {
+		case change.Delete:
+			if err := submissions.Delete(&model.FormSubmission{}).Error; err != nil {
+				return fmt.Errorf("delete submissions: %w", err)
+			}
+		case change.Status != "":
+			if err := submissions.Update("status", change.Status).Error; err != nil {
+				return fmt.Errorf("update submission status: %w", err)
+			}
+		}
+
+		if len(change.Output) > 0 {
+			if err := tx.Create(&jobOutput{JobID: job.ID, Position: job.Processed, Data: string(change.Output)}).Error; err != nil {
+				return fmt.Errorf("append export output: %w", err)
+			}
+		}
+
+		if err := tx.Save(job).Error; err != nil {
+			return fmt.Errorf("save job progress: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to commit bulk chunk", "job_id", job.ID, "form_id", job.FormID, "error", err)
+
+		return fmt.Errorf("commit bulk chunk: %w", common.NewDatabaseError("update", "bulk_job", job.ID, err))
+	}
+
+	return nil
+}
+
+// Output returns the export file written by a job
+func (s *Store) Output(ctx context.Context, jobID string) ([]byte, error) {
+	var chunks []jobOutput
+	if err := s.db.GetDB().WithContext(ctx).
+		Where("job_id = ?", jobID).
+		Order("position ASC").
+		Find(&chunks).Error; err != nil {
+		return nil, fmt.Errorf("get bulk job output: %w", common.NewDatabaseError("get", "bulk_job_output", jobID, err))
+	}
+
+	var output []byte
+	for _, chunk := range chunks {
+		output = append(output, chunk.Data...)
+	}
+
+	return output, nil
+}
+
+// applySelection scopes a submissions query to a form and a job's selection
+func applySelection(query *gorm.DB, formID string, selection bulk.Selection) *gorm.DB {
+	query = query.Where("form_id = ?", formID)
+
+	if len(selection.IDs) > 0 {
+		query = query.Where("uuid IN ?", []string(selection.IDs))
+	}
+
+	if selection.Status != "" {
+		query = query.Where("status = ?", selection.Status)
+	}
+
+	if selection.SubmittedAfter != nil {
+		query = query.Where("submitted_at >= ?", *selection.SubmittedAfter)
+	}
+
+	if selection.SubmittedBefore != nil {
+		query = query.Where("submitted_at < ?", *selection.SubmittedBefore)
+	}
+
+	return query
+}
@@ -0,0 +1,130 @@
+package common
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// ErrInvalidCursor is returned when a pagination cursor cannot be decoded
+var ErrInvalidCursor = errors.New("invalid cursor")
+
+// Sort orders accepted by keyset queries
+const (
+	OrderAsc  = "asc"
+	OrderDesc = "desc"
+)
+
+// Cursor marks a position in a keyset-paginated listing: the sort value and ID of
+// the row at a page edge, plus the sort and order the listing was requested with.
+// Backward cursors continue towards the start of the listing.
+type Cursor struct {
+	Sort     string `json:"s"`
+	Order    string `json:"o"`
+	Value    string `json:"v"`
+	ID       string `json:"id"`
+	Backward bool   `json:"b,omitempty"`
+}
+
+// Encode returns the opaque, URL-safe form of the cursor
+func (c Cursor) Encode() string {
+	data, err := json.Marshal(c)
+	if err != nil {
+		return ""
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// Matches reports whether the cursor was issued for the given sort and order
+func (c Cursor) Matches(sort, order string) bool {
+	return c.Sort == sort && c.Order == order
+}
+
+// DecodeCursor parses a cursor produced by Cursor.Encode
+func DecodeCursor(encoded string) (*Cursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, fmt.Errorf("decode cursor: %w", ErrInvalidCursor)
+	}
+
+	var cursor Cursor
+	if jsonErr := json.Unmarshal(data, &cursor); jsonErr != nil {
+		return nil, fmt.Errorf("decode cursor: %w", ErrInvalidCursor)
+	}
+
+	if cursor.ID == "" || (cursor.Order != OrderAsc && cursor.Order != OrderDesc) {
+		return nil, fmt.Errorf("decode cursor: %w", ErrInvalidCursor)
+	}
+
+	return &cursor, nil
+}
+
+// KeysetCondition returns the WHERE clause and ORDER BY expression that continue a
+// keyset scan over column, with idColumn breaking ties. With no cursor the condition
+// is empty. Backward scans run in reverse order; KeysetPage restores the requested order.
+func KeysetCondition(column, idColumn, order string, cursor *Cursor) (condition, orderBy string) {
+	descending := order == OrderDesc
+	if cursor != nil && cursor.Backward {
+		descending = !descending
+	}
+
+	direction, operator := "ASC", ">"
+	if descending {
+		direction, operator = "DESC", "<"
+	}
+
+	orderBy = fmt.Sprintf("%s %s, %s %s", column, direction, idColumn, direction)
+
+	if cursor != nil {
+		condition = fmt.Sprintf("(%s, %s) %s (?, ?)", column, idColumn, operator)
+	}
+
+	return condition, orderBy
+}
+
+// KeysetPage turns the rows of a keyset query, fetched with limit+1 to detect a further
+// page, into one page in the requested order and the cursors of its neighbours. key
+// returns the sort value and ID of a row.
+func KeysetPage[T any](
+	rows []T,
+	limit int,
+	cursor *Cursor,
+	sort, order string,
+	key func(T) (value, id string),
+) (page []T, next, prev string) {
+	backward := cursor != nil && cursor.Backward
+
+	hasMore := len(rows) > limit
+	if hasMore {
+		rows = rows[:limit]
+	}
+
+	if backward {
+		slices.Reverse(rows)
+	}
+
+	if len(rows) == 0 {
+		return rows, "", ""
+	}
+
+	edge := func(row T, backward bool) string {
+		value, id := key(row)
+
+		return Cursor{Sort: sort, Order: order, Value: value, ID: id, Backward: backward}.Encode()
+	}
+
+	// Moving forward there is a next page only if an extra row came back, and a previous
+	// page whenever we started from a cursor; moving backward the reverse holds
+	if (!backward && hasMore) || backward {
+		next = edge(rows[len(rows)-1], false)
+	}
+
+	if (!backward && cursor != nil) || (backward && hasMore) {
+		prev = edge(rows[0], true)
+	}
+
+	return rows, next, prev
+}
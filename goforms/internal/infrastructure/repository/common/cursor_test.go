@@ -0,0 +1,111 @@
+package common_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+func TestCursor_RoundTrip(t *testing.T) {
+	cursor := common.Cursor{Sort: "title", Order: common.OrderAsc, Value: "Contact / Sales", ID: "form-1", Backward: true}
+
+	decoded, err := common.DecodeCursor(cursor.Encode())
+	require.NoError(t, err)
+	assert.Equal(t, cursor, *decoded)
+	assert.True(t, decoded.Matches("title", common.OrderAsc))
+	assert.False(t, decoded.Matches("title", common.OrderDesc))
+}
+
+func TestDecodeCursor_Invalid(t *testing.T) {
+	for _, encoded := range []string{
+		"not base64!",
+		"bm90IGpzb24",                        // "not json"
+		common.Cursor{Order: "asc"}.Encode(), // missing ID
+		common.Cursor{ID: "form-1"}.Encode(), // missing order
+		common.Cursor{ID: "x", Order: "up"}.Encode(),
+	} {
+		_, err := common.DecodeCursor(encoded)
+		require.ErrorIs(t, err, common.ErrInvalidCursor, encoded)
+	}
+}
+
+func TestKeysetCondition(t *testing.T) {
+	condition, orderBy := common.KeysetCondition("created_at", "uuid", common.OrderDesc, nil)
+	assert.Empty(t, condition)
+	assert.Equal(t, "created_at DESC, uuid DESC", orderBy)
+
+	condition, orderBy = common.KeysetCondition("created_at", "uuid", common.OrderDesc, &common.Cursor{ID: "a"})
+	assert.Equal(t, "(created_at, uuid) < (?, ?)", condition)
+	assert.Equal(t, "created_at DESC, uuid DESC", orderBy)
+
+	condition, orderBy = common.KeysetCondition("created_at", "uuid", common.OrderDesc, &common.Cursor{ID: "a", Backward: true})
+	assert.Equal(t, "(created_at, uuid) > (?, ?)", condition)
+	assert.Equal(t, "created_at ASC, uuid ASC", orderBy)
+}
+
+// keysetRows simulates a keyset query over the items 1..n sorted ascending
+func keysetRows(n, limit int, cursor *common.Cursor) []int {
+	var rows []int
+
+	if cursor == nil || !cursor.Backward {
+		start := 1
+		if cursor != nil {
+			start, _ = strconv.Atoi(cursor.Value)
+			start++
+		}
+
+		for i := start; i <= n && len(rows) <= limit; i++ {
+			rows = append(rows, i)
+		}
+
+		return rows
+	}
+
+	end, _ := strconv.Atoi(cursor.Value)
+	for i := end - 1; i >= 1 && len(rows) <= limit; i-- {
+		rows = append(rows, i)
+	}
+
+	return rows
+}
+
+func TestKeysetPage_WalksForwardAndBack(t *testing.T) {
+	const total, limit = 5, 2
+
+	key := func(i int) (value, id string) { return strconv.Itoa(i), strconv.Itoa(i) }
+	page := func(encoded string) (rows []int, next, prev string) {
+		var cursor *common.Cursor
+		if encoded != "" {
+			var err error
+			cursor, err = common.DecodeCursor(encoded)
+			require.NoError(t, err)
+		}
+
+		return common.KeysetPage(keysetRows(total, limit, cursor), limit, cursor, "n", common.OrderAsc, key)
+	}
+
+	first, next, prev := page("")
+	assert.Equal(t, []int{1, 2}, first)
+	assert.Empty(t, prev)
+
+	second, next, prev := page(next)
+	assert.Equal(t, []int{3, 4}, second)
+	require.NotEmpty(t, prev)
+
+	last, lastNext, lastPrev := page(next)
+	assert.Equal(t, []int{5}, last)
+	assert.Empty(t, lastNext)
+
+	back, backNext, backPrev := page(lastPrev)
+	assert.Equal(t, []int{3, 4}, back)
+	assert.NotEmpty(t, backNext)
+	assert.NotEmpty(t, backPrev)
+
+	start, _, startPrev := page(prev)
+	assert.Equal(t, []int{1, 2}, start)
+	assert.Empty(t, startPrev)
+}
@@ -0,0 +1,51 @@
+package common
+
+import (
+	"errors"
+	"strings"
+
+	"gorm.io/gorm"
+)
+
+// SQL dialects, as named by their GORM dialectors. MariaDB uses the mysql dialector.
+const (
+	DialectPostgres = "postgres"
+	DialectMySQL    = "mysql"
+	DialectSQLite   = "sqlite"
+)
+
+// likeEscape escapes wildcards in patterns built by ContainsPattern. A backslash is not
+// portable: SQLite has no default LIKE escape and MySQL also treats it as a string escape.
+const likeEscape = "!"
+
+// likeReplacer escapes the escape character and the LIKE wildcards
+var likeReplacer = strings.NewReplacer(likeEscape, likeEscape+likeEscape, "%", likeEscape+"%", "_", likeEscape+"_")
+
+// TextContains returns a condition with one placeholder, matching rows whose column read as
+// text contains a pattern built by ContainsPattern, ignoring case, in the dialect of db
+func TextContains(db *gorm.DB, column string) string {
+	return "LOWER(" + textCast(db, column) + ") LIKE ? ESCAPE '" + likeEscape + "'"
+}
+
+// ContainsPattern returns the LIKE pattern for TextContains matching value literally
+func ContainsPattern(value string) string {
+	return "%" + likeReplacer.Replace(strings.ToLower(value)) + "%"
+}
+
+// textCast converts a column of any type, such as JSON, to text
+func textCast(db *gorm.DB, column string) string {
+	if db.Name() == DialectMySQL {
+		return "CAST(" + column + " AS CHAR)"
+	}
+
+	return "CAST(" + column + " AS TEXT)"
+}
+
+// IsDuplicateKey reports whether err, returned by a statement on db, violates a unique index
+func IsDuplicateKey(db *gorm.DB, err error) bool {
+	if translator, ok := db.Dialector.(gorm.ErrorTranslator); ok {
+		err = translator.Translate(err)
+	}
+
+	return errors.Is(err, gorm.ErrDuplicatedKey)
+}
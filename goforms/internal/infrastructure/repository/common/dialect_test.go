@@ -0,0 +1,24 @@
+package common_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+func TestContainsPattern_EscapesWildcards(t *testing.T) {
+	tests := map[string]string{
+		"Contact":    "%contact%",
+		"100%":       "%100!%%",
+		"first_name": "%first!_name%",
+		"wow!":       "%wow!!%",
+		`C:\Forms`:   `%c:\forms%`,
+		"":           "%%",
+	}
+
+	for value, want := range tests {
+		assert.Equal(t, want, common.ContainsPattern(value), value)
+	}
+}
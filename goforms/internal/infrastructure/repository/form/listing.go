@@ -0,0 +1,305 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/goformx/goforms/internal/domain/form"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+// submissionCountColumn counts a form's submissions; listings select it as submission_count
+const submissionCountColumn = "(SELECT COUNT(*) FROM form_submissions WHERE form_submissions.form_id = forms.uuid)"
+
+// formSortColumns maps form listing sorts onto the columns they order by
+var formSortColumns = map[string]string{
+	form.SortCreated:     "forms.created_at",
+	form.SortUpdated:     "forms.updated_at",
+	form.SortTitle:       "forms.title",
+	form.SortSubmissions: submissionCountColumn,
+}
+
+// submissionSortColumns maps submission listing sorts onto the columns they order by
+var submissionSortColumns = map[string]string{
+	form.SortSubmitted: "form_submissions.submitted_at",
+	form.SortCreated:   "form_submissions.created_at",
+	form.SortUpdated:   "form_submissions.updated_at",
+}
+
+// formTag is a row of form_tags
+type formTag struct {
+	FormID    string    `gorm:"column:form_id;primaryKey"`
+	Tag       string    `gorm:"column:tag;primaryKey"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName specifies the table name for form tags
+func (formTag) TableName() string {
+	return "form_tags"
+}
+
+// ListFormsPage returns one page of the forms matching a normalized filter
+func (s *Store) ListFormsPage(ctx context.Context, filter form.FormListFilter) (*form.FormPage, error) {
+	db := s.db.GetDB().WithContext(ctx)
+
+	var total int64
+	if err := applyFormFilter(db.Model(&model.Form{}), filter).Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("count forms: %w", common.NewDatabaseError("count", "form", "", err))
+	}
+
+	column := formSortColumns[filter.Sort]
+	condition, orderBy := common.KeysetCondition(column, "forms.uuid", filter.Order, filter.Cursor)
+
+	query := applyFormFilter(db.Model(&model.Form{}), filter).
+		Select("forms.*, " + submissionCountColumn + " AS submission_count").
+		Order(orderBy).
+		Limit(filter.Limit + 1)
+
+	if filter.Cursor != nil {
+		value, err := formCursorValue(filter.Sort, filter.Cursor.Value)
+		if err != nil {
+			return nil, fmt.Errorf("list forms page: %w", common.NewInvalidInputError("list", "form", "", err))
+		}
+
+		query = query.Where(condition, value, filter.Cursor.ID)
+	}
+
+	var rows []*model.Form
+	if err := query.Find(&rows).Error; err != nil {
+		s.logger.Error("failed to list forms page", "error", err)
+
+		return nil, fmt.Errorf("list forms page: %w", common.NewDatabaseError("list", "form", "", err))
+	}
+
+	forms, next, prev := common.KeysetPage(rows, filter.Limit, filter.Cursor, filter.Sort, filter.Order,
+		func(f *model.Form) (value, id string) { return formSortValue(f, filter.Sort), f.ID })
+
+	if err := s.loadTags(db, forms); err != nil {
+		return nil, err
+	}
+
+	return &form.FormPage{Forms: forms, Total: total, NextCursor: next, PrevCursor: prev}, nil
+}
+
+// ListSubmissionsPage returns one page of the submissions matching a normalized filter
+func (s *Store) ListSubmissionsPage(
+	ctx context.Context,
+	filter form.SubmissionListFilter,
+) (*form.SubmissionPage, error) {
+	db := s.db.GetDB().WithContext(ctx)
+
+	var total int64
+	if err := applySubmissionFilter(db.Model(&model.FormSubmission{}), filter).Count(&total).Error; err != nil {
+		return nil, fmt.Errorf("count submissions: %w", common.NewDatabaseError("count", "form_submission", filter.FormID, err))
+	}
+
+	condition, orderBy := common.KeysetCondition(
+		submissionSortColumns[filter.Sort], "form_submissions.uuid", filter.Order, filter.Cursor,
+	)
+
+	query := applySubmissionFilter(db.Model(&model.FormSubmission{}), filter).
+		Order(orderBy).
+		Limit(filter.Limit + 1)
+
+	if filter.Cursor != nil {
+		value, err := time.Parse(time.RFC3339Nano, filter.Cursor.Value)
+		if err != nil {
+			return nil, fmt.Errorf("list submissions page: %w",
+				common.NewInvalidInputError("list", "form_submission", filter.FormID, err))
+		}
+
+		query = query.Where(condition, value, filter.Cursor.ID)
+	}
+
+	var rows []*model.FormSubmission
+	if err := query.Find(&rows).Error; err != nil {
+		s.logger.Error("failed to list submissions page", "form_id", filter.FormID, "error", err)
+
+		return nil, fmt.Errorf("list submissions page: %w",
+			common.NewDatabaseError("list", "form_submission", filter.FormID, err))
+	}
+
+	submissions, next, prev := common.KeysetPage(rows, filter.Limit, filter.Cursor, filter.Sort, filter.Order,
+		func(sub *model.FormSubmission) (value, id string) {
+			return submissionSortTime(sub, filter.Sort).UTC().Format(time.RFC3339Nano), sub.ID
+		})
+
+	if err := s.loadSubmissionTags(db, submissions); err != nil {
+		return nil, err
+	}
+
+	return &form.SubmissionPage{Submissions: submissions, Total: total, NextCursor: next, PrevCursor: prev}, nil
+}
+
+// applyFormFilter scopes a forms query to the filter's owner and narrowing fields
+func applyFormFilter(query *gorm.DB, filter form.FormListFilter) *gorm.DB {
+	if filter.WorkspaceID != "" {
+		query = query.Where("forms.workspace_id = ?", filter.WorkspaceID)
+	} else {
+		query = query.Where("forms.user_id = ? AND forms.workspace_id = ''", filter.UserID)
+	}
+
+	if filter.Status != "" {
+		query = query.Where("forms.status = ?", filter.Status)
+	}
+
+	if filter.Tag != "" {
+		query = query.Where("forms.uuid IN (SELECT form_id FROM form_tags WHERE tag = ?)", filter.Tag)
+	}
+
+	if filter.Search != "" {
+		query = query.Where(common.TextContains(query, "forms.title"), common.ContainsPattern(filter.Search))
+	}
+
+	return query
+}
+
+// applySubmissionFilter scopes a submissions query to the filter's form and narrowing fields
+func applySubmissionFilter(query *gorm.DB, filter form.SubmissionListFilter) *gorm.DB {
+	query = query.Where("form_submissions.form_id = ?", filter.FormID)
+
+	if filter.Status != "" {
+		query = query.Where("form_submissions.status = ?", filter.Status)
+	}
+
+	if filter.ReviewStatus != "" {
+		query = query.Where("form_submissions.review_status = ?", filter.ReviewStatus)
+	}
+
+	switch filter.AssigneeID {
+	case "":
+	case form.AssigneeNone:
+		query = query.Where("form_submissions.assignee_id = ''")
+	default:
+		query = query.Where("form_submissions.assignee_id = ?", filter.AssigneeID)
+	}
+
+	if filter.Tag != "" {
+		query = query.Where("form_submissions.uuid IN (SELECT submission_id FROM submission_tags WHERE tag = ?)", filter.Tag)
+	}
+
+	return query
+}
+
+// formSortValue renders a form's sort key for a cursor
+func formSortValue(f *model.Form, sort string) string {
+	switch sort {
+	case form.SortUpdated:
+		return f.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	case form.SortTitle:
+		return f.Title
+	case form.SortSubmissions:
+		return strconv.FormatInt(f.SubmissionCount, 10)
+	default:
+		return f.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+}
+
+// formCursorValue parses a cursor's sort key back into a query argument
+func formCursorValue(sort, value string) (any, error) {
+	switch sort {
+	case form.SortTitle:
+		return value, nil
+	case form.SortSubmissions:
+		return strconv.ParseInt(value, 10, 64)
+	default:
+		return time.Parse(time.RFC3339Nano, value)
+	}
+}
+
+// submissionSortTime returns the timestamp a submission is sorted by
+func submissionSortTime(sub *model.FormSubmission, sort string) time.Time {
+	switch sort {
+	case form.SortCreated:
+		return sub.CreatedAt
+	case form.SortUpdated:
+		return sub.UpdatedAt
+	default:
+		return sub.SubmittedAt
+	}
+}
+
+// loadTags fills in the tags of each form
+func (s *Store) loadTags(db *gorm.DB, forms []*model.Form) error {
+	if len(forms) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(forms))
+	byID := make(map[string]*model.Form, len(forms))
+
+	for i, f := range forms {
+		ids[i] = f.ID
+		byID[f.ID] = f
+		f.Tags = []string{}
+	}
+
+	var tags []formTag
+	if err := db.Where("form_id IN ?", ids).Order("tag ASC").Find(&tags).Error; err != nil {
+		return fmt.Errorf("load form tags: %w", common.NewDatabaseError("list", "form_tag", "", err))
+	}
+
+	for _, tag := range tags {
+		if f, ok := byID[tag.FormID]; ok {
+			f.Tags = append(f.Tags, tag.Tag)
+		}
+	}
+
+	return nil
+}
+
+// loadSubmissionTags fills in the tags of a page of submissions with a single query
+func (s *Store) loadSubmissionTags(db *gorm.DB, submissions []*model.FormSubmission) error {
+	if len(submissions) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(submissions))
+	byID := make(map[string]*model.FormSubmission, len(submissions))
+
+	for i, sub := range submissions {
+		ids[i] = sub.ID
+		byID[sub.ID] = sub
+		sub.Tags = []string{}
+	}
+
+	var tags []model.SubmissionTag
+	if err := db.Where("submission_id IN ?", ids).Order("tag ASC").Find(&tags).Error; err != nil {
+		return fmt.Errorf("load submission tags: %w", common.NewDatabaseError("list", "submission_tag", "", err))
+	}
+
+	for _, tag := range tags {
+		if sub, ok := byID[tag.SubmissionID]; ok {
+			sub.Tags = append(sub.Tags, tag.Tag)
+		}
+	}
+
+	return nil
+}
+
+// replaceTags swaps a form's stored tags for the given ones
+func replaceTags(tx *gorm.DB, formID string, tags []string) error {
+	if err := tx.Where("form_id = ?", formID).Delete(&formTag{}).Error; err != nil {
+		return fmt.Errorf("delete form tags: %w", err)
+	}
+
+	if len(tags) == 0 {
+		return nil
+	}
+
+	rows := make([]formTag, len(tags))
+	for i, tag := range tags {
+		rows[i] = formTag{FormID: formID, Tag: tag}
+	}
+
+	if err := tx.Create(&rows).Error; err != nil {
+		return fmt.Errorf("create form tags: %w", err)
+	}
+
+	return nil
+}
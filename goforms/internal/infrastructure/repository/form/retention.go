@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/goformx/goforms/internal/domain/form"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+// purgeScope restricts a query to the submissions selected by a purge filter
+func purgeScope(filter form.SubmissionPurgeFilter) func(*gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		db = db.Where("submitted_at < ?", filter.SubmittedBefore)
+
+		if filter.FormID != "" {
+			db = db.Where("form_id = ?", filter.FormID)
+		}
+
+		if filter.Status != "" {
+			db = db.Where("status = ?", filter.Status)
+		}
+
+		return db
+	}
+}
+
+// CountPurgeableSubmissions counts the submissions a purge with the filter would delete
+func (s *Store) CountPurgeableSubmissions(ctx context.Context, filter form.SubmissionPurgeFilter) (int64, error) {
+	var count int64
+	if err := s.db.GetDB().WithContext(ctx).
+		Model(&model.FormSubmission{}).
+		Scopes(purgeScope(filter)).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("count purgeable submissions: %w",
+			common.NewDatabaseError("count", "form_submission", filter.FormID, err))
+	}
+
+	return count, nil
+}
+
+// PurgeSubmissions deletes the submissions matching the filter, form.PurgeBatchSize at a time so
+// a large purge never holds locks on the whole table. Tags and notes go with them through
+// their foreign keys.
+func (s *Store) PurgeSubmissions(ctx context.Context, filter form.SubmissionPurgeFilter) (int64, error) {
+	db := s.db.GetDB().WithContext(ctx)
+
+	var deleted int64
+
+	for {
+		var ids []string
+		if err := db.Model(&model.FormSubmission{}).
+			Scopes(purgeScope(filter)).
+			Order("submitted_at").
+			Limit(form.PurgeBatchSize).
+			Pluck("uuid", &ids).Error; err != nil {
+			return deleted, fmt.Errorf("purge submissions: %w",
+				common.NewDatabaseError("purge", "form_submission", filter.FormID, err))
+		}
+
+		if len(ids) == 0 {
+			return deleted, nil
+		}
+
+		result := db.Where("uuid IN ?", ids).Delete(&model.FormSubmission{})
+		if result.Error != nil {
+			return deleted, fmt.Errorf("purge submissions: %w",
+				common.NewDatabaseError("purge", "form_submission", filter.FormID, result.Error))
+		}
+
+		deleted += result.RowsAffected
+
+		s.logger.Debug("purged submission batch", "deleted", result.RowsAffected, "total", deleted)
+
+		if len(ids) < form.PurgeBatchSize {
+			return deleted, nil
+		}
+	}
+}
@@ -32,9 +32,16 @@ func NewStore(db database.DB, logger logging.Logger) form.Repository {
 	}
 }
 
-// CreateForm creates a new form
+// CreateForm creates a new form along with its tags
 func (s *Store) CreateForm(ctx context.Context, formModel *model.Form) error {
-	if err := s.db.GetDB().WithContext(ctx).Create(formModel).Error; err != nil {
+	err := s.db.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(formModel).Error; err != nil {
+			return err
+		}
+
+		return replaceTags(tx, formModel.ID, formModel.Tags)
+	})
+	if err != nil {
 		s.logger.Error("failed to create form",
 			"form_id", formModel.ID,
 			"error", err,
@@ -82,6 +89,10 @@ func (s *Store) GetFormByID(ctx context.Context, id string) (*model.Form, error)
 		return nil, fmt.Errorf("get form by ID: %w", dbErr)
 	}
 
+	if err := s.loadTags(s.db.GetDB().WithContext(ctx), []*model.Form{&formModel}); err != nil {
+		return nil, fmt.Errorf("get form by ID: %w", err)
+	}
+
 	s.logger.Debug("form retrieved successfully",
 		"id_length", len(normalizedID),
 		"form_title", formModel.Title)
@@ -89,11 +100,11 @@ func (s *Store) GetFormByID(ctx context.Context, id string) (*model.Form, error)
 	return &formModel, nil
 }
 
-// ListForms retrieves all forms for a user
+// ListForms retrieves the personal forms of a user; forms they created in a workspace belong to the workspace
 func (s *Store) ListForms(ctx context.Context, userID string) ([]*model.Form, error) {
 	var forms []*model.Form
 	if err := s.db.GetDB().WithContext(ctx).
-		Where("user_id = ?", userID).
+		Where("user_id = ? AND workspace_id = ''", userID).
 		Order("created_at DESC").
 		Find(&forms).Error; err != nil {
 		s.logger.Error("failed to list forms",
@@ -107,14 +118,43 @@ func (s *Store) ListForms(ctx context.Context, userID string) ([]*model.Form, er
 	return forms, nil
 }
 
-// UpdateForm updates a form
+// ListFormsByWorkspace retrieves all forms owned by a workspace
+func (s *Store) ListFormsByWorkspace(ctx context.Context, workspaceID string) ([]*model.Form, error) {
+	var forms []*model.Form
+	if err := s.db.GetDB().WithContext(ctx).
+		Where("workspace_id = ?", workspaceID).
+		Order("created_at DESC").
+		Find(&forms).Error; err != nil {
+		s.logger.Error("failed to list workspace forms",
+			"workspace_id", workspaceID,
+			"error", err,
+		)
+
+		return nil, fmt.Errorf("list workspace forms: %w", common.NewDatabaseError("list", "form", "", err))
+	}
+
+	return forms, nil
+}
+
+// UpdateForm updates a form, replacing its tags when Tags is non-nil
 func (s *Store) UpdateForm(ctx context.Context, formModel *model.Form) error {
-	result := s.db.GetDB().WithContext(ctx).Model(&model.Form{}).Where("uuid = ?", formModel.ID).Updates(formModel)
-	if result.Error != nil {
-		return fmt.Errorf("update form: %w", common.NewDatabaseError("update", "form", formModel.ID, result.Error))
+	var rowsAffected int64
+
+	err := s.db.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.Form{}).Where("uuid = ?", formModel.ID).Updates(formModel)
+
+		rowsAffected = result.RowsAffected
+		if result.Error != nil || rowsAffected == 0 || formModel.Tags == nil {
+			return result.Error
+		}
+
+		return replaceTags(tx, formModel.ID, formModel.Tags)
+	})
+	if err != nil {
+		return fmt.Errorf("update form: %w", common.NewDatabaseError("update", "form", formModel.ID, err))
 	}
 
-	if result.RowsAffected == 0 {
+	if rowsAffected == 0 {
 		return fmt.Errorf("update form: %w", common.NewNotFoundError("update", "form", formModel.ID))
 	}
 
@@ -199,6 +239,10 @@ func (s *Store) GetSubmissionByID(ctx context.Context, submissionID string) (*mo
 			common.NewDatabaseError("get", "form_submission", submissionID, err))
 	}
 
+	if err := s.loadSubmissionTags(s.db.GetDB().WithContext(ctx), []*model.FormSubmission{&submission}); err != nil {
+		return nil, err
+	}
+
 	return &submission, nil
 }
 
@@ -328,12 +372,12 @@ func (s *Store) GetSubmissionsByStatus(
 	return submissions, nil
 }
 
-// CountFormsByUser returns the number of forms owned by a user.
+// CountFormsByUser returns the number of personal forms of a user; workspace forms count against the workspace.
 func (s *Store) CountFormsByUser(ctx context.Context, userID string) (int, error) {
 	var count int64
 	if err := s.db.GetDB().WithContext(ctx).
 		Model(&model.Form{}).
-		Where("user_id = ?", userID).
+		Where("user_id = ? AND workspace_id = ''", userID).
 		Count(&count).Error; err != nil {
 		return 0, fmt.Errorf("count forms by user: %w", err)
 	}
@@ -341,7 +385,7 @@ func (s *Store) CountFormsByUser(ctx context.Context, userID string) (int, error
 	return int(count), nil
 }
 
-// CountSubmissionsByUserMonth returns the number of submissions for a user in a given month.
+// CountSubmissionsByUserMonth returns the number of submissions to a user's personal forms in a given month.
 func (s *Store) CountSubmissionsByUserMonth(
 	ctx context.Context,
 	userID string,
@@ -356,7 +400,7 @@ func (s *Store) CountSubmissionsByUserMonth(
 		Model(&model.FormSubmission{}).
 		Joins("JOIN forms ON forms.uuid = form_submissions.form_id AND forms.deleted_at IS NULL").
 		Where(
-			"forms.user_id = ? AND form_submissions.created_at >= ? AND form_submissions.created_at < ?",
+			"forms.user_id = ? AND forms.workspace_id = '' AND form_submissions.created_at >= ? AND form_submissions.created_at < ?",
 			userID, startOfMonth, endOfMonth,
 		).
 		Count(&count).Error; err != nil {
@@ -365,3 +409,41 @@ func (s *Store) CountSubmissionsByUserMonth(
 
 	return int(count), nil
 }
+
+// CountFormsByWorkspace returns the number of forms owned by a workspace.
+func (s *Store) CountFormsByWorkspace(ctx context.Context, workspaceID string) (int, error) {
+	var count int64
+	if err := s.db.GetDB().WithContext(ctx).
+		Model(&model.Form{}).
+		Where("workspace_id = ?", workspaceID).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("count forms by workspace: %w", err)
+	}
+
+	return int(count), nil
+}
+
+// CountSubmissionsByWorkspaceMonth returns the number of submissions for a workspace in a given month.
+func (s *Store) CountSubmissionsByWorkspaceMonth(
+	ctx context.Context,
+	workspaceID string,
+	year int,
+	month int,
+) (int, error) {
+	startOfMonth := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	endOfMonth := startOfMonth.AddDate(0, 1, 0)
+
+	var count int64
+	if err := s.db.GetDB().WithContext(ctx).
+		Model(&model.FormSubmission{}).
+		Joins("JOIN forms ON forms.uuid = form_submissions.form_id AND forms.deleted_at IS NULL").
+		Where(
+			"forms.workspace_id = ? AND form_submissions.created_at >= ? AND form_submissions.created_at < ?",
+			workspaceID, startOfMonth, endOfMonth,
+		).
+		Count(&count).Error; err != nil {
+		return 0, fmt.Errorf("count submissions by workspace month: %w", err)
+	}
+
+	return int(count), nil
+}
@@ -42,9 +42,9 @@ func (s *Store) Create(ctx context.Context, submission *model.FormSubmission) er
 // GetByID retrieves a form submission by ID
 func (s *Store) GetByID(ctx context.Context, id string) (*model.FormSubmission, error) {
 	var submission model.FormSubmission
-	if err := s.db.GetDB().WithContext(ctx).Where("id = ?", id).First(&submission).Error; err != nil {
+	if err := s.db.GetDB().WithContext(ctx).Where("uuid = ?", id).First(&submission).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			return nil, fmt.Errorf("form submission not found: %s", id)
+			return nil, fmt.Errorf("get form submission: %w", common.NewNotFoundError("get", "form_submission", id))
 		}
 
 		return nil, fmt.Errorf("failed to get form submission: %w", err)
@@ -74,7 +74,7 @@ func (s *Store) Update(ctx context.Context, submission *model.FormSubmission) er
 
 // Delete deletes a form submission by ID
 func (s *Store) Delete(ctx context.Context, id string) error {
-	if err := s.db.GetDB().WithContext(ctx).Where("id = ?", id).Delete(&model.FormSubmission{}).Error; err != nil {
+	if err := s.db.GetDB().WithContext(ctx).Where("uuid = ?", id).Delete(&model.FormSubmission{}).Error; err != nil {
 		return fmt.Errorf("failed to delete form submission: %w", err)
 	}
 
@@ -228,10 +228,11 @@ func (s *Store) GetActiveSubmissions(ctx context.Context, active bool) ([]*model
 func (s *Store) Search(ctx context.Context, query string, offset, limit int) ([]*model.FormSubmission, error) {
 	var submissions []*model.FormSubmission
 
-	searchQuery := "%" + query + "%"
+	db := s.db.GetDB().WithContext(ctx)
+	pattern := common.ContainsPattern(query)
 
-	if err := s.db.GetDB().WithContext(ctx).
-		Where("data::text ILIKE ? OR status::text ILIKE ?", searchQuery, searchQuery).
+	if err := db.
+		Where(common.TextContains(db, "data")+" OR "+common.TextContains(db, "status"), pattern, pattern).
 		Offset(offset).
 		Limit(limit).
 		Find(&submissions).Error; err != nil {
@@ -248,7 +249,7 @@ func (s *Store) UpdateStatus(
 	status model.SubmissionStatus,
 ) error {
 	if err := s.db.GetDB().WithContext(ctx).Model(&model.FormSubmission{}).
-		Where("id = ?", id).Update("status", status).Error; err != nil {
+		Where("uuid = ?", id).Update("status", status).Error; err != nil {
 		return fmt.Errorf("failed to update submission status: %w", err)
 	}
 
@@ -0,0 +1,289 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/goformx/goforms/internal/domain/bulk"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+// BulkStore implements bulk.Repository in memory
+type BulkStore struct {
+	db     *Database
+	logger logging.Logger
+}
+
+// NewBulkStore creates a new in-memory bulk job store
+func NewBulkStore(db *Database, logger logging.Logger) bulk.Repository {
+	return &BulkStore{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// findJob returns the job with the ID
+func (d *Database) findJob(id string) *bulk.Job {
+	for _, job := range d.jobs {
+		if job.ID == id {
+			return job
+		}
+	}
+
+	return nil
+}
+
+// saveJob stores every field of a job, inserting it when it does not exist yet the way
+// GORM's Save does
+func (d *Database) saveJob(job *bulk.Job) error {
+	job.UpdatedAt = now()
+
+	for i, stored := range d.jobs {
+		if stored.ID == job.ID {
+			d.jobs[i] = cloneJob(job)
+
+			return nil
+		}
+	}
+
+	return d.insertJob(job)
+}
+
+// insertJob applies the defaults of a new job row and stores a copy of it
+func (d *Database) insertJob(job *bulk.Job) error {
+	if err := job.BeforeCreate(nil); err != nil {
+		return fmt.Errorf("before create: %w", err)
+	}
+
+	if d.findJob(job.ID) != nil {
+		return fmt.Errorf("%w: bulk_jobs.uuid %s", errDuplicateKey, job.ID)
+	}
+
+	if d.findForm(job.FormID) == nil {
+		return fmt.Errorf("%w: bulk_jobs.form_id %s", errForeignKey, job.FormID)
+	}
+
+	timestamp := now()
+	if job.CreatedAt.IsZero() {
+		job.CreatedAt = timestamp
+	}
+
+	if job.UpdatedAt.IsZero() {
+		job.UpdatedAt = timestamp
+	}
+
+	d.jobs = append(d.jobs, cloneJob(job))
+
+	return nil
+}
+
+// CreateJob persists a new job
+func (s *BulkStore) CreateJob(_ context.Context, job *bulk.Job) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	if err := s.db.insertJob(job); err != nil {
+		return fmt.Errorf("create bulk job: %w", common.NewDatabaseError("create", "bulk_job", job.ID, err))
+	}
+
+	return nil
+}
+
+// GetJob returns a job by ID
+func (s *BulkStore) GetJob(_ context.Context, id string) (*bulk.Job, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	job := s.db.findJob(id)
+	if job == nil {
+		return nil, bulk.ErrJobNotFound
+	}
+
+	return cloneJob(job), nil
+}
+
+// ListJobs returns a form's most recent jobs, newest first
+func (s *BulkStore) ListJobs(_ context.Context, formID string, limit int) ([]*bulk.Job, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	var jobs []*bulk.Job
+
+	for _, job := range s.db.jobs {
+		if job.FormID == formID {
+			jobs = append(jobs, cloneJob(job))
+		}
+	}
+
+	slices.SortStableFunc(jobs, func(a, b *bulk.Job) int {
+		if c := b.CreatedAt.Compare(a.CreatedAt); c != 0 {
+			return c
+		}
+
+		return strings.Compare(b.ID, a.ID)
+	})
+
+	return window(jobs, 0, limit), nil
+}
+
+// UpdateJob saves a job's status, progress and timestamps
+func (s *BulkStore) UpdateJob(_ context.Context, job *bulk.Job) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	if err := s.db.saveJob(job); err != nil {
+		s.logger.Error("failed to update bulk job", "job_id", job.ID, "error", err)
+
+		return fmt.Errorf("update bulk job: %w", common.NewDatabaseError("update", "bulk_job", job.ID, err))
+	}
+
+	return nil
+}
+
+// TransitionJob saves a job's status, error and timestamps only while its stored status is from
+func (s *BulkStore) TransitionJob(_ context.Context, job *bulk.Job, from bulk.Status) (bool, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	stored := s.db.findJob(job.ID)
+	if stored == nil || stored.Status != from {
+		return false, nil
+	}
+
+	job.UpdatedAt = now()
+
+	stored.Status = job.Status
+	stored.Error = job.Error
+	stored.StartedAt = job.StartedAt
+	stored.CompletedAt = job.CompletedAt
+	stored.UpdatedAt = job.UpdatedAt
+
+	return true, nil
+}
+
+// ListStaleJobs returns the queued and running jobs last updated before the time
+func (s *BulkStore) ListStaleJobs(_ context.Context, before time.Time) ([]*bulk.Job, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	var jobs []*bulk.Job
+
+	for _, job := range s.db.jobs {
+		if (job.Status == bulk.StatusQueued || job.Status == bulk.StatusRunning) && job.UpdatedAt.Before(before) {
+			jobs = append(jobs, cloneJob(job))
+		}
+	}
+
+	slices.SortStableFunc(jobs, func(a, b *bulk.Job) int { return a.UpdatedAt.Compare(b.UpdatedAt) })
+
+	return jobs, nil
+}
+
+// CountSelection returns the number of a form's submissions matching the selection
+func (s *BulkStore) CountSelection(_ context.Context, formID string, selection bulk.Selection) (int64, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	var count int64
+
+	for _, sub := range s.db.submissions {
+		if selected(sub, formID, selection) {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+// NextChunk returns up to limit matching submissions with IDs greater than afterID, ordered by ID
+func (s *BulkStore) NextChunk(
+	_ context.Context,
+	formID string,
+	selection bulk.Selection,
+	afterID string,
+	limit int,
+) ([]*model.FormSubmission, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	submissions := s.db.formSubmissions(func(sub *model.FormSubmission) bool {
+		return selected(sub, formID, selection) && (afterID == "" || sub.ID > afterID)
+	})
+
+	slices.SortFunc(submissions, func(a, b *model.FormSubmission) int { return cmp.Compare(a.ID, b.ID) })
+
+	return window(submissions, 0, limit), nil
+}
+
+// CommitChunk applies the change to the submissions, appends any export output and saves
+// the job's progress at once
+func (s *BulkStore) CommitChunk(_ context.Context, job *bulk.Job, submissionIDs []string, change bulk.Change) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	// Check the job first so a failed commit changes nothing, as a rolled back transaction would
+	if stored := s.db.findJob(job.ID); stored == nil && s.db.findForm(job.FormID) == nil {
+		err := fmt.Errorf("%w: bulk_jobs.form_id %s", errForeignKey, job.FormID)
+		s.logger.Error("failed to commit bulk chunk", "job_id", job.ID, "form_id", job.FormID, "error", err)
+
+		return fmt.Errorf("commit bulk chunk: %w", common.NewDatabaseError("update", "bulk_job", job.ID, err))
+	}
+
+	inChunk := func(sub *model.FormSubmission) bool {
+		return sub.FormID == job.FormID && slices.Contains(submissionIDs, sub.ID)
+	}
+
+	switch {
+	case change.Delete:
+		s.db.deleteSubmissions(inChunk)
+	case change.Status != "":
+		for _, sub := range s.db.submissions {
+			if inChunk(sub) {
+				sub.Status = change.Status
+				sub.UpdatedAt = now()
+			}
+		}
+	}
+
+	if len(change.Output) > 0 {
+		s.db.jobOutputs[job.ID] = append(s.db.jobOutputs[job.ID], change.Output...)
+	}
+
+	if err := s.db.saveJob(job); err != nil {
+		return fmt.Errorf("commit bulk chunk: %w", common.NewDatabaseError("update", "bulk_job", job.ID, err))
+	}
+
+	return nil
+}
+
+// Output returns the export file written by a job
+func (s *BulkStore) Output(_ context.Context, jobID string) ([]byte, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	return slices.Clone(s.db.jobOutputs[jobID]), nil
+}
+
+// selected reports whether a submission belongs to the form and matches a job's selection
+func selected(sub *model.FormSubmission, formID string, selection bulk.Selection) bool {
+	switch {
+	case sub.FormID != formID:
+		return false
+	case len(selection.IDs) > 0 && !slices.Contains(selection.IDs, sub.ID):
+		return false
+	case selection.Status != "" && sub.Status != selection.Status:
+		return false
+	case selection.SubmittedAfter != nil && sub.SubmittedAt.Before(*selection.SubmittedAfter):
+		return false
+	case selection.SubmittedBefore != nil && !sub.SubmittedAt.Before(*selection.SubmittedBefore):
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,223 @@
+// Package repository provides in-memory implementations of the form, form submission, user,
+// bulk job and submission review repositories. They keep every row in process memory and mirror the GORM stores'
+// semantics, so the server can run without a database for demos and frontend development.
+package repository
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/goformx/goforms/internal/domain/bulk"
+	"github.com/goformx/goforms/internal/domain/entities"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/domain/review"
+)
+
+// Constraint violations reported the way a database would reject the write
+var (
+	errDuplicateKey = errors.New("duplicate key value violates unique constraint")
+	errForeignKey   = errors.New("insert violates foreign key constraint")
+)
+
+// Database holds the rows shared by the memory stores. Rows are kept in insertion order,
+// which stands in for the physical order of an unordered SQL query, and are copied on the
+// way in and out so callers never share state with the store.
+type Database struct {
+	mu          sync.RWMutex
+	users       []*entities.User
+	forms       []*model.Form
+	formTags    map[string][]string
+	submissions []*model.FormSubmission
+	// submissionTags and notes belong to submissions and are deleted with them
+	submissionTags map[string][]string
+	notes          []*review.Note
+	jobs           []*bulk.Job
+	// jobOutputs holds each export job's committed chunks, concatenated in commit order
+	jobOutputs map[string][]byte
+}
+
+// NewDatabase creates an empty in-memory database
+func NewDatabase() *Database {
+	return &Database{
+		formTags:       make(map[string][]string),
+		submissionTags: make(map[string][]string),
+		jobOutputs:     make(map[string][]byte),
+	}
+}
+
+// now returns the current time the way the database's NowFunc does
+func now() time.Time {
+	return time.Now().UTC()
+}
+
+// findUser returns the user with the ID, including soft-deleted ones
+func (d *Database) findUser(id string) *entities.User {
+	for _, u := range d.users {
+		if u.ID == id {
+			return u
+		}
+	}
+
+	return nil
+}
+
+// findForm returns the form with the ID, including soft-deleted ones
+func (d *Database) findForm(id string) *model.Form {
+	for _, f := range d.forms {
+		if f.ID == id {
+			return f
+		}
+	}
+
+	return nil
+}
+
+// findSubmission returns the submission with the ID
+func (d *Database) findSubmission(id string) *model.FormSubmission {
+	for _, sub := range d.submissions {
+		if sub.ID == id {
+			return sub
+		}
+	}
+
+	return nil
+}
+
+// deleteSubmissions removes the submissions matching fn, with their tags and notes, and
+// returns how many were removed
+func (d *Database) deleteSubmissions(fn func(*model.FormSubmission) bool) int64 {
+	before := len(d.submissions)
+	d.submissions = slices.DeleteFunc(d.submissions, func(sub *model.FormSubmission) bool {
+		if !fn(sub) {
+			return false
+		}
+
+		delete(d.submissionTags, sub.ID)
+		d.notes = slices.DeleteFunc(d.notes, func(note *review.Note) bool { return note.SubmissionID == sub.ID })
+
+		return true
+	})
+
+	return int64(before - len(d.submissions))
+}
+
+// countSubmissions returns the number of submissions of a form
+func (d *Database) countSubmissions(formID string) int64 {
+	var count int64
+
+	for _, sub := range d.submissions {
+		if sub.FormID == formID {
+			count++
+		}
+	}
+
+	return count
+}
+
+// window applies SQL OFFSET and LIMIT semantics to rows: a negative limit returns every
+// row after the offset
+func window[T any](rows []T, offset, limit int) []T {
+	if offset > 0 {
+		if offset >= len(rows) {
+			return nil
+		}
+
+		rows = rows[offset:]
+	}
+
+	if limit >= 0 && limit < len(rows) {
+		rows = rows[:limit]
+	}
+
+	return rows
+}
+
+// cloneJSON copies a JSON column through its encoding, so reads get their own nested maps
+// and numbers come back as float64 as they do from the database
+func cloneJSON(value model.JSON) model.JSON {
+	if value == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(map[string]any(value))
+	if err != nil {
+		return nil
+	}
+
+	var cloned model.JSON
+	if err = json.Unmarshal(data, (*map[string]any)(&cloned)); err != nil {
+		return nil
+	}
+
+	return cloned
+}
+
+// cloneWorkflow copies a review workflow
+func cloneWorkflow(workflow *model.ReviewWorkflow) *model.ReviewWorkflow {
+	if workflow == nil {
+		return nil
+	}
+
+	cloned := *workflow
+	cloned.Statuses = slices.Clone(workflow.Statuses)
+
+	if workflow.Transitions != nil {
+		cloned.Transitions = make(map[string][]string, len(workflow.Transitions))
+		for status, next := range workflow.Transitions {
+			cloned.Transitions[status] = slices.Clone(next)
+		}
+	}
+
+	return &cloned
+}
+
+// cloneForm copies a form row without its tags and submission count, which are not columns
+func cloneForm(f *model.Form) *model.Form {
+	cloned := *f
+	cloned.Schema = cloneJSON(f.Schema)
+	cloned.CorsOrigins = cloneJSON(f.CorsOrigins)
+	cloned.CorsMethods = cloneJSON(f.CorsMethods)
+	cloned.CorsHeaders = cloneJSON(f.CorsHeaders)
+	cloned.ReviewWorkflow = cloneWorkflow(f.ReviewWorkflow)
+	cloned.Fields = nil
+	cloned.Tags = nil
+	cloned.SubmissionCount = 0
+
+	return &cloned
+}
+
+// cloneSubmission copies a submission row without its tags, which are not a column
+func cloneSubmission(sub *model.FormSubmission) *model.FormSubmission {
+	cloned := *sub
+	cloned.Data = cloneJSON(sub.Data)
+	cloned.Metadata = cloneJSON(sub.Metadata)
+	cloned.Tags = nil
+
+	return &cloned
+}
+
+// cloneUser copies a user row
+func cloneUser(u *entities.User) *entities.User {
+	cloned := *u
+
+	return &cloned
+}
+
+// cloneJob copies a job row
+func cloneJob(job *bulk.Job) *bulk.Job {
+	cloned := *job
+	cloned.Selection.IDs = slices.Clone(job.Selection.IDs)
+	cloned.ExportColumns = slices.Clone(job.ExportColumns)
+
+	return &cloned
+}
+
+// cloneNote copies a note row
+func cloneNote(note *review.Note) *review.Note {
+	cloned := *note
+
+	return &cloned
+}
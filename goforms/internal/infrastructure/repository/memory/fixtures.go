@@ -0,0 +1,67 @@
+package repository
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/goformx/goforms/internal/domain/entities"
+	"github.com/goformx/goforms/internal/domain/form"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/domain/user"
+)
+
+// Fixtures are the users, forms and submissions a store is seeded with. Forms must belong to
+// one of the users and submissions to one of the forms. Users loaded from fixtures have no
+// password.
+type Fixtures struct {
+	Users       []*entities.User        `json:"users"`
+	Forms       []*model.Form           `json:"forms"`
+	Submissions []*model.FormSubmission `json:"submissions"`
+}
+
+// ReadFixtures reads fixtures from a JSON file, rejecting unknown fields
+func ReadFixtures(path string) (*Fixtures, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read fixtures: %w", err)
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var fixtures Fixtures
+	if err = decoder.Decode(&fixtures); err != nil {
+		return nil, fmt.Errorf("decode fixtures %s: %w", path, err)
+	}
+
+	return &fixtures, nil
+}
+
+// Load creates the fixtures through the given repositories, so the same file seeds the
+// memory stores and a database alike
+func (f *Fixtures) Load(ctx context.Context, users user.Repository, forms form.Repository) error {
+	for i, u := range f.Users {
+		if err := users.Create(ctx, u); err != nil {
+			return fmt.Errorf("load fixture user %d: %w", i, err)
+		}
+	}
+
+	for i, formModel := range f.Forms {
+		formModel.Tags = model.NormalizeTags(formModel.Tags)
+
+		if err := forms.CreateForm(ctx, formModel); err != nil {
+			return fmt.Errorf("load fixture form %d: %w", i, err)
+		}
+	}
+
+	for i, submission := range f.Submissions {
+		if err := forms.CreateSubmission(ctx, submission); err != nil {
+			return fmt.Errorf("load fixture submission %d: %w", i, err)
+		}
+	}
+
+	return nil
+}
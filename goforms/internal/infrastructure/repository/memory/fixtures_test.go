@@ -0,0 +1,87 @@
+package repository_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+	repository "github.com/goformx/goforms/internal/infrastructure/repository/memory"
+	"github.com/goformx/goforms/internal/infrastructure/sanitization"
+)
+
+// newLogger creates a logger that discards its output
+func newLogger(t *testing.T) logging.Logger {
+	t.Helper()
+
+	factory, err := logging.NewFactory(&logging.FactoryConfig{AppName: "goforms-test", LogLevel: "error"},
+		sanitization.NewService())
+	require.NoError(t, err)
+
+	logger, err := factory.WithTestCore(zapcore.NewNopCore()).CreateLogger()
+	require.NoError(t, err)
+
+	return logger
+}
+
+func TestFixtures_LoadSeedsStores(t *testing.T) {
+	ctx := t.Context()
+	db, logger := repository.NewDatabase(), newLogger(t)
+	users, forms := repository.NewUserStore(db, logger), repository.NewFormStore(db, logger)
+
+	fixtures, err := repository.ReadFixtures(filepath.Join("testdata", "fixtures.json"))
+	require.NoError(t, err)
+	require.NoError(t, fixtures.Load(ctx, users, forms))
+
+	u, err := users.GetByEmail(ctx, "demo@example.com")
+	require.NoError(t, err)
+	assert.True(t, u.Active)
+
+	f, err := forms.GetFormByID(ctx, "22222222-2222-4222-8222-222222222222")
+	require.NoError(t, err)
+	assert.Equal(t, u.ID, f.UserID)
+	assert.Equal(t, []string{"demo", "support"}, f.Tags)
+
+	submissions, err := forms.ListSubmissions(ctx, f.ID)
+	require.NoError(t, err)
+	require.Len(t, submissions, 1)
+	assert.Equal(t, "ada@example.com", submissions[0].Data["email"])
+}
+
+func TestFixtures_RejectsInvalidFiles(t *testing.T) {
+	dir := t.TempDir()
+
+	tests := map[string]string{
+		"unknown field": `{"users":[],"workspaces":[]}`,
+		"not json":      `users:`,
+	}
+
+	for name, doc := range tests {
+		t.Run(name, func(t *testing.T) {
+			path := filepath.Join(dir, name+".json")
+			require.NoError(t, os.WriteFile(path, []byte(doc), 0o600))
+
+			_, err := repository.ReadFixtures(path)
+			require.Error(t, err)
+		})
+	}
+
+	_, err := repository.ReadFixtures(filepath.Join(dir, "missing.json"))
+	require.Error(t, err)
+}
+
+func TestFixtures_LoadRequiresOwners(t *testing.T) {
+	db, logger := repository.NewDatabase(), newLogger(t)
+
+	fixtures, err := repository.ReadFixtures(filepath.Join("testdata", "fixtures.json"))
+	require.NoError(t, err)
+
+	fixtures.Users = nil
+
+	err = fixtures.Load(t.Context(), repository.NewUserStore(db, logger), repository.NewFormStore(db, logger))
+	require.ErrorContains(t, err, "load fixture form 0")
+}
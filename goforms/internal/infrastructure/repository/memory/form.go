@@ -0,0 +1,461 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	"github.com/goformx/goforms/internal/domain/form"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+// defaultPlanTier is the plan_tier column default of the forms table
+const defaultPlanTier = "free"
+
+// FormStore implements form.Repository in memory
+type FormStore struct {
+	db     *Database
+	logger logging.Logger
+}
+
+// NewFormStore creates a new in-memory form store
+func NewFormStore(db *Database, logger logging.Logger) form.Repository {
+	return &FormStore{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// CreateForm creates a new form along with its tags
+func (s *FormStore) CreateForm(_ context.Context, formModel *model.Form) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	if err := formModel.BeforeCreate(nil); err != nil {
+		return fmt.Errorf("create form: %w", common.NewDatabaseError("create", "form", formModel.ID, err))
+	}
+
+	if err := s.db.checkForm(formModel); err != nil {
+		s.logger.Error("failed to create form", "form_id", formModel.ID, "error", err)
+
+		return fmt.Errorf("create form: %w", common.NewDatabaseError("create", "form", formModel.ID, err))
+	}
+
+	timestamp := now()
+	if formModel.CreatedAt.IsZero() {
+		formModel.CreatedAt = timestamp
+	}
+
+	if formModel.UpdatedAt.IsZero() {
+		formModel.UpdatedAt = timestamp
+	}
+
+	if formModel.PlanTier == "" {
+		formModel.PlanTier = defaultPlanTier
+	}
+
+	s.db.forms = append(s.db.forms, cloneForm(formModel))
+	s.db.formTags[formModel.ID] = slices.Clone(formModel.Tags)
+
+	return nil
+}
+
+// checkForm reports the constraints a new form row would violate
+func (d *Database) checkForm(formModel *model.Form) error {
+	if d.findForm(formModel.ID) != nil {
+		return fmt.Errorf("%w: forms.uuid %s", errDuplicateKey, formModel.ID)
+	}
+
+	if d.findUser(formModel.UserID) == nil {
+		return fmt.Errorf("%w: forms.user_id %s", errForeignKey, formModel.UserID)
+	}
+
+	return checkTags(formModel.Tags)
+}
+
+// checkTags rejects repeated tags, which the form_tags primary key does not allow
+func checkTags(tags []string) error {
+	seen := make(map[string]bool, len(tags))
+
+	for _, tag := range tags {
+		if seen[tag] {
+			return fmt.Errorf("%w: form_tags.tag %s", errDuplicateKey, tag)
+		}
+
+		seen[tag] = true
+	}
+
+	return nil
+}
+
+// normalizeFormID trims and lowercases a form ID and checks it is a UUID
+func normalizeFormID(op, id string) (string, error) {
+	normalizedID := strings.TrimSpace(strings.ToLower(id))
+
+	if _, err := uuid.Parse(normalizedID); err != nil {
+		return "", common.NewInvalidInputError(op, "form", id, err)
+	}
+
+	return normalizedID, nil
+}
+
+// liveForm returns the form with the ID unless it was deleted
+func (d *Database) liveForm(id string) *model.Form {
+	f := d.findForm(id)
+	if f == nil || f.DeletedAt.Valid {
+		return nil
+	}
+
+	return f
+}
+
+// liveForms returns the forms that were not deleted and match fn, in insertion order
+func (d *Database) liveForms(fn func(*model.Form) bool) []*model.Form {
+	var forms []*model.Form
+
+	for _, f := range d.forms {
+		if !f.DeletedAt.Valid && fn(f) {
+			forms = append(forms, f)
+		}
+	}
+
+	return forms
+}
+
+// withTags copies a form row and fills in its tags in alphabetical order
+func (d *Database) withTags(f *model.Form) *model.Form {
+	cloned := cloneForm(f)
+	cloned.Tags = slices.Clone(d.formTags[f.ID])
+	slices.Sort(cloned.Tags)
+
+	if cloned.Tags == nil {
+		cloned.Tags = []string{}
+	}
+
+	return cloned
+}
+
+// GetFormByID retrieves a form by ID
+func (s *FormStore) GetFormByID(_ context.Context, id string) (*model.Form, error) {
+	normalizedID, err := normalizeFormID("get", id)
+	if err != nil {
+		return nil, fmt.Errorf("get form by ID: %w", err)
+	}
+
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	f := s.db.liveForm(normalizedID)
+	if f == nil {
+		return nil, fmt.Errorf("get form by ID: %w", common.NewNotFoundError("get", "form", normalizedID))
+	}
+
+	return s.db.withTags(f), nil
+}
+
+// ListForms retrieves the personal forms of a user, newest first
+func (s *FormStore) ListForms(_ context.Context, userID string) ([]*model.Form, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	return newestFirst(s.db.liveForms(personalFormOf(userID))), nil
+}
+
+// ListFormsByWorkspace retrieves all forms owned by a workspace, newest first
+func (s *FormStore) ListFormsByWorkspace(_ context.Context, workspaceID string) ([]*model.Form, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	return newestFirst(s.db.liveForms(func(f *model.Form) bool { return f.WorkspaceID == workspaceID })), nil
+}
+
+// newestFirst copies forms ordered by creation time, newest first
+func newestFirst(forms []*model.Form) []*model.Form {
+	sorted := make([]*model.Form, len(forms))
+	for i, f := range forms {
+		sorted[i] = cloneForm(f)
+	}
+
+	slices.SortStableFunc(sorted, func(a, b *model.Form) int {
+		return b.CreatedAt.Compare(a.CreatedAt)
+	})
+
+	return sorted
+}
+
+// ListFormsPage returns one page of the forms matching a normalized filter
+func (s *FormStore) ListFormsPage(_ context.Context, filter form.FormListFilter) (*form.FormPage, error) {
+	var after sortKey
+
+	if filter.Cursor != nil {
+		key, err := formCursorKey(filter.Sort, filter.Cursor.Value)
+		if err != nil {
+			return nil, fmt.Errorf("list forms page: %w", common.NewInvalidInputError("list", "form", "", err))
+		}
+
+		after = key
+	}
+
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	matched := s.db.liveForms(func(f *model.Form) bool { return s.db.matchesFormFilter(f, filter) })
+
+	rows := make([]*model.Form, len(matched))
+	for i, f := range matched {
+		rows[i] = s.db.withTags(f)
+		rows[i].SubmissionCount = s.db.countSubmissions(f.ID)
+	}
+
+	rows = keysetScan(rows, filter.Limit, filter.Order, filter.Cursor, after,
+		func(f *model.Form) (sortKey, string) { return formSortKey(f, filter.Sort), f.ID })
+
+	forms, next, prev := common.KeysetPage(rows, filter.Limit, filter.Cursor, filter.Sort, filter.Order,
+		func(f *model.Form) (value, id string) { return formSortValue(f, filter.Sort), f.ID })
+
+	return &form.FormPage{Forms: forms, Total: int64(len(matched)), NextCursor: next, PrevCursor: prev}, nil
+}
+
+// matchesFormFilter reports whether a form is in the filter's scope and matches its narrowing fields
+func (d *Database) matchesFormFilter(f *model.Form, filter form.FormListFilter) bool {
+	if filter.WorkspaceID != "" {
+		if f.WorkspaceID != filter.WorkspaceID {
+			return false
+		}
+	} else if !personalFormOf(filter.UserID)(f) {
+		return false
+	}
+
+	if filter.Status != "" && f.Status != filter.Status {
+		return false
+	}
+
+	if filter.Tag != "" && !slices.Contains(d.formTags[f.ID], filter.Tag) {
+		return false
+	}
+
+	return filter.Search == "" || strings.Contains(strings.ToLower(f.Title), strings.ToLower(filter.Search))
+}
+
+// formSortKey returns the key a form is sorted by
+func formSortKey(f *model.Form, sort string) sortKey {
+	switch sort {
+	case form.SortUpdated:
+		return sortKey{at: f.UpdatedAt}
+	case form.SortTitle:
+		return sortKey{text: f.Title}
+	case form.SortSubmissions:
+		return sortKey{number: f.SubmissionCount}
+	default:
+		return sortKey{at: f.CreatedAt}
+	}
+}
+
+// formSortValue renders a form's sort key for a cursor
+func formSortValue(f *model.Form, sort string) string {
+	switch sort {
+	case form.SortUpdated:
+		return f.UpdatedAt.UTC().Format(time.RFC3339Nano)
+	case form.SortTitle:
+		return f.Title
+	case form.SortSubmissions:
+		return strconv.FormatInt(f.SubmissionCount, 10)
+	default:
+		return f.CreatedAt.UTC().Format(time.RFC3339Nano)
+	}
+}
+
+// formCursorKey parses a cursor's sort value back into a sort key
+func formCursorKey(sort, value string) (sortKey, error) {
+	switch sort {
+	case form.SortTitle:
+		return sortKey{text: value}, nil
+	case form.SortSubmissions:
+		number, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return sortKey{}, fmt.Errorf("parse cursor value: %w", err)
+		}
+
+		return sortKey{number: number}, nil
+	default:
+		at, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return sortKey{}, fmt.Errorf("parse cursor value: %w", err)
+		}
+
+		return sortKey{at: at}, nil
+	}
+}
+
+// UpdateForm updates the non-zero fields of a form, replacing its tags when Tags is non-nil
+func (s *FormStore) UpdateForm(_ context.Context, formModel *model.Form) error {
+	if formModel.Tags != nil {
+		if err := checkTags(formModel.Tags); err != nil {
+			return fmt.Errorf("update form: %w", common.NewDatabaseError("update", "form", formModel.ID, err))
+		}
+	}
+
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	f := s.db.liveForm(formModel.ID)
+	if f == nil {
+		return fmt.Errorf("update form: %w", common.NewNotFoundError("update", "form", formModel.ID))
+	}
+
+	applyFormUpdates(f, cloneForm(formModel))
+
+	if formModel.Tags != nil {
+		s.db.formTags[f.ID] = slices.Clone(formModel.Tags)
+	}
+
+	return nil
+}
+
+// applyFormUpdates copies the non-zero fields of update onto a stored form, as GORM's
+// Updates does with a struct, and stamps the update time
+func applyFormUpdates(f, update *model.Form) {
+	setIfNonZero(&f.UserID, update.UserID)
+	setIfNonZero(&f.Title, update.Title)
+	setIfNonZero(&f.Description, update.Description)
+	setIfNonZero(&f.Status, update.Status)
+	setIfNonZero(&f.PlanTier, update.PlanTier)
+	setIfNonZero(&f.WorkspaceID, update.WorkspaceID)
+	setIfNonZero(&f.Active, update.Active)
+
+	if !update.CreatedAt.IsZero() {
+		f.CreatedAt = update.CreatedAt
+	}
+
+	for _, column := range []struct{ stored, updated *model.JSON }{
+		{&f.Schema, &update.Schema},
+		{&f.CorsOrigins, &update.CorsOrigins},
+		{&f.CorsMethods, &update.CorsMethods},
+		{&f.CorsHeaders, &update.CorsHeaders},
+	} {
+		if *column.updated != nil {
+			*column.stored = *column.updated
+		}
+	}
+
+	if update.ReviewWorkflow != nil {
+		f.ReviewWorkflow = update.ReviewWorkflow
+	}
+
+	f.UpdatedAt = now()
+}
+
+// setIfNonZero assigns value unless it is the zero value of its type
+func setIfNonZero[T comparable](field *T, value T) {
+	var zero T
+	if value != zero {
+		*field = value
+	}
+}
+
+// DeleteForm soft-deletes a form
+func (s *FormStore) DeleteForm(_ context.Context, id string) error {
+	normalizedID, err := normalizeFormID("delete", id)
+	if err != nil {
+		return fmt.Errorf("delete form: %w", err)
+	}
+
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	f := s.db.liveForm(normalizedID)
+	if f == nil {
+		return fmt.Errorf("delete form: %w", common.NewNotFoundError("delete", "form", normalizedID))
+	}
+
+	f.DeletedAt = gorm.DeletedAt{Time: now(), Valid: true}
+
+	return nil
+}
+
+// GetFormsByStatus returns forms by their status
+func (s *FormStore) GetFormsByStatus(_ context.Context, status string) ([]*model.Form, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	matched := s.db.liveForms(func(f *model.Form) bool { return f.Status == status })
+
+	forms := make([]*model.Form, len(matched))
+	for i, f := range matched {
+		forms[i] = cloneForm(f)
+	}
+
+	return forms, nil
+}
+
+// CountFormsByUser returns the number of personal forms of a user
+func (s *FormStore) CountFormsByUser(_ context.Context, userID string) (int, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	return len(s.db.liveForms(personalFormOf(userID))), nil
+}
+
+// CountFormsByWorkspace returns the number of forms owned by a workspace
+func (s *FormStore) CountFormsByWorkspace(_ context.Context, workspaceID string) (int, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	return len(s.db.liveForms(func(f *model.Form) bool { return f.WorkspaceID == workspaceID })), nil
+}
+
+// CountSubmissionsByUserMonth returns the number of submissions to a user's personal forms in a given month
+func (s *FormStore) CountSubmissionsByUserMonth(_ context.Context, userID string, year, month int) (int, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	return s.db.countSubmissionsInMonth(personalFormOf(userID), year, month), nil
+}
+
+// personalFormOf matches the forms a user created outside any workspace
+func personalFormOf(userID string) func(f *model.Form) bool {
+	return func(f *model.Form) bool { return f.UserID == userID && f.WorkspaceID == "" }
+}
+
+// CountSubmissionsByWorkspaceMonth returns the number of submissions for a workspace in a given month
+func (s *FormStore) CountSubmissionsByWorkspaceMonth(
+	_ context.Context,
+	workspaceID string,
+	year int,
+	month int,
+) (int, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	return s.db.countSubmissionsInMonth(func(f *model.Form) bool { return f.WorkspaceID == workspaceID }, year, month), nil
+}
+
+// countSubmissionsInMonth counts the submissions created in a calendar month (UTC) on the
+// forms matching owned that were not deleted
+func (d *Database) countSubmissionsInMonth(owned func(*model.Form) bool, year, month int) int {
+	startOfMonth := time.Date(year, time.Month(month), 1, 0, 0, 0, 0, time.UTC)
+	endOfMonth := startOfMonth.AddDate(0, 1, 0)
+
+	forms := make(map[string]bool)
+	for _, f := range d.liveForms(owned) {
+		forms[f.ID] = true
+	}
+
+	count := 0
+
+	for _, sub := range d.submissions {
+		if forms[sub.FormID] && !sub.CreatedAt.Before(startOfMonth) && sub.CreatedAt.Before(endOfMonth) {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,327 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/goformx/goforms/internal/domain/form"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+// errNoSubmitter is returned by GetByFormAndUser: submissions do not record who made them
+var errNoSubmitter = errors.New("form submissions do not record a user")
+
+// CreateSubmission creates a new form submission
+func (s *FormStore) CreateSubmission(_ context.Context, submission *model.FormSubmission) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	if err := s.db.insertSubmission(submission); err != nil {
+		s.logger.Error("failed to create form submission",
+			"submission_id", submission.ID,
+			"form_id", submission.FormID,
+			"error", err,
+		)
+
+		return fmt.Errorf("create submission: %w", common.NewDatabaseError("create", "form_submission", submission.ID, err))
+	}
+
+	return nil
+}
+
+// insertSubmission applies the defaults of a new submission row and stores a copy of it
+func (d *Database) insertSubmission(submission *model.FormSubmission) error {
+	if err := submission.BeforeCreate(nil); err != nil {
+		return fmt.Errorf("before create: %w", err)
+	}
+
+	if d.findSubmission(submission.ID) != nil {
+		return fmt.Errorf("%w: form_submissions.uuid %s", errDuplicateKey, submission.ID)
+	}
+
+	if d.findForm(submission.FormID) == nil {
+		return fmt.Errorf("%w: form_submissions.form_id %s", errForeignKey, submission.FormID)
+	}
+
+	timestamp := now()
+	if submission.CreatedAt.IsZero() {
+		submission.CreatedAt = timestamp
+	}
+
+	if submission.UpdatedAt.IsZero() {
+		submission.UpdatedAt = timestamp
+	}
+
+	d.submissions = append(d.submissions, cloneSubmission(submission))
+
+	return nil
+}
+
+// GetSubmissionByID retrieves a form submission by ID
+func (s *FormStore) GetSubmissionByID(_ context.Context, submissionID string) (*model.FormSubmission, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	sub := s.db.findSubmission(submissionID)
+	if sub == nil {
+		return nil, fmt.Errorf("get submission by ID: %w",
+			common.NewNotFoundError("get", "form_submission", submissionID))
+	}
+
+	return s.db.withSubmissionTags(sub), nil
+}
+
+// withSubmissionTags copies a submission row and fills in its tags in alphabetical order
+func (d *Database) withSubmissionTags(sub *model.FormSubmission) *model.FormSubmission {
+	cloned := cloneSubmission(sub)
+	cloned.Tags = d.sortedSubmissionTags(sub.ID)
+
+	return cloned
+}
+
+// sortedSubmissionTags returns a copy of a submission's tags in alphabetical order
+func (d *Database) sortedSubmissionTags(submissionID string) []string {
+	tags := slices.Clone(d.submissionTags[submissionID])
+	if tags == nil {
+		return []string{}
+	}
+
+	slices.Sort(tags)
+
+	return tags
+}
+
+// formSubmissions copies the submissions matching fn, in insertion order
+func (d *Database) formSubmissions(fn func(*model.FormSubmission) bool) []*model.FormSubmission {
+	var submissions []*model.FormSubmission
+
+	for _, sub := range d.submissions {
+		if fn(sub) {
+			submissions = append(submissions, cloneSubmission(sub))
+		}
+	}
+
+	return submissions
+}
+
+// ListSubmissions retrieves all submissions for a form
+func (s *FormStore) ListSubmissions(_ context.Context, formID string) ([]*model.FormSubmission, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	return s.db.formSubmissions(func(sub *model.FormSubmission) bool { return sub.FormID == formID }), nil
+}
+
+// ListSubmissionsPage returns one page of the submissions matching a normalized filter
+func (s *FormStore) ListSubmissionsPage(
+	_ context.Context,
+	filter form.SubmissionListFilter,
+) (*form.SubmissionPage, error) {
+	var after sortKey
+
+	if filter.Cursor != nil {
+		at, err := time.Parse(time.RFC3339Nano, filter.Cursor.Value)
+		if err != nil {
+			return nil, fmt.Errorf("list submissions page: %w",
+				common.NewInvalidInputError("list", "form_submission", filter.FormID, err))
+		}
+
+		after = sortKey{at: at}
+	}
+
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	matched := s.db.formSubmissions(func(sub *model.FormSubmission) bool { return s.db.matchesSubmissionFilter(sub, filter) })
+
+	rows := keysetScan(matched, filter.Limit, filter.Order, filter.Cursor, after,
+		func(sub *model.FormSubmission) (sortKey, string) {
+			return sortKey{at: submissionSortTime(sub, filter.Sort)}, sub.ID
+		})
+
+	submissions, next, prev := common.KeysetPage(rows, filter.Limit, filter.Cursor, filter.Sort, filter.Order,
+		func(sub *model.FormSubmission) (value, id string) {
+			return submissionSortTime(sub, filter.Sort).UTC().Format(time.RFC3339Nano), sub.ID
+		})
+
+	for _, sub := range submissions {
+		sub.Tags = s.db.sortedSubmissionTags(sub.ID)
+	}
+
+	return &form.SubmissionPage{
+		Submissions: submissions,
+		Total:       int64(len(matched)),
+		NextCursor:  next,
+		PrevCursor:  prev,
+	}, nil
+}
+
+// matchesSubmissionFilter reports whether a submission belongs to the filter's form and matches
+// its narrowing fields
+func (d *Database) matchesSubmissionFilter(sub *model.FormSubmission, filter form.SubmissionListFilter) bool {
+	if sub.FormID != filter.FormID {
+		return false
+	}
+
+	if filter.Status != "" && sub.Status != filter.Status {
+		return false
+	}
+
+	if filter.ReviewStatus != "" && sub.ReviewStatus != filter.ReviewStatus {
+		return false
+	}
+
+	switch filter.AssigneeID {
+	case "":
+	case form.AssigneeNone:
+		if sub.AssigneeID != "" {
+			return false
+		}
+	default:
+		if sub.AssigneeID != filter.AssigneeID {
+			return false
+		}
+	}
+
+	return filter.Tag == "" || slices.Contains(d.submissionTags[sub.ID], filter.Tag)
+}
+
+// submissionSortTime returns the timestamp a submission is sorted by
+func submissionSortTime(sub *model.FormSubmission, sort string) time.Time {
+	switch sort {
+	case form.SortCreated:
+		return sub.CreatedAt
+	case form.SortUpdated:
+		return sub.UpdatedAt
+	default:
+		return sub.SubmittedAt
+	}
+}
+
+// UpdateSubmission updates the non-zero fields of a form submission
+func (s *FormStore) UpdateSubmission(_ context.Context, submission *model.FormSubmission) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	sub := s.db.findSubmission(submission.ID)
+	if sub == nil {
+		return fmt.Errorf("update submission: %w", common.NewNotFoundError("update", "form_submission", submission.ID))
+	}
+
+	update := cloneSubmission(submission)
+
+	setIfNonZero(&sub.FormID, update.FormID)
+	setIfNonZero(&sub.Status, update.Status)
+	setIfNonZero(&sub.ReviewStatus, update.ReviewStatus)
+	setIfNonZero(&sub.AssigneeID, update.AssigneeID)
+
+	if !update.SubmittedAt.IsZero() {
+		sub.SubmittedAt = update.SubmittedAt
+	}
+
+	if !update.CreatedAt.IsZero() {
+		sub.CreatedAt = update.CreatedAt
+	}
+
+	if update.Data != nil {
+		sub.Data = update.Data
+	}
+
+	if update.Metadata != nil {
+		sub.Metadata = update.Metadata
+	}
+
+	sub.UpdatedAt = now()
+
+	return nil
+}
+
+// DeleteSubmission deletes a form submission
+func (s *FormStore) DeleteSubmission(_ context.Context, submissionID string) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	if s.db.deleteSubmissions(func(sub *model.FormSubmission) bool { return sub.ID == submissionID }) == 0 {
+		return fmt.Errorf("delete submission: %w", common.NewNotFoundError("delete", "form_submission", submissionID))
+	}
+
+	return nil
+}
+
+// GetByFormID retrieves all submissions for a form
+func (s *FormStore) GetByFormID(ctx context.Context, formID string) ([]*model.FormSubmission, error) {
+	return s.ListSubmissions(ctx, formID)
+}
+
+// GetByFormIDPaginated retrieves paginated submissions for a form
+func (s *FormStore) GetByFormIDPaginated(
+	ctx context.Context,
+	formID string,
+	params common.PaginationParams,
+) (*common.PaginationResult, error) {
+	submissions, err := s.ListSubmissions(ctx, formID)
+	if err != nil {
+		return nil, err
+	}
+
+	total := len(submissions)
+
+	return &common.PaginationResult{
+		Items:      window(submissions, params.GetOffset(), params.GetLimit()),
+		TotalItems: total,
+		Page:       params.Page,
+		PageSize:   params.PageSize,
+		TotalPages: (total + params.PageSize - 1) / params.PageSize,
+	}, nil
+}
+
+// GetByFormAndUser retrieves a submission by form ID and user ID. Submissions do not record
+// who made them, so there is never a match.
+func (s *FormStore) GetByFormAndUser(_ context.Context, formID, userID string) (*model.FormSubmission, error) {
+	return nil, fmt.Errorf("failed to get submission: %w",
+		common.NewInvalidInputError("get", "form_submission", formID+"/"+userID, errNoSubmitter))
+}
+
+// GetSubmissionsByStatus retrieves submissions by status
+func (s *FormStore) GetSubmissionsByStatus(
+	_ context.Context,
+	status model.SubmissionStatus,
+) ([]*model.FormSubmission, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	return s.db.formSubmissions(func(sub *model.FormSubmission) bool { return sub.Status == status }), nil
+}
+
+// CountPurgeableSubmissions counts the submissions a purge with the filter would delete
+func (s *FormStore) CountPurgeableSubmissions(_ context.Context, filter form.SubmissionPurgeFilter) (int64, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	return int64(len(s.db.formSubmissions(purgeable(filter)))), nil
+}
+
+// PurgeSubmissions deletes the submissions matching the filter
+func (s *FormStore) PurgeSubmissions(_ context.Context, filter form.SubmissionPurgeFilter) (int64, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	deleted := s.db.deleteSubmissions(purgeable(filter))
+
+	s.logger.Debug("purged submissions", "deleted", deleted)
+
+	return deleted, nil
+}
+
+// purgeable selects the submissions a purge filter applies to
+func purgeable(filter form.SubmissionPurgeFilter) func(*model.FormSubmission) bool {
+	return func(sub *model.FormSubmission) bool {
+		return sub.SubmittedAt.Before(filter.SubmittedBefore) &&
+			(filter.FormID == "" || sub.FormID == filter.FormID) &&
+			(filter.Status == "" || sub.Status == filter.Status)
+	}
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+// sortKey is the value a listing is sorted by; only the field of the listing's sort is set
+type sortKey struct {
+	at     time.Time
+	text   string
+	number int64
+}
+
+// compare orders two keys of the same sort
+func (k sortKey) compare(other sortKey) int {
+	if c := k.at.Compare(other.at); c != 0 {
+		return c
+	}
+
+	if c := cmp.Compare(k.number, other.number); c != 0 {
+		return c
+	}
+
+	return strings.Compare(k.text, other.text)
+}
+
+// keysetScan does in memory what a query built with common.KeysetCondition does in SQL: it
+// orders rows by their sort key with the ID breaking ties, keeps the rows after the cursor,
+// and returns up to limit+1 of them for common.KeysetPage
+func keysetScan[T any](
+	rows []T,
+	limit int,
+	order string,
+	cursor *common.Cursor,
+	after sortKey,
+	key func(T) (sortKey, string),
+) []T {
+	descending := order == common.OrderDesc
+	if cursor != nil && cursor.Backward {
+		descending = !descending
+	}
+
+	compare := func(aKey sortKey, aID string, bKey sortKey, bID string) int {
+		c := aKey.compare(bKey)
+		if c == 0 {
+			c = strings.Compare(aID, bID)
+		}
+
+		if descending {
+			return -c
+		}
+
+		return c
+	}
+
+	scanned := make([]T, 0, len(rows))
+
+	for _, row := range rows {
+		rowKey, id := key(row)
+		if cursor == nil || compare(rowKey, id, after, cursor.ID) > 0 {
+			scanned = append(scanned, row)
+		}
+	}
+
+	slices.SortStableFunc(scanned, func(a, b T) int {
+		aKey, aID := key(a)
+		bKey, bID := key(b)
+
+		return compare(aKey, aID, bKey, bID)
+	})
+
+	return window(scanned, 0, limit+1)
+}
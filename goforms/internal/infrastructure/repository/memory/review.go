@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/domain/review"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+// ReviewStore implements review.Repository in memory
+type ReviewStore struct {
+	db     *Database
+	logger logging.Logger
+}
+
+// NewReviewStore creates a new in-memory submission review store
+func NewReviewStore(db *Database, logger logging.Logger) review.Repository {
+	return &ReviewStore{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// GetSubmission returns a form's submission with its tags
+func (s *ReviewStore) GetSubmission(_ context.Context, formID, submissionID string) (*model.FormSubmission, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	sub := s.db.findSubmission(submissionID)
+	if sub == nil || sub.FormID != formID {
+		return nil, review.ErrSubmissionNotFound
+	}
+
+	return s.db.withSubmissionTags(sub), nil
+}
+
+// SaveReview saves the submission's review status and assignee, and replaces its tags unless tags is
+// nil, only while its stored review status is from
+func (s *ReviewStore) SaveReview(_ context.Context, submission *model.FormSubmission, from string, tags []string) (bool, error) {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	sub := s.db.findSubmission(submission.ID)
+	if sub == nil || sub.FormID != submission.FormID || sub.ReviewStatus != from {
+		return false, nil
+	}
+
+	sub.ReviewStatus = submission.ReviewStatus
+	sub.AssigneeID = submission.AssigneeID
+	sub.UpdatedAt = now()
+
+	if tags != nil {
+		s.db.submissionTags[sub.ID] = slices.Clone(tags)
+	}
+
+	return true, nil
+}
+
+// CreateNote persists a new note
+func (s *ReviewStore) CreateNote(_ context.Context, note *review.Note) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	if err := note.BeforeCreate(nil); err != nil {
+		return fmt.Errorf("create submission note: %w", err)
+	}
+
+	var err error
+
+	switch {
+	case s.db.findNote(note.ID) != nil:
+		err = fmt.Errorf("%w: submission_notes.uuid %s", errDuplicateKey, note.ID)
+	case s.db.findSubmission(note.SubmissionID) == nil:
+		err = fmt.Errorf("%w: submission_notes.submission_id %s", errForeignKey, note.SubmissionID)
+	}
+
+	if err != nil {
+		return fmt.Errorf("create submission note: %w", common.NewDatabaseError("create", "submission_note", note.ID, err))
+	}
+
+	if note.CreatedAt.IsZero() {
+		note.CreatedAt = now()
+	}
+
+	s.db.notes = append(s.db.notes, cloneNote(note))
+
+	return nil
+}
+
+// findNote returns the note with the ID
+func (d *Database) findNote(id string) *review.Note {
+	for _, note := range d.notes {
+		if note.ID == id {
+			return note
+		}
+	}
+
+	return nil
+}
+
+// GetNote returns a note by ID
+func (s *ReviewStore) GetNote(_ context.Context, noteID string) (*review.Note, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	note := s.db.findNote(noteID)
+	if note == nil {
+		return nil, review.ErrNoteNotFound
+	}
+
+	return cloneNote(note), nil
+}
+
+// ListNotes returns a submission's notes, oldest first
+func (s *ReviewStore) ListNotes(_ context.Context, submissionID string) ([]*review.Note, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	var notes []*review.Note
+
+	for _, note := range s.db.notes {
+		if note.SubmissionID == submissionID {
+			notes = append(notes, cloneNote(note))
+		}
+	}
+
+	slices.SortStableFunc(notes, func(a, b *review.Note) int {
+		if c := a.CreatedAt.Compare(b.CreatedAt); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.ID, b.ID)
+	})
+
+	return notes, nil
+}
+
+// DeleteNote removes a note
+func (s *ReviewStore) DeleteNote(_ context.Context, noteID string) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	s.db.notes = slices.DeleteFunc(s.db.notes, func(note *review.Note) bool { return note.ID == noteID })
+
+	return nil
+}
@@ -0,0 +1,203 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/goformx/goforms/internal/domain/form"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+// SubmissionStore implements form.SubmissionRepository in memory
+type SubmissionStore struct {
+	db     *Database
+	logger logging.Logger
+}
+
+// NewSubmissionStore creates a new in-memory form submission store
+func NewSubmissionStore(db *Database, logger logging.Logger) form.SubmissionRepository {
+	return &SubmissionStore{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create creates a new form submission
+func (s *SubmissionStore) Create(_ context.Context, submission *model.FormSubmission) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	if err := s.db.insertSubmission(submission); err != nil {
+		s.logger.Error("failed to create form submission", "form_id", submission.FormID, "error", err)
+
+		return fmt.Errorf("failed to create form submission: %w",
+			common.NewDatabaseError("create", "form_submission", submission.ID, err))
+	}
+
+	return nil
+}
+
+// GetByID retrieves a form submission by ID
+func (s *SubmissionStore) GetByID(_ context.Context, id string) (*model.FormSubmission, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	sub := s.db.findSubmission(id)
+	if sub == nil {
+		return nil, fmt.Errorf("get form submission: %w", common.NewNotFoundError("get", "form_submission", id))
+	}
+
+	return cloneSubmission(sub), nil
+}
+
+// GetByFormID retrieves all submissions for a specific form
+func (s *SubmissionStore) GetByFormID(_ context.Context, formID string) ([]*model.FormSubmission, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	return s.db.formSubmissions(func(sub *model.FormSubmission) bool { return sub.FormID == formID }), nil
+}
+
+// Update stores every field of a form submission, inserting it when it does not exist yet
+// the way GORM's Save does
+func (s *SubmissionStore) Update(_ context.Context, submission *model.FormSubmission) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	for i, sub := range s.db.submissions {
+		if sub.ID == submission.ID {
+			if s.db.findForm(submission.FormID) == nil {
+				return fmt.Errorf("failed to update form submission: %w", common.NewDatabaseError("update", "form_submission",
+					submission.ID, fmt.Errorf("%w: form_submissions.form_id %s", errForeignKey, submission.FormID)))
+			}
+
+			submission.UpdatedAt = now()
+			s.db.submissions[i] = cloneSubmission(submission)
+
+			return nil
+		}
+	}
+
+	if err := s.db.insertSubmission(submission); err != nil {
+		return fmt.Errorf("failed to update form submission: %w",
+			common.NewDatabaseError("update", "form_submission", submission.ID, err))
+	}
+
+	return nil
+}
+
+// Delete deletes a form submission by ID; deleting a missing submission is not an error
+func (s *SubmissionStore) Delete(_ context.Context, id string) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	s.db.deleteSubmissions(func(sub *model.FormSubmission) bool { return sub.ID == id })
+
+	return nil
+}
+
+// List retrieves a paginated list of form submissions
+func (s *SubmissionStore) List(_ context.Context, offset, limit int) ([]*model.FormSubmission, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	all := s.db.formSubmissions(func(*model.FormSubmission) bool { return true })
+
+	return window(all, offset, limit), nil
+}
+
+// Count returns the total number of form submissions
+func (s *SubmissionStore) Count(_ context.Context) (int, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	return len(s.db.submissions), nil
+}
+
+// Search finds submissions whose data or status contains the query, ignoring case
+func (s *SubmissionStore) Search(_ context.Context, query string, offset, limit int) ([]*model.FormSubmission, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	needle := strings.ToLower(query)
+
+	found := s.db.formSubmissions(func(sub *model.FormSubmission) bool {
+		data, err := json.Marshal(sub.Data)
+		if err != nil {
+			return false
+		}
+
+		return strings.Contains(strings.ToLower(string(data)), needle) ||
+			strings.Contains(strings.ToLower(string(sub.Status)), needle)
+	})
+
+	return window(found, offset, limit), nil
+}
+
+// GetByFormIDPaginated retrieves form submissions by form ID with pagination
+func (s *SubmissionStore) GetByFormIDPaginated(
+	_ context.Context,
+	formID string,
+	params common.PaginationParams,
+) (*common.PaginationResult, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	return paginate(s.db.formSubmissions(func(sub *model.FormSubmission) bool { return sub.FormID == formID }), params), nil
+}
+
+// GetSubmissionsByStatus retrieves form submissions by status with pagination
+func (s *SubmissionStore) GetSubmissionsByStatus(
+	_ context.Context,
+	status model.SubmissionStatus,
+	params common.PaginationParams,
+) (*common.PaginationResult, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	return paginate(s.db.formSubmissions(func(sub *model.FormSubmission) bool { return sub.Status == status }), params), nil
+}
+
+// paginate builds the page of submissions selected by params, with the items as []any
+func paginate(submissions []*model.FormSubmission, params common.PaginationParams) *common.PaginationResult {
+	if len(submissions) == 0 {
+		return &common.PaginationResult{Items: []any{}, Page: params.Page, PageSize: params.PageSize}
+	}
+
+	page := window(submissions, params.GetOffset(), params.GetLimit())
+
+	items := make([]any, len(page))
+	for i, submission := range page {
+		items[i] = submission
+	}
+
+	result := common.NewPaginationResult(items, len(submissions), params.Page, params.PageSize)
+
+	return &result
+}
+
+// GetByFormAndUser retrieves a submission by form ID and user ID. Submissions do not record
+// who made them, so there is never a match.
+func (s *SubmissionStore) GetByFormAndUser(_ context.Context, formID, userID string) (*model.FormSubmission, error) {
+	return nil, fmt.Errorf("failed to get submission: %w",
+		common.NewInvalidInputError("get", "form_submission", formID+"/"+userID, errNoSubmitter))
+}
+
+// CreateSubmission creates a new form submission
+func (s *SubmissionStore) CreateSubmission(ctx context.Context, submission *model.FormSubmission) error {
+	return s.Create(ctx, submission)
+}
+
+// UpdateSubmission updates an existing form submission
+func (s *SubmissionStore) UpdateSubmission(ctx context.Context, submission *model.FormSubmission) error {
+	return s.Update(ctx, submission)
+}
+
+// DeleteSubmission deletes a form submission
+func (s *SubmissionStore) DeleteSubmission(ctx context.Context, id string) error {
+	return s.Delete(ctx, id)
+}
@@ -0,0 +1,247 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/goformx/goforms/internal/domain/entities"
+	"github.com/goformx/goforms/internal/domain/user"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+// UserStore implements user.Repository in memory
+type UserStore struct {
+	db     *Database
+	logger logging.Logger
+}
+
+// NewUserStore creates a new in-memory user store
+func NewUserStore(db *Database, logger logging.Logger) user.Repository {
+	return &UserStore{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// Create stores a new user
+func (s *UserStore) Create(_ context.Context, u *entities.User) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	if err := s.db.insertUser(u); err != nil {
+		s.logger.Error("failed to create user", "error", err)
+
+		return fmt.Errorf("create user: %w", common.NewDatabaseError("create", "user", u.ID, err))
+	}
+
+	return nil
+}
+
+// insertUser applies the defaults of a new user row and stores a copy of it
+func (d *Database) insertUser(u *entities.User) error {
+	if err := u.BeforeCreate(nil); err != nil {
+		return fmt.Errorf("before create: %w", err)
+	}
+
+	if d.findUser(u.ID) != nil {
+		return fmt.Errorf("%w: users.uuid %s", errDuplicateKey, u.ID)
+	}
+
+	if err := d.checkEmail(u); err != nil {
+		return err
+	}
+
+	timestamp := now()
+	if u.CreatedAt.IsZero() {
+		u.CreatedAt = timestamp
+	}
+
+	if u.UpdatedAt.IsZero() {
+		u.UpdatedAt = timestamp
+	}
+
+	d.users = append(d.users, cloneUser(u))
+
+	return nil
+}
+
+// checkEmail enforces the unique index on users.email, which covers deleted users too
+func (d *Database) checkEmail(u *entities.User) error {
+	for _, existing := range d.users {
+		if existing.ID != u.ID && existing.Email == u.Email {
+			return fmt.Errorf("%w: users.email", errDuplicateKey)
+		}
+	}
+
+	return nil
+}
+
+// liveUsers copies the users that were not deleted and match fn, ordered by ID
+func (d *Database) liveUsers(fn func(*entities.User) bool) []*entities.User {
+	var users []*entities.User
+
+	for _, u := range d.users {
+		if !u.DeletedAt.Valid && fn(u) {
+			users = append(users, cloneUser(u))
+		}
+	}
+
+	slices.SortFunc(users, func(a, b *entities.User) int { return strings.Compare(a.ID, b.ID) })
+
+	return users
+}
+
+// liveUser copies the first user that was not deleted and matches fn
+func (d *Database) liveUser(fn func(*entities.User) bool) *entities.User {
+	for _, u := range d.users {
+		if !u.DeletedAt.Valid && fn(u) {
+			return cloneUser(u)
+		}
+	}
+
+	return nil
+}
+
+// GetByEmail retrieves a user by email
+func (s *UserStore) GetByEmail(_ context.Context, email string) (*entities.User, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	u := s.db.liveUser(func(u *entities.User) bool { return u.Email == email })
+	if u == nil {
+		return nil, fmt.Errorf("get user by email: %w", common.NewNotFoundError("get_by_email", "user", email))
+	}
+
+	return u, nil
+}
+
+// GetByID retrieves a user by ID
+func (s *UserStore) GetByID(_ context.Context, id string) (*entities.User, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	u := s.db.liveUser(func(u *entities.User) bool { return u.ID == id })
+	if u == nil {
+		return nil, fmt.Errorf("get user by ID: %w", common.NewNotFoundError("get_by_id", "user", id))
+	}
+
+	return u, nil
+}
+
+// GetByIDString retrieves a user by a numeric ID string
+func (s *UserStore) GetByIDString(ctx context.Context, id string) (*entities.User, error) {
+	userID, err := strconv.ParseUint(id, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("get user by ID string: %w", common.NewInvalidInputError("get_by_id_string", "user", id, err))
+	}
+
+	return s.GetByID(ctx, strconv.FormatUint(userID, 10))
+}
+
+// Update stores every field of a user, inserting it when it does not exist yet the way
+// GORM's Save does
+func (s *UserStore) Update(_ context.Context, u *entities.User) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	index := slices.IndexFunc(s.db.users, func(existing *entities.User) bool { return existing.ID == u.ID })
+	if index < 0 {
+		if err := s.db.insertUser(u); err != nil {
+			return fmt.Errorf("update user: %w", common.NewDatabaseError("update", "user", u.ID, err))
+		}
+
+		return nil
+	}
+
+	if err := s.db.checkEmail(u); err != nil {
+		return fmt.Errorf("update user: %w", common.NewDatabaseError("update", "user", u.ID, err))
+	}
+
+	u.UpdatedAt = now()
+	s.db.users[index] = cloneUser(u)
+
+	return nil
+}
+
+// Delete soft-deletes a user by ID
+func (s *UserStore) Delete(_ context.Context, id string) error {
+	s.db.mu.Lock()
+	defer s.db.mu.Unlock()
+
+	u := s.db.findUser(id)
+	if u == nil || u.DeletedAt.Valid {
+		return fmt.Errorf("delete user: %w", common.NewNotFoundError("delete", "user", id))
+	}
+
+	u.DeletedAt = gorm.DeletedAt{Time: now(), Valid: true}
+
+	return nil
+}
+
+// List returns a page of users ordered by ID
+func (s *UserStore) List(_ context.Context, offset, limit int) ([]*entities.User, error) {
+	return s.page(func(*entities.User) bool { return true }, offset, limit), nil
+}
+
+// ListPaginated returns a paginated list of users
+func (s *UserStore) ListPaginated(_ context.Context, params common.PaginationParams) common.PaginationResult {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	users := s.db.liveUsers(func(*entities.User) bool { return true })
+
+	return common.NewPaginationResult(window(users, params.GetOffset(), params.GetLimit()), len(users), params.Page, params.PageSize)
+}
+
+// page returns the users matching fn ordered by ID, after offset and up to limit
+func (s *UserStore) page(fn func(*entities.User) bool, offset, limit int) []*entities.User {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	return window(s.db.liveUsers(fn), offset, limit)
+}
+
+// Count returns the total number of users
+func (s *UserStore) Count(_ context.Context) (int, error) {
+	s.db.mu.RLock()
+	defer s.db.mu.RUnlock()
+
+	return len(s.db.liveUsers(func(*entities.User) bool { return true })), nil
+}
+
+// GetByUsername retrieves a user by username. Users have no username, so none is found.
+func (s *UserStore) GetByUsername(_ context.Context, username string) (*entities.User, error) {
+	return nil, fmt.Errorf("get user by username: %w", common.NewNotFoundError("get_by_username", "user", username))
+}
+
+// GetByRole retrieves users by role
+func (s *UserStore) GetByRole(_ context.Context, role string, offset, limit int) ([]*entities.User, error) {
+	return s.page(func(u *entities.User) bool { return u.Role == role }, offset, limit), nil
+}
+
+// GetActiveUsers retrieves all active users
+func (s *UserStore) GetActiveUsers(_ context.Context, offset, limit int) ([]*entities.User, error) {
+	return s.page(func(u *entities.User) bool { return u.Active }, offset, limit), nil
+}
+
+// GetInactiveUsers retrieves all inactive users
+func (s *UserStore) GetInactiveUsers(_ context.Context, offset, limit int) ([]*entities.User, error) {
+	return s.page(func(u *entities.User) bool { return !u.Active }, offset, limit), nil
+}
+
+// Search finds users whose name or email contains the query, ignoring case
+func (s *UserStore) Search(_ context.Context, query string, offset, limit int) ([]*entities.User, error) {
+	needle := strings.ToLower(query)
+
+	return s.page(func(u *entities.User) bool {
+		return strings.Contains(strings.ToLower(u.FirstName), needle) ||
+			strings.Contains(strings.ToLower(u.LastName), needle) ||
+			strings.Contains(strings.ToLower(u.Email), needle)
+	}, offset, limit), nil
+}
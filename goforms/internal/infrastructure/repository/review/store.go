@@ -0,0 +1,167 @@
+// Package repository provides the submission review repository implementation
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/domain/review"
+	"github.com/goformx/goforms/internal/infrastructure/database"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+// Store implements review.Repository interface
+type Store struct {
+	db     database.DB
+	logger logging.Logger
+}
+
+// NewStore creates a new submission review store
+func NewStore(db database.DB, logger logging.Logger) review.Repository {
+	return &Store{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// GetSubmission returns a form's submission with its tags
+func (s *Store) GetSubmission(ctx context.Context, formID, submissionID string) (*model.FormSubmission, error) {
+	db := s.db.GetDB().WithContext(ctx)
+
+	var submission model.FormSubmission
+	if err := db.Where("uuid = ? AND form_id = ?", submissionID, formID).First(&submission).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, review.ErrSubmissionNotFound
+		}
+
+		return nil, fmt.Errorf("get submission: %w", common.NewDatabaseError("get", "form_submission", submissionID, err))
+	}
+
+	var tags []model.SubmissionTag
+	if err := db.Where("submission_id = ?", submissionID).Order("tag ASC").Find(&tags).Error; err != nil {
+		return nil, fmt.Errorf("load submission tags: %w", common.NewDatabaseError("list", "submission_tag", submissionID, err))
+	}
+
+	submission.Tags = make([]string, len(tags))
+	for i, tag := range tags {
+		submission.Tags[i] = tag.Tag
+	}
+
+	return &submission, nil
+}
+
+// SaveReview saves the submission's review status and assignee, and replaces its tags unless tags is
+// nil, only while its stored review status is from
+func (s *Store) SaveReview(ctx context.Context, submission *model.FormSubmission, from string, tags []string) (bool, error) {
+	saved := false
+
+	err := s.db.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		current := func() *gorm.DB {
+			return tx.Model(&model.FormSubmission{}).
+				Where("uuid = ? AND form_id = ? AND review_status = ?", submission.ID, submission.FormID, from)
+		}
+
+		result := current().Updates(map[string]any{
+			"review_status": submission.ReviewStatus,
+			"assignee_id":   submission.AssigneeID,
+		})
+		if result.Error != nil {
+			return fmt.Errorf("update review state: %w", result.Error)
+		}
+
+		// MariaDB counts changed rows rather than matched ones, so an update that changes nothing
+		// reports no rows; look the row up before treating it as a conflict
+		if result.RowsAffected == 0 {
+			var matched int64
+			if err := current().Count(&matched).Error; err != nil {
+				return fmt.Errorf("check review state: %w", err)
+			}
+
+			if matched == 0 {
+				return nil
+			}
+		}
+
+		saved = true
+
+		if tags == nil {
+			return nil
+		}
+
+		if err := tx.Where("submission_id = ?", submission.ID).Delete(&model.SubmissionTag{}).Error; err != nil {
+			return fmt.Errorf("delete submission tags: %w", err)
+		}
+
+		if len(tags) == 0 {
+			return nil
+		}
+
+		rows := make([]model.SubmissionTag, len(tags))
+		for i, tag := range tags {
+			rows[i] = model.SubmissionTag{SubmissionID: submission.ID, Tag: tag}
+		}
+
+		if err := tx.Create(&rows).Error; err != nil {
+			return fmt.Errorf("create submission tags: %w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to save submission review", "submission_id", submission.ID, "error", err)
+
+		return false, fmt.Errorf("save submission review: %w", common.NewDatabaseError("update", "form_submission", submission.ID, err))
+	}
+
+	return saved, nil
+}
+
+// CreateNote persists a new note
+func (s *Store) CreateNote(ctx context.Context, note *review.Note) error {
+	if err := s.db.GetDB().WithContext(ctx).Create(note).Error; err != nil {
+		return fmt.Errorf("create submission note: %w", common.NewDatabaseError("create", "submission_note", note.ID, err))
+	}
+
+	return nil
+}
+
+// GetNote returns a note by ID
+func (s *Store) GetNote(ctx context.Context, noteID string) (*review.Note, error) {
+	var note review.Note
+	if err := s.db.GetDB().WithContext(ctx).Where("uuid = ?", noteID).First(&note).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, review.ErrNoteNotFound
+		}
+
+		return nil, fmt.Errorf("get submission note: %w", common.NewDatabaseError("get", "submission_note", noteID, err))
+	}
+
+	return &note, nil
+}
+
+// ListNotes returns a submission's notes, oldest first
+func (s *Store) ListNotes(ctx context.Context, submissionID string) ([]*review.Note, error) {
+	var notes []*review.Note
+	if err := s.db.GetDB().WithContext(ctx).
+		Where("submission_id = ?", submissionID).
+		Order("created_at ASC, uuid ASC").
+		Find(&notes).Error; err != nil {
+		return nil, fmt.Errorf("list submission notes: %w", common.NewDatabaseError("list", "submission_note", submissionID, err))
+	}
+
+	return notes, nil
+}
+
+// DeleteNote removes a note
+func (s *Store) DeleteNote(ctx context.Context, noteID string) error {
+	if err := s.db.GetDB().WithContext(ctx).Where("uuid = ?", noteID).Delete(&review.Note{}).Error; err != nil {
+		return fmt.Errorf("delete submission note: %w", common.NewDatabaseError("delete", "submission_note", noteID, err))
+	}
+
+	return nil
+}
@@ -257,12 +257,16 @@ func (s *Store) GetInactiveUsers(ctx context.Context, offset, limit int) ([]*ent
 	return users, nil
 }
 
-// Search searches users by name or email
+// Search finds users whose name or email contains the query, ignoring case
 func (s *Store) Search(ctx context.Context, query string, offset, limit int) ([]*entities.User, error) {
 	var users []*entities.User
 
-	result := s.db.GetDB().WithContext(ctx).
-		Where("name LIKE ? OR email LIKE ?", "%"+query+"%", "%"+query+"%").
+	db := s.db.GetDB().WithContext(ctx)
+	pattern := common.ContainsPattern(query)
+
+	result := db.
+		Where(common.TextContains(db, "first_name")+" OR "+common.TextContains(db, "last_name")+" OR "+
+			common.TextContains(db, "email"), pattern, pattern, pattern).
 		Order("uuid").
 		Offset(offset).
 		Limit(limit).
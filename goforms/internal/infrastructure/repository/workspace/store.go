@@ -0,0 +1,200 @@
+// Package repository provides the workspace repository implementation
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/goformx/goforms/internal/domain/workspace"
+	"github.com/goformx/goforms/internal/infrastructure/database"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+)
+
+// Store implements workspace.Repository interface
+type Store struct {
+	db     database.DB
+	logger logging.Logger
+}
+
+// NewStore creates a new workspace store
+func NewStore(db database.DB, logger logging.Logger) workspace.Repository {
+	return &Store{
+		db:     db,
+		logger: logger,
+	}
+}
+
+// CreateWorkspace persists a workspace together with its initial owner membership
+func (s *Store) CreateWorkspace(ctx context.Context, ws *workspace.Workspace, owner *workspace.Member) error {
+	err := s.db.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(ws).Error; err != nil {
+			return err
+		}
+
+		owner.WorkspaceID = ws.ID
+
+		return tx.Create(owner).Error
+	})
+	if err != nil {
+		s.logger.Error("failed to create workspace", "workspace_id", ws.ID, "error", err)
+
+		return fmt.Errorf("create workspace: %w", common.NewDatabaseError("create", "workspace", ws.ID, err))
+	}
+
+	return nil
+}
+
+// GetWorkspace returns a workspace by ID
+func (s *Store) GetWorkspace(ctx context.Context, id string) (*workspace.Workspace, error) {
+	var ws workspace.Workspace
+	if err := s.db.GetDB().WithContext(ctx).Where("uuid = ?", id).First(&ws).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("get workspace: %w", common.NewNotFoundError("get", "workspace", id))
+		}
+
+		return nil, fmt.Errorf("get workspace: %w", common.NewDatabaseError("get", "workspace", id, err))
+	}
+
+	return &ws, nil
+}
+
+// ListWorkspacesByUser returns all workspaces the user is a member of
+func (s *Store) ListWorkspacesByUser(ctx context.Context, userID string) ([]*workspace.Workspace, error) {
+	var workspaces []*workspace.Workspace
+	if err := s.db.GetDB().WithContext(ctx).
+		Joins("JOIN workspace_members ON workspace_members.workspace_id = workspaces.uuid").
+		Where("workspace_members.user_id = ?", userID).
+		Order("workspaces.created_at ASC").
+		Find(&workspaces).Error; err != nil {
+		return nil, fmt.Errorf("list workspaces: %w", common.NewDatabaseError("list", "workspace", "", err))
+	}
+
+	return workspaces, nil
+}
+
+// GetMember returns a user's membership in a workspace
+func (s *Store) GetMember(ctx context.Context, workspaceID, userID string) (*workspace.Member, error) {
+	var member workspace.Member
+	if err := s.db.GetDB().WithContext(ctx).
+		Where("workspace_id = ? AND user_id = ?", workspaceID, userID).
+		First(&member).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("get workspace member: %w",
+				common.NewNotFoundError("get", "workspace_member", workspaceID))
+		}
+
+		return nil, fmt.Errorf("get workspace member: %w",
+			common.NewDatabaseError("get", "workspace_member", workspaceID, err))
+	}
+
+	return &member, nil
+}
+
+// ListMembers returns all members of a workspace
+func (s *Store) ListMembers(ctx context.Context, workspaceID string) ([]*workspace.Member, error) {
+	var members []*workspace.Member
+	if err := s.db.GetDB().WithContext(ctx).
+		Where("workspace_id = ?", workspaceID).
+		Order("created_at ASC").
+		Find(&members).Error; err != nil {
+		return nil, fmt.Errorf("list workspace members: %w",
+			common.NewDatabaseError("list", "workspace_member", workspaceID, err))
+	}
+
+	return members, nil
+}
+
+// SaveMember creates or updates a membership, refusing to demote the last owner
+func (s *Store) SaveMember(ctx context.Context, member *workspace.Member) error {
+	err := s.withOwnersLocked(ctx, member.WorkspaceID, func(tx *gorm.DB, owners []string) error {
+		if member.Role != workspace.RoleOwner && isLastOwner(owners, member.UserID) {
+			return workspace.ErrLastOwner
+		}
+
+		return tx.Clauses(clause.OnConflict{
+			Columns:   []clause.Column{{Name: "workspace_id"}, {Name: "user_id"}},
+			DoUpdates: clause.AssignmentColumns([]string{"role", "updated_at"}),
+		}).Create(member).Error
+	})
+	if err != nil {
+		if errors.Is(err, workspace.ErrLastOwner) {
+			return err
+		}
+
+		return fmt.Errorf("save workspace member: %w",
+			common.NewDatabaseError("save", "workspace_member", member.WorkspaceID, err))
+	}
+
+	return nil
+}
+
+// DeleteMember removes a membership, refusing to remove the last owner
+func (s *Store) DeleteMember(ctx context.Context, workspaceID, userID string) error {
+	err := s.withOwnersLocked(ctx, workspaceID, func(tx *gorm.DB, owners []string) error {
+		if isLastOwner(owners, userID) {
+			return workspace.ErrLastOwner
+		}
+
+		result := tx.Where("workspace_id = ? AND user_id = ?", workspaceID, userID).Delete(&workspace.Member{})
+		if result.Error == nil && result.RowsAffected == 0 {
+			return common.NewNotFoundError("delete", "workspace_member", workspaceID)
+		}
+
+		return result.Error
+	})
+	if err != nil {
+		if errors.Is(err, workspace.ErrLastOwner) || errors.Is(err, common.ErrNotFound) {
+			return fmt.Errorf("delete workspace member: %w", err)
+		}
+
+		return fmt.Errorf("delete workspace member: %w",
+			common.NewDatabaseError("delete", "workspace_member", workspaceID, err))
+	}
+
+	return nil
+}
+
+// withOwnersLocked runs change in a transaction holding row locks on the workspace's owner memberships.
+// Concurrent changes to different owners queue on the locks and each sees the owners left by the other,
+// so together they cannot remove every owner. SQLite has no row locks; its database lock keeps
+// overlapping writers from both committing.
+func (s *Store) withOwnersLocked(
+	ctx context.Context,
+	workspaceID string,
+	change func(tx *gorm.DB, owners []string) error,
+) error {
+	return s.db.GetDB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var owners []string
+		if err := tx.Model(&workspace.Member{}).
+			Clauses(clause.Locking{Strength: clause.LockingStrengthUpdate}).
+			Where("workspace_id = ? AND role = ?", workspaceID, workspace.RoleOwner).
+			Pluck("user_id", &owners).Error; err != nil {
+			return err
+		}
+
+		return change(tx, owners)
+	})
+}
+
+// isLastOwner reports whether userID is the only one of the owners
+func isLastOwner(owners []string, userID string) bool {
+	return len(owners) == 1 && owners[0] == userID
+}
+
+// UpdatePlanTier records the plan tier the workspace's usage is charged to
+func (s *Store) UpdatePlanTier(ctx context.Context, workspaceID, planTier string) error {
+	if err := s.db.GetDB().WithContext(ctx).
+		Model(&workspace.Workspace{}).
+		Where("uuid = ?", workspaceID).
+		Update("plan_tier", planTier).Error; err != nil {
+		return fmt.Errorf("update workspace plan tier: %w",
+			common.NewDatabaseError("update", "workspace", workspaceID, err))
+	}
+
+	return nil
+}
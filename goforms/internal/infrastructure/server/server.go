@@ -31,6 +31,8 @@ type Server struct {
 	logger logging.Logger
 	config *config.Config
 	server *http.Server
+
+	onShutdown []func()
 }
 
 // URL returns the server's full HTTP URL
@@ -38,6 +40,13 @@ func (s *Server) URL() string {
 	return s.config.App.GetServerURL()
 }
 
+// OnShutdown registers f to run when the server starts shutting down. Shutdown waits for active
+// requests, so handlers of long-lived requests, such as event streams, use it to end them.
+// Functions must be registered before Start.
+func (s *Server) OnShutdown(f func()) {
+	s.onShutdown = append(s.onShutdown, f)
+}
+
 // Start starts the server and returns when it's ready to accept connections
 func (s *Server) Start(ctx context.Context) error {
 	// Extract host and port from the URL for the HTTP server
@@ -52,6 +61,10 @@ func (s *Server) Start(ctx context.Context) error {
 		ReadHeaderTimeout: s.config.App.ReadTimeout,
 	}
 
+	for _, f := range s.onShutdown {
+		s.server.RegisterOnShutdown(f)
+	}
+
 	// Create channels for server startup coordination
 	started := make(chan struct{})
 	errored := make(chan error, 1)
@@ -5,9 +5,12 @@ package main
 
 import (
 	"context"
+	"errors"
+	"flag"
 	"fmt"
 	"os"
 	"os/signal"
+	"strings"
 	"syscall"
 	"time"
 
@@ -18,6 +21,7 @@ import (
 	"github.com/goformx/goforms/internal/application/handlers/web"
 	appmiddleware "github.com/goformx/goforms/internal/application/middleware"
 	"github.com/goformx/goforms/internal/application/middleware/access"
+	"github.com/goformx/goforms/internal/cli"
 	"github.com/goformx/goforms/internal/domain"
 	"github.com/goformx/goforms/internal/infrastructure"
 	"github.com/goformx/goforms/internal/infrastructure/config"
@@ -41,6 +45,7 @@ type appParams struct {
 	MiddlewareManager *appmiddleware.Manager
 	AccessManager     *access.Manager
 	Config            *config.Config
+	ConfigSource      *config.ViperConfig
 
 	// New middleware system components
 	MigrationAdapter *appmiddleware.MigrationAdapter
@@ -86,6 +91,11 @@ func setupLifecycle(p appParams) {
 				"git_commit", v.GitCommit,
 			)
 
+			// Names where secrets came from, never their values
+			if sources := p.ConfigSource.SecretSources(); len(sources) > 0 {
+				p.Logger.Info("resolved configuration secrets", "sources", sources)
+			}
+
 			status := p.MigrationAdapter.GetMigrationStatus()
 			p.Logger.Info("middleware system status",
 				"new_system_enabled", status.NewSystemEnabled,
@@ -119,24 +129,65 @@ func setupLifecycle(p appParams) {
 	})
 }
 
-// main initializes the Fx application and manages graceful shutdown.
+// main runs an operational subcommand when one is given, and the server otherwise.
+// Flags without a command, as in goforms --storage=memory, are serve flags.
 func main() {
+	args := os.Args[1:]
+	if len(args) > 0 && args[0] != "serve" && !isServeFlag(args[0]) {
+		os.Exit(cli.Run(context.Background(), args, cli.Streams{In: os.Stdin, Out: os.Stdout, Err: os.Stderr}))
+	}
+
+	if len(args) > 0 && args[0] == "serve" {
+		args = args[1:]
+	}
+
+	opts, err := parseServeFlags(args, os.Stderr)
+	if err != nil {
+		if errors.Is(err, flag.ErrHelp) {
+			os.Exit(0)
+		}
+
+		fmt.Fprintf(os.Stderr, "serve: %v\n", err)
+		os.Exit(cli.ExitUsage)
+	}
+
+	serve(opts)
+}
+
+// isServeFlag reports whether an argument is a flag of the serve command rather than a request for help
+func isServeFlag(arg string) bool {
+	return strings.HasPrefix(arg, "-") && arg != "-h" && arg != "-help" && arg != "--help"
+}
+
+// serve initializes the Fx application and manages graceful shutdown.
+func serve(opts serveOptions) {
+	storage, cleanup, err := storageOption(opts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "application startup failed: %v\n", err)
+		os.Exit(1)
+	}
+
 	app := fx.New(
 		// Modules
 		config.Module,
+		config.ReloadModule,
 		infrastructure.Module,
 		domain.Module,
 		application.Module,
 		appmiddleware.Module,
 		web.Module,
 
+		// Storage backend selected with --storage
+		storage,
+
 		// Setup
 		fx.Invoke(setupApplication),
 		fx.Invoke(setupLifecycle),
 	)
 
-	if err := app.Start(context.Background()); err != nil {
+	if err = app.Start(context.Background()); err != nil {
 		fmt.Fprintf(os.Stderr, "application startup failed: %v\n", err)
+		cleanup()
 		os.Exit(1)
 	}
 
@@ -145,7 +196,10 @@ func main() {
 	signal.Notify(sigChan, syscall.SIGINT, syscall.SIGTERM)
 	<-sigChan
 
-	if err := app.Stop(context.Background()); err != nil {
+	err = app.Stop(context.Background())
+	cleanup()
+
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "application shutdown failed: %v\n", err)
 		os.Exit(1)
 	}
@@ -0,0 +1,34 @@
+// Package migrations embeds the SQL migrations of every supported database so the
+// binary can apply them without the migration files on disk.
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+)
+
+//go:embed postgresql/*.sql mariadb/*.sql sqlite/*.sql
+var files embed.FS
+
+// dirs maps database drivers to the directory holding their migrations
+var dirs = map[string]string{
+	"postgres": "postgresql",
+	"mariadb":  "mariadb",
+	"sqlite":   "sqlite",
+}
+
+// ForDriver returns the migrations of the configured database driver
+func ForDriver(driver string) (fs.FS, error) {
+	dir, ok := dirs[driver]
+	if !ok {
+		return nil, fmt.Errorf("no migrations for database driver %q", driver)
+	}
+
+	sub, err := fs.Sub(files, dir)
+	if err != nil {
+		return nil, fmt.Errorf("open %s migrations: %w", driver, err)
+	}
+
+	return sub, nil
+}
@@ -0,0 +1,23 @@
+-- Create append-only audit_logs table; each row is hash-chained to its predecessor
+CREATE TABLE IF NOT EXISTS audit_logs (
+    uuid VARCHAR(36) PRIMARY KEY,
+    sequence BIGINT NOT NULL,
+    occurred_at DATETIME(6) NOT NULL,
+    actor_id VARCHAR(255) NOT NULL,
+    owner_id VARCHAR(255) NOT NULL DEFAULT '',
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    request_id VARCHAR(255) NOT NULL DEFAULT '',
+    action VARCHAR(64) NOT NULL,
+    resource_type VARCHAR(32) NOT NULL,
+    resource_id VARCHAR(255) NOT NULL DEFAULT '',
+    `before` JSON NULL,
+    `after` JSON NULL,
+    prev_hash CHAR(64) NOT NULL,
+    hash CHAR(64) NOT NULL,
+    UNIQUE KEY idx_audit_logs_sequence (sequence)
+);
+
+CREATE INDEX IF NOT EXISTS idx_audit_logs_owner_id ON audit_logs (owner_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_actor_id ON audit_logs (actor_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_resource ON audit_logs (resource_type, resource_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_occurred_at ON audit_logs (occurred_at);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_forms_workspace_id ON forms;
+ALTER TABLE forms DROP COLUMN workspace_id;
+DROP TABLE IF EXISTS workspace_members;
+DROP TABLE IF EXISTS workspaces;
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_form_submissions_form_submitted ON form_submissions;
+DROP INDEX IF EXISTS idx_forms_workspace_created ON forms;
+DROP INDEX IF EXISTS idx_forms_user_created ON forms;
+DROP TABLE IF EXISTS form_tags;
@@ -0,0 +1,15 @@
+-- Create form_tags table; tags are stored lowercased and unique per form
+CREATE TABLE IF NOT EXISTS form_tags (
+    form_id VARCHAR(36) NOT NULL,
+    tag VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (form_id, tag),
+    FOREIGN KEY (form_id) REFERENCES forms (uuid) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_form_tags_tag ON form_tags (tag, form_id);
+
+-- Keyset pagination scans forms and submissions in sort order within an owner
+CREATE INDEX IF NOT EXISTS idx_forms_user_created ON forms (user_id, created_at, uuid);
+CREATE INDEX IF NOT EXISTS idx_forms_workspace_created ON forms (workspace_id, created_at, uuid);
+CREATE INDEX IF NOT EXISTS idx_form_submissions_form_submitted ON form_submissions (form_id, submitted_at, uuid);
@@ -0,0 +1,12 @@
+-- Create sessions table; session IDs are stored hashed so the table never holds usable cookies
+CREATE TABLE IF NOT EXISTS sessions (
+    id_hash VARCHAR(64) PRIMARY KEY,
+    user_id VARCHAR(255) NOT NULL,
+    email VARCHAR(255) NOT NULL DEFAULT '',
+    role VARCHAR(32) NOT NULL DEFAULT '',
+    created_at DATETIME(6) NOT NULL,
+    expires_at DATETIME(6) NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_sessions_user_id ON sessions (user_id);
+CREATE INDEX IF NOT EXISTS idx_sessions_expires_at ON sessions (expires_at);
@@ -0,0 +1,2 @@
+-- Workspace usage is charged to the plan of its owners, recorded when an owner acts in the workspace
+ALTER TABLE workspaces ADD COLUMN plan_tier VARCHAR(20) NOT NULL DEFAULT 'free';
@@ -0,0 +1,36 @@
+-- Create append-only audit_logs table; each row is hash-chained to its predecessor
+CREATE TABLE IF NOT EXISTS audit_logs (
+    uuid VARCHAR(36) PRIMARY KEY,
+    sequence BIGINT NOT NULL,
+    occurred_at TIMESTAMP(6) NOT NULL,
+    actor_id VARCHAR(255) NOT NULL,
+    owner_id VARCHAR(255) NOT NULL DEFAULT '',
+    ip_address VARCHAR(45) NOT NULL DEFAULT '',
+    request_id VARCHAR(255) NOT NULL DEFAULT '',
+    action VARCHAR(64) NOT NULL,
+    resource_type VARCHAR(32) NOT NULL,
+    resource_id VARCHAR(255) NOT NULL DEFAULT '',
+    before JSONB NULL,
+    after JSONB NULL,
+    prev_hash CHAR(64) NOT NULL,
+    hash CHAR(64) NOT NULL
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_audit_logs_sequence ON audit_logs (sequence);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_owner_id ON audit_logs (owner_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_actor_id ON audit_logs (actor_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_resource ON audit_logs (resource_type, resource_id);
+CREATE INDEX IF NOT EXISTS idx_audit_logs_occurred_at ON audit_logs (occurred_at);
+
+-- Reject in-place modification of audit rows
+CREATE OR REPLACE FUNCTION prevent_audit_logs_mutation()
+RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'audit_logs is append-only';
+END;
+$$ language 'plpgsql';
+
+CREATE TRIGGER audit_logs_append_only
+    BEFORE UPDATE OR DELETE ON audit_logs
+    FOR EACH ROW
+    EXECUTE FUNCTION prevent_audit_logs_mutation();
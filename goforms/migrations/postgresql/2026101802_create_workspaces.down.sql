@@ -0,0 +1,6 @@
+DROP INDEX IF EXISTS idx_forms_workspace_id;
+ALTER TABLE forms DROP COLUMN workspace_id;
+DROP TRIGGER IF EXISTS update_workspace_members_updated_at ON workspace_members;
+DROP TABLE IF EXISTS workspace_members;
+DROP TRIGGER IF EXISTS update_workspaces_updated_at ON workspaces;
+DROP TABLE IF EXISTS workspaces;
@@ -0,0 +1,2 @@
+DROP TRIGGER IF EXISTS update_api_keys_updated_at ON api_keys;
+DROP TABLE IF EXISTS api_keys;
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS bulk_job_outputs;
+DROP TRIGGER IF EXISTS update_bulk_jobs_updated_at ON bulk_jobs;
+DROP TABLE IF EXISTS bulk_jobs;
@@ -0,0 +1,42 @@
+-- Create bulk_jobs table; each job records the last submission of its last committed chunk
+-- so that a failed job can resume without applying a chunk twice
+CREATE TABLE IF NOT EXISTS bulk_jobs (
+    uuid VARCHAR(36) PRIMARY KEY,
+    form_id VARCHAR(36) NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    operation VARCHAR(32) NOT NULL,
+    selection_ids TEXT NULL,
+    selection_status VARCHAR(20) NULL,
+    selection_submitted_after TIMESTAMP NULL,
+    selection_submitted_before TIMESTAMP NULL,
+    target_status VARCHAR(20) NULL,
+    export_format VARCHAR(10) NULL,
+    export_columns TEXT NULL,
+    status VARCHAR(20) NOT NULL,
+    total BIGINT NOT NULL DEFAULT 0,
+    processed BIGINT NOT NULL DEFAULT 0,
+    checkpoint VARCHAR(36) NULL,
+    error VARCHAR(1000) NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    started_at TIMESTAMP NULL,
+    completed_at TIMESTAMP NULL,
+    FOREIGN KEY (form_id) REFERENCES forms (uuid) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_bulk_jobs_form_created ON bulk_jobs (form_id, created_at);
+
+-- Export output is appended one chunk at a time, in the same transaction as the job's progress
+CREATE TABLE IF NOT EXISTS bulk_job_outputs (
+    job_id VARCHAR(36) NOT NULL,
+    position BIGINT NOT NULL,
+    data TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (job_id, position),
+    FOREIGN KEY (job_id) REFERENCES bulk_jobs (uuid) ON DELETE CASCADE
+);
+
+CREATE TRIGGER update_bulk_jobs_updated_at
+    BEFORE UPDATE ON bulk_jobs
+    FOR EACH ROW
+    EXECUTE FUNCTION update_updated_at_column();
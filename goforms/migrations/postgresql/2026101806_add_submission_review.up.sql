@@ -0,0 +1,33 @@
+-- Forms may configure their own review workflow; NULL means the default one
+ALTER TABLE forms ADD COLUMN review_workflow JSONB NULL;
+
+-- Review state is tracked separately from the processing status of a submission
+ALTER TABLE form_submissions ADD COLUMN review_status VARCHAR(50) NOT NULL DEFAULT '';
+ALTER TABLE form_submissions ADD COLUMN assignee_id VARCHAR(255) NOT NULL DEFAULT '';
+UPDATE form_submissions SET review_status = 'new' WHERE review_status = '';
+
+CREATE INDEX IF NOT EXISTS idx_form_submissions_form_review_status ON form_submissions (form_id, review_status);
+CREATE INDEX IF NOT EXISTS idx_form_submissions_form_assignee ON form_submissions (form_id, assignee_id);
+
+-- Create submission_tags table; tags are stored lowercased
+CREATE TABLE IF NOT EXISTS submission_tags (
+    submission_id VARCHAR(36) NOT NULL,
+    tag VARCHAR(50) NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    PRIMARY KEY (submission_id, tag),
+    FOREIGN KEY (submission_id) REFERENCES form_submissions (uuid) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_submission_tags_tag ON submission_tags (tag, submission_id);
+
+-- Create submission_notes table for internal notes left by the form's team
+CREATE TABLE IF NOT EXISTS submission_notes (
+    uuid VARCHAR(36) PRIMARY KEY,
+    submission_id VARCHAR(36) NOT NULL,
+    author_id VARCHAR(255) NOT NULL,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    FOREIGN KEY (submission_id) REFERENCES form_submissions (uuid) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS idx_submission_notes_submission_id ON submission_notes (submission_id, created_at);
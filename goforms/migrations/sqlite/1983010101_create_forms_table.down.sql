@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_forms_user_id;
+DROP TABLE IF EXISTS forms;
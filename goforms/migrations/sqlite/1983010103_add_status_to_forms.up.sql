@@ -0,0 +1,2 @@
+-- Add status column to forms table
+ALTER TABLE forms ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'draft';
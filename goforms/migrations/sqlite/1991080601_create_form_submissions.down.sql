@@ -0,0 +1,2 @@
+DROP INDEX IF EXISTS idx_form_submissions_form_id;
+DROP TABLE IF EXISTS form_submissions;
@@ -0,0 +1,3 @@
+ALTER TABLE forms DROP COLUMN cors_headers;
+ALTER TABLE forms DROP COLUMN cors_methods;
+ALTER TABLE forms DROP COLUMN cors_origins;
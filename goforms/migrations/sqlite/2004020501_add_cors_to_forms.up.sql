@@ -0,0 +1,4 @@
+-- Add CORS settings to forms table; SQLite adds one column per statement
+ALTER TABLE forms ADD COLUMN cors_origins JSON DEFAULT '[]';
+ALTER TABLE forms ADD COLUMN cors_methods JSON DEFAULT '["GET", "POST", "OPTIONS"]';
+ALTER TABLE forms ADD COLUMN cors_headers JSON DEFAULT '["Content-Type", "Accept", "Origin"]';
@@ -0,0 +1,2 @@
+ALTER TABLE form_submissions DROP COLUMN metadata;
+ALTER TABLE form_submissions DROP COLUMN status;
@@ -0,0 +1,3 @@
+-- Add status and metadata columns to form_submissions
+ALTER TABLE form_submissions ADD COLUMN status VARCHAR(20) NOT NULL DEFAULT 'pending';
+ALTER TABLE form_submissions ADD COLUMN metadata JSON;
@@ -0,0 +1 @@
+ALTER TABLE forms DROP COLUMN plan_tier;
@@ -0,0 +1,21 @@
+-- Create api_keys table; only the SHA-256 hash of each key is stored
+CREATE TABLE IF NOT EXISTS api_keys (
+    uuid VARCHAR(36) PRIMARY KEY,
+    name VARCHAR(100) NOT NULL,
+    prefix VARCHAR(16) NOT NULL,
+    hash VARCHAR(64) NOT NULL,
+    form_id VARCHAR(36) NULL,
+    workspace_id VARCHAR(36) NULL,
+    permissions VARCHAR(255) NOT NULL,
+    created_by VARCHAR(255) NOT NULL,
+    expires_at TIMESTAMP NULL,
+    last_used_at TIMESTAMP NULL,
+    revoked_at TIMESTAMP NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS idx_api_keys_hash ON api_keys (hash);
+CREATE INDEX IF NOT EXISTS idx_api_keys_form_id ON api_keys (form_id);
+CREATE INDEX IF NOT EXISTS idx_api_keys_workspace_id ON api_keys (workspace_id);
+CREATE INDEX IF NOT EXISTS idx_api_keys_expires_at ON api_keys (expires_at);
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS idx_form_submissions_form_submitted;
+DROP INDEX IF EXISTS idx_forms_workspace_created;
+DROP INDEX IF EXISTS idx_forms_user_created;
+DROP TABLE IF EXISTS form_tags;
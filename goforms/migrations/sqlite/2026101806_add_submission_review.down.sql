@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS submission_notes;
+DROP TABLE IF EXISTS submission_tags;
+DROP INDEX IF EXISTS idx_form_submissions_form_assignee;
+DROP INDEX IF EXISTS idx_form_submissions_form_review_status;
+ALTER TABLE form_submissions DROP COLUMN assignee_id;
+ALTER TABLE form_submissions DROP COLUMN review_status;
+ALTER TABLE forms DROP COLUMN review_workflow;
@@ -0,0 +1,13 @@
+-- Create idempotency_keys table; keys are hashed together with the caller and route they were sent to
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    key_hash VARCHAR(64) PRIMARY KEY,
+    fingerprint VARCHAR(64) NOT NULL,
+    status VARCHAR(16) NOT NULL,
+    response_status INT NOT NULL DEFAULT 0,
+    response_headers TEXT NULL,
+    response_body BLOB NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    expires_at TIMESTAMP NOT NULL
+);
+
+CREATE INDEX IF NOT EXISTS idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
@@ -0,0 +1,124 @@
+package client
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	headerUserID      = "X-User-Id"
+	headerTimestamp   = "X-Timestamp"
+	headerSignature   = "X-Signature"
+	headerPlanTier    = "X-Plan-Tier"
+	headerWorkspaceID = "X-Workspace-Id"
+	headerKeyID       = "X-Key-Id"
+	headerNonce       = "X-Nonce"
+
+	// defaultPlanTier is the tier the server assumes without X-Plan-Tier
+	defaultPlanTier = "free"
+
+	// nonceBytes of randomness encode to a 32 character nonce, inside the 16 to 128 the server accepts
+	nonceBytes = 24
+	// idempotencyKeyBytes of randomness make a generated Idempotency-Key
+	idempotencyKeyBytes = 16
+)
+
+// Identity is the user an assertion speaks for
+type Identity struct {
+	UserID string
+	// PlanTier is the user's plan, such as free or pro; empty signs as free
+	PlanTier string
+	// WorkspaceID selects the active workspace; empty means the user's personal forms
+	WorkspaceID string
+}
+
+// Signer signs user assertions with the secret shared with the server
+type Signer struct {
+	// Secret is the shared secret, or the secret of KeyID
+	Secret string
+	// KeyID names the key in the server's key ring; empty uses the single shared secret
+	KeyID string
+	// DisableNonce leaves out X-Nonce. Nonces let the server reject replayed requests and are
+	// required when the server sets assertion.nonce.required.
+	DisableNonce bool
+}
+
+// sign sets the assertion headers of req. The payload matches what assertion.Middleware
+// verifies: METHOD:PATH:USER_ID:TIMESTAMP:PLAN_TIER, then :WORKSPACE_ID:NONCE when a nonce
+// is sent, or :WORKSPACE_ID when only a workspace is.
+func (s *Signer) sign(req *http.Request, identity Identity) error {
+	planTier := identity.PlanTier
+	if planTier == "" {
+		planTier = defaultPlanTier
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	payload := req.Method + ":" + req.URL.Path + ":" + identity.UserID + ":" + timestamp + ":" + planTier
+
+	var nonce string
+
+	if !s.DisableNonce {
+		var err error
+
+		if nonce, err = randomToken(nonceBytes); err != nil {
+			return err
+		}
+
+		payload += ":" + identity.WorkspaceID + ":" + nonce
+	} else if identity.WorkspaceID != "" {
+		payload += ":" + identity.WorkspaceID
+	}
+
+	mac := hmac.New(sha256.New, []byte(s.Secret))
+	mac.Write([]byte(payload))
+
+	req.Header.Set(headerUserID, identity.UserID)
+	req.Header.Set(headerTimestamp, timestamp)
+	req.Header.Set(headerSignature, hex.EncodeToString(mac.Sum(nil)))
+	req.Header.Set(headerPlanTier, planTier)
+
+	if identity.WorkspaceID != "" {
+		req.Header.Set(headerWorkspaceID, identity.WorkspaceID)
+	}
+
+	if s.KeyID != "" {
+		req.Header.Set(headerKeyID, s.KeyID)
+	}
+
+	if nonce != "" {
+		req.Header.Set(headerNonce, nonce)
+	}
+
+	return nil
+}
+
+// randomToken returns n random bytes as unpadded URL-safe base64
+func randomToken(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("client: generate random token: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// newIdempotencyKey returns the key a create or submit call sends unless the caller chose one
+func newIdempotencyKey(opts []CallOption) (string, error) {
+	var o callOptions
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.idempotencyKey != "" {
+		return o.idempotencyKey, nil
+	}
+
+	return randomToken(idempotencyKeyBytes)
+}
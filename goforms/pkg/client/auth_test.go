@@ -0,0 +1,142 @@
+package client_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/goformx/goforms/internal/application/middleware/assertion"
+	"github.com/goformx/goforms/internal/application/middleware/context"
+	appconfig "github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/pkg/client"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "client-test-secret"
+
+// newAssertionServer serves GET /api/forms/usage/forms-count behind the real assertion
+// middleware and reports the identity it verified
+func newAssertionServer(t *testing.T, assertionCfg appconfig.AssertionConfig, seen *atomic.Value) *httptest.Server {
+	t.Helper()
+
+	cfg := &appconfig.Config{Security: appconfig.SecurityConfig{Assertion: assertionCfg}}
+
+	e := echo.New()
+	e.Use(assertion.NewMiddleware(cfg, nil).Verify())
+	e.GET("/api/forms/usage/forms-count", func(c echo.Context) error {
+		userID, _ := context.GetUserID(c)
+		planTier, _ := context.GetPlanTier(c)
+		workspaceID, _ := context.GetWorkspaceID(c)
+		seen.Store(client.Identity{UserID: userID, PlanTier: planTier, WorkspaceID: workspaceID})
+
+		return c.JSON(http.StatusOK, map[string]any{"success": true, "data": map[string]int{"count": 7}})
+	})
+
+	server := httptest.NewServer(e)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func seenIdentity(t *testing.T, seen *atomic.Value) client.Identity {
+	t.Helper()
+
+	identity, ok := seen.Load().(client.Identity)
+	require.True(t, ok, "the handler was not reached")
+
+	return identity
+}
+
+func TestSigner_PassesAssertionMiddleware(t *testing.T) {
+	tests := []struct {
+		name     string
+		config   appconfig.AssertionConfig
+		signer   client.Signer
+		identity client.Identity
+		wantTier string
+	}{
+		{
+			name:     "nonce and default plan tier",
+			config:   appconfig.AssertionConfig{Secret: testSecret, TimestampSkewSeconds: 60},
+			signer:   client.Signer{Secret: testSecret},
+			identity: client.Identity{UserID: "user-1"},
+			wantTier: "free",
+		},
+		{
+			name:     "workspace without nonce",
+			config:   appconfig.AssertionConfig{Secret: testSecret, TimestampSkewSeconds: 60},
+			signer:   client.Signer{Secret: testSecret, DisableNonce: true},
+			identity: client.Identity{UserID: "user-1", PlanTier: "pro", WorkspaceID: "ws-1"},
+			wantTier: "pro",
+		},
+		{
+			name: "rotating key with required nonce",
+			config: appconfig.AssertionConfig{
+				TimestampSkewSeconds: 60,
+				Keys:                 []appconfig.AssertionKey{{ID: "k2", Secret: "rotated-secret"}},
+				Nonce:                appconfig.AssertionNonceConfig{Required: true},
+			},
+			signer:   client.Signer{Secret: "rotated-secret", KeyID: "k2"},
+			identity: client.Identity{UserID: "user-1", PlanTier: "business", WorkspaceID: "ws-1"},
+			wantTier: "business",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var seen atomic.Value
+
+			server := newAssertionServer(t, tt.config, &seen)
+
+			c, err := client.New(server.URL, client.WithAssertion(tt.signer, tt.identity))
+			require.NoError(t, err)
+
+			// Two calls in a row prove each request carries its own nonce
+			for range 2 {
+				count, countErr := c.CountForms(t.Context())
+				require.NoError(t, countErr)
+				assert.Equal(t, 7, count)
+			}
+
+			got := seenIdentity(t, &seen)
+			assert.Equal(t, tt.identity.UserID, got.UserID)
+			assert.Equal(t, tt.wantTier, got.PlanTier)
+			assert.Equal(t, tt.identity.WorkspaceID, got.WorkspaceID)
+		})
+	}
+}
+
+func TestSigner_WrongSecretIsUnauthorized(t *testing.T) {
+	var seen atomic.Value
+
+	server := newAssertionServer(t, appconfig.AssertionConfig{Secret: testSecret, TimestampSkewSeconds: 60}, &seen)
+
+	c, err := client.New(server.URL,
+		client.WithAssertion(client.Signer{Secret: "wrong"}, client.Identity{UserID: "user-1"}))
+	require.NoError(t, err)
+
+	_, err = c.CountForms(t.Context())
+	require.ErrorIs(t, err, client.ErrUnauthorized)
+	assert.Nil(t, seen.Load())
+}
+
+func TestClient_As(t *testing.T) {
+	var seen atomic.Value
+
+	server := newAssertionServer(t, appconfig.AssertionConfig{Secret: testSecret, TimestampSkewSeconds: 60}, &seen)
+
+	c, err := client.New(server.URL,
+		client.WithAssertion(client.Signer{Secret: testSecret}, client.Identity{UserID: "user-1"}))
+	require.NoError(t, err)
+
+	_, err = c.As(client.Identity{UserID: "user-2"}).CountForms(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "user-2", seenIdentity(t, &seen).UserID)
+
+	_, err = c.CountForms(t.Context())
+	require.NoError(t, err)
+	assert.Equal(t, "user-1", seenIdentity(t, &seen).UserID)
+}
@@ -0,0 +1,347 @@
+// Package client is a typed Go client for the GoFormX API. It signs user assertions the way
+// the Laravel app does, sends API keys to the public and server-to-server routes, retries
+// transient failures without duplicating writes, pages through listings and turns
+// problem+json responses into *Error values that can be matched with errors.Is.
+//
+//	c, err := client.New("https://forms.example.com",
+//		client.WithAssertion(client.Signer{Secret: secret}, client.Identity{UserID: "42", PlanTier: "pro"}),
+//	)
+//	form, err := c.CreateForm(ctx, client.CreateFormRequest{Title: "Contact"})
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// DefaultTimeout bounds each attempt of a request made with the default HTTP client
+	DefaultTimeout = 30 * time.Second
+
+	headerAPIKey         = "X-API-Key"
+	headerIdempotencyKey = "Idempotency-Key"
+	headerRetryAfter     = "Retry-After"
+	contentTypeJSON      = "application/json"
+	contentTypeProblem   = "application/problem+json"
+)
+
+// ErrNoCredentials is returned without a request being sent when a route needs credentials
+// the client was not given
+var ErrNoCredentials = errors.New("client: no credentials for this route")
+
+// Client calls the GoFormX API. It is safe for concurrent use.
+type Client struct {
+	baseURL    *url.URL
+	httpClient *http.Client
+	userAgent  string
+	retry      RetryPolicy
+
+	signer   *Signer
+	identity Identity
+	apiKey   string
+}
+
+// Option configures a Client
+type Option func(*Client)
+
+// WithHTTPClient sends requests through hc instead of a client with DefaultTimeout
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = hc
+	}
+}
+
+// WithAssertion signs requests to the /api/forms routes as identity
+func WithAssertion(signer Signer, identity Identity) Option {
+	return func(c *Client) {
+		c.signer = &signer
+		c.identity = identity
+	}
+}
+
+// WithAPIKey sends key to the public /forms routes and the /api/server routes
+func WithAPIKey(key string) Option {
+	return func(c *Client) {
+		c.apiKey = key
+	}
+}
+
+// WithRetryPolicy replaces DefaultRetryPolicy
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(c *Client) {
+		c.retry = policy
+	}
+}
+
+// WithUserAgent sets the User-Agent header of every request
+func WithUserAgent(userAgent string) Option {
+	return func(c *Client) {
+		c.userAgent = userAgent
+	}
+}
+
+// New creates a client for the API at baseURL, such as https://forms.example.com
+func New(baseURL string, opts ...Option) (*Client, error) {
+	parsed, err := url.Parse(strings.TrimRight(baseURL, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("client: parse base URL: %w", err)
+	}
+
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return nil, fmt.Errorf("client: base URL %q must be http or https", baseURL)
+	}
+
+	c := &Client{
+		baseURL:    parsed,
+		httpClient: &http.Client{Timeout: DefaultTimeout},
+		userAgent:  "goformx-go-client",
+		retry:      DefaultRetryPolicy(),
+	}
+
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return c, nil
+}
+
+// As returns a copy of the client that signs assertions as identity, for services acting
+// on behalf of several users with one signing key
+func (c *Client) As(identity Identity) *Client {
+	clone := *c
+	clone.identity = identity
+
+	return &clone
+}
+
+// CallOption adjusts a single call
+type CallOption func(*callOptions)
+
+type callOptions struct {
+	idempotencyKey string
+}
+
+// WithIdempotencyKey sends key as the Idempotency-Key of a create or submit call instead of
+// a generated one, so a retry after a crash of the caller is still recognised
+func WithIdempotencyKey(key string) CallOption {
+	return func(o *callOptions) {
+		o.idempotencyKey = key
+	}
+}
+
+// authMode is the credentials a route takes
+type authMode int
+
+const (
+	authNone authMode = iota
+	// authAssertion signs the request with the identity's assertion headers
+	authAssertion
+	// authAPIKey requires the API key
+	authAPIKey
+	// authOptionalKey sends the API key when the client has one
+	authOptionalKey
+)
+
+// request is one API call; its body is kept encoded so every attempt sends the same bytes
+type request struct {
+	method string
+	path   string
+	query  url.Values
+	body   []byte
+	auth   authMode
+	// idempotencyKey makes a POST safe to retry
+	idempotencyKey string
+}
+
+func newRequest(method, path string, auth authMode) *request {
+	return &request{method: method, path: path, auth: auth}
+}
+
+func (r *request) withQuery(query url.Values) *request {
+	r.query = query
+
+	return r
+}
+
+func (r *request) withJSON(body any) (*request, error) {
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("client: encode request body: %w", err)
+	}
+
+	r.body = encoded
+
+	return r, nil
+}
+
+// idempotent reports whether sending the request twice has the effect of sending it once
+func (r *request) idempotent() bool {
+	switch r.method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+
+	return r.idempotencyKey != ""
+}
+
+// envelope is the body of every JSON success response
+type envelope struct {
+	Success bool            `json:"success"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// do sends the request, retrying as the policy allows, and decodes the data of the response
+// envelope into out when out is not nil
+func (c *Client) do(ctx context.Context, req *request, out any) error {
+	if err := c.checkCredentials(req.auth); err != nil {
+		return err
+	}
+
+	var lastErr error
+
+	for attempt := 1; ; attempt++ {
+		resp, err := c.send(ctx, req)
+
+		retryAfter, retry := c.retry.shouldRetry(req, resp, err, attempt)
+		if !retry {
+			if err != nil {
+				return err
+			}
+
+			return decodeResponse(resp, out)
+		}
+
+		if resp != nil {
+			lastErr = errorFromResponse(resp)
+		} else {
+			lastErr = err
+		}
+
+		if sleepErr := sleepContext(ctx, c.retry.backoff(attempt, retryAfter)); sleepErr != nil {
+			return errors.Join(lastErr, sleepErr)
+		}
+	}
+}
+
+// send makes one attempt. Assertions are signed per attempt so retries carry a fresh
+// timestamp and nonce.
+func (c *Client) send(ctx context.Context, req *request) (*http.Response, error) {
+	target := c.baseURL.JoinPath(req.path)
+	if len(req.query) > 0 {
+		target.RawQuery = req.query.Encode()
+	}
+
+	var body io.Reader = http.NoBody
+	if req.body != nil {
+		body = bytes.NewReader(req.body)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, req.method, target.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("client: build request: %w", err)
+	}
+
+	httpReq.Header.Set("Accept", contentTypeJSON+", "+contentTypeProblem)
+	httpReq.Header.Set("User-Agent", c.userAgent)
+
+	if req.body != nil {
+		httpReq.Header.Set("Content-Type", contentTypeJSON)
+	}
+
+	if req.idempotencyKey != "" {
+		httpReq.Header.Set(headerIdempotencyKey, req.idempotencyKey)
+	}
+
+	if err = c.authenticate(httpReq, req.auth); err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, fmt.Errorf("client: %s %s: %w", req.method, req.path, err)
+	}
+
+	return resp, nil
+}
+
+func (c *Client) checkCredentials(mode authMode) error {
+	switch mode {
+	case authAssertion:
+		if c.signer == nil || c.identity.UserID == "" {
+			return fmt.Errorf("%w: the route needs WithAssertion", ErrNoCredentials)
+		}
+	case authAPIKey:
+		if c.apiKey == "" {
+			return fmt.Errorf("%w: the route needs WithAPIKey", ErrNoCredentials)
+		}
+	case authNone, authOptionalKey:
+	}
+
+	return nil
+}
+
+func (c *Client) authenticate(req *http.Request, mode authMode) error {
+	switch mode {
+	case authAssertion:
+		return c.signer.sign(req, c.identity)
+	case authAPIKey, authOptionalKey:
+		if c.apiKey != "" {
+			req.Header.Set(headerAPIKey, c.apiKey)
+		}
+	case authNone:
+	}
+
+	return nil
+}
+
+// decodeResponse closes the response, returning an *Error for failures and decoding the
+// envelope's data into out otherwise
+func decodeResponse(resp *http.Response, out any) error {
+	if resp.StatusCode >= http.StatusBadRequest {
+		return errorFromResponse(resp)
+	}
+
+	defer resp.Body.Close()
+
+	if out == nil || resp.StatusCode == http.StatusNoContent {
+		_, _ = io.Copy(io.Discard, resp.Body)
+
+		return nil
+	}
+
+	var env envelope
+	if err := json.NewDecoder(resp.Body).Decode(&env); err != nil {
+		return fmt.Errorf("client: decode response: %w", err)
+	}
+
+	if len(env.Data) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(env.Data, out); err != nil {
+		return fmt.Errorf("client: decode response data: %w", err)
+	}
+
+	return nil
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("client: wait to retry: %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
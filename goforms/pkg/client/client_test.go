@@ -0,0 +1,298 @@
+package client_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/goformx/goforms/pkg/client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fastRetries keeps retry tests quick; MaxBackoff also caps waits asked for with Retry-After
+var fastRetries = client.RetryPolicy{MaxAttempts: 3, MinBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+func newTestClient(t *testing.T, handler http.HandlerFunc, opts ...client.Option) *client.Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	opts = append([]client.Option{
+		client.WithAssertion(client.Signer{Secret: testSecret}, client.Identity{UserID: "user-1"}),
+		client.WithAPIKey("gfx_test"),
+		client.WithRetryPolicy(fastRetries),
+	}, opts...)
+
+	c, err := client.New(server.URL, opts...)
+	require.NoError(t, err)
+
+	return c
+}
+
+func writeData(t *testing.T, w http.ResponseWriter, status int, data any) {
+	t.Helper()
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	assert.NoError(t, json.NewEncoder(w).Encode(map[string]any{"success": true, "data": data}))
+}
+
+func writeProblem(t *testing.T, w http.ResponseWriter, status int, problem map[string]any) {
+	t.Helper()
+
+	w.Header().Set("Content-Type", "application/problem+json")
+	w.WriteHeader(status)
+	assert.NoError(t, json.NewEncoder(w).Encode(problem))
+}
+
+func TestNew_RejectsNonHTTPBaseURL(t *testing.T) {
+	_, err := client.New("ftp://forms.example.com")
+	require.Error(t, err)
+
+	_, err = client.New("https://forms.example.com/")
+	require.NoError(t, err)
+}
+
+func TestClient_MissingCredentialsSendNothing(t *testing.T) {
+	var hits atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) { hits.Add(1) }))
+	t.Cleanup(server.Close)
+
+	c, err := client.New(server.URL)
+	require.NoError(t, err)
+
+	_, err = c.GetForm(t.Context(), "form-1")
+	require.ErrorIs(t, err, client.ErrNoCredentials)
+
+	_, err = c.ServerGetForm(t.Context(), "form-1")
+	require.ErrorIs(t, err, client.ErrNoCredentials)
+
+	assert.Zero(t, hits.Load())
+}
+
+func TestClient_RetriesTransientFailures(t *testing.T) {
+	var hits atomic.Int32
+
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		if hits.Add(1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		writeData(t, w, http.StatusOK, map[string]any{"form": map[string]any{"id": "form-1", "title": "Contact"}})
+	})
+
+	form, err := c.GetForm(t.Context(), "form-1")
+	require.NoError(t, err)
+	assert.Equal(t, "Contact", form.Title)
+	assert.Equal(t, int32(3), hits.Load())
+}
+
+func TestClient_GivesUpAfterMaxAttempts(t *testing.T) {
+	var hits atomic.Int32
+
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusBadGateway)
+	})
+
+	_, err := c.GetForm(t.Context(), "form-1")
+
+	var apiErr *client.Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, http.StatusBadGateway, apiErr.Status)
+	assert.Equal(t, client.CodeServerError, apiErr.Code)
+	assert.Equal(t, int32(3), hits.Load())
+}
+
+func TestClient_CreateFormRetriesWithSameIdempotencyKey(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		keys   []string
+		nonces []string
+	)
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		keys = append(keys, r.Header.Get("Idempotency-Key"))
+		nonces = append(nonces, r.Header.Get("X-Nonce"))
+		attempt := len(keys)
+		mu.Unlock()
+
+		if attempt == 1 {
+			// The first attempt is still in flight on the server
+			w.Header().Set("Retry-After", "1")
+			writeProblem(t, w, http.StatusConflict, map[string]any{"status": http.StatusConflict, "code": "CONFLICT"})
+
+			return
+		}
+
+		writeData(t, w, http.StatusCreated, map[string]any{"form": map[string]any{"id": "form-1"}})
+	})
+
+	form, err := c.CreateForm(t.Context(), client.CreateFormRequest{Title: "Contact"})
+	require.NoError(t, err)
+	assert.Equal(t, "form-1", form.ID)
+
+	require.Len(t, keys, 2)
+	assert.NotEmpty(t, keys[0])
+	assert.Equal(t, keys[0], keys[1])
+	assert.NotEqual(t, nonces[0], nonces[1], "each attempt must be signed with a fresh nonce")
+}
+
+func TestClient_SubmitUsesCallerIdempotencyKey(t *testing.T) {
+	var key, apiKey atomic.Value
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		key.Store(r.Header.Get("Idempotency-Key"))
+		apiKey.Store(r.Header.Get("X-API-Key"))
+		writeData(t, w, http.StatusCreated, map[string]any{"submission_id": "sub-1", "status": "pending"})
+	})
+
+	receipt, err := c.Submit(t.Context(), "form-1", map[string]any{"email": "a@example.com"},
+		client.WithIdempotencyKey("order-42"))
+	require.NoError(t, err)
+	assert.Equal(t, "sub-1", receipt.SubmissionID)
+	assert.Equal(t, "order-42", key.Load())
+	assert.Equal(t, "gfx_test", apiKey.Load())
+}
+
+func TestClient_DoesNotRetryWritesWithoutIdempotencyKey(t *testing.T) {
+	var hits atomic.Int32
+
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		hits.Add(1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	})
+
+	_, err := c.ServerCreateSubmission(t.Context(), "form-1", map[string]any{"name": "Ada"})
+	require.ErrorIs(t, err, &client.Error{Code: client.CodeUnavailable})
+	assert.Equal(t, int32(1), hits.Load())
+}
+
+func TestClient_DecodesProblems(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/forms/missing":
+			writeProblem(t, w, http.StatusNotFound, map[string]any{
+				"type":     "urn:goformx:problem:form-not-found",
+				"title":    "Not Found",
+				"status":   http.StatusNotFound,
+				"detail":   "Form not found",
+				"instance": r.URL.Path,
+				"code":     "FORM_NOT_FOUND",
+			})
+		case "/api/forms/invalid":
+			writeProblem(t, w, http.StatusUnprocessableEntity, map[string]any{
+				"status": http.StatusUnprocessableEntity,
+				"code":   "VALIDATION_ERROR",
+				"errors": []map[string]any{{"field": "title", "message": "Title is required", "rule": "required"}},
+			})
+		default:
+			w.Header().Set("Content-Type", "text/html")
+			w.WriteHeader(http.StatusForbidden)
+		}
+	})
+
+	_, err := c.GetForm(t.Context(), "missing")
+	require.ErrorIs(t, err, client.ErrFormNotFound)
+	require.ErrorIs(t, err, client.ErrNotFound)
+	require.NotErrorIs(t, err, client.ErrValidation)
+	assert.EqualError(t, err, "goformx: 404 FORM_NOT_FOUND: Form not found")
+
+	_, err = c.UpdateForm(t.Context(), "invalid", client.UpdateFormRequest{})
+
+	var apiErr *client.Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.ErrorIs(t, err, client.ErrValidation)
+	assert.Equal(t, []client.FieldError{{Field: "title", Message: "Title is required", Rule: "required"}}, apiErr.Errors)
+
+	_, err = c.GetForm(t.Context(), "other")
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, client.CodeForbidden, apiErr.Code)
+	assert.Equal(t, "Forbidden", apiErr.Title)
+}
+
+func TestClient_ReportsRetryAfterWhenRateLimited(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Retry-After", "30")
+		writeProblem(t, w, http.StatusTooManyRequests, map[string]any{"status": http.StatusTooManyRequests, "code": "RATE_LIMITED"})
+	}, client.WithRetryPolicy(client.RetryPolicy{MaxAttempts: 1}))
+
+	_, err := c.CountForms(t.Context())
+
+	var apiErr *client.Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.ErrorIs(t, err, client.ErrRateLimited)
+	assert.Equal(t, 30*time.Second, apiErr.RetryAfter)
+}
+
+func TestClient_AllFormsFollowsCursors(t *testing.T) {
+	pages := map[string]map[string]any{
+		"": {
+			"forms":      []map[string]any{{"id": "f1"}, {"id": "f2"}},
+			"pagination": map[string]any{"next_cursor": "c2"},
+		},
+		"c2": {
+			"forms":      []map[string]any{{"id": "f3"}},
+			"pagination": map[string]any{},
+		},
+	}
+
+	var (
+		mu      sync.Mutex
+		queries []string
+	)
+
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		queries = append(queries, r.URL.RawQuery)
+		mu.Unlock()
+
+		writeData(t, w, http.StatusOK, pages[r.URL.Query().Get("cursor")])
+	})
+
+	var ids []string
+
+	for form, err := range c.AllForms(t.Context(), client.ListFormsOptions{ListOptions: client.ListOptions{Limit: 2}, Tag: "hr"}) {
+		require.NoError(t, err)
+
+		ids = append(ids, form.ID)
+	}
+
+	assert.Equal(t, []string{"f1", "f2", "f3"}, ids)
+	assert.Equal(t, []string{"limit=2&tag=hr", "cursor=c2&limit=2&tag=hr"}, queries)
+
+	// Stopping early fetches no further pages
+	queries = nil
+	for form := range c.AllForms(t.Context(), client.ListFormsOptions{}) {
+		assert.Equal(t, "f1", form.ID)
+
+		break
+	}
+
+	assert.Len(t, queries, 1)
+}
+
+func TestClient_AllSubmissionsYieldsError(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, _ *http.Request) {
+		writeProblem(t, w, http.StatusForbidden, map[string]any{"status": http.StatusForbidden, "code": "FORBIDDEN"})
+	})
+
+	var errs []error
+
+	for _, err := range c.AllServerSubmissions(t.Context(), "form-1", client.ListSubmissionsOptions{}) {
+		errs = append(errs, err)
+	}
+
+	require.Len(t, errs, 1)
+	assert.ErrorIs(t, errs[0], client.ErrForbidden)
+}
@@ -0,0 +1,228 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// maxErrorBody bounds how much of an error response is read
+const maxErrorBody = 64 << 10
+
+// ErrorCode is the stable code of a problem; it mirrors the server's error codes
+type ErrorCode string
+
+// Error codes the API returns
+const (
+	CodeValidation    ErrorCode = "VALIDATION_ERROR"
+	CodeRequired      ErrorCode = "REQUIRED_FIELD"
+	CodeInvalid       ErrorCode = "INVALID_VALUE"
+	CodeInvalidFormat ErrorCode = "INVALID_FORMAT"
+	CodeInvalidInput  ErrorCode = "INVALID_INPUT"
+
+	CodeUnauthorized     ErrorCode = "UNAUTHORIZED"
+	CodeForbidden        ErrorCode = "FORBIDDEN"
+	CodeAuthentication   ErrorCode = "AUTHENTICATION_ERROR"
+	CodeInsufficientRole ErrorCode = "INSUFFICIENT_ROLE"
+
+	CodeNotFound      ErrorCode = "NOT_FOUND"
+	CodeConflict      ErrorCode = "CONFLICT"
+	CodeBadRequest    ErrorCode = "BAD_REQUEST"
+	CodeServerError   ErrorCode = "SERVER_ERROR"
+	CodeAlreadyExists ErrorCode = "ALREADY_EXISTS"
+	CodeDatabase      ErrorCode = "DB_ERROR"
+	CodeTimeout       ErrorCode = "TIMEOUT"
+	CodeUnavailable   ErrorCode = "SERVICE_UNAVAILABLE"
+
+	CodeMethodNotAllowed ErrorCode = "METHOD_NOT_ALLOWED"
+	CodePayloadTooLarge  ErrorCode = "PAYLOAD_TOO_LARGE"
+	CodeUnprocessable    ErrorCode = "UNPROCESSABLE"
+	CodeRateLimited      ErrorCode = "RATE_LIMITED"
+
+	CodeFormValidation   ErrorCode = "FORM_VALIDATION_ERROR"
+	CodeFormNotFound     ErrorCode = "FORM_NOT_FOUND"
+	CodeFormSubmission   ErrorCode = "FORM_SUBMISSION_ERROR"
+	CodeFormAccessDenied ErrorCode = "FORM_ACCESS_DENIED"
+	CodeFormInvalid      ErrorCode = "FORM_INVALID"
+	CodeFormExpired      ErrorCode = "FORM_EXPIRED"
+
+	CodeUserNotFound     ErrorCode = "USER_NOT_FOUND"
+	CodeUserExists       ErrorCode = "USER_EXISTS"
+	CodeUserDisabled     ErrorCode = "USER_DISABLED"
+	CodeUserInvalid      ErrorCode = "USER_INVALID"
+	CodeUserUnauthorized ErrorCode = "USER_UNAUTHORIZED"
+
+	CodeLimitExceeded       ErrorCode = "LIMIT_EXCEEDED"
+	CodeFeatureNotAvailable ErrorCode = "FEATURE_NOT_AVAILABLE"
+)
+
+// Errors to match with errors.Is. Those with a code match that code only; those with a
+// status match every problem with that status, so ErrNotFound also matches ErrFormNotFound.
+var (
+	ErrBadRequest   = &Error{Status: http.StatusBadRequest}
+	ErrUnauthorized = &Error{Status: http.StatusUnauthorized}
+	ErrForbidden    = &Error{Status: http.StatusForbidden}
+	ErrNotFound     = &Error{Status: http.StatusNotFound}
+	ErrConflict     = &Error{Status: http.StatusConflict}
+	ErrRateLimited  = &Error{Status: http.StatusTooManyRequests}
+
+	ErrValidation          = &Error{Code: CodeValidation}
+	ErrFormNotFound        = &Error{Code: CodeFormNotFound}
+	ErrFormValidation      = &Error{Code: CodeFormValidation}
+	ErrLimitExceeded       = &Error{Code: CodeLimitExceeded}
+	ErrFeatureNotAvailable = &Error{Code: CodeFeatureNotAvailable}
+)
+
+// FieldError is one invalid field of a validation problem
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+	Rule    string `json:"rule,omitempty"`
+}
+
+// Error is an RFC 7807 problem returned by the API, or a failure status without one
+type Error struct {
+	Type     string    `json:"type"`
+	Title    string    `json:"title"`
+	Status   int       `json:"status"`
+	Detail   string    `json:"detail,omitempty"`
+	Instance string    `json:"instance,omitempty"`
+	Code     ErrorCode `json:"code"`
+
+	// Errors lists the invalid fields of a validation problem
+	Errors []FieldError `json:"errors,omitempty"`
+
+	// LimitType, Limit and Current describe a plan limit that was reached
+	LimitType string `json:"limit_type,omitempty"`
+	Limit     *int   `json:"limit,omitempty"`
+	Current   *int   `json:"current,omitempty"`
+	// Feature names a plan feature that is not available
+	Feature string `json:"feature,omitempty"`
+	// RequiredTier is the plan tier to upgrade to
+	RequiredTier string `json:"required_tier,omitempty"`
+
+	// RetryAfter is the wait the server asked for with Retry-After, if any
+	RetryAfter time.Duration `json:"-"`
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	message := "goformx: " + strconv.Itoa(e.Status)
+	if e.Code != "" {
+		message += " " + string(e.Code)
+	}
+
+	if e.Detail != "" {
+		message += ": " + e.Detail
+	} else if e.Title != "" {
+		message += ": " + e.Title
+	}
+
+	return message
+}
+
+// Is matches a target *Error by code when it has one, and by status otherwise
+func (e *Error) Is(target error) bool {
+	var t *Error
+	if !errors.As(target, &t) {
+		return false
+	}
+
+	switch {
+	case t.Code != "":
+		return t.Code == e.Code
+	case t.Status != 0:
+		return t.Status == e.Status
+	}
+
+	return false
+}
+
+// errorFromResponse reads and closes a failed response. Problem bodies are decoded; any
+// other body leaves an Error with the status and its generic code.
+func errorFromResponse(resp *http.Response) *Error {
+	defer resp.Body.Close()
+
+	apiErr := &Error{}
+
+	mediaType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if mediaType == contentTypeProblem || mediaType == contentTypeJSON {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBody))
+		_ = json.Unmarshal(body, apiErr)
+	}
+
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, maxErrorBody))
+
+	// The status line is authoritative; a proxy may have replaced the server's response
+	apiErr.Status = resp.StatusCode
+	if apiErr.Code == "" {
+		apiErr.Code = codeForStatus(resp.StatusCode)
+	}
+
+	if apiErr.Title == "" {
+		apiErr.Title = http.StatusText(resp.StatusCode)
+	}
+
+	apiErr.RetryAfter = parseRetryAfter(resp.Header.Get(headerRetryAfter))
+
+	return apiErr
+}
+
+// codeForStatus is the generic code the server gives errors of a status that carry no code
+func codeForStatus(status int) ErrorCode {
+	switch status {
+	case http.StatusBadRequest:
+		return CodeBadRequest
+	case http.StatusUnauthorized:
+		return CodeUnauthorized
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusMethodNotAllowed:
+		return CodeMethodNotAllowed
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusRequestEntityTooLarge:
+		return CodePayloadTooLarge
+	case http.StatusUnprocessableEntity:
+		return CodeUnprocessable
+	case http.StatusTooManyRequests:
+		return CodeRateLimited
+	case http.StatusServiceUnavailable:
+		return CodeUnavailable
+	case http.StatusGatewayTimeout:
+		return CodeTimeout
+	}
+
+	if status < http.StatusInternalServerError {
+		return CodeBadRequest
+	}
+
+	return CodeServerError
+}
+
+// parseRetryAfter reads Retry-After as seconds or an HTTP date
+func parseRetryAfter(value string) time.Duration {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+
+	if at, err := http.ParseTime(value); err == nil {
+		if wait := time.Until(at); wait > 0 {
+			return wait
+		}
+	}
+
+	return 0
+}
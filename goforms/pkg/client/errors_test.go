@@ -0,0 +1,53 @@
+package client_test
+
+import (
+	"testing"
+
+	domainerrors "github.com/goformx/goforms/internal/domain/common/errors"
+	"github.com/goformx/goforms/pkg/client"
+	"github.com/stretchr/testify/assert"
+)
+
+// TestErrorCodes_MirrorDomainCodes keeps the client codes in step with the codes the API returns
+func TestErrorCodes_MirrorDomainCodes(t *testing.T) {
+	codes := map[client.ErrorCode]domainerrors.ErrorCode{
+		client.CodeValidation:          domainerrors.ErrCodeValidation,
+		client.CodeRequired:            domainerrors.ErrCodeRequired,
+		client.CodeInvalid:             domainerrors.ErrCodeInvalid,
+		client.CodeInvalidFormat:       domainerrors.ErrCodeInvalidFormat,
+		client.CodeInvalidInput:        domainerrors.ErrCodeInvalidInput,
+		client.CodeUnauthorized:        domainerrors.ErrCodeUnauthorized,
+		client.CodeForbidden:           domainerrors.ErrCodeForbidden,
+		client.CodeAuthentication:      domainerrors.ErrCodeAuthentication,
+		client.CodeInsufficientRole:    domainerrors.ErrCodeInsufficientRole,
+		client.CodeNotFound:            domainerrors.ErrCodeNotFound,
+		client.CodeConflict:            domainerrors.ErrCodeConflict,
+		client.CodeBadRequest:          domainerrors.ErrCodeBadRequest,
+		client.CodeServerError:         domainerrors.ErrCodeServerError,
+		client.CodeAlreadyExists:       domainerrors.ErrCodeAlreadyExists,
+		client.CodeDatabase:            domainerrors.ErrCodeDatabase,
+		client.CodeTimeout:             domainerrors.ErrCodeTimeout,
+		client.CodeUnavailable:         domainerrors.ErrCodeUnavailable,
+		client.CodeMethodNotAllowed:    domainerrors.ErrCodeMethodNotAllowed,
+		client.CodePayloadTooLarge:     domainerrors.ErrCodePayloadTooLarge,
+		client.CodeUnprocessable:       domainerrors.ErrCodeUnprocessable,
+		client.CodeRateLimited:         domainerrors.ErrCodeRateLimited,
+		client.CodeFormValidation:      domainerrors.ErrCodeFormValidation,
+		client.CodeFormNotFound:        domainerrors.ErrCodeFormNotFound,
+		client.CodeFormSubmission:      domainerrors.ErrCodeFormSubmission,
+		client.CodeFormAccessDenied:    domainerrors.ErrCodeFormAccessDenied,
+		client.CodeFormInvalid:         domainerrors.ErrCodeFormInvalid,
+		client.CodeFormExpired:         domainerrors.ErrCodeFormExpired,
+		client.CodeUserNotFound:        domainerrors.ErrCodeUserNotFound,
+		client.CodeUserExists:          domainerrors.ErrCodeUserExists,
+		client.CodeUserDisabled:        domainerrors.ErrCodeUserDisabled,
+		client.CodeUserInvalid:         domainerrors.ErrCodeUserInvalid,
+		client.CodeUserUnauthorized:    domainerrors.ErrCodeUserUnauthorized,
+		client.CodeLimitExceeded:       domainerrors.ErrCodeLimitExceeded,
+		client.CodeFeatureNotAvailable: domainerrors.ErrCodeFeatureNotAvailable,
+	}
+
+	for clientCode, domainCode := range codes {
+		assert.Equal(t, string(domainCode), string(clientCode))
+	}
+}
@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+const (
+	pathForms       = "/api/forms"
+	pathPublicForms = "/forms"
+	pathServerForms = "/api/server/forms"
+)
+
+// formResult is the data of the responses that return one form
+type formResult struct {
+	Form *Form `json:"form"`
+}
+
+// ListForms returns one page of the forms of the identity, or of its active workspace
+func (c *Client) ListForms(ctx context.Context, opts ListFormsOptions) (*FormPage, error) {
+	query := opts.values()
+	setQuery(query, "status", opts.Status)
+	setQuery(query, "tag", opts.Tag)
+	setQuery(query, "q", opts.Query)
+
+	var page FormPage
+	if err := c.do(ctx, newRequest(http.MethodGet, pathForms, authAssertion).withQuery(query), &page); err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+}
+
+// AllForms iterates over every form ListForms would return, fetching pages as it goes.
+// Iteration stops at the first error, which is yielded with a zero form.
+func (c *Client) AllForms(ctx context.Context, opts ListFormsOptions) iter.Seq2[FormSummary, error] {
+	return paginate(func(cursor string) ([]FormSummary, string, error) {
+		opts.Cursor = cursor
+
+		page, err := c.ListForms(ctx, opts)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return page.Forms, page.Pagination.NextCursor, nil
+	}, opts.Cursor)
+}
+
+// CreateForm creates a form. The request carries an Idempotency-Key, so it is retried
+// without creating the form twice.
+func (c *Client) CreateForm(ctx context.Context, body CreateFormRequest, opts ...CallOption) (*Form, error) {
+	req, err := newRequest(http.MethodPost, pathForms, authAssertion).withJSON(body)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.idempotencyKey, err = newIdempotencyKey(opts); err != nil {
+		return nil, err
+	}
+
+	return c.doForm(ctx, req)
+}
+
+// GetForm returns a form
+func (c *Client) GetForm(ctx context.Context, formID string) (*Form, error) {
+	return c.doForm(ctx, newRequest(http.MethodGet, formPath(pathForms, formID), authAssertion))
+}
+
+// UpdateForm changes a form and returns it as stored
+func (c *Client) UpdateForm(ctx context.Context, formID string, body UpdateFormRequest) (*Form, error) {
+	req, err := newRequest(http.MethodPut, formPath(pathForms, formID), authAssertion).withJSON(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doForm(ctx, req)
+}
+
+// DeleteForm deletes a form and its submissions
+func (c *Client) DeleteForm(ctx context.Context, formID string) error {
+	return c.do(ctx, newRequest(http.MethodDelete, formPath(pathForms, formID), authAssertion), nil)
+}
+
+// CountForms returns how many forms the identity has
+func (c *Client) CountForms(ctx context.Context) (int, error) {
+	var count Count
+	if err := c.do(ctx, newRequest(http.MethodGet, pathForms+"/usage/forms-count", authAssertion), &count); err != nil {
+		return 0, err
+	}
+
+	return count.Count, nil
+}
+
+// CountSubmissions returns how many submissions the identity's forms received in month,
+// given as YYYY-MM; empty means the current month
+func (c *Client) CountSubmissions(ctx context.Context, month string) (*Count, error) {
+	query := url.Values{}
+	setQuery(query, "month", month)
+
+	var count Count
+
+	req := newRequest(http.MethodGet, pathForms+"/usage/submissions-count", authAssertion).withQuery(query)
+	if err := c.do(ctx, req, &count); err != nil {
+		return nil, err
+	}
+
+	return &count, nil
+}
+
+func (c *Client) doForm(ctx context.Context, req *request) (*Form, error) {
+	var result formResult
+	if err := c.do(ctx, req, &result); err != nil {
+		return nil, err
+	}
+
+	return result.Form, nil
+}
+
+// values encodes the paging options as query parameters
+func (o ListOptions) values() url.Values {
+	query := url.Values{}
+	if o.Limit > 0 {
+		query.Set("limit", strconv.Itoa(o.Limit))
+	}
+
+	setQuery(query, "cursor", o.Cursor)
+	setQuery(query, "sort", o.Sort)
+	setQuery(query, "order", o.Order)
+
+	return query
+}
+
+// paginate yields the items of successive pages until a page has no next cursor
+func paginate[T any](fetch func(cursor string) ([]T, string, error), cursor string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		for {
+			items, next, err := fetch(cursor)
+			if err != nil {
+				var zero T
+
+				yield(zero, err)
+
+				return
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if next == "" || next == cursor {
+				return
+			}
+
+			cursor = next
+		}
+	}
+}
+
+func formPath(base, formID string) string {
+	return base + "/" + url.PathEscape(formID)
+}
+
+func setQuery(query url.Values, name, value string) {
+	if value != "" {
+		query.Set(name, value)
+	}
+}
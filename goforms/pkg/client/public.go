@@ -0,0 +1,47 @@
+package client
+
+import (
+	"context"
+	"net/http"
+)
+
+// FormSchema returns the Form.io schema of a published form. The API key is sent when the
+// client has one.
+func (c *Client) FormSchema(ctx context.Context, formID string) (map[string]any, error) {
+	var schema map[string]any
+	if err := c.do(ctx, newRequest(http.MethodGet, formPath(pathPublicForms, formID)+"/schema", authOptionalKey), &schema); err != nil {
+		return nil, err
+	}
+
+	return schema, nil
+}
+
+// FormValidation returns the client-side validation rules of a form, keyed by component key
+func (c *Client) FormValidation(ctx context.Context, formID string) (map[string]any, error) {
+	var rules map[string]any
+	if err := c.do(ctx, newRequest(http.MethodGet, formPath(pathPublicForms, formID)+"/validation", authOptionalKey), &rules); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// Submit sends a response to a form the way an embed does. The request carries an
+// Idempotency-Key, so it is retried without recording the response twice.
+func (c *Client) Submit(ctx context.Context, formID string, data map[string]any, opts ...CallOption) (*SubmissionReceipt, error) {
+	req, err := newRequest(http.MethodPost, formPath(pathPublicForms, formID)+"/submit", authOptionalKey).withJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.idempotencyKey, err = newIdempotencyKey(opts); err != nil {
+		return nil, err
+	}
+
+	var receipt SubmissionReceipt
+	if err = c.do(ctx, req, &receipt); err != nil {
+		return nil, err
+	}
+
+	return &receipt, nil
+}
@@ -0,0 +1,80 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"time"
+)
+
+const (
+	defaultMaxAttempts = 3
+	defaultMinBackoff  = 200 * time.Millisecond
+	defaultMaxBackoff  = 5 * time.Second
+)
+
+// RetryPolicy decides how often and how long to wait before retrying a request. Only
+// requests that are safe to repeat are retried: GET, PUT and DELETE, and POSTs carrying an
+// Idempotency-Key, which the server answers with the stored response instead of running
+// them twice.
+type RetryPolicy struct {
+	// MaxAttempts counts the first attempt; 1 disables retries
+	MaxAttempts int
+	// MinBackoff is the wait before the first retry; it doubles with each attempt
+	MinBackoff time.Duration
+	// MaxBackoff caps the wait, including one asked for with Retry-After
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy makes three attempts, waiting up to 200ms and then up to 400ms
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{MaxAttempts: defaultMaxAttempts, MinBackoff: defaultMinBackoff, MaxBackoff: defaultMaxBackoff}
+}
+
+// shouldRetry reports whether to make another attempt after the outcome of attempt, and the
+// wait the server asked for
+func (p RetryPolicy) shouldRetry(req *request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts || !req.idempotent() {
+		return 0, false
+	}
+
+	if err != nil {
+		// Cancellation is the caller's decision, and credentials or encoding errors repeat
+		return 0, !errors.Is(err, context.Canceled) && !errors.Is(err, context.DeadlineExceeded) &&
+			!errors.Is(err, ErrNoCredentials)
+	}
+
+	retryAfter := parseRetryAfter(resp.Header.Get(headerRetryAfter))
+
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return retryAfter, true
+	case http.StatusConflict:
+		// The first request with this Idempotency-Key is still running; its response will be
+		// replayed once it finishes
+		return retryAfter, req.idempotencyKey != "" && retryAfter > 0
+	}
+
+	return 0, false
+}
+
+// backoff is the wait before the retry following attempt: the server's Retry-After when it
+// sent one, and exponential backoff with jitter otherwise
+func (p RetryPolicy) backoff(attempt int, retryAfter time.Duration) time.Duration {
+	if retryAfter > 0 {
+		return min(retryAfter, p.MaxBackoff)
+	}
+
+	wait := p.MinBackoff << (attempt - 1)
+	if wait <= 0 || wait > p.MaxBackoff {
+		wait = p.MaxBackoff
+	}
+
+	if wait <= 0 {
+		return 0
+	}
+
+	// Half fixed, half random, so clients that failed together do not retry together
+	return wait/2 + rand.N(wait/2+1) //nolint:gosec // jitter does not need a secure source
+}
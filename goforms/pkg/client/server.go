@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// apiKeyList is the data of ListFormAPIKeys
+type apiKeyList struct {
+	APIKeys []APIKey `json:"api_keys"`
+}
+
+// ServerGetForm returns a form with an API key scoped to it or its workspace
+func (c *Client) ServerGetForm(ctx context.Context, formID string) (*Form, error) {
+	return c.doForm(ctx, newRequest(http.MethodGet, formPath(pathServerForms, formID), authAPIKey))
+}
+
+// ServerUpdateForm changes a form with an API key holding manage_form
+func (c *Client) ServerUpdateForm(ctx context.Context, formID string, body UpdateFormRequest) (*Form, error) {
+	req, err := newRequest(http.MethodPut, formPath(pathServerForms, formID), authAPIKey).withJSON(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.doForm(ctx, req)
+}
+
+// ServerListSubmissions returns one page of a form's submissions with an API key holding
+// read_submissions
+func (c *Client) ServerListSubmissions(ctx context.Context, formID string, opts ListSubmissionsOptions) (*SubmissionPage, error) {
+	return c.listSubmissions(ctx, formPath(pathServerForms, formID), authAPIKey, opts)
+}
+
+// AllServerSubmissions iterates over every submission ServerListSubmissions would return
+func (c *Client) AllServerSubmissions(ctx context.Context, formID string, opts ListSubmissionsOptions) iter.Seq2[Submission, error] {
+	return c.allSubmissions(ctx, formPath(pathServerForms, formID), authAPIKey, opts)
+}
+
+// ServerGetSubmission returns a submission with an API key holding read_submissions
+func (c *Client) ServerGetSubmission(ctx context.Context, formID, submissionID string) (*Submission, error) {
+	return c.getSubmission(ctx, formPath(pathServerForms, formID), authAPIKey, submissionID)
+}
+
+// ServerCreateSubmission records a submission with an API key holding submit. The route
+// does not take an Idempotency-Key, so the request is never retried.
+func (c *Client) ServerCreateSubmission(ctx context.Context, formID string, data map[string]any) (*SubmissionReceipt, error) {
+	req, err := newRequest(http.MethodPost, formPath(pathServerForms, formID)+"/submissions", authAPIKey).withJSON(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var receipt SubmissionReceipt
+	if err = c.do(ctx, req, &receipt); err != nil {
+		return nil, err
+	}
+
+	return &receipt, nil
+}
+
+// ListFormAPIKeys returns the API keys scoped to a form
+func (c *Client) ListFormAPIKeys(ctx context.Context, formID string) ([]APIKey, error) {
+	var list apiKeyList
+	if err := c.do(ctx, newRequest(http.MethodGet, formPath(pathForms, formID)+"/api-keys", authAssertion), &list); err != nil {
+		return nil, err
+	}
+
+	return list.APIKeys, nil
+}
+
+// CreateFormAPIKey creates an API key scoped to a form. The key is returned only once.
+func (c *Client) CreateFormAPIKey(ctx context.Context, formID string, body CreateAPIKeyRequest) (*CreatedAPIKey, error) {
+	req, err := newRequest(http.MethodPost, formPath(pathForms, formID)+"/api-keys", authAssertion).withJSON(body)
+	if err != nil {
+		return nil, err
+	}
+
+	var created CreatedAPIKey
+	if err = c.do(ctx, req, &created); err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+// RevokeFormAPIKey revokes one of a form's API keys
+func (c *Client) RevokeFormAPIKey(ctx context.Context, formID, keyID string) error {
+	path := formPath(pathForms, formID) + "/api-keys/" + url.PathEscape(keyID)
+
+	return c.do(ctx, newRequest(http.MethodDelete, path, authAssertion), nil)
+}
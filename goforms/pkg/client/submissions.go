@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// ListSubmissions returns one page of a form's submissions
+func (c *Client) ListSubmissions(ctx context.Context, formID string, opts ListSubmissionsOptions) (*SubmissionPage, error) {
+	return c.listSubmissions(ctx, formPath(pathForms, formID), authAssertion, opts)
+}
+
+// AllSubmissions iterates over every submission ListSubmissions would return
+func (c *Client) AllSubmissions(ctx context.Context, formID string, opts ListSubmissionsOptions) iter.Seq2[Submission, error] {
+	return c.allSubmissions(ctx, formPath(pathForms, formID), authAssertion, opts)
+}
+
+// GetSubmission returns one of a form's submissions
+func (c *Client) GetSubmission(ctx context.Context, formID, submissionID string) (*Submission, error) {
+	return c.getSubmission(ctx, formPath(pathForms, formID), authAssertion, submissionID)
+}
+
+func (c *Client) listSubmissions(
+	ctx context.Context,
+	formPath string,
+	auth authMode,
+	opts ListSubmissionsOptions,
+) (*SubmissionPage, error) {
+	query := opts.values()
+	setQuery(query, "status", opts.Status)
+	setQuery(query, "review_status", opts.ReviewStatus)
+	setQuery(query, "assignee", opts.Assignee)
+	setQuery(query, "tag", opts.Tag)
+
+	var page SubmissionPage
+	if err := c.do(ctx, newRequest(http.MethodGet, formPath+"/submissions", auth).withQuery(query), &page); err != nil {
+		return nil, err
+	}
+
+	return &page, nil
+}
+
+func (c *Client) allSubmissions(
+	ctx context.Context,
+	formPath string,
+	auth authMode,
+	opts ListSubmissionsOptions,
+) iter.Seq2[Submission, error] {
+	return paginate(func(cursor string) ([]Submission, string, error) {
+		opts.Cursor = cursor
+
+		page, err := c.listSubmissions(ctx, formPath, auth, opts)
+		if err != nil {
+			return nil, "", err
+		}
+
+		return page.Submissions, page.Pagination.NextCursor, nil
+	}, opts.Cursor)
+}
+
+func (c *Client) getSubmission(ctx context.Context, formPath string, auth authMode, submissionID string) (*Submission, error) {
+	var submission Submission
+
+	req := newRequest(http.MethodGet, formPath+"/submissions/"+url.PathEscape(submissionID), auth)
+	if err := c.do(ctx, req, &submission); err != nil {
+		return nil, err
+	}
+
+	return &submission, nil
+}
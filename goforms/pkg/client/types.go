@@ -0,0 +1,201 @@
+package client
+
+import "time"
+
+// Form statuses
+const (
+	FormStatusDraft     = "draft"
+	FormStatusPublished = "published"
+	FormStatusArchived  = "archived"
+)
+
+// Submission statuses
+const (
+	SubmissionStatusPending    = "pending"
+	SubmissionStatusProcessing = "processing"
+	SubmissionStatusCompleted  = "completed"
+	SubmissionStatusFailed     = "failed"
+	SubmissionStatusSpam       = "spam"
+)
+
+// Form is a form with its Form.io schema
+type Form struct {
+	ID          string         `json:"id"`
+	Title       string         `json:"title"`
+	Description string         `json:"description"`
+	Status      string         `json:"status"`
+	Schema      map[string]any `json:"schema"`
+	CorsOrigins map[string]any `json:"cors_origins,omitempty"`
+	Tags        []string       `json:"tags"`
+	// ReviewWorkflow is the form's review statuses; forms without one use the default workflow
+	ReviewWorkflow *ReviewWorkflow `json:"review_workflow,omitempty"`
+	WorkspaceID    string          `json:"workspace_id,omitempty"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// FormSummary is a form as it appears in a listing
+type FormSummary struct {
+	ID              string    `json:"id"`
+	Title           string    `json:"title"`
+	Description     string    `json:"description"`
+	Status          string    `json:"status"`
+	Tags            []string  `json:"tags"`
+	SubmissionCount int       `json:"submission_count"`
+	CreatedAt       time.Time `json:"created_at"`
+	UpdatedAt       time.Time `json:"updated_at"`
+}
+
+// ReviewWorkflow is the review statuses of a form's submissions
+type ReviewWorkflow struct {
+	Statuses []ReviewStatus `json:"statuses"`
+	// Initial is the status of new submissions
+	Initial string `json:"initial"`
+	// Transitions lists the statuses each status may move to; none means any
+	Transitions map[string][]string `json:"transitions,omitempty"`
+}
+
+// ReviewStatus is one status of a review workflow
+type ReviewStatus struct {
+	Key   string `json:"key"`
+	Label string `json:"label"`
+}
+
+// Pagination describes where a page sits in a listing. Empty cursors mean there is no page
+// in that direction.
+type Pagination struct {
+	Total      int    `json:"total"`
+	Limit      int    `json:"limit"`
+	Sort       string `json:"sort"`
+	Order      string `json:"order"`
+	NextCursor string `json:"next_cursor"`
+	PrevCursor string `json:"prev_cursor"`
+}
+
+// FormPage is one page of forms
+type FormPage struct {
+	Forms      []FormSummary `json:"forms"`
+	Count      int           `json:"count"`
+	Pagination Pagination    `json:"pagination"`
+}
+
+// Submission is a response to a form
+type Submission struct {
+	ID           string         `json:"id"`
+	FormID       string         `json:"form_id"`
+	Status       string         `json:"status"`
+	ReviewStatus string         `json:"review_status"`
+	AssigneeID   string         `json:"assignee_id,omitempty"`
+	Tags         []string       `json:"tags,omitempty"`
+	SubmittedAt  time.Time      `json:"submitted_at"`
+	Data         map[string]any `json:"data"`
+}
+
+// SubmissionPage is one page of submissions
+type SubmissionPage struct {
+	Submissions []Submission `json:"submissions"`
+	Count       int          `json:"count"`
+	Pagination  Pagination   `json:"pagination"`
+}
+
+// SubmissionReceipt acknowledges a submission
+type SubmissionReceipt struct {
+	SubmissionID string    `json:"submission_id"`
+	Status       string    `json:"status"`
+	SubmittedAt  time.Time `json:"submitted_at"`
+}
+
+// Count is a usage count
+type Count struct {
+	Count int `json:"count"`
+	// Month is the YYYY-MM month a submission count covers
+	Month string `json:"month,omitempty"`
+}
+
+// CreateFormRequest is the body of CreateForm
+type CreateFormRequest struct {
+	Title string   `json:"title"`
+	Tags  []string `json:"tags,omitempty"`
+}
+
+// UpdateFormRequest is the body of UpdateForm. Title is required; empty Status keeps the
+// current status, nil Tags keep the current tags and nil Schema keeps the current schema.
+type UpdateFormRequest struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Status      string `json:"status,omitempty"`
+	// CorsOrigins is a comma-separated list of origins allowed to embed and submit the form;
+	// publishing requires it
+	CorsOrigins    string          `json:"cors_origins,omitempty"`
+	Schema         map[string]any  `json:"schema,omitempty"`
+	Tags           []string        `json:"tags,omitempty"`
+	ReviewWorkflow *ReviewWorkflow `json:"review_workflow,omitempty"`
+}
+
+// ListOptions pages and orders a listing. Zero values use the server's defaults.
+type ListOptions struct {
+	Limit int
+	// Cursor is the NextCursor or PrevCursor of a previous page
+	Cursor string
+	Sort   string
+	// Order is asc or desc
+	Order string
+}
+
+// ListFormsOptions filters and pages ListForms
+type ListFormsOptions struct {
+	ListOptions
+
+	Status string
+	Tag    string
+	// Query searches form titles
+	Query string
+}
+
+// ListSubmissionsOptions filters and pages ListSubmissions
+type ListSubmissionsOptions struct {
+	ListOptions
+
+	Status       string
+	ReviewStatus string
+	// Assignee is a user ID, me or none
+	Assignee string
+	Tag      string
+}
+
+// API key permissions
+const (
+	PermissionSubmit          = "submit"
+	PermissionReadSubmissions = "read_submissions"
+	PermissionManageForm      = "manage_form"
+)
+
+// APIKey is a scoped API key; the key itself is only returned when it is created
+type APIKey struct {
+	ID          string     `json:"id"`
+	Name        string     `json:"name"`
+	Prefix      string     `json:"prefix"`
+	FormID      string     `json:"form_id,omitempty"`
+	WorkspaceID string     `json:"workspace_id,omitempty"`
+	Permissions []string   `json:"permissions"`
+	CreatedBy   string     `json:"created_by"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt  *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt   *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt   time.Time  `json:"created_at"`
+	UpdatedAt   time.Time  `json:"updated_at"`
+}
+
+// CreatedAPIKey is a new API key with the key to send as X-API-Key
+type CreatedAPIKey struct {
+	APIKey APIKey `json:"api_key"`
+	// Key is shown only once; store it now
+	Key string `json:"key"`
+}
+
+// CreateAPIKeyRequest is the body of CreateFormAPIKey; Permissions needs at least one permission
+type CreateAPIKeyRequest struct {
+	Name        string     `json:"name"`
+	Permissions []string   `json:"permissions,omitempty"`
+	ExpiresAt   *time.Time `json:"expires_at,omitempty"`
+}
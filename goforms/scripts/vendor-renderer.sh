@@ -0,0 +1,84 @@
+#!/bin/bash
+
+# Vendors the pinned Form.io renderer into the embedded asset bundle so form embeds
+# work without reaching a CDN. The files are compiled in via go:embed and committed
+# together with VERSION and SHA384SUMS, which pin the release and its digests.
+#
+# Without arguments the script checks the committed files against the pins, fetching
+# the pinned release only when they are missing, and fails on any digest mismatch.
+# To move to another release, review it and run with --update, which fetches it and
+# rewrites the pins; commit the result.
+#
+# Usage: scripts/vendor-renderer.sh [--update [version]]
+
+set -euo pipefail
+
+DEST="$(cd "$(dirname "$0")/.." && pwd)/internal/infrastructure/renderer/assets/formio"
+FILES=(formio.full.min.js formio.full.min.css)
+
+UPDATE=false
+if [ "${1:-}" = "--update" ]; then
+    UPDATE=true
+    shift
+fi
+
+if [ "$UPDATE" = false ] && [ ! -f "${DEST}/SHA384SUMS" ]; then
+    echo "No pinned Form.io digests in ${DEST}/SHA384SUMS." >&2
+    echo "Review the release to vendor, run $0 --update [version] and commit the result." >&2
+    exit 1
+fi
+
+PINNED_VERSION="$(cat "${DEST}/VERSION" 2>/dev/null || true)"
+FORMIO_VERSION="${1:-${PINNED_VERSION:-4.14.13}}"
+BASE_URL="https://cdn.jsdelivr.net/npm/formiojs@${FORMIO_VERSION}/dist"
+
+# sha384 prints the Subresource Integrity value of a file, as the server computes it
+sha384() {
+    echo "sha384-$(openssl dgst -sha384 -binary "$1" | base64 | tr -d '\n')"
+}
+
+# pinned prints the pinned digest of a file, or nothing
+pinned() {
+    awk -v file="$1" '$2 == file { print $1 }' "${DEST}/SHA384SUMS" 2>/dev/null || true
+}
+
+# verified reports whether every vendored file matches its pinned digest
+verified() {
+    for file in "${FILES[@]}"; do
+        [ -f "${DEST}/${file}" ] || return 1
+        [ "$(sha384 "${DEST}/${file}")" = "$(pinned "$file")" ] || return 1
+    done
+}
+
+if [ "$UPDATE" = false ] && verified; then
+    echo "formiojs ${PINNED_VERSION} is vendored and matches its pinned digests"
+    exit 0
+fi
+
+TMP="$(mktemp -d)"
+trap 'rm -rf "$TMP"' EXIT
+
+for file in "${FILES[@]}"; do
+    echo "Fetching ${file} (formiojs ${FORMIO_VERSION})"
+    curl -fsSL "${BASE_URL}/${file}" -o "${TMP}/${file}"
+
+    digest="$(sha384 "${TMP}/${file}")"
+    if [ "$UPDATE" = false ] && [ "$digest" != "$(pinned "$file")" ]; then
+        echo "${file} does not match its pinned digest: got ${digest}, want $(pinned "$file")" >&2
+        exit 1
+    fi
+
+    echo "${digest}  ${file}" >> "${TMP}/SHA384SUMS"
+done
+
+mkdir -p "$DEST"
+
+for file in "${FILES[@]}"; do
+    mv "${TMP}/${file}" "${DEST}/${file}"
+done
+
+mv "${TMP}/SHA384SUMS" "${DEST}/SHA384SUMS"
+echo "$FORMIO_VERSION" > "${DEST}/VERSION"
+
+echo "Vendored formiojs ${FORMIO_VERSION} into ${DEST}:"
+cat "${DEST}/SHA384SUMS"
@@ -0,0 +1,150 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"go.uber.org/fx"
+
+	"github.com/goformx/goforms/internal/domain/bulk"
+	"github.com/goformx/goforms/internal/domain/form"
+	"github.com/goformx/goforms/internal/domain/review"
+	"github.com/goformx/goforms/internal/domain/user"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+	"github.com/goformx/goforms/internal/infrastructure/migration"
+	memorystore "github.com/goformx/goforms/internal/infrastructure/repository/memory"
+)
+
+// Storage backends selected with --storage
+const (
+	storageDatabase = "database"
+	storageMemory   = "memory"
+)
+
+// serveOptions are the flags of the serve command
+type serveOptions struct {
+	storage  string
+	fixtures string
+}
+
+// parseServeFlags parses the flags of the serve command
+func parseServeFlags(args []string, output io.Writer) (serveOptions, error) {
+	var opts serveOptions
+
+	flags := flag.NewFlagSet("serve", flag.ContinueOnError)
+	flags.SetOutput(output)
+	flags.StringVar(&opts.storage, "storage", storageDatabase,
+		"where forms, submissions and users are kept: database, or memory to run without a database")
+	flags.StringVar(&opts.fixtures, "fixtures", "", "JSON file of users, forms and submissions to seed memory storage with")
+
+	if err := flags.Parse(args); err != nil {
+		return opts, fmt.Errorf("parse serve flags: %w", err)
+	}
+
+	if flags.NArg() > 0 {
+		return opts, fmt.Errorf("unexpected arguments: %v", flags.Args())
+	}
+
+	switch opts.storage {
+	case storageDatabase:
+		if opts.fixtures != "" {
+			return opts, fmt.Errorf("--fixtures requires --storage=%s", storageMemory)
+		}
+	case storageMemory:
+	default:
+		return opts, fmt.Errorf("unknown storage %q: use %s or %s", opts.storage, storageDatabase, storageMemory)
+	}
+
+	return opts, nil
+}
+
+// storageOption returns the Fx options selecting the storage backend and a cleanup function
+// to call once the application has stopped
+func storageOption(opts serveOptions) (option fx.Option, cleanup func(), err error) {
+	if opts.storage != storageMemory {
+		return fx.Options(), func() {}, nil
+	}
+
+	var fixtures *memorystore.Fixtures
+	if opts.fixtures != "" {
+		if fixtures, err = memorystore.ReadFixtures(opts.fixtures); err != nil {
+			return nil, nil, fmt.Errorf("memory storage: %w", err)
+		}
+	}
+
+	// Repositories that never touch forms or submissions, such as audit logs and workspaces,
+	// use a throwaway SQLite database so the server still needs no database server
+	dir, err := os.MkdirTemp("", "goforms-memory-")
+	if err != nil {
+		return nil, nil, fmt.Errorf("memory storage: %w", err)
+	}
+
+	option = fx.Options(
+		fx.Supply(config.Overrides{
+			"database.driver": config.DriverSQLite,
+			"database.path":   filepath.Join(dir, "goforms.db"),
+			"session.store":   config.SessionStoreMemory,
+		}),
+		fx.Decorate(newMemoryRepositories),
+		fx.Invoke(func(p memoryStorageParams) { startMemoryStorage(p, fixtures) }),
+	)
+
+	return option, func() { _ = os.RemoveAll(dir) }, nil
+}
+
+// newMemoryRepositories replaces the repositories that read or write forms and submissions
+// with in-memory stores sharing one database
+func newMemoryRepositories(logger logging.Logger) (
+	user.Repository,
+	form.Repository,
+	form.SubmissionRepository,
+	bulk.Repository,
+	review.Repository,
+) {
+	db := memorystore.NewDatabase()
+
+	return memorystore.NewUserStore(db, logger),
+		memorystore.NewFormStore(db, logger),
+		memorystore.NewSubmissionStore(db, logger),
+		memorystore.NewBulkStore(db, logger),
+		memorystore.NewReviewStore(db, logger)
+}
+
+// memoryStorageParams are the dependencies of startMemoryStorage
+type memoryStorageParams struct {
+	fx.In
+
+	Lifecycle fx.Lifecycle
+	Runner    *migration.Runner
+	Users     user.Repository
+	Forms     form.Repository
+	Logger    logging.Logger
+}
+
+// startMemoryStorage migrates the throwaway database and seeds the memory stores on start
+func startMemoryStorage(p memoryStorageParams, fixtures *memorystore.Fixtures) {
+	p.Lifecycle.Append(fx.Hook{
+		OnStart: func(ctx context.Context) error {
+			if _, err := p.Runner.Up(ctx, 0); err != nil {
+				return fmt.Errorf("migrate memory storage database: %w", err)
+			}
+
+			if fixtures != nil {
+				if err := fixtures.Load(ctx, p.Users, p.Forms); err != nil {
+					return fmt.Errorf("load fixtures: %w", err)
+				}
+			}
+
+			p.Logger.Warn("using memory storage: data is lost when the server stops",
+				"fixtures_loaded", fixtures != nil,
+			)
+
+			return nil
+		},
+	})
+}
@@ -0,0 +1,84 @@
+package integration_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goformx/goforms/internal/domain/audit"
+	auditstore "github.com/goformx/goforms/internal/infrastructure/repository/audit"
+)
+
+func TestAuditStore_SQLite(t *testing.T) {
+	tdb := newTestDB(t)
+	ctx := t.Context()
+	store := auditstore.NewStore(tdb.db, tdb.logger)
+
+	t.Run("reports a taken sequence", func(t *testing.T) {
+		entry := func() *audit.Entry {
+			return &audit.Entry{
+				ID: uuid.NewString(), Sequence: 100, OccurredAt: time.Now().UTC(), Action: audit.ActionFormCreated,
+				ResourceType: audit.ResourceForm, PrevHash: "prev", Hash: "hash",
+			}
+		}
+
+		require.NoError(t, store.Append(ctx, entry()))
+		require.ErrorIs(t, store.Append(ctx, entry()), audit.ErrSequenceTaken)
+	})
+
+	t.Run("replicas sharing the database keep one chain", func(t *testing.T) {
+		shared := newTestDB(t)
+
+		// Each service is a separate replica
+		replicas := []audit.Service{
+			audit.NewService(auditstore.NewStore(shared.db, shared.logger), shared.logger),
+			audit.NewService(auditstore.NewStore(shared.db, shared.logger), shared.logger),
+		}
+
+		for i := range 6 {
+			_, err := replicas[i%2].Record(ctx, audit.Record{
+				Action: audit.ActionFormCreated, ResourceType: audit.ResourceForm, ResourceID: "form-1",
+			})
+			require.NoError(t, err)
+		}
+
+		result, err := replicas[0].Verify(ctx)
+		require.NoError(t, err)
+		assert.True(t, result.Valid, result.Reason)
+		assert.Equal(t, 6, result.Checked)
+	})
+
+	t.Run("concurrent writers keep one chain without a lock", func(t *testing.T) {
+		shared := newTestDB(t)
+		svc := audit.NewService(auditstore.NewStore(shared.db, shared.logger), shared.logger)
+
+		var wg sync.WaitGroup
+
+		errs := make(chan error, 8)
+
+		for range 8 {
+			wg.Go(func() {
+				_, err := svc.Record(ctx, audit.Record{
+					Action: audit.ActionSubmissionCreated, ResourceType: audit.ResourceSubmission, ResourceID: uuid.NewString(),
+				})
+				errs <- err
+			})
+		}
+
+		wg.Wait()
+		close(errs)
+
+		for err := range errs {
+			require.NoError(t, err)
+		}
+
+		result, err := svc.Verify(ctx)
+		require.NoError(t, err)
+		assert.True(t, result.Valid, result.Reason)
+		assert.Equal(t, 8, result.Checked)
+	})
+}
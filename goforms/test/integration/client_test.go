@@ -0,0 +1,227 @@
+package integration_test
+
+import (
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goformx/goforms/internal/application/handlers/web"
+	"github.com/goformx/goforms/internal/application/middleware/idempotency"
+	"github.com/goformx/goforms/internal/application/response"
+	"github.com/goformx/goforms/internal/application/validation"
+	"github.com/goformx/goforms/internal/domain/apikey"
+	"github.com/goformx/goforms/internal/domain/audit"
+	"github.com/goformx/goforms/internal/domain/form"
+	"github.com/goformx/goforms/internal/domain/user"
+	"github.com/goformx/goforms/internal/domain/workspace"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/event"
+	apikeystore "github.com/goformx/goforms/internal/infrastructure/repository/apikey"
+	auditstore "github.com/goformx/goforms/internal/infrastructure/repository/audit"
+	formstore "github.com/goformx/goforms/internal/infrastructure/repository/form"
+	userstore "github.com/goformx/goforms/internal/infrastructure/repository/user"
+	workspacestore "github.com/goformx/goforms/internal/infrastructure/repository/workspace"
+	"github.com/goformx/goforms/internal/infrastructure/sanitization"
+	"github.com/goformx/goforms/pkg/client"
+)
+
+const (
+	clientTestSecret = "integration-assertion-secret"
+	// Users are identified by UUID, as Laravel sends them
+	ownerID    = "7b0c5a9e-2f1d-4c3b-9a8e-1d2c3b4a5f60"
+	strangerID = "0e9d8c7b-6a5f-4e3d-8c2b-1a0f9e8d7c6b"
+)
+
+// newAPIServer serves the form API routes over HTTP, backed by a migrated SQLite database
+func newAPIServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	tdb := newTestDB(t)
+	tdb.cfg.Security.Assertion = config.AssertionConfig{Secret: clientTestSecret, TimestampSkewSeconds: 60}
+
+	sanitizer := sanitization.NewService()
+	users := userstore.NewStore(tdb.db, tdb.logger)
+	forms := form.NewService(formstore.NewStore(tdb.db, tdb.logger), event.NewMemoryEventBus(tdb.logger), tdb.logger)
+
+	base := web.NewBaseHandler(tdb.logger, tdb.cfg, user.NewService(users, tdb.logger), forms, nil,
+		response.NewErrorHandler(tdb.logger, sanitizer), nil)
+
+	handler := web.NewFormAPIHandler(
+		base,
+		forms,
+		nil,
+		validation.NewFormValidator(tdb.logger),
+		sanitizer,
+		userstore.NewUserEnsurer(users),
+		audit.NewService(auditstore.NewStore(tdb.db, tdb.logger), tdb.logger),
+		workspace.NewService(workspacestore.NewStore(tdb.db, tdb.logger), tdb.logger),
+		apikey.NewService(apikeystore.NewStore(tdb.db, tdb.logger), tdb.logger),
+	)
+	handler.Idempotency = idempotency.NewMiddleware(idempotency.NewMemoryStore(), time.Hour, tdb.logger)
+
+	e := echo.New()
+	e.HTTPErrorHandler = response.HTTPErrorHandler
+	handler.RegisterRoutes(e)
+
+	server := httptest.NewServer(e)
+	t.Cleanup(server.Close)
+
+	return server
+}
+
+func newAssertionClient(t *testing.T, baseURL, userID string) *client.Client {
+	t.Helper()
+
+	c, err := client.New(baseURL,
+		client.WithAssertion(client.Signer{Secret: clientTestSecret}, client.Identity{UserID: userID, PlanTier: "pro"}))
+	require.NoError(t, err)
+
+	return c
+}
+
+// contactSchema is a Form.io schema with one required field
+func contactSchema() map[string]any {
+	return map[string]any{
+		"display": "form",
+		"components": []any{
+			map[string]any{
+				"type":     "textfield",
+				"key":      "name",
+				"label":    "Name",
+				"input":    true,
+				"validate": map[string]any{"required": true},
+			},
+		},
+	}
+}
+
+func TestClient_FormLifecycle(t *testing.T) {
+	server := newAPIServer(t)
+	ctx := t.Context()
+	owner := newAssertionClient(t, server.URL, ownerID)
+
+	created, err := owner.CreateForm(ctx, client.CreateFormRequest{Title: "Contact", Tags: []string{"sales"}},
+		client.WithIdempotencyKey("create-contact"))
+	require.NoError(t, err)
+	require.NotEmpty(t, created.ID)
+
+	// Replaying the key returns the form created the first time instead of a second one
+	replayed, err := owner.CreateForm(ctx, client.CreateFormRequest{Title: "Contact", Tags: []string{"sales"}},
+		client.WithIdempotencyKey("create-contact"))
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, replayed.ID)
+
+	_, err = owner.CreateForm(ctx, client.CreateFormRequest{Title: "Feedback"})
+	require.NoError(t, err)
+
+	count, err := owner.CountForms(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, count)
+
+	var titles []string
+
+	for summary, iterErr := range owner.AllForms(ctx, client.ListFormsOptions{ListOptions: client.ListOptions{Limit: 1}}) {
+		require.NoError(t, iterErr)
+
+		titles = append(titles, summary.Title)
+	}
+
+	assert.ElementsMatch(t, []string{"Contact", "Feedback"}, titles)
+
+	published, err := owner.UpdateForm(ctx, created.ID, client.UpdateFormRequest{
+		Title:       "Contact us",
+		Status:      client.FormStatusPublished,
+		CorsOrigins: "https://example.com",
+		Schema:      contactSchema(),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Contact us", published.Title)
+
+	fetched, err := owner.GetForm(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, client.FormStatusPublished, fetched.Status)
+
+	// Forms of other users are hidden rather than forbidden
+	_, err = newAssertionClient(t, server.URL, strangerID).GetForm(ctx, created.ID)
+	require.ErrorIs(t, err, client.ErrNotFound)
+
+	_, err = owner.GetForm(ctx, "00000000-0000-0000-0000-000000000000")
+	require.ErrorIs(t, err, client.ErrNotFound)
+
+	require.NoError(t, owner.DeleteForm(ctx, created.ID))
+
+	_, err = owner.GetForm(ctx, created.ID)
+	require.ErrorIs(t, err, client.ErrNotFound)
+}
+
+func TestClient_SubmissionsWithAPIKey(t *testing.T) {
+	server := newAPIServer(t)
+	ctx := t.Context()
+	owner := newAssertionClient(t, server.URL, ownerID)
+
+	created, err := owner.CreateForm(ctx, client.CreateFormRequest{Title: "Contact"})
+	require.NoError(t, err)
+
+	_, err = owner.UpdateForm(ctx, created.ID, client.UpdateFormRequest{
+		Title:       "Contact",
+		Status:      client.FormStatusPublished,
+		CorsOrigins: "https://example.com",
+		Schema:      contactSchema(),
+	})
+	require.NoError(t, err)
+
+	public, err := client.New(server.URL)
+	require.NoError(t, err)
+
+	schema, err := public.FormSchema(ctx, created.ID)
+	require.NoError(t, err)
+	assert.Contains(t, schema, "components")
+
+	receipt, err := public.Submit(ctx, created.ID, map[string]any{"name": "Ada"})
+	require.NoError(t, err)
+	require.NotEmpty(t, receipt.SubmissionID)
+
+	_, err = public.Submit(ctx, created.ID, map[string]any{})
+	require.ErrorIs(t, err, client.ErrBadRequest)
+
+	key, err := owner.CreateFormAPIKey(ctx, created.ID, client.CreateAPIKeyRequest{
+		Name:        "backend",
+		Permissions: []string{client.PermissionSubmit, client.PermissionReadSubmissions},
+	})
+	require.NoError(t, err)
+	require.NotEmpty(t, key.Key)
+
+	server2server, err := client.New(server.URL, client.WithAPIKey(key.Key))
+	require.NoError(t, err)
+
+	_, err = server2server.ServerCreateSubmission(ctx, created.ID, map[string]any{"name": "Grace"})
+	require.NoError(t, err)
+
+	var names []any
+
+	for submission, iterErr := range server2server.AllServerSubmissions(ctx, created.ID,
+		client.ListSubmissionsOptions{ListOptions: client.ListOptions{Limit: 1}}) {
+		require.NoError(t, iterErr)
+
+		names = append(names, submission.Data["name"])
+	}
+
+	assert.ElementsMatch(t, []any{"Ada", "Grace"}, names)
+
+	submission, err := owner.GetSubmission(ctx, created.ID, receipt.SubmissionID)
+	require.NoError(t, err)
+	assert.Equal(t, "Ada", submission.Data["name"])
+
+	// The key cannot manage the form
+	_, err = server2server.ServerUpdateForm(ctx, created.ID, client.UpdateFormRequest{Title: "Renamed"})
+	require.ErrorIs(t, err, client.ErrForbidden)
+
+	require.NoError(t, owner.RevokeFormAPIKey(ctx, created.ID, key.APIKey.ID))
+
+	_, err = server2server.ServerGetForm(ctx, created.ID)
+	require.ErrorIs(t, err, client.ErrUnauthorized)
+}
@@ -0,0 +1,123 @@
+package integration_test
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zapcore"
+
+	"github.com/goformx/goforms/internal/domain/entities"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+	"github.com/goformx/goforms/internal/infrastructure/database"
+	"github.com/goformx/goforms/internal/infrastructure/logging"
+	"github.com/goformx/goforms/internal/infrastructure/migration"
+	userstore "github.com/goformx/goforms/internal/infrastructure/repository/user"
+	"github.com/goformx/goforms/internal/infrastructure/sanitization"
+)
+
+// testDB is a migrated SQLite database in a temporary file
+type testDB struct {
+	cfg    *config.Config
+	db     database.DB
+	logger logging.Logger
+}
+
+// newTestDB creates and migrates a SQLite database that is removed when the test ends
+func newTestDB(t *testing.T) *testDB {
+	t.Helper()
+
+	cfg := &config.Config{
+		App: config.AppConfig{Name: "goforms-test", Environment: "test"},
+		Database: config.DatabaseConfig{
+			Driver:          config.DriverSQLite,
+			Path:            filepath.Join(t.TempDir(), "goforms.db"),
+			MaxOpenConns:    4,
+			MaxIdleConns:    4,
+			ConnMaxLifetime: time.Hour,
+			Logging:         config.DatabaseLoggingConfig{LogLevel: "silent"},
+		},
+	}
+
+	logger := newTestLogger(t)
+
+	db, err := database.New(cfg, logger)
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, db.Close()) })
+
+	runner, err := migration.NewDatabaseRunner(cfg, db, logger)
+	require.NoError(t, err)
+
+	_, err = runner.Up(t.Context(), 0)
+	require.NoError(t, err)
+
+	return &testDB{cfg: cfg, db: db, logger: logger}
+}
+
+// newTestLogger creates a logger that discards its output
+func newTestLogger(t *testing.T) logging.Logger {
+	t.Helper()
+
+	factory, err := logging.NewFactory(&logging.FactoryConfig{
+		AppName:     "goforms-test",
+		Environment: "test",
+		LogLevel:    "error",
+	}, sanitization.NewService())
+	require.NoError(t, err)
+
+	logger, err := factory.WithTestCore(zapcore.NewNopCore()).CreateLogger()
+	require.NoError(t, err)
+
+	return logger
+}
+
+// createUser stores a user for forms to belong to
+func (tdb *testDB) createUser(t *testing.T, email string) *entities.User {
+	t.Helper()
+
+	u := &entities.User{
+		Email:          email,
+		HashedPassword: "not-a-real-hash",
+		FirstName:      "Test",
+		LastName:       "User",
+		Role:           "user",
+		Active:         true,
+	}
+	require.NoError(t, userstore.NewStore(tdb.db, tdb.logger).Create(t.Context(), u))
+
+	return u
+}
+
+func TestMigrations_SQLiteRoundTrip(t *testing.T) {
+	tdb := newTestDB(t)
+	ctx := t.Context()
+
+	runner, err := migration.NewDatabaseRunner(tdb.cfg, tdb.db, tdb.logger)
+	require.NoError(t, err)
+
+	status, err := runner.Status(ctx)
+	require.NoError(t, err)
+	require.Empty(t, status.Pending())
+
+	rolledBack, err := runner.Down(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, rolledBack, len(status.Migrations))
+
+	applied, err := runner.Up(ctx, 0)
+	require.NoError(t, err)
+	require.Len(t, applied, len(status.Migrations))
+}
+
+func TestDatabase_SQLiteConnectionPragmas(t *testing.T) {
+	tdb := newTestDB(t)
+
+	var foreignKeys int
+	require.NoError(t, tdb.db.GetDB().Raw("PRAGMA foreign_keys").Scan(&foreignKeys).Error)
+	assert.Equal(t, 1, foreignKeys)
+
+	var journalMode string
+	require.NoError(t, tdb.db.GetDB().Raw("PRAGMA journal_mode").Scan(&journalMode).Error)
+	assert.Equal(t, "wal", journalMode)
+}
@@ -0,0 +1,163 @@
+package integration_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goformx/goforms/internal/domain/form"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+	formstore "github.com/goformx/goforms/internal/infrastructure/repository/form"
+	submissionstore "github.com/goformx/goforms/internal/infrastructure/repository/form/submission"
+)
+
+// testSchema is a minimal valid form schema
+var testSchema = model.JSON{"display": "form", "components": []any{}}
+
+func TestFormRepository_SQLite(t *testing.T) {
+	tdb := newTestDB(t)
+	ctx := t.Context()
+	owner := tdb.createUser(t, "owner@example.com")
+	repo := formstore.NewStore(tdb.db, tdb.logger)
+
+	titles := []string{"Contact us", "Job application", "Survey 100%", "Survey 100 percent"}
+	for _, title := range titles {
+		f := model.NewForm(owner.ID, title, "", testSchema)
+		f.Tags = []string{"public"}
+		require.NoError(t, repo.CreateForm(ctx, f))
+	}
+
+	t.Run("reads back JSON columns and tags", func(t *testing.T) {
+		forms, err := repo.ListForms(ctx, owner.ID)
+		require.NoError(t, err)
+		require.Len(t, forms, len(titles))
+
+		f, err := repo.GetFormByID(ctx, forms[0].ID)
+		require.NoError(t, err)
+		assert.Equal(t, "form", f.Schema["display"])
+		assert.Equal(t, []string{"public"}, f.Tags)
+	})
+
+	t.Run("searches titles case-insensitively and literally", func(t *testing.T) {
+		page := listForms(t, repo, form.FormListFilter{UserID: owner.ID, Search: "SURVEY 100%"})
+		require.Len(t, page.Forms, 1)
+		assert.Equal(t, "Survey 100%", page.Forms[0].Title)
+	})
+
+	t.Run("pages through forms with cursors", func(t *testing.T) {
+		var seen []string
+
+		filter := form.FormListFilter{UserID: owner.ID, Sort: form.SortTitle, Limit: 3}
+		for {
+			page := listForms(t, repo, filter)
+			assert.Equal(t, int64(len(titles)), page.Total)
+
+			for _, f := range page.Forms {
+				seen = append(seen, f.Title)
+			}
+
+			if page.NextCursor == "" {
+				break
+			}
+
+			cursor, err := common.DecodeCursor(page.NextCursor)
+			require.NoError(t, err)
+
+			filter.Cursor = cursor
+		}
+
+		assert.Equal(t, []string{"Contact us", "Job application", "Survey 100 percent", "Survey 100%"}, seen)
+	})
+}
+
+func TestSubmissionRepository_SQLite(t *testing.T) {
+	tdb := newTestDB(t)
+	ctx := t.Context()
+	owner := tdb.createUser(t, "owner@example.com")
+	forms := formstore.NewStore(tdb.db, tdb.logger)
+
+	f := model.NewForm(owner.ID, "Contact us", "", testSchema)
+	require.NoError(t, forms.CreateForm(ctx, f))
+
+	now := time.Now().UTC()
+	for i := range 5 {
+		require.NoError(t, forms.CreateSubmission(ctx, &model.FormSubmission{
+			FormID:      f.ID,
+			Data:        model.JSON{"email": fmt.Sprintf("Person%d@Example.com", i)},
+			SubmittedAt: now.Add(-time.Duration(i) * 24 * time.Hour),
+			Status:      model.SubmissionStatusPending,
+		}))
+	}
+
+	t.Run("searches submission data as text", func(t *testing.T) {
+		searcher, ok := submissionstore.NewStore(tdb.db, tdb.logger).(interface {
+			Search(ctx context.Context, query string, offset, limit int) ([]*model.FormSubmission, error)
+		})
+		require.True(t, ok)
+
+		found, err := searcher.Search(ctx, "person3@example", 0, 10)
+		require.NoError(t, err)
+		require.Len(t, found, 1)
+		assert.Equal(t, "Person3@Example.com", found[0].Data["email"])
+	})
+
+	t.Run("pages through submissions newest first", func(t *testing.T) {
+		filter := form.SubmissionListFilter{FormID: f.ID, Limit: 2}
+		filter.Normalize()
+
+		page, err := forms.ListSubmissionsPage(ctx, filter)
+		require.NoError(t, err)
+		require.Len(t, page.Submissions, 2)
+		assert.Equal(t, "Person0@Example.com", page.Submissions[0].Data["email"])
+
+		filter.Cursor, err = common.DecodeCursor(page.NextCursor)
+		require.NoError(t, err)
+
+		page, err = forms.ListSubmissionsPage(ctx, filter)
+		require.NoError(t, err)
+		require.Len(t, page.Submissions, 2)
+		assert.Equal(t, "Person2@Example.com", page.Submissions[0].Data["email"])
+	})
+
+	t.Run("purges submissions older than a cutoff", func(t *testing.T) {
+		filter := form.SubmissionPurgeFilter{SubmittedBefore: now.Add(-36 * time.Hour), FormID: f.ID}
+
+		count, err := forms.CountPurgeableSubmissions(ctx, filter)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), count)
+
+		deleted, err := forms.PurgeSubmissions(ctx, filter)
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), deleted)
+
+		remaining, err := forms.ListSubmissions(ctx, f.ID)
+		require.NoError(t, err)
+		assert.Len(t, remaining, 2)
+	})
+
+	t.Run("deleting a form cascades to its submissions", func(t *testing.T) {
+		require.NoError(t, tdb.db.GetDB().Exec("DELETE FROM forms WHERE uuid = ?", f.ID).Error)
+
+		remaining, err := forms.ListSubmissions(ctx, f.ID)
+		require.NoError(t, err)
+		assert.Empty(t, remaining)
+	})
+}
+
+// listForms returns a page of forms for a filter
+func listForms(t *testing.T, repo form.Repository, filter form.FormListFilter) *form.FormPage {
+	t.Helper()
+
+	filter.Normalize()
+	require.NoError(t, filter.Validate())
+
+	page, err := repo.ListFormsPage(t.Context(), filter)
+	require.NoError(t, err)
+
+	return page
+}
@@ -0,0 +1,899 @@
+package integration_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goformx/goforms/internal/domain/bulk"
+	"github.com/goformx/goforms/internal/domain/entities"
+	"github.com/goformx/goforms/internal/domain/form"
+	"github.com/goformx/goforms/internal/domain/form/model"
+	"github.com/goformx/goforms/internal/domain/review"
+	"github.com/goformx/goforms/internal/domain/user"
+	bulkstore "github.com/goformx/goforms/internal/infrastructure/repository/bulk"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+	formstore "github.com/goformx/goforms/internal/infrastructure/repository/form"
+	submissionstore "github.com/goformx/goforms/internal/infrastructure/repository/form/submission"
+	memorystore "github.com/goformx/goforms/internal/infrastructure/repository/memory"
+	reviewstore "github.com/goformx/goforms/internal/infrastructure/repository/review"
+	userstore "github.com/goformx/goforms/internal/infrastructure/repository/user"
+)
+
+// contractStores are the repositories the contract suite exercises, all backed by one store
+type contractStores struct {
+	users       user.Repository
+	forms       form.Repository
+	submissions form.SubmissionRepository
+	bulkJobs    bulk.Repository
+	reviews     review.Repository
+}
+
+// contractBackends create empty stores for every implementation that must honour the contract
+var contractBackends = map[string]func(t *testing.T) contractStores{
+	"memory": func(t *testing.T) contractStores {
+		t.Helper()
+
+		db, logger := memorystore.NewDatabase(), newTestLogger(t)
+
+		return contractStores{
+			users:       memorystore.NewUserStore(db, logger),
+			forms:       memorystore.NewFormStore(db, logger),
+			submissions: memorystore.NewSubmissionStore(db, logger),
+			bulkJobs:    memorystore.NewBulkStore(db, logger),
+			reviews:     memorystore.NewReviewStore(db, logger),
+		}
+	},
+	"gorm": func(t *testing.T) contractStores {
+		t.Helper()
+
+		tdb := newTestDB(t)
+
+		// Start from empty tables like the memory stores do, without the demo form the migrations seed
+		require.NoError(t, tdb.db.GetDB().Exec("DELETE FROM forms").Error)
+		require.NoError(t, tdb.db.GetDB().Exec("DELETE FROM users").Error)
+
+		return contractStores{
+			users:       userstore.NewStore(tdb.db, tdb.logger),
+			forms:       formstore.NewStore(tdb.db, tdb.logger),
+			submissions: submissionstore.NewStore(tdb.db, tdb.logger),
+			bulkJobs:    bulkstore.NewStore(tdb.db, tdb.logger),
+			reviews:     reviewstore.NewStore(tdb.db, tdb.logger),
+		}
+	},
+}
+
+// runContract runs a contract test against a fresh store of every backend
+func runContract(t *testing.T, test func(t *testing.T, stores contractStores)) {
+	t.Helper()
+
+	for name, newStores := range contractBackends {
+		t.Run(name, func(t *testing.T) {
+			test(t, newStores(t))
+		})
+	}
+}
+
+// createUser stores a user for forms to belong to
+func (s contractStores) createUser(t *testing.T, email string) *entities.User {
+	t.Helper()
+
+	u := &entities.User{Email: email, HashedPassword: "not-a-real-hash", FirstName: "Test", LastName: "User"}
+	require.NoError(t, s.users.Create(t.Context(), u))
+
+	return u
+}
+
+// createForm stores a form owned by ownerID
+func (s contractStores) createForm(t *testing.T, ownerID, title string, tags ...string) *model.Form {
+	t.Helper()
+
+	f := model.NewForm(ownerID, title, "", testSchema)
+	f.Tags = tags
+	require.NoError(t, s.forms.CreateForm(t.Context(), f))
+
+	return f
+}
+
+// createSubmission stores a pending submission of a form
+func (s contractStores) createSubmission(t *testing.T, formID string, submittedAt time.Time, data model.JSON) *model.FormSubmission {
+	t.Helper()
+
+	sub := &model.FormSubmission{FormID: formID, Data: data, SubmittedAt: submittedAt, Status: model.SubmissionStatusPending}
+	require.NoError(t, s.forms.CreateSubmission(t.Context(), sub))
+
+	return sub
+}
+
+// pageForms walks a form listing through its next cursors and returns the titles in order
+func pageForms(t *testing.T, repo form.Repository, filter form.FormListFilter) []string {
+	t.Helper()
+
+	var titles []string
+
+	for {
+		page := listForms(t, repo, filter)
+		for _, f := range page.Forms {
+			titles = append(titles, f.Title)
+		}
+
+		if page.NextCursor == "" {
+			return titles
+		}
+
+		cursor, err := common.DecodeCursor(page.NextCursor)
+		require.NoError(t, err)
+
+		filter.Cursor = cursor
+	}
+}
+
+func TestFormRepositoryContract_CreateAndGet(t *testing.T) {
+	runContract(t, func(t *testing.T, s contractStores) {
+		ctx := t.Context()
+		owner := s.createUser(t, "owner@example.com")
+
+		f := &model.Form{UserID: owner.ID, Title: "Contact", Schema: testSchema, Tags: []string{"sales", "public"}}
+		require.NoError(t, s.forms.CreateForm(ctx, f))
+		assert.NotEmpty(t, f.ID)
+		assert.Equal(t, "draft", f.Status)
+		assert.Equal(t, "free", f.PlanTier)
+		assert.True(t, f.Active)
+
+		got, err := s.forms.GetFormByID(ctx, "  "+strings.ToUpper(f.ID)+" ")
+		require.NoError(t, err)
+		assert.Equal(t, "Contact", got.Title)
+		assert.Equal(t, "form", got.Schema["display"])
+		assert.Equal(t, []string{"public", "sales"}, got.Tags)
+		assert.WithinDuration(t, f.CreatedAt, got.CreatedAt, time.Millisecond)
+
+		got.Schema["display"] = "wizard"
+		again, err := s.forms.GetFormByID(ctx, f.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "form", again.Schema["display"], "reads must not share state with the store")
+
+		_, err = s.forms.GetFormByID(ctx, "not-a-uuid")
+		require.Error(t, err)
+		assert.NotErrorIs(t, err, common.ErrNotFound)
+
+		_, err = s.forms.GetFormByID(ctx, "6f1c2b3a-0000-4000-8000-000000000001")
+		require.ErrorIs(t, err, common.ErrNotFound)
+
+		require.Error(t, s.forms.CreateForm(ctx, model.NewForm("6f1c2b3a-0000-4000-8000-00000000ffff", "Orphan", "", testSchema)),
+			"forms must belong to a stored user")
+	})
+}
+
+func TestFormRepositoryContract_ListUpdateDelete(t *testing.T) {
+	runContract(t, func(t *testing.T, s contractStores) {
+		ctx := t.Context()
+		owner := s.createUser(t, "owner@example.com")
+
+		first := s.createForm(t, owner.ID, "First", "a")
+		second := s.createForm(t, owner.ID, "Second")
+		s.createForm(t, owner.ID, "Third")
+		require.NoError(t, s.forms.UpdateForm(ctx, &model.Form{ID: second.ID, WorkspaceID: "workspace-1"}))
+
+		// Forms created in a workspace belong to it, not to the creator's personal forms
+		forms, err := s.forms.ListForms(ctx, owner.ID)
+		require.NoError(t, err)
+		require.Len(t, forms, 2)
+		assert.Equal(t, "Third", forms[0].Title, "newest first")
+		assert.Equal(t, first.ID, forms[1].ID)
+
+		count, err := s.forms.CountFormsByUser(ctx, owner.ID)
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+
+		workspaceForms, err := s.forms.ListFormsByWorkspace(ctx, "workspace-1")
+		require.NoError(t, err)
+		require.Len(t, workspaceForms, 1)
+		assert.Equal(t, second.ID, workspaceForms[0].ID)
+
+		t.Run("updates only non-zero fields", func(t *testing.T) {
+			require.NoError(t, s.forms.UpdateForm(ctx, &model.Form{ID: first.ID, Title: "Renamed", Status: "published"}))
+
+			got, getErr := s.forms.GetFormByID(ctx, first.ID)
+			require.NoError(t, getErr)
+			assert.Equal(t, "Renamed", got.Title)
+			assert.Equal(t, "published", got.Status)
+			assert.Equal(t, "form", got.Schema["display"])
+			assert.Equal(t, []string{"a"}, got.Tags, "nil tags leave the tags alone")
+			assert.False(t, got.UpdatedAt.Before(first.UpdatedAt))
+
+			require.NoError(t, s.forms.UpdateForm(ctx, &model.Form{ID: first.ID, Tags: []string{}}))
+
+			got, getErr = s.forms.GetFormByID(ctx, first.ID)
+			require.NoError(t, getErr)
+			assert.Empty(t, got.Tags)
+
+			published, statusErr := s.forms.GetFormsByStatus(ctx, "published")
+			require.NoError(t, statusErr)
+			require.Len(t, published, 1)
+			assert.Equal(t, first.ID, published[0].ID)
+		})
+
+		t.Run("updating a missing form is not found", func(t *testing.T) {
+			err := s.forms.UpdateForm(ctx, &model.Form{ID: "6f1c2b3a-0000-4000-8000-000000000001", Title: "Nobody"})
+			require.ErrorIs(t, err, common.ErrNotFound)
+		})
+
+		t.Run("deletes softly", func(t *testing.T) {
+			require.NoError(t, s.forms.DeleteForm(ctx, second.ID))
+			require.ErrorIs(t, s.forms.DeleteForm(ctx, second.ID), common.ErrNotFound)
+
+			_, getErr := s.forms.GetFormByID(ctx, second.ID)
+			require.ErrorIs(t, getErr, common.ErrNotFound)
+			require.ErrorIs(t, s.forms.UpdateForm(ctx, &model.Form{ID: second.ID, Title: "Back"}), common.ErrNotFound)
+
+			count, countErr := s.forms.CountFormsByWorkspace(ctx, "workspace-1")
+			require.NoError(t, countErr)
+			assert.Zero(t, count)
+		})
+	})
+}
+
+func TestFormRepositoryContract_ListFormsPage(t *testing.T) {
+	runContract(t, func(t *testing.T, s contractStores) {
+		ctx := t.Context()
+		owner := s.createUser(t, "owner@example.com")
+		other := s.createUser(t, "other@example.com")
+
+		titles := []string{"Contact us", "Job application", "Survey 100%", "Survey 100 percent", "Feedback"}
+		forms := make(map[string]*model.Form, len(titles))
+
+		for i, title := range titles {
+			forms[title] = s.createForm(t, owner.ID, title)
+			for range i {
+				s.createSubmission(t, forms[title].ID, time.Now().UTC(), model.JSON{"n": i})
+			}
+		}
+
+		s.createForm(t, other.ID, "Someone else's")
+		require.NoError(t, s.forms.UpdateForm(ctx, &model.Form{ID: forms["Feedback"].ID, Status: "published",
+			Tags: []string{"public"}}))
+
+		// A form the owner created in a workspace is listed with the workspace only
+		team := s.createForm(t, owner.ID, "Team form")
+		require.NoError(t, s.forms.UpdateForm(ctx, &model.Form{ID: team.ID, WorkspaceID: "workspace-1"}))
+
+		t.Run("filters and counts", func(t *testing.T) {
+			page := listForms(t, s.forms, form.FormListFilter{UserID: owner.ID})
+			assert.Equal(t, int64(len(titles)), page.Total)
+
+			page = listForms(t, s.forms, form.FormListFilter{UserID: owner.ID, WorkspaceID: "workspace-1"})
+			require.Len(t, page.Forms, 1)
+			assert.Equal(t, team.ID, page.Forms[0].ID)
+
+			page = listForms(t, s.forms, form.FormListFilter{UserID: owner.ID, Search: "SURVEY 100%"})
+			require.Len(t, page.Forms, 1)
+			assert.Equal(t, "Survey 100%", page.Forms[0].Title)
+
+			page = listForms(t, s.forms, form.FormListFilter{UserID: owner.ID, Status: "published"})
+			require.Len(t, page.Forms, 1)
+			assert.Equal(t, "Feedback", page.Forms[0].Title)
+			assert.Equal(t, int64(4), page.Forms[0].SubmissionCount)
+
+			page = listForms(t, s.forms, form.FormListFilter{UserID: owner.ID, Tag: "PUBLIC"})
+			require.Len(t, page.Forms, 1)
+			assert.Equal(t, []string{"public"}, page.Forms[0].Tags)
+
+			page = listForms(t, s.forms, form.FormListFilter{UserID: owner.ID, Search: "Contact"})
+			require.Len(t, page.Forms, 1)
+			assert.Equal(t, []string{}, page.Forms[0].Tags)
+		})
+
+		t.Run("pages forwards by each sort", func(t *testing.T) {
+			assert.Equal(t,
+				[]string{"Contact us", "Feedback", "Job application", "Survey 100 percent", "Survey 100%"},
+				pageForms(t, s.forms, form.FormListFilter{UserID: owner.ID, Sort: form.SortTitle, Limit: 2}))
+
+			assert.Equal(t,
+				[]string{"Feedback", "Survey 100 percent", "Survey 100%", "Job application", "Contact us"},
+				pageForms(t, s.forms, form.FormListFilter{UserID: owner.ID, Sort: form.SortSubmissions, Limit: 2}))
+
+			assert.Equal(t,
+				[]string{"Feedback", "Survey 100 percent", "Survey 100%", "Job application", "Contact us"},
+				pageForms(t, s.forms, form.FormListFilter{UserID: owner.ID, Limit: 3}))
+		})
+
+		t.Run("pages backwards from a next cursor", func(t *testing.T) {
+			filter := form.FormListFilter{UserID: owner.ID, Sort: form.SortTitle, Limit: 2}
+
+			first := listForms(t, s.forms, filter)
+			require.NotEmpty(t, first.NextCursor)
+			assert.Empty(t, first.PrevCursor)
+
+			var err error
+
+			filter.Cursor, err = common.DecodeCursor(first.NextCursor)
+			require.NoError(t, err)
+
+			second := listForms(t, s.forms, filter)
+			require.NotEmpty(t, second.PrevCursor)
+
+			filter.Cursor, err = common.DecodeCursor(second.PrevCursor)
+			require.NoError(t, err)
+
+			back := listForms(t, s.forms, filter)
+			require.Len(t, back.Forms, 2)
+			assert.Equal(t, first.Forms[0].ID, back.Forms[0].ID)
+			assert.Equal(t, first.Forms[1].ID, back.Forms[1].ID)
+			assert.Empty(t, back.PrevCursor)
+		})
+
+		t.Run("rejects a cursor with an unreadable value", func(t *testing.T) {
+			filter := form.FormListFilter{UserID: owner.ID, Limit: 2}
+			filter.Normalize()
+			filter.Cursor = &common.Cursor{Sort: filter.Sort, Order: filter.Order, Value: "yesterday", ID: "x"}
+
+			_, err := s.forms.ListFormsPage(ctx, filter)
+			require.Error(t, err)
+		})
+	})
+}
+
+func TestFormRepositoryContract_MonthlySubmissionCounts(t *testing.T) {
+	runContract(t, func(t *testing.T, s contractStores) {
+		ctx := t.Context()
+		owner := s.createUser(t, "owner@example.com")
+
+		personal := s.createForm(t, owner.ID, "Personal")
+		team := s.createForm(t, owner.ID, "Team")
+		deleted := s.createForm(t, owner.ID, "Deleted")
+		require.NoError(t, s.forms.UpdateForm(ctx, &model.Form{ID: team.ID, WorkspaceID: "workspace-1"}))
+
+		inMonth := time.Date(2026, time.March, 31, 23, 0, 0, 0, time.UTC)
+		nextMonth := time.Date(2026, time.April, 1, 0, 0, 0, 0, time.UTC)
+
+		submit := func(formID string, created time.Time) {
+			require.NoError(t, s.forms.CreateSubmission(ctx, &model.FormSubmission{
+				FormID: formID, Data: model.JSON{}, SubmittedAt: created, Status: model.SubmissionStatusCompleted,
+				CreatedAt: created,
+			}))
+		}
+
+		for _, created := range []time.Time{inMonth, inMonth.Add(-time.Hour), nextMonth} {
+			submit(personal.ID, created)
+		}
+
+		submit(team.ID, inMonth)
+		submit(team.ID, nextMonth)
+		submit(deleted.ID, inMonth)
+		require.NoError(t, s.forms.DeleteForm(ctx, deleted.ID))
+
+		// Submissions to workspace forms count against the workspace only
+		count, err := s.forms.CountSubmissionsByUserMonth(ctx, owner.ID, 2026, int(time.March))
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+
+		count, err = s.forms.CountSubmissionsByWorkspaceMonth(ctx, "workspace-1", 2026, int(time.March))
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+
+		count, err = s.forms.CountSubmissionsByWorkspaceMonth(ctx, "workspace-1", 2026, int(time.April))
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+}
+
+func TestFormRepositoryContract_Submissions(t *testing.T) {
+	runContract(t, func(t *testing.T, s contractStores) {
+		ctx := t.Context()
+		owner := s.createUser(t, "owner@example.com")
+		f := s.createForm(t, owner.ID, "Contact")
+
+		now := time.Now().UTC()
+
+		subs := make([]*model.FormSubmission, 5)
+		for i := range subs {
+			subs[i] = s.createSubmission(t, f.ID, now.Add(-time.Duration(i)*time.Hour), model.JSON{"n": i})
+		}
+
+		t.Run("creates and reads submissions", func(t *testing.T) {
+			got, err := s.forms.GetSubmissionByID(ctx, subs[0].ID)
+			require.NoError(t, err)
+			assert.InDelta(t, 0, got.Data["n"], 0)
+			assert.Equal(t, []string{}, got.Tags)
+
+			_, err = s.forms.GetSubmissionByID(ctx, "6f1c2b3a-0000-4000-8000-000000000001")
+			require.ErrorIs(t, err, common.ErrNotFound)
+
+			err = s.forms.CreateSubmission(ctx, &model.FormSubmission{
+				FormID: "6f1c2b3a-0000-4000-8000-000000000001", Data: model.JSON{}, SubmittedAt: now,
+			})
+			require.Error(t, err, "submissions must belong to a stored form")
+
+			listed, err := s.forms.ListSubmissions(ctx, f.ID)
+			require.NoError(t, err)
+			assert.Len(t, listed, len(subs))
+		})
+
+		t.Run("updates non-zero fields", func(t *testing.T) {
+			require.NoError(t, s.forms.UpdateSubmission(ctx, &model.FormSubmission{
+				ID: subs[1].ID, Status: model.SubmissionStatusCompleted, AssigneeID: "reviewer",
+			}))
+
+			got, err := s.forms.GetSubmissionByID(ctx, subs[1].ID)
+			require.NoError(t, err)
+			assert.Equal(t, model.SubmissionStatusCompleted, got.Status)
+			assert.Equal(t, "reviewer", got.AssigneeID)
+			assert.InDelta(t, 1, got.Data["n"], 0)
+
+			err = s.forms.UpdateSubmission(ctx, &model.FormSubmission{ID: "missing", Status: model.SubmissionStatusSpam})
+			require.ErrorIs(t, err, common.ErrNotFound)
+		})
+
+		t.Run("pages and filters submissions", func(t *testing.T) {
+			filter := form.SubmissionListFilter{FormID: f.ID, Limit: 2}
+			filter.Normalize()
+
+			page, err := s.forms.ListSubmissionsPage(ctx, filter)
+			require.NoError(t, err)
+			assert.Equal(t, int64(len(subs)), page.Total)
+			require.Len(t, page.Submissions, 2)
+			assert.Equal(t, subs[0].ID, page.Submissions[0].ID)
+
+			filter.Cursor, err = common.DecodeCursor(page.NextCursor)
+			require.NoError(t, err)
+
+			page, err = s.forms.ListSubmissionsPage(ctx, filter)
+			require.NoError(t, err)
+			require.Len(t, page.Submissions, 2)
+			assert.Equal(t, subs[2].ID, page.Submissions[0].ID)
+
+			for assignee, want := range map[string]int64{"reviewer": 1, form.AssigneeNone: 4} {
+				filter = form.SubmissionListFilter{FormID: f.ID, AssigneeID: assignee}
+				filter.Normalize()
+
+				page, err = s.forms.ListSubmissionsPage(ctx, filter)
+				require.NoError(t, err)
+				assert.Equal(t, want, page.Total, assignee)
+			}
+
+			filter = form.SubmissionListFilter{FormID: f.ID, Status: model.SubmissionStatusCompleted}
+			filter.Normalize()
+
+			page, err = s.forms.ListSubmissionsPage(ctx, filter)
+			require.NoError(t, err)
+			assert.Equal(t, int64(1), page.Total)
+
+			result, err := s.forms.GetByFormIDPaginated(ctx, f.ID, common.NewPaginationParams(2, 2))
+			require.NoError(t, err)
+			assert.Equal(t, len(subs), result.TotalItems)
+			assert.Equal(t, 3, result.TotalPages)
+			assert.Len(t, result.Items, 2)
+		})
+
+		t.Run("purges and deletes submissions", func(t *testing.T) {
+			filter := form.SubmissionPurgeFilter{SubmittedBefore: now.Add(-150 * time.Minute), FormID: f.ID}
+
+			count, err := s.forms.CountPurgeableSubmissions(ctx, filter)
+			require.NoError(t, err)
+			assert.Equal(t, int64(2), count)
+
+			deleted, err := s.forms.PurgeSubmissions(ctx, filter)
+			require.NoError(t, err)
+			assert.Equal(t, int64(2), deleted)
+
+			require.NoError(t, s.forms.DeleteSubmission(ctx, subs[0].ID))
+			require.ErrorIs(t, s.forms.DeleteSubmission(ctx, subs[0].ID), common.ErrNotFound)
+
+			remaining, err := s.forms.GetByFormID(ctx, f.ID)
+			require.NoError(t, err)
+			assert.Len(t, remaining, 2)
+		})
+	})
+}
+
+func TestSubmissionRepositoryContract(t *testing.T) {
+	runContract(t, func(t *testing.T, s contractStores) {
+		ctx := t.Context()
+		owner := s.createUser(t, "owner@example.com")
+		f := s.createForm(t, owner.ID, "Contact")
+
+		for i := range 3 {
+			require.NoError(t, s.submissions.Create(ctx, &model.FormSubmission{
+				FormID:      f.ID,
+				Data:        model.JSON{"email": fmt.Sprintf("Person%d@Example.com", i)},
+				SubmittedAt: time.Now().UTC(),
+				Status:      model.SubmissionStatusPending,
+			}))
+		}
+
+		all, err := s.submissions.List(ctx, 0, -1)
+		require.NoError(t, err)
+		require.Len(t, all, 3)
+
+		t.Run("reads, saves and deletes by ID", func(t *testing.T) {
+			got, getErr := s.submissions.GetByID(ctx, all[0].ID)
+			require.NoError(t, getErr)
+			assert.Equal(t, "Person0@Example.com", got.Data["email"])
+
+			got.Status = model.SubmissionStatusSpam
+			require.NoError(t, s.submissions.Update(ctx, got))
+
+			spam, statusErr := s.submissions.GetSubmissionsByStatus(ctx, model.SubmissionStatusSpam, common.NewPaginationParams(1, 10))
+			require.NoError(t, statusErr)
+			assert.Equal(t, 1, spam.TotalItems)
+			assert.Len(t, spam.Items, 1)
+
+			require.NoError(t, s.submissions.Delete(ctx, got.ID))
+
+			_, getErr = s.submissions.GetByID(ctx, got.ID)
+			require.ErrorIs(t, getErr, common.ErrNotFound)
+		})
+
+		t.Run("searches data and status", func(t *testing.T) {
+			found, searchErr := s.submissions.Search(ctx, "person2@example", 0, 10)
+			require.NoError(t, searchErr)
+			require.Len(t, found, 1)
+			assert.Equal(t, "Person2@Example.com", found[0].Data["email"])
+
+			found, searchErr = s.submissions.Search(ctx, "PENDING", 0, 10)
+			require.NoError(t, searchErr)
+			assert.Len(t, found, 2)
+		})
+
+		t.Run("counts and pages", func(t *testing.T) {
+			count, countErr := s.submissions.Count(ctx)
+			require.NoError(t, countErr)
+			assert.Equal(t, 2, count)
+
+			page, listErr := s.submissions.List(ctx, 1, 5)
+			require.NoError(t, listErr)
+			assert.Len(t, page, 1)
+
+			result, pageErr := s.submissions.GetByFormIDPaginated(ctx, f.ID, common.NewPaginationParams(1, 1))
+			require.NoError(t, pageErr)
+			assert.Equal(t, 2, result.TotalItems)
+			assert.Equal(t, 2, result.TotalPages)
+			assert.Len(t, result.Items, 1)
+
+			empty, pageErr := s.submissions.GetByFormIDPaginated(ctx, "6f1c2b3a-0000-4000-8000-000000000001",
+				common.NewPaginationParams(1, 10))
+			require.NoError(t, pageErr)
+			assert.Equal(t, []any{}, empty.Items)
+		})
+	})
+}
+
+func TestUserRepositoryContract(t *testing.T) {
+	runContract(t, func(t *testing.T, s contractStores) {
+		ctx := t.Context()
+
+		ada := s.createUser(t, "ada@example.com")
+		assert.NotEmpty(t, ada.ID)
+		assert.Equal(t, "user", ada.Role)
+		assert.True(t, ada.Active)
+
+		grace := &entities.User{Email: "grace@example.com", HashedPassword: "x", FirstName: "Grace", LastName: "Hopper", Role: "admin"}
+		require.NoError(t, s.users.Create(ctx, grace))
+
+		linus := s.createUser(t, "linus@example.com")
+
+		require.Error(t, s.users.Create(ctx, &entities.User{Email: "ada@example.com", HashedPassword: "x"}),
+			"emails are unique")
+
+		t.Run("gets by ID and email", func(t *testing.T) {
+			got, err := s.users.GetByEmail(ctx, "grace@example.com")
+			require.NoError(t, err)
+			assert.Equal(t, grace.ID, got.ID)
+
+			got, err = s.users.GetByID(ctx, ada.ID)
+			require.NoError(t, err)
+			assert.Equal(t, "ada@example.com", got.Email)
+
+			_, err = s.users.GetByEmail(ctx, "nobody@example.com")
+			require.ErrorIs(t, err, common.ErrNotFound)
+
+			_, err = s.users.GetByID(ctx, "6f1c2b3a-0000-4000-8000-000000000001")
+			require.ErrorIs(t, err, common.ErrNotFound)
+		})
+
+		t.Run("updates every field", func(t *testing.T) {
+			got, err := s.users.GetByID(ctx, linus.ID)
+			require.NoError(t, err)
+
+			got.Active = false
+			got.LastName = "Torvalds"
+			require.NoError(t, s.users.Update(ctx, got))
+
+			inactive, err := s.users.GetInactiveUsers(ctx, 0, 10)
+			require.NoError(t, err)
+			require.Len(t, inactive, 1)
+			assert.Equal(t, "Torvalds", inactive[0].LastName)
+
+			active, err := s.users.GetActiveUsers(ctx, 0, 10)
+			require.NoError(t, err)
+			assert.Len(t, active, 2)
+		})
+
+		t.Run("lists by ID with offset and limit", func(t *testing.T) {
+			all, err := s.users.List(ctx, 0, 10)
+			require.NoError(t, err)
+			require.Len(t, all, 3)
+
+			for i := 1; i < len(all); i++ {
+				assert.Less(t, all[i-1].ID, all[i].ID)
+			}
+
+			page, err := s.users.List(ctx, 1, 1)
+			require.NoError(t, err)
+			require.Len(t, page, 1)
+			assert.Equal(t, all[1].ID, page[0].ID)
+
+			admins, err := s.users.GetByRole(ctx, "admin", 0, 10)
+			require.NoError(t, err)
+			require.Len(t, admins, 1)
+			assert.Equal(t, grace.ID, admins[0].ID)
+		})
+
+		t.Run("searches names and email ignoring case", func(t *testing.T) {
+			found, err := s.users.Search(ctx, "HOPPER", 0, 10)
+			require.NoError(t, err)
+			require.Len(t, found, 1)
+			assert.Equal(t, grace.ID, found[0].ID)
+
+			found, err = s.users.Search(ctx, "example.com", 0, 10)
+			require.NoError(t, err)
+			assert.Len(t, found, 3)
+		})
+
+		t.Run("deletes softly", func(t *testing.T) {
+			require.NoError(t, s.users.Delete(ctx, ada.ID))
+			require.ErrorIs(t, s.users.Delete(ctx, ada.ID), common.ErrNotFound)
+
+			_, err := s.users.GetByID(ctx, ada.ID)
+			require.ErrorIs(t, err, common.ErrNotFound)
+
+			count, err := s.users.Count(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, 2, count)
+
+			require.Error(t, s.users.Create(ctx, &entities.User{Email: "ada@example.com", HashedPassword: "x"}),
+				"deleted users keep their email")
+		})
+	})
+}
+
+func TestBulkRepositoryContract(t *testing.T) {
+	runContract(t, func(t *testing.T, s contractStores) {
+		ctx := t.Context()
+		owner := s.createUser(t, "owner@example.com")
+		f := s.createForm(t, owner.ID, "Contact")
+		other := s.createForm(t, owner.ID, "Other")
+
+		now := time.Now().UTC().Truncate(time.Second)
+
+		subs := make([]*model.FormSubmission, 4)
+		for i := range subs {
+			subs[i] = s.createSubmission(t, f.ID, now.Add(-time.Duration(i)*time.Hour), model.JSON{"n": i})
+		}
+
+		s.createSubmission(t, other.ID, now, model.JSON{})
+
+		job := &bulk.Job{FormID: f.ID, CreatedBy: owner.ID, Operation: bulk.OperationExport, Status: bulk.StatusQueued}
+		require.NoError(t, s.bulkJobs.CreateJob(ctx, job))
+
+		t.Run("stores and lists jobs", func(t *testing.T) {
+			got, err := s.bulkJobs.GetJob(ctx, job.ID)
+			require.NoError(t, err)
+			assert.Equal(t, bulk.StatusQueued, got.Status)
+
+			_, err = s.bulkJobs.GetJob(ctx, "6f1c2b3a-0000-4000-8000-000000000001")
+			require.ErrorIs(t, err, bulk.ErrJobNotFound)
+
+			err = s.bulkJobs.CreateJob(ctx, &bulk.Job{
+				FormID: "6f1c2b3a-0000-4000-8000-000000000001", CreatedBy: owner.ID,
+				Operation: bulk.OperationDelete, Status: bulk.StatusQueued,
+			})
+			require.Error(t, err, "jobs must belong to a stored form")
+
+			got.Status = bulk.StatusRunning
+			require.NoError(t, s.bulkJobs.UpdateJob(ctx, got))
+
+			jobs, err := s.bulkJobs.ListJobs(ctx, f.ID, 10)
+			require.NoError(t, err)
+			require.Len(t, jobs, 1)
+			assert.Equal(t, bulk.StatusRunning, jobs[0].Status)
+		})
+
+		t.Run("transitions jobs from the expected status only", func(t *testing.T) {
+			claimed := &bulk.Job{FormID: f.ID, CreatedBy: owner.ID, Operation: bulk.OperationDelete, Status: bulk.StatusQueued}
+			require.NoError(t, s.bulkJobs.CreateJob(ctx, claimed))
+
+			stale, err := s.bulkJobs.ListStaleJobs(ctx, time.Now().Add(time.Minute))
+			require.NoError(t, err)
+			assert.True(t, slices.ContainsFunc(stale, func(j *bulk.Job) bool { return j.ID == claimed.ID }))
+
+			stale, err = s.bulkJobs.ListStaleJobs(ctx, time.Now().Add(-time.Minute))
+			require.NoError(t, err)
+			assert.Empty(t, stale)
+
+			claimed.Status = bulk.StatusRunning
+			ok, err := s.bulkJobs.TransitionJob(ctx, claimed, bulk.StatusQueued)
+			require.NoError(t, err)
+			assert.True(t, ok)
+
+			// A second claim of the same queued job loses
+			ok, err = s.bulkJobs.TransitionJob(ctx, claimed, bulk.StatusQueued)
+			require.NoError(t, err)
+			assert.False(t, ok)
+
+			claimed.Status, claimed.Error = bulk.StatusFailed, "interrupted"
+			ok, err = s.bulkJobs.TransitionJob(ctx, claimed, bulk.StatusRunning)
+			require.NoError(t, err)
+			assert.True(t, ok)
+
+			got, err := s.bulkJobs.GetJob(ctx, claimed.ID)
+			require.NoError(t, err)
+			assert.Equal(t, bulk.StatusFailed, got.Status)
+			assert.Equal(t, "interrupted", got.Error)
+
+			stale, err = s.bulkJobs.ListStaleJobs(ctx, time.Now().Add(time.Minute))
+			require.NoError(t, err)
+			assert.False(t, slices.ContainsFunc(stale, func(j *bulk.Job) bool { return j.ID == claimed.ID }), "failed jobs are not stale")
+		})
+
+		t.Run("selects submissions in ID order", func(t *testing.T) {
+			count, err := s.bulkJobs.CountSelection(ctx, f.ID, bulk.Selection{})
+			require.NoError(t, err)
+			assert.Equal(t, int64(len(subs)), count)
+
+			after := now.Add(-150 * time.Minute)
+
+			count, err = s.bulkJobs.CountSelection(ctx, f.ID, bulk.Selection{SubmittedAfter: &after})
+			require.NoError(t, err)
+			assert.Equal(t, int64(3), count)
+
+			count, err = s.bulkJobs.CountSelection(ctx, f.ID, bulk.Selection{IDs: bulk.IDList{subs[0].ID, subs[1].ID}})
+			require.NoError(t, err)
+			assert.Equal(t, int64(2), count)
+
+			var ids []string
+
+			afterID := ""
+
+			for {
+				chunk, chunkErr := s.bulkJobs.NextChunk(ctx, f.ID, bulk.Selection{}, afterID, 3)
+				require.NoError(t, chunkErr)
+
+				if len(chunk) == 0 {
+					break
+				}
+
+				for _, sub := range chunk {
+					ids = append(ids, sub.ID)
+				}
+
+				afterID = chunk[len(chunk)-1].ID
+			}
+
+			assert.Len(t, ids, len(subs))
+			assert.IsIncreasing(t, ids)
+		})
+
+		t.Run("commits chunks with their output", func(t *testing.T) {
+			job.Processed = 2
+			require.NoError(t, s.bulkJobs.CommitChunk(ctx, job, []string{subs[0].ID, subs[1].ID}, bulk.Change{
+				Status: model.SubmissionStatusSpam, Output: []byte("a\n"),
+			}))
+
+			job.Processed = 3
+			require.NoError(t, s.bulkJobs.CommitChunk(ctx, job, []string{subs[2].ID}, bulk.Change{
+				Delete: true, Output: []byte("b\n"),
+			}))
+
+			output, err := s.bulkJobs.Output(ctx, job.ID)
+			require.NoError(t, err)
+			assert.Equal(t, "a\nb\n", string(output))
+
+			got, err := s.bulkJobs.GetJob(ctx, job.ID)
+			require.NoError(t, err)
+			assert.Equal(t, int64(3), got.Processed)
+
+			spam, err := s.bulkJobs.CountSelection(ctx, f.ID, bulk.Selection{Status: model.SubmissionStatusSpam})
+			require.NoError(t, err)
+			assert.Equal(t, int64(2), spam)
+
+			_, err = s.forms.GetSubmissionByID(ctx, subs[2].ID)
+			require.ErrorIs(t, err, common.ErrNotFound)
+		})
+	})
+}
+
+func TestReviewRepositoryContract(t *testing.T) {
+	runContract(t, func(t *testing.T, s contractStores) {
+		ctx := t.Context()
+		owner := s.createUser(t, "owner@example.com")
+		f := s.createForm(t, owner.ID, "Contact")
+		sub := s.createSubmission(t, f.ID, time.Now().UTC(), model.JSON{})
+
+		t.Run("saves review state and tags", func(t *testing.T) {
+			got, err := s.reviews.GetSubmission(ctx, f.ID, sub.ID)
+			require.NoError(t, err)
+			assert.Equal(t, []string{}, got.Tags)
+
+			_, err = s.reviews.GetSubmission(ctx, "6f1c2b3a-0000-4000-8000-000000000001", sub.ID)
+			require.ErrorIs(t, err, review.ErrSubmissionNotFound)
+
+			from := got.ReviewStatus
+			got.ReviewStatus, got.AssigneeID = "in_review", "reviewer"
+			saved, err := s.reviews.SaveReview(ctx, got, from, []string{"vip", "billing"})
+			require.NoError(t, err)
+			assert.True(t, saved)
+
+			got.AssigneeID = ""
+			saved, err = s.reviews.SaveReview(ctx, got, "in_review", nil)
+			require.NoError(t, err)
+			assert.True(t, saved)
+
+			// Saving nothing new still succeeds while the status is unchanged
+			saved, err = s.reviews.SaveReview(ctx, got, "in_review", nil)
+			require.NoError(t, err)
+			assert.True(t, saved)
+
+			// An update read before the change above is refused and leaves the tags alone
+			stale := *got
+			stale.ReviewStatus = "rejected"
+			saved, err = s.reviews.SaveReview(ctx, &stale, from, []string{})
+			require.NoError(t, err)
+			assert.False(t, saved)
+
+			got, err = s.reviews.GetSubmission(ctx, f.ID, sub.ID)
+			require.NoError(t, err)
+			assert.Equal(t, "in_review", got.ReviewStatus)
+			assert.Empty(t, got.AssigneeID)
+			assert.Equal(t, []string{"billing", "vip"}, got.Tags)
+
+			filter := form.SubmissionListFilter{FormID: f.ID, Tag: "vip"}
+			filter.Normalize()
+
+			page, err := s.forms.ListSubmissionsPage(ctx, filter)
+			require.NoError(t, err)
+			require.Len(t, page.Submissions, 1)
+			assert.Equal(t, []string{"billing", "vip"}, page.Submissions[0].Tags)
+		})
+
+		t.Run("keeps notes oldest first", func(t *testing.T) {
+			first := &review.Note{SubmissionID: sub.ID, AuthorID: owner.ID, Body: "first"}
+			require.NoError(t, s.reviews.CreateNote(ctx, first))
+			require.NoError(t, s.reviews.CreateNote(ctx, &review.Note{
+				SubmissionID: sub.ID, AuthorID: owner.ID, Body: "second", CreatedAt: first.CreatedAt.Add(time.Second),
+			}))
+
+			err := s.reviews.CreateNote(ctx, &review.Note{
+				SubmissionID: "6f1c2b3a-0000-4000-8000-000000000001", AuthorID: owner.ID, Body: "orphan",
+			})
+			require.Error(t, err, "notes must belong to a stored submission")
+
+			notes, err := s.reviews.ListNotes(ctx, sub.ID)
+			require.NoError(t, err)
+			require.Len(t, notes, 2)
+			assert.Equal(t, "first", notes[0].Body)
+
+			got, err := s.reviews.GetNote(ctx, first.ID)
+			require.NoError(t, err)
+			assert.Equal(t, "first", got.Body)
+
+			require.NoError(t, s.reviews.DeleteNote(ctx, first.ID))
+
+			_, err = s.reviews.GetNote(ctx, first.ID)
+			require.ErrorIs(t, err, review.ErrNoteNotFound)
+		})
+
+		t.Run("deleting a submission deletes its notes and tags", func(t *testing.T) {
+			require.NoError(t, s.forms.DeleteSubmission(ctx, sub.ID))
+
+			notes, err := s.reviews.ListNotes(ctx, sub.ID)
+			require.NoError(t, err)
+			assert.Empty(t, notes)
+		})
+	})
+}
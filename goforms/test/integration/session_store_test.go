@@ -0,0 +1,216 @@
+package integration_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goformx/goforms/internal/application/middleware/session"
+	"github.com/goformx/goforms/internal/infrastructure/config"
+)
+
+// runSessionContract runs a test against every session store
+func runSessionContract(t *testing.T, test func(t *testing.T, storage session.Storage)) {
+	t.Helper()
+
+	t.Run("memory", func(t *testing.T) {
+		test(t, session.NewMemoryStorage())
+	})
+
+	t.Run("database", func(t *testing.T) {
+		tdb := newTestDB(t)
+		test(t, session.NewDatabaseStorage(tdb.db, tdb.logger))
+	})
+
+	t.Run("redis", func(t *testing.T) {
+		server := miniredis.RunT(t)
+
+		storage := session.NewRedisStorage(config.SessionRedisConfig{
+			Addr:      server.Addr(),
+			KeyPrefix: "goforms:session:",
+		})
+		t.Cleanup(func() { _ = storage.Close() })
+
+		test(t, storage)
+	})
+}
+
+func newSession(userID, role string, ttl time.Duration) *session.Session {
+	now := time.Now()
+
+	return &session.Session{
+		UserID:    userID,
+		Email:     userID + "@example.com",
+		Role:      role,
+		CreatedAt: now,
+		ExpiresAt: now.Add(ttl),
+	}
+}
+
+func TestSessionStorageContract_SaveGetDelete(t *testing.T) {
+	runSessionContract(t, func(t *testing.T, storage session.Storage) {
+		ctx := t.Context()
+
+		_, err := storage.Get(ctx, "missing")
+		require.ErrorIs(t, err, session.ErrSessionNotFound)
+
+		require.NoError(t, storage.Save(ctx, "session-a", newSession("user-1", "user", time.Hour)))
+
+		got, err := storage.Get(ctx, "session-a")
+		require.NoError(t, err)
+		assert.Equal(t, "user-1", got.UserID)
+		assert.Equal(t, "user-1@example.com", got.Email)
+		assert.Equal(t, "user", got.Role)
+
+		// Saving again replaces the session
+		require.NoError(t, storage.Save(ctx, "session-a", newSession("user-1", "admin", time.Hour)))
+
+		got, err = storage.Get(ctx, "session-a")
+		require.NoError(t, err)
+		assert.Equal(t, "admin", got.Role)
+
+		require.NoError(t, storage.Delete(ctx, "session-a"))
+		require.NoError(t, storage.Delete(ctx, "session-a"))
+
+		_, err = storage.Get(ctx, "session-a")
+		require.ErrorIs(t, err, session.ErrSessionNotFound)
+	})
+}
+
+func TestSessionStorageContract_IgnoresExpiredSessions(t *testing.T) {
+	runSessionContract(t, func(t *testing.T, storage session.Storage) {
+		ctx := t.Context()
+
+		expired := newSession("user-1", "user", time.Hour)
+		expired.ExpiresAt = time.Now().Add(-time.Minute)
+
+		require.NoError(t, storage.Save(ctx, "session-expired", expired))
+
+		_, err := storage.Get(ctx, "session-expired")
+		require.ErrorIs(t, err, session.ErrSessionNotFound)
+	})
+}
+
+func TestSessionStorageContract_DeleteUser(t *testing.T) {
+	runSessionContract(t, func(t *testing.T, storage session.Storage) {
+		ctx := t.Context()
+
+		require.NoError(t, storage.Save(ctx, "session-a", newSession("user-1", "user", time.Hour)))
+		require.NoError(t, storage.Save(ctx, "session-b", newSession("user-1", "user", time.Hour)))
+		require.NoError(t, storage.Save(ctx, "session-c", newSession("user-2", "user", time.Hour)))
+
+		removed, err := storage.DeleteUser(ctx, "user-1")
+		require.NoError(t, err)
+		assert.Equal(t, 2, removed)
+
+		for _, id := range []string{"session-a", "session-b"} {
+			_, err = storage.Get(ctx, id)
+			require.ErrorIs(t, err, session.ErrSessionNotFound)
+		}
+
+		_, err = storage.Get(ctx, "session-c")
+		require.NoError(t, err)
+
+		removed, err = storage.DeleteUser(ctx, "user-1")
+		require.NoError(t, err)
+		assert.Zero(t, removed)
+	})
+}
+
+func TestSessionStorageContract_ManagerRotatesSessionID(t *testing.T) {
+	runSessionContract(t, func(t *testing.T, storage session.Storage) {
+		ctx := t.Context()
+		manager := newSessionManager(t, storage)
+
+		oldID, err := manager.CreateSession(ctx, "user-1", "user-1@example.com", "user")
+		require.NoError(t, err)
+
+		newID, err := manager.RotateSession(ctx, oldID, "user-1", "user-1@example.com", "admin")
+		require.NoError(t, err)
+		assert.NotEqual(t, oldID, newID)
+
+		_, err = manager.GetSession(ctx, oldID)
+		require.ErrorIs(t, err, session.ErrSessionNotFound)
+
+		got, err := manager.GetSession(ctx, newID)
+		require.NoError(t, err)
+		assert.Equal(t, "admin", got.Role)
+		assert.Equal(t, "user-1", got.UserID)
+
+		// Logging in without a session, or with an unknown one, starts a fresh session
+		freshID, err := manager.RotateSession(ctx, "", "user-1", "user-1@example.com", "admin")
+		require.NoError(t, err)
+
+		_, err = manager.RotateSession(ctx, oldID, "user-1", "user-1@example.com", "admin")
+		require.NoError(t, err)
+
+		removed, err := manager.DeleteUserSessions(ctx, "user-1")
+		require.NoError(t, err)
+		assert.Equal(t, 3, removed)
+
+		_, err = manager.GetSession(ctx, freshID)
+		require.ErrorIs(t, err, session.ErrSessionNotFound)
+	})
+}
+
+// failingDeleteStorage is a session store that cannot remove sessions
+type failingDeleteStorage struct {
+	session.Storage
+}
+
+func (s failingDeleteStorage) Delete(context.Context, string) error {
+	return errors.New("store unavailable")
+}
+
+func TestSessionManager_RotateFailsClosedWhenTheOldSessionStays(t *testing.T) {
+	ctx := t.Context()
+	storage := failingDeleteStorage{Storage: session.NewMemoryStorage()}
+	manager := newSessionManager(t, storage)
+
+	oldID, err := manager.CreateSession(ctx, "user-1", "user-1@example.com", "admin")
+	require.NoError(t, err)
+
+	// Demoting the user must not hand out a new ID while the admin session is still valid
+	newID, err := manager.RotateSession(ctx, oldID, "user-1", "user-1@example.com", "user")
+	require.Error(t, err)
+	assert.Empty(t, newID)
+
+	removed, err := storage.DeleteUser(ctx, "user-1")
+	require.NoError(t, err)
+	assert.Equal(t, 1, removed, "no session was started for the new role")
+}
+
+func newSessionManager(t *testing.T, storage session.Storage) *session.Manager {
+	t.Helper()
+
+	return session.NewManager(newTestLogger(t), &session.Config{
+		SessionConfig: &config.SessionConfig{MaxAge: time.Hour, CookieName: "session"},
+	}, storage, nil)
+}
+
+func TestRedisSessionStorage_ExpiresSessionsAndHashesIDs(t *testing.T) {
+	server := miniredis.RunT(t)
+
+	storage := session.NewRedisStorage(config.SessionRedisConfig{Addr: server.Addr(), KeyPrefix: "sess:"})
+	t.Cleanup(func() { _ = storage.Close() })
+
+	ctx := t.Context()
+
+	require.NoError(t, storage.Save(ctx, "raw-session-id", newSession("user-1", "user", time.Minute)))
+
+	for _, key := range server.Keys() {
+		assert.NotContains(t, key, "raw-session-id")
+		assert.Positive(t, server.TTL(key))
+	}
+
+	server.FastForward(2 * time.Minute)
+
+	_, err := storage.Get(ctx, "raw-session-id")
+	require.ErrorIs(t, err, session.ErrSessionNotFound)
+	assert.Empty(t, server.Keys())
+}
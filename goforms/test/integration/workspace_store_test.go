@@ -0,0 +1,54 @@
+package integration_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goformx/goforms/internal/domain/workspace"
+	"github.com/goformx/goforms/internal/infrastructure/repository/common"
+	workspacestore "github.com/goformx/goforms/internal/infrastructure/repository/workspace"
+)
+
+func TestWorkspaceStore_SQLite(t *testing.T) {
+	tdb := newTestDB(t)
+	ctx := t.Context()
+	store := workspacestore.NewStore(tdb.db, tdb.logger)
+
+	ws := &workspace.Workspace{ID: "8a0d3c52-5a3e-4a43-9a57-3f9f0f6d1c01", Name: "Agency", CreatedBy: "ada", PlanTier: "pro"}
+	require.NoError(t, store.CreateWorkspace(ctx, ws, &workspace.Member{UserID: "ada", Role: workspace.RoleOwner}))
+
+	member := func(userID string, role workspace.Role) *workspace.Member {
+		return &workspace.Member{WorkspaceID: ws.ID, UserID: userID, Role: role}
+	}
+
+	t.Run("keeps the last owner", func(t *testing.T) {
+		require.ErrorIs(t, store.SaveMember(ctx, member("ada", workspace.RoleEditor)), workspace.ErrLastOwner)
+		require.ErrorIs(t, store.DeleteMember(ctx, ws.ID, "ada"), workspace.ErrLastOwner)
+
+		require.NoError(t, store.SaveMember(ctx, member("grace", workspace.RoleOwner)))
+		require.NoError(t, store.SaveMember(ctx, member("ada", workspace.RoleEditor)))
+		require.ErrorIs(t, store.DeleteMember(ctx, ws.ID, "grace"), workspace.ErrLastOwner)
+
+		require.NoError(t, store.DeleteMember(ctx, ws.ID, "ada"))
+		require.ErrorIs(t, store.DeleteMember(ctx, ws.ID, "ada"), common.ErrNotFound)
+
+		members, err := store.ListMembers(ctx, ws.ID)
+		require.NoError(t, err)
+		require.Len(t, members, 1)
+		assert.Equal(t, workspace.RoleOwner, members[0].Role)
+	})
+
+	t.Run("records the plan tier", func(t *testing.T) {
+		got, err := store.GetWorkspace(ctx, ws.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "pro", got.PlanTier)
+
+		require.NoError(t, store.UpdatePlanTier(ctx, ws.ID, "business"))
+
+		got, err = store.GetWorkspace(ctx, ws.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "business", got.PlanTier)
+	})
+}